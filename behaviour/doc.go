@@ -4,7 +4,7 @@ Package Behaviour provides a mechanism for reactors to report behaviour of peers
 Instead of a reactor calling the switch directly it will call the behaviour module which will
 handle the stoping and marking peer as good on behalf of the reactor.
 
-There are four different behaviours a reactor can report.
+There are nine different behaviours a reactor can report.
 
 1. bad message
 
@@ -37,5 +37,48 @@ There are four different behaviours a reactor can report.
 	}
 
 This message will request the peer be marked as good
+
+5. invalid vote
+
+	type invalidVote struct {
+		explanation string
+	}
+
+# This message will request the peer be stopped for an error
+
+6. bad proposal
+
+	type badProposal struct {
+		explanation string
+	}
+
+# This message will request the peer be stopped for an error
+
+7. invalid tx flood
+
+	type invalidTxFlood struct {
+		explanation string
+	}
+
+# This message will request the peer be stopped for an error
+
+8. invalid evidence
+
+	type invalidEvidence struct {
+		explanation string
+	}
+
+# This message will request the peer be stopped for an error
+
+9. bad chunk
+
+	type badChunk struct {
+		explanation string
+	}
+
+This message will request the peer be stopped for an error
+
+The SwitchReporter counts every reported behaviour by type in the
+PeerBehaviours metric.
 */
 package behaviour
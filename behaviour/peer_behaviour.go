@@ -47,3 +47,75 @@ type blockPart struct {
 func BlockPart(peerID p2p.ID, explanation string) PeerBehaviour {
 	return PeerBehaviour{peerID: peerID, reason: blockPart{explanation}}
 }
+
+type invalidVote struct {
+	explanation string
+}
+
+// InvalidVote returns an invalidVote PeerBehaviour.
+func InvalidVote(peerID p2p.ID, explanation string) PeerBehaviour {
+	return PeerBehaviour{peerID: peerID, reason: invalidVote{explanation}}
+}
+
+type badProposal struct {
+	explanation string
+}
+
+// BadProposal returns a badProposal PeerBehaviour.
+func BadProposal(peerID p2p.ID, explanation string) PeerBehaviour {
+	return PeerBehaviour{peerID: peerID, reason: badProposal{explanation}}
+}
+
+type invalidTxFlood struct {
+	explanation string
+}
+
+// InvalidTxFlood returns an invalidTxFlood PeerBehaviour.
+func InvalidTxFlood(peerID p2p.ID, explanation string) PeerBehaviour {
+	return PeerBehaviour{peerID: peerID, reason: invalidTxFlood{explanation}}
+}
+
+type invalidEvidence struct {
+	explanation string
+}
+
+// InvalidEvidence returns an invalidEvidence PeerBehaviour.
+func InvalidEvidence(peerID p2p.ID, explanation string) PeerBehaviour {
+	return PeerBehaviour{peerID: peerID, reason: invalidEvidence{explanation}}
+}
+
+type badChunk struct {
+	explanation string
+}
+
+// BadChunk returns a badChunk PeerBehaviour.
+func BadChunk(peerID p2p.ID, explanation string) PeerBehaviour {
+	return PeerBehaviour{peerID: peerID, reason: badChunk{explanation}}
+}
+
+// behaviourType returns the name of the reported behaviour, used as a
+// metrics label, and whether the reason is known.
+func behaviourType(reason interface{}) (string, bool) {
+	switch reason.(type) {
+	case badMessage:
+		return "bad_message", true
+	case messageOutOfOrder:
+		return "message_out_of_order", true
+	case consensusVote:
+		return "consensus_vote", true
+	case blockPart:
+		return "block_part", true
+	case invalidVote:
+		return "invalid_vote", true
+	case badProposal:
+		return "bad_proposal", true
+	case invalidTxFlood:
+		return "invalid_tx_flood", true
+	case invalidEvidence:
+		return "invalid_evidence", true
+	case badChunk:
+		return "bad_chunk", true
+	default:
+		return "", false
+	}
+}
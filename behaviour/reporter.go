@@ -14,19 +14,42 @@ type Reporter interface {
 }
 
 // SwitchReporter reports peer behaviour to an internal Switch.
+//
+// Good behaviours mark the peer as good, misbehaviours mark the peer as bad,
+// which lowers its trust score if the Switch keeps track of it, and stop it.
 type SwitchReporter struct {
-	sw *p2p.Switch
+	sw      *p2p.Switch
+	metrics *Metrics
+}
+
+// SwitchReporterOption sets an optional parameter on the SwitchReporter.
+type SwitchReporterOption func(*SwitchReporter)
+
+// WithMetrics sets the metrics.
+func WithMetrics(metrics *Metrics) SwitchReporterOption {
+	return func(spbr *SwitchReporter) { spbr.metrics = metrics }
 }
 
 // NewSwitchReporter return a new SwitchReporter instance which wraps the Switch.
-func NewSwitchReporter(sw *p2p.Switch) *SwitchReporter {
-	return &SwitchReporter{
-		sw: sw,
+func NewSwitchReporter(sw *p2p.Switch, options ...SwitchReporterOption) *SwitchReporter {
+	spbr := &SwitchReporter{
+		sw:      sw,
+		metrics: NopMetrics(),
 	}
+	for _, option := range options {
+		option(spbr)
+	}
+	return spbr
 }
 
 // Report reports the behaviour of a peer to the Switch.
 func (spbr *SwitchReporter) Report(behaviour PeerBehaviour) error {
+	name, ok := behaviourType(behaviour.reason)
+	if !ok {
+		return errors.New("unknown reason reported")
+	}
+	spbr.metrics.PeerBehaviours.With("behaviour", name).Add(1)
+
 	peer := spbr.sw.Peers().Get(behaviour.peerID)
 	if peer == nil {
 		return errors.New("peer not found")
@@ -36,16 +59,29 @@ func (spbr *SwitchReporter) Report(behaviour PeerBehaviour) error {
 	case consensusVote, blockPart:
 		spbr.sw.MarkPeerAsGood(peer)
 	case badMessage:
-		spbr.sw.StopPeerForError(peer, reason.explanation)
+		spbr.stopPeerForMisbehaviour(peer, reason.explanation)
 	case messageOutOfOrder:
-		spbr.sw.StopPeerForError(peer, reason.explanation)
-	default:
-		return errors.New("unknown reason reported")
+		spbr.stopPeerForMisbehaviour(peer, reason.explanation)
+	case invalidVote:
+		spbr.stopPeerForMisbehaviour(peer, reason.explanation)
+	case badProposal:
+		spbr.stopPeerForMisbehaviour(peer, reason.explanation)
+	case invalidTxFlood:
+		spbr.stopPeerForMisbehaviour(peer, reason.explanation)
+	case invalidEvidence:
+		spbr.stopPeerForMisbehaviour(peer, reason.explanation)
+	case badChunk:
+		spbr.stopPeerForMisbehaviour(peer, reason.explanation)
 	}
 
 	return nil
 }
 
+func (spbr *SwitchReporter) stopPeerForMisbehaviour(peer p2p.Peer, explanation string) {
+	spbr.sw.MarkPeerAsBad(peer)
+	spbr.sw.StopPeerForError(peer, explanation)
+}
+
 // MockReporter is a concrete implementation of the Reporter
 // interface used in reactor tests to ensure reactors report the correct
 // behaviour in manufactured scenarios.
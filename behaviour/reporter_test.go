@@ -1,10 +1,16 @@
 package behaviour_test
 
 import (
+	"strings"
 	"sync"
 	"testing"
 
+	"github.com/go-kit/kit/metrics"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
 	bh "github.com/Finschia/ostracon/behaviour"
+	"github.com/Finschia/ostracon/config"
 	"github.com/Finschia/ostracon/p2p"
 )
 
@@ -203,3 +209,60 @@ func TestMockPeerBehaviourReporterConcurrency(t *testing.T) {
 		}
 	}
 }
+
+// labelCounter is a metrics.Counter recording the added values per label
+// values.
+type labelCounter struct {
+	mtx    *sync.Mutex
+	labels []string
+	values map[string]float64
+}
+
+func newLabelCounter() *labelCounter {
+	return &labelCounter{mtx: &sync.Mutex{}, values: map[string]float64{}}
+}
+
+func (c *labelCounter) With(labelValues ...string) metrics.Counter {
+	return &labelCounter{mtx: c.mtx, labels: append(c.labels, labelValues...), values: c.values}
+}
+
+func (c *labelCounter) Add(delta float64) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	c.values[strings.Join(c.labels, ",")] += delta
+}
+
+// TestSwitchReporter tests that the SwitchReporter stops misbehaving peers
+// and counts the reported behaviours by type.
+func TestSwitchReporter(t *testing.T) {
+	sws := p2p.MakeConnectedSwitches(config.DefaultP2PConfig(), 2, func(i int, sw *p2p.Switch, _ *config.P2PConfig) *p2p.Switch {
+		return sw
+	}, p2p.Connect2Switches)
+	t.Cleanup(func() {
+		for _, sw := range sws {
+			if err := sw.Stop(); err != nil {
+				t.Error(err)
+			}
+		}
+	})
+	peerID := sws[1].NodeInfo().ID()
+	require.NotNil(t, sws[0].Peers().Get(peerID))
+
+	counter := newLabelCounter()
+	pr := bh.NewSwitchReporter(sws[0], bh.WithMetrics(&bh.Metrics{PeerBehaviours: counter}))
+
+	require.NoError(t, pr.Report(bh.ConsensusVote(peerID, "voted")))
+	require.NotNil(t, sws[0].Peers().Get(peerID))
+
+	require.NoError(t, pr.Report(bh.InvalidVote(peerID, "invalid vote")))
+	assert.Nil(t, sws[0].Peers().Get(peerID))
+
+	// the peer is gone, but the behaviour is counted anyway
+	assert.Error(t, pr.Report(bh.BadProposal(peerID, "bad proposal")))
+
+	assert.Equal(t, map[string]float64{
+		"behaviour,consensus_vote": 1,
+		"behaviour,invalid_vote":   1,
+		"behaviour,bad_proposal":   1,
+	}, counter.values)
+}
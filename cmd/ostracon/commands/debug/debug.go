@@ -12,10 +12,12 @@ var (
 	nodeRPCAddr string
 	profAddr    string
 	frequency   uint
+	rounds      int32
 
 	flagNodeRPCAddr = "rpc-laddr"
 	flagProfAddr    = "pprof-laddr"
 	flagFrequency   = "frequency"
+	flagRounds      = "rounds"
 
 	logger = log.NewOCLogger(log.NewSyncWriter(os.Stdout))
 )
@@ -38,4 +40,5 @@ func init() {
 
 	DebugCmd.AddCommand(killCmd)
 	DebugCmd.AddCommand(dumpCmd)
+	DebugCmd.AddCommand(proposersCmd)
 }
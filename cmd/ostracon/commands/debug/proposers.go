@@ -0,0 +1,82 @@
+package debug
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	dbm "github.com/tendermint/tm-db"
+
+	cfg "github.com/Finschia/ostracon/config"
+	"github.com/Finschia/ostracon/libs/cli"
+	tmjson "github.com/Finschia/ostracon/libs/json"
+	tmos "github.com/Finschia/ostracon/libs/os"
+	ctypes "github.com/Finschia/ostracon/rpc/core/types"
+	sm "github.com/Finschia/ostracon/state"
+)
+
+var proposersCmd = &cobra.Command{
+	Use:   "proposers",
+	Short: "Print the proposers elected for the next rounds from the local state",
+	Long: `Print the proposers elected for the first rounds of the next height. The
+election is computed from the last proof hash and the validator set found in
+the local state database, so the node must not be running.
+
+Example:
+$ ostracon debug proposers --rounds 5`,
+	Args: cobra.NoArgs,
+	RunE: proposersCmdHandler,
+}
+
+func init() {
+	proposersCmd.Flags().Int32Var(
+		&rounds,
+		flagRounds,
+		10,
+		"the number of rounds to elect the proposers for",
+	)
+}
+
+func proposersCmdHandler(_ *cobra.Command, _ []string) error {
+	if rounds <= 0 {
+		return errors.New("rounds must be positive")
+	}
+
+	home := viper.GetString(cli.HomeFlag)
+	conf := cfg.DefaultConfig()
+	conf = conf.SetRoot(home)
+
+	if !tmos.FileExists(filepath.Join(conf.DBDir(), "state.db")) {
+		return fmt.Errorf("no statestore found in %v", conf.DBDir())
+	}
+	stateDB, err := dbm.NewDB("state", dbm.BackendType(conf.DBBackend), conf.DBDir())
+	if err != nil {
+		return fmt.Errorf("failed to open the state database: %w", err)
+	}
+	stateStore := sm.NewStore(stateDB, sm.StoreOptions{
+		DiscardABCIResponses: conf.Storage.DiscardABCIResponses,
+	})
+	defer stateStore.Close()
+
+	state, err := stateStore.Load()
+	if err != nil {
+		return fmt.Errorf("failed to load the state: %w", err)
+	}
+	if state.IsEmpty() {
+		return errors.New("no state found")
+	}
+
+	height, proposers := state.UpcomingProposers(rounds)
+	bz, err := tmjson.MarshalIndent(&ctypes.ResultUpcomingProposers{
+		Height:    height,
+		Proposers: proposers,
+	}, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode the proposers: %w", err)
+	}
+
+	fmt.Println(string(bz))
+	return nil
+}
@@ -7,6 +7,7 @@ import (
 
 	tmjson "github.com/Finschia/ostracon/libs/json"
 	"github.com/Finschia/ostracon/privval"
+	"github.com/Finschia/ostracon/types"
 )
 
 // GenValidatorCmd allows the generation of a keypair for a
@@ -16,15 +17,26 @@ var GenValidatorCmd = &cobra.Command{
 	Aliases: []string{"gen_validator"},
 	Short:   "Generate new validator keypair",
 	PreRun:  deprecateSnakeCase,
-	Run:     genValidator,
+	RunE:    genValidator,
 }
 
-func genValidator(cmd *cobra.Command, args []string) {
-	pv := privval.GenFilePV("", "")
+var keyType string
+
+func init() {
+	GenValidatorCmd.Flags().StringVar(&keyType, "key-type", types.ABCIPubKeyTypeEd25519,
+		"private key type (ed25519 | secp256k1)")
+}
+
+func genValidator(cmd *cobra.Command, args []string) error {
+	pv, err := privval.GenFilePVWithKeyType("", "", keyType)
+	if err != nil {
+		return err
+	}
 	jsbz, err := tmjson.Marshal(pv)
 	if err != nil {
-		panic(err)
+		return err
 	}
 	fmt.Printf(`%v
 `, string(jsbz))
+	return nil
 }
@@ -62,6 +62,7 @@ var (
 	maxOpenConnections int
 
 	sequential     bool
+	verifyVRF      bool
 	trustingPeriod time.Duration
 	trustedHeight  int64
 	trustedHash    []byte
@@ -98,6 +99,9 @@ func init() {
 	LightCmd.Flags().BoolVar(&sequential, "sequential", false,
 		"sequential verification. Verify all headers sequentially as opposed to using skipping verification",
 	)
+	LightCmd.Flags().BoolVar(&verifyVRF, "vrf", false,
+		"VRF verification. Verify that every header was proposed by the validator elected via VRF",
+	)
 }
 
 func runProxy(cmd *cobra.Command, args []string) error {
@@ -172,6 +176,10 @@ func runProxy(cmd *cobra.Command, args []string) error {
 		options = append(options, light.SkippingVerification(trustLevel))
 	}
 
+	if verifyVRF {
+		options = append(options, light.VRFVerification())
+	}
+
 	var c *light.Client
 	if trustedHeight > 0 && len(trustedHash) > 0 { // fresh installation
 		c, err = light.NewHTTPClient(
@@ -3,6 +3,7 @@ package commands
 import (
 	"errors"
 	"fmt"
+	"path/filepath"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -16,6 +17,7 @@ import (
 	"github.com/Finschia/ostracon/state/indexer"
 	blockidxkv "github.com/Finschia/ostracon/state/indexer/block/kv"
 	"github.com/Finschia/ostracon/state/indexer/sink/psql"
+	"github.com/Finschia/ostracon/state/indexer/sink/sqlite"
 	"github.com/Finschia/ostracon/state/txindex"
 	"github.com/Finschia/ostracon/state/txindex/kv"
 	"github.com/Finschia/ostracon/types"
@@ -42,6 +44,10 @@ reindex from the base block height(inclusive); and the default end-height is 0,
 the tooling will reindex until the latest block height(inclusive). User can omit
 either or both arguments.
 
+With --replace, the events already indexed in the height interval are removed first, so
+that the index follows the index_keys and exclude_keys settings of the tx_index section after
+they changed. Run it over the whole interval to index again if it gets interrupted.
+
 Note: This operation requires ABCIResponses. Do not set DiscardABCIResponses to true if you
 want to use this command.
 	`,
@@ -50,6 +56,7 @@ want to use this command.
 	tendermint reindex-event --start-height 2
 	tendermint reindex-event --end-height 10
 	tendermint reindex-event --start-height 2 --end-height 10
+	tendermint reindex-event --replace
 	`,
 	Run: func(cmd *cobra.Command, args []string) {
 		bs, ss, err := loadStateAndBlockStore(config)
@@ -76,6 +83,7 @@ want to use this command.
 			txIndexer:    ti,
 			blockStore:   bs,
 			stateStore:   ss,
+			replace:      replace,
 		}
 		if err := eventReIndex(cmd, riArgs); err != nil {
 			panic(fmt.Errorf("%s: %w", reindexFailed, err))
@@ -88,14 +96,25 @@ want to use this command.
 var (
 	startHeight int64
 	endHeight   int64
+	replace     bool
 )
 
 func init() {
 	ReIndexEventCmd.Flags().Int64Var(&startHeight, "start-height", 0, "the block height would like to start for re-index")
 	ReIndexEventCmd.Flags().Int64Var(&endHeight, "end-height", 0, "the block height would like to finish for re-index")
+	ReIndexEventCmd.Flags().BoolVar(&replace, "replace", false,
+		"remove the events already indexed in the height interval before re-indexing them")
+}
+
+// heightDeleter is implemented by the indexers able to remove what they
+// indexed in a height interval, which --replace does before re-indexing it.
+type heightDeleter interface {
+	DeleteHeights(startHeight, endHeight int64) error
 }
 
 func loadEventSinks(cfg *tmcfg.Config) (indexer.BlockIndexer, txindex.TxIndexer, error) {
+	filter := indexer.NewKeyFilter(cfg.TxIndex.IndexKeys, cfg.TxIndex.ExcludeKeys)
+
 	switch strings.ToLower(cfg.TxIndex.Indexer) {
 	case "null":
 		return nil, nil, errors.New("found null event sink, please check the tx-index section in the config.toml")
@@ -104,7 +123,14 @@ func loadEventSinks(cfg *tmcfg.Config) (indexer.BlockIndexer, txindex.TxIndexer,
 		if conn == "" {
 			return nil, nil, errors.New("the psql connection settings cannot be empty")
 		}
-		es, err := psql.NewEventSink(conn, cfg.ChainID())
+		es, err := psql.NewEventSink(conn, cfg.ChainID(), psql.WithKeyFilter(filter))
+		if err != nil {
+			return nil, nil, err
+		}
+		return es.BlockIndexer(), es.TxIndexer(), nil
+	case "sqlite":
+		es, err := sqlite.NewEventSink(filepath.Join(cfg.DBDir(), sqlite.DBFileName), cfg.ChainID(),
+			sqlite.WithKeyFilter(filter))
 		if err != nil {
 			return nil, nil, err
 		}
@@ -115,8 +141,8 @@ func loadEventSinks(cfg *tmcfg.Config) (indexer.BlockIndexer, txindex.TxIndexer,
 			return nil, nil, err
 		}
 
-		txIndexer := kv.NewTxIndex(store)
-		blockIndexer := blockidxkv.New(dbm.NewPrefixDB(store, []byte("block_events")))
+		txIndexer := kv.NewTxIndex(store, kv.WithKeyFilter(filter))
+		blockIndexer := blockidxkv.New(dbm.NewPrefixDB(store, []byte("block_events")), blockidxkv.WithKeyFilter(filter))
 		return blockIndexer, txIndexer, nil
 	default:
 		return nil, nil, fmt.Errorf("unsupported event sink type: %s", cfg.TxIndex.Indexer)
@@ -130,9 +156,16 @@ type eventReIndexArgs struct {
 	txIndexer    txindex.TxIndexer
 	blockStore   state.BlockStore
 	stateStore   state.Store
+	replace      bool
 }
 
 func eventReIndex(cmd *cobra.Command, args eventReIndexArgs) error {
+	if args.replace {
+		if err := deleteIndexedHeights(args); err != nil {
+			return err
+		}
+	}
+
 	var bar progressbar.Bar
 	bar.NewOption(args.startHeight-1, args.endHeight)
 
@@ -193,6 +226,24 @@ func eventReIndex(cmd *cobra.Command, args eventReIndexArgs) error {
 	return nil
 }
 
+// deleteIndexedHeights removes the txs and then the blocks indexed in the
+// height interval.
+func deleteIndexedHeights(args eventReIndexArgs) error {
+	for _, idx := range []interface{}{args.txIndexer, args.blockIndexer} {
+		deleter, ok := idx.(heightDeleter)
+		if !ok {
+			return fmt.Errorf("the %T indexer can't remove the indexed events", idx)
+		}
+		if err := deleter.DeleteHeights(args.startHeight, args.endHeight); err != nil {
+			return fmt.Errorf("removing the events indexed from height %d to %d: %w",
+				args.startHeight, args.endHeight, err)
+		}
+	}
+
+	fmt.Printf("removed the events indexed from height %d to %d\n", args.startHeight, args.endHeight)
+	return nil
+}
+
 func checkValidHeight(bs state.BlockStore) error {
 	base := bs.Base()
 
@@ -15,8 +15,12 @@ import (
 	prototmstate "github.com/tendermint/tendermint/proto/tendermint/state"
 
 	tmcfg "github.com/Finschia/ostracon/config"
+	"github.com/Finschia/ostracon/libs/pubsub/query"
+	"github.com/Finschia/ostracon/state/indexer"
+	blockidxkv "github.com/Finschia/ostracon/state/indexer/block/kv"
 	blockmocks "github.com/Finschia/ostracon/state/indexer/mocks"
 	"github.com/Finschia/ostracon/state/mocks"
+	"github.com/Finschia/ostracon/state/txindex/kv"
 	txmocks "github.com/Finschia/ostracon/state/txindex/mocks"
 	"github.com/Finschia/ostracon/types"
 )
@@ -91,12 +95,14 @@ func TestLoadEventSink(t *testing.T) {
 		{"NULL", "", true},
 		{"KV", "", false},
 		{"PSQL", "", true}, // true because empty connect url
+		{"SQLITE", "", false},
 		// skip to test PSQL connect with correct url
 		{"UnsupportedSinkType", "wrongUrl", true},
 	}
 
 	for idx, tc := range testCases {
 		cfg := tmcfg.TestConfig()
+		cfg.SetRoot(t.TempDir())
 		cfg.TxIndex.Indexer = tc.sinks
 		cfg.TxIndex.PsqlConn = tc.connURL
 		_, _, err := loadEventSinks(cfg)
@@ -192,3 +198,85 @@ func TestReIndexEvent(t *testing.T) {
 		}
 	}
 }
+
+func TestReIndexEventReplace(t *testing.T) {
+	mockBlockStore := &mocks.BlockStore{}
+	mockStateStore := &mocks.Store{}
+
+	tx := types.Tx("HELLO WORLD")
+	mockBlockStore.On("LoadBlock", base).Return(&types.Block{
+		Header: types.Header{Height: base},
+		Data:   types.Data{Txs: types.Txs{tx}},
+	})
+
+	events := []abcitypes.Event{{
+		Type: "transfer",
+		Attributes: []abcitypes.EventAttribute{
+			{Key: []byte("sender"), Value: []byte("alice"), Index: true},
+			{Key: []byte("memo"), Value: []byte("hello"), Index: true},
+		},
+	}}
+	mockStateStore.On("LoadABCIResponses", base).Return(&prototmstate.ABCIResponses{
+		DeliverTxs: []*abcitypes.ResponseDeliverTx{{Events: events}},
+		EndBlock:   &abcitypes.ResponseEndBlock{},
+		BeginBlock: &abcitypes.ResponseBeginBlock{Events: events},
+	}, nil)
+
+	store := dbm.NewMemDB()
+	reIndex := func(filter *indexer.KeyFilter, replace bool) (*kv.TxIndex, *blockidxkv.BlockerIndexer) {
+		txIndexer := kv.NewTxIndex(store, kv.WithKeyFilter(filter))
+		blockIndexer := blockidxkv.New(dbm.NewPrefixDB(store, []byte("block_events")), blockidxkv.WithKeyFilter(filter))
+		args := eventReIndexArgs{
+			startHeight:  base,
+			endHeight:    base,
+			blockIndexer: blockIndexer,
+			txIndexer:    txIndexer,
+			blockStore:   mockBlockStore,
+			stateStore:   mockStateStore,
+			replace:      replace,
+		}
+		require.NoError(t, eventReIndex(setupReIndexEventCmd(), args))
+		return txIndexer, blockIndexer
+	}
+	search := func(txIndexer *kv.TxIndex, blockIndexer *blockidxkv.BlockerIndexer, q string) (int, int) {
+		txs, err := txIndexer.Search(context.Background(), query.MustParse(q))
+		require.NoError(t, err)
+		heights, err := blockIndexer.Search(context.Background(), query.MustParse(q))
+		require.NoError(t, err)
+		return len(txs), len(heights)
+	}
+
+	txIndexer, blockIndexer := reIndex(nil, false)
+	txs, blocks := search(txIndexer, blockIndexer, "transfer.memo = 'hello'")
+	require.Equal(t, 1, txs)
+	require.Equal(t, 1, blocks)
+
+	// without --replace, the excluded events stay in the index
+	txIndexer, blockIndexer = reIndex(indexer.NewKeyFilter(nil, []string{"*.memo"}), false)
+	txs, blocks = search(txIndexer, blockIndexer, "transfer.memo = 'hello'")
+	require.Equal(t, 1, txs)
+	require.Equal(t, 1, blocks)
+
+	txIndexer, blockIndexer = reIndex(indexer.NewKeyFilter(nil, []string{"*.memo"}), true)
+	txs, blocks = search(txIndexer, blockIndexer, "transfer.memo = 'hello'")
+	require.Equal(t, 0, txs)
+	require.Equal(t, 0, blocks)
+	txs, blocks = search(txIndexer, blockIndexer, "transfer.sender = 'alice'")
+	require.Equal(t, 1, txs)
+	require.Equal(t, 1, blocks)
+
+	got, err := txIndexer.Get(tx.Hash())
+	require.NoError(t, err)
+	require.NotNil(t, got)
+}
+
+func TestReIndexEventReplaceUnsupported(t *testing.T) {
+	args := eventReIndexArgs{
+		startHeight:  base,
+		endHeight:    height,
+		blockIndexer: &blockmocks.BlockIndexer{},
+		txIndexer:    &txmocks.TxIndexer{},
+		replace:      true,
+	}
+	require.Error(t, eventReIndex(setupReIndexEventCmd(), args))
+}
@@ -0,0 +1,238 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	dbm "github.com/tendermint/tm-db"
+
+	abcitypes "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/Finschia/ostracon/light"
+	"github.com/Finschia/ostracon/proxy"
+	sm "github.com/Finschia/ostracon/state"
+	"github.com/Finschia/ostracon/statesync"
+	"github.com/Finschia/ostracon/store"
+	"github.com/Finschia/ostracon/types"
+)
+
+var (
+	archivePath    string
+	snapshotHeight uint64
+)
+
+// StateSyncCmd groups the commands restoring and exporting state sync snapshots offline.
+var StateSyncCmd = &cobra.Command{
+	Use:   "statesync",
+	Short: "Restore or export state sync snapshots offline",
+}
+
+// StateSyncRestoreCmd restores a snapshot archive into the app and bootstraps the node with it.
+var StateSyncRestoreCmd = &cobra.Command{
+	Use:   "restore",
+	Short: "Restore a snapshot archive into the app and bootstrap the node with it",
+	Long: `
+restore state syncs the node from a snapshot archive written by "statesync export", instead
+of fetching the snapshot from peers and the light blocks from RPC servers, e.g. for disaster
+recovery or air-gapped validators. The chunks of the snapshot are applied to the app, which
+must be running and empty, and the state and block stores of the node, which must not have
+synced yet, are bootstrapped at the snapshot height. The node then starts from there.
+
+The light blocks of the archive are verified from the trusted one given by trust_height,
+trust_hash and trust_period of the statesync section, which must be among them: the archive
+holds the light blocks from the snapshot height - 1 to the snapshot height + 2.
+`,
+	Example: `
+	ostracon statesync restore --archive snapshot.tar
+	ostracon statesync restore --archive snapshot.tar --statesync.trust_height 1000 --statesync.trust_hash 0A1B...
+	`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		height, err := restoreStateSync()
+		if err != nil {
+			return fmt.Errorf("failed to restore snapshot: %w", err)
+		}
+
+		fmt.Printf("Restored snapshot at height %d\n", height)
+		return nil
+	},
+}
+
+// StateSyncExportCmd exports a snapshot of the app to an archive.
+var StateSyncExportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Export a snapshot of the app to an archive",
+	Long: `
+export writes a snapshot of the app, loaded through ListSnapshots and LoadSnapshotChunk, to
+an archive that "statesync restore" restores offline. The archive also holds the light blocks,
+blocks and consensus params needed to bootstrap a node at the snapshot height, loaded from the
+stores of the node, which must be stopped while the app is running. The default height is 0,
+meaning the latest snapshot whose height + 2 is in the block store.
+`,
+	Example: `
+	ostracon statesync export --archive snapshot.tar
+	ostracon statesync export --archive snapshot.tar --height 1000
+	`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		snapshot, err := exportStateSync()
+		if err != nil {
+			return fmt.Errorf("failed to export snapshot: %w", err)
+		}
+
+		fmt.Printf("Exported snapshot at height %d, format %d with %d chunks to %s\n",
+			snapshot.Height, snapshot.Format, snapshot.Chunks, archivePath)
+		return nil
+	},
+}
+
+func init() {
+	StateSyncCmd.AddCommand(StateSyncRestoreCmd, StateSyncExportCmd)
+
+	for _, cmd := range []*cobra.Command{StateSyncRestoreCmd, StateSyncExportCmd} {
+		cmd.Flags().StringVar(&archivePath, "archive", "", "path of the snapshot archive")
+		_ = cmd.MarkFlagRequired("archive")
+		cmd.Flags().String("proxy_app", config.ProxyApp, "proxy app address, or one of: 'kvstore',"+
+			" 'persistent_kvstore', 'counter', 'e2e' or 'noop' for local testing.")
+		cmd.Flags().String("abci", config.ABCI, "specify abci transport (socket | grpc)")
+	}
+
+	StateSyncRestoreCmd.Flags().Int64("statesync.trust_height", config.StateSync.TrustHeight,
+		"height of the trusted light block")
+	StateSyncRestoreCmd.Flags().String("statesync.trust_hash", config.StateSync.TrustHash,
+		"hash of the trusted light block, in hex")
+	StateSyncRestoreCmd.Flags().Duration("statesync.trust_period", config.StateSync.TrustPeriod,
+		"trust period of the trusted light block")
+
+	StateSyncExportCmd.Flags().Uint64Var(&snapshotHeight, "height", 0, "height of the snapshot to export")
+}
+
+// restoreStateSync restores the archive and bootstraps the stores, as the node does after state
+// syncing. It returns the height of the snapshot.
+func restoreStateSync() (int64, error) {
+	blockStore, stateStore, err := openStateAndBlockStore()
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		_ = blockStore.Close()
+		_ = stateStore.Close()
+	}()
+
+	genDoc, err := types.GenesisDocFromFile(config.GenesisFile())
+	if err != nil {
+		return 0, err
+	}
+	state, err := stateStore.LoadFromDBOrGenesisDoc(genDoc)
+	if err != nil {
+		return 0, err
+	}
+	if state.LastBlockHeight > 0 || blockStore.Height() > 0 {
+		return 0, fmt.Errorf("the node already has a state at height %d", state.LastBlockHeight)
+	}
+
+	f, err := os.Open(archivePath)
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+
+	proxyApp, err := startProxyApp()
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		_ = proxyApp.Stop()
+	}()
+
+	newState, previousState, commit, err := statesync.RestoreArchive(f, *config.StateSync,
+		logger.With("module", "statesync"), proxyApp.Snapshot(), proxyApp.Query(), state,
+		light.TrustOptions{
+			Period: config.StateSync.TrustPeriod,
+			Height: config.StateSync.TrustHeight,
+			Hash:   config.StateSync.TrustHashBytes(),
+		})
+	if err != nil {
+		return 0, err
+	}
+
+	if previousState.LastBlockHeight > 0 {
+		if err := stateStore.Bootstrap(previousState); err != nil {
+			return 0, fmt.Errorf("failed to bootstrap node with previous state: %w", err)
+		}
+	}
+	if err := stateStore.Bootstrap(newState); err != nil {
+		return 0, fmt.Errorf("failed to bootstrap node with new state: %w", err)
+	}
+	if err := blockStore.SaveSeenCommit(newState.LastBlockHeight, commit); err != nil {
+		return 0, fmt.Errorf("failed to store last seen commit: %w", err)
+	}
+
+	return newState.LastBlockHeight, nil
+}
+
+// exportStateSync exports a snapshot of the app, with the data of the stores, to the archive.
+func exportStateSync() (*abcitypes.Snapshot, error) {
+	blockStore, stateStore, err := loadStateAndBlockStore(config)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = blockStore.Close()
+		_ = stateStore.Close()
+	}()
+
+	proxyApp, err := startProxyApp()
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = proxyApp.Stop()
+	}()
+
+	f, err := os.OpenFile(archivePath, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600)
+	if err != nil {
+		return nil, err
+	}
+	snapshot, err := statesync.ExportArchive(f, proxyApp.Snapshot(), stateStore, blockStore, snapshotHeight)
+	if cErr := f.Close(); err == nil {
+		err = cErr
+	}
+	if err != nil {
+		_ = os.Remove(archivePath)
+		return nil, err
+	}
+	return snapshot, nil
+}
+
+// openStateAndBlockStore opens the block and state stores, creating them if they do not exist
+// yet, unlike loadStateAndBlockStore.
+func openStateAndBlockStore() (*store.BlockStore, sm.Store, error) {
+	dbType := dbm.BackendType(config.DBBackend)
+
+	blockStoreDB, err := dbm.NewDB("blockstore", dbType, config.DBDir())
+	if err != nil {
+		return nil, nil, err
+	}
+	stateDB, err := dbm.NewDB("state", dbType, config.DBDir())
+	if err != nil {
+		_ = blockStoreDB.Close()
+		return nil, nil, err
+	}
+
+	return store.NewBlockStore(blockStoreDB), sm.NewStore(stateDB, sm.StoreOptions{
+		DiscardABCIResponses: config.Storage.DiscardABCIResponses,
+	}), nil
+}
+
+func startProxyApp() (proxy.AppConns, error) {
+	if config.ProxyApp == "" {
+		return nil, errors.New("no proxy_app set")
+	}
+	proxyApp := proxy.NewAppConns(proxy.DefaultClientCreator(config.ProxyApp, config.ABCI, config.DBDir()))
+	proxyApp.SetLogger(logger.With("module", "proxy"))
+	if err := proxyApp.Start(); err != nil {
+		return nil, fmt.Errorf("error starting proxy app connections: %w", err)
+	}
+	return proxyApp, nil
+}
@@ -2,23 +2,32 @@ package main
 
 import (
 	"flag"
+	"net"
 	"os"
 	"time"
 
+	"google.golang.org/grpc"
+
 	"github.com/Finschia/ostracon/crypto/ed25519"
 	"github.com/Finschia/ostracon/libs/log"
 	tmnet "github.com/Finschia/ostracon/libs/net"
 	tmos "github.com/Finschia/ostracon/libs/os"
 
 	"github.com/Finschia/ostracon/privval"
+	grpcprivval "github.com/Finschia/ostracon/privval/grpc"
+	"github.com/Finschia/ostracon/types"
 )
 
 func main() {
 	var (
-		addr             = flag.String("addr", ":26659", "Address of client to connect to")
+		addr             = flag.String("addr", ":26659", "Address of client to connect to, or grpc:// address to listen on")
 		chainID          = flag.String("chain-id", "mychain", "chain id")
 		privValKeyPath   = flag.String("priv-key", "", "priv val key file path")
 		privValStatePath = flag.String("priv-state", "", "priv val state file path")
+		signerStatePath  = flag.String("signer-state", "", "last sign state file path of the gRPC signer")
+		certFile         = flag.String("cert", "", "absolute path to the server certificate (gRPC only)")
+		keyFile          = flag.String("key", "", "absolute path to the server key (gRPC only)")
+		rootCA           = flag.String("root-ca", "", "absolute path to the root CA certificate of clients (gRPC only)")
 
 		logger = log.NewOCLogger(
 			log.NewSyncWriter(os.Stdout),
@@ -36,8 +45,13 @@ func main() {
 
 	pv := privval.LoadFilePV(*privValKeyPath, *privValStatePath)
 
-	var dialer privval.SocketDialer
 	protocol, address := tmnet.ProtocolAndAddress(*addr)
+	if protocol == "grpc" {
+		serveGRPC(logger, address, *chainID, pv, *signerStatePath, *certFile, *keyFile, *rootCA)
+		return
+	}
+
+	var dialer privval.SocketDialer
 	switch protocol {
 	case "unix":
 		dialer = privval.DialUnixFn(address)
@@ -68,3 +82,52 @@ func main() {
 	// Run forever.
 	select {}
 }
+
+// serveGRPC serves the gRPC privval protocol on the address until it receives
+// SIGTERM or CTRL-C.
+func serveGRPC(
+	logger log.Logger,
+	address, chainID string,
+	pv types.PrivValidator,
+	signerStatePath, certFile, keyFile, rootCA string,
+) {
+	if signerStatePath == "" {
+		logger.Error("The gRPC signer needs a last sign state file path")
+		os.Exit(1)
+	}
+	lastSignState, err := privval.LoadOrGenFilePVLastSignState(signerStatePath)
+	if err != nil {
+		logger.Error("Failed to load the last sign state", "err", err)
+		os.Exit(1)
+	}
+
+	var opts []grpc.ServerOption
+	if certFile != "" && keyFile != "" && rootCA != "" {
+		creds, err := grpcprivval.ServerTLSCredentials(certFile, keyFile, rootCA)
+		if err != nil {
+			logger.Error("Failed to load the TLS credentials", "err", err)
+			os.Exit(1)
+		}
+		opts = append(opts, grpc.Creds(creds))
+	} else {
+		logger.Info("Serving without TLS")
+	}
+
+	lis, err := net.Listen("tcp", address)
+	if err != nil {
+		logger.Error("Failed to listen", "addr", address, "err", err)
+		os.Exit(1)
+	}
+
+	s := grpc.NewServer(opts...)
+	grpcprivval.NewSignerServer(chainID, pv, lastSignState, logger).Register(s)
+
+	// Stop upon receiving SIGTERM or CTRL-C.
+	tmos.TrapSignal(logger, func() {
+		s.GracefulStop()
+	})
+
+	if err := s.Serve(lis); err != nil {
+		panic(err)
+	}
+}
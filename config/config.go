@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"os"
 	"path/filepath"
+	"strings"
 	"time"
 )
 
@@ -150,6 +151,9 @@ func (cfg *Config) ValidateBasic() error {
 	if err := cfg.Consensus.ValidateBasic(); err != nil {
 		return fmt.Errorf("error in [consensus] section: %w", err)
 	}
+	if err := cfg.TxIndex.ValidateBasic(); err != nil {
+		return fmt.Errorf("error in [tx_index] section: %w", err)
+	}
 	if err := cfg.Instrumentation.ValidateBasic(); err != nil {
 		return fmt.Errorf("error in [instrumentation] section: %w", err)
 	}
@@ -241,10 +245,18 @@ type BaseConfig struct { //nolint: maligned
 	PrivValidatorState string `mapstructure:"priv_validator_state_file"`
 
 	// TCP or UNIX socket address for Ostracon to listen on for
-	// connections from an external PrivValidator process
+	// connections from an external PrivValidator process,
+	// or gRPC address of an external PrivValidator process to connect to
 	// example) tcp://0.0.0.0:26659
+	// example) grpc://127.0.0.1:26659
 	PrivValidatorListenAddr string `mapstructure:"priv_validator_laddr"`
 
+	// Client certificate, client key and root CA certificate files for
+	// mutual TLS with a gRPC PrivValidator process
+	PrivValidatorClientCertificate string `mapstructure:"priv_validator_client_certificate_file"`
+	PrivValidatorClientKey         string `mapstructure:"priv_validator_client_key_file"`
+	PrivValidatorRootCA            string `mapstructure:"priv_validator_root_ca_file"`
+
 	// Validator's remote addresses to allow a connection
 	// List of addresses in TOML array format to allow
 	// ostracon only allows a connection from these listed addresses
@@ -315,6 +327,32 @@ func (cfg BaseConfig) PrivValidatorStateFile() string {
 	return rootify(cfg.PrivValidatorState, cfg.RootDir)
 }
 
+// PrivValidatorClientCertificateFile returns the full path to the client
+// certificate used to connect to a gRPC PrivValidator
+func (cfg BaseConfig) PrivValidatorClientCertificateFile() string {
+	return rootify(cfg.PrivValidatorClientCertificate, cfg.RootDir)
+}
+
+// PrivValidatorClientKeyFile returns the full path to the client key used to
+// connect to a gRPC PrivValidator
+func (cfg BaseConfig) PrivValidatorClientKeyFile() string {
+	return rootify(cfg.PrivValidatorClientKey, cfg.RootDir)
+}
+
+// PrivValidatorRootCAFile returns the full path to the root CA certificate
+// of a gRPC PrivValidator
+func (cfg BaseConfig) PrivValidatorRootCAFile() string {
+	return rootify(cfg.PrivValidatorRootCA, cfg.RootDir)
+}
+
+// ArePrivValidatorClientSecurityOptionsPresent returns true if the files for
+// mutual TLS with a gRPC PrivValidator are all set
+func (cfg BaseConfig) ArePrivValidatorClientSecurityOptionsPresent() bool {
+	return cfg.PrivValidatorClientCertificate != "" &&
+		cfg.PrivValidatorClientKey != "" &&
+		cfg.PrivValidatorRootCA != ""
+}
+
 // NodeKeyFile returns the full path to the node_key.json file
 func (cfg BaseConfig) NodeKeyFile() string {
 	return rootify(cfg.NodeKey, cfg.RootDir)
@@ -333,6 +371,11 @@ func (cfg BaseConfig) ValidateBasic() error {
 	default:
 		return errors.New("unknown log_format (must be 'plain' or 'json')")
 	}
+	if (cfg.PrivValidatorClientCertificate != "" || cfg.PrivValidatorClientKey != "" || cfg.PrivValidatorRootCA != "") &&
+		!cfg.ArePrivValidatorClientSecurityOptionsPresent() {
+		return errors.New("priv_validator_client_certificate_file, priv_validator_client_key_file and " +
+			"priv_validator_root_ca_file must be set together")
+	}
 	return nil
 }
 
@@ -365,7 +408,9 @@ type RPCConfig struct {
 	CORSAllowedHeaders []string `mapstructure:"cors_allowed_headers"`
 
 	// TCP or UNIX socket address for the gRPC server to listen on
-	// NOTE: This server only supports /broadcast_tx_commit
+	// NOTE: The calls are subject to the same roles and rate limits as the
+	// RPC server ones. The callers authenticate with the bearer token of their
+	// "authorization" metadata: TLS client certificates are not supported.
 	GRPCListenAddress string `mapstructure:"grpc_laddr"`
 
 	// Maximum number of simultaneous connections.
@@ -444,6 +489,11 @@ type RPCConfig struct {
 	// predictability in subscription behaviour.
 	CloseOnSlowClient bool `mapstructure:"experimental_close_on_slow_client"`
 
+	// Maximum number of committed blocks whose events can be replayed by
+	// /subscribe with from_height, counting back from the latest one.
+	// 0 means no limit.
+	MaxReplayHeights int64 `mapstructure:"max_replay_heights"`
+
 	// How long to wait for a tx to be committed during /broadcast_tx_commit
 	// WARNING: Using a value larger than 'WriteTimeout' will result in increasing the
 	// global HTTP write timeout, which applies to all connections and endpoints.
@@ -477,6 +527,46 @@ type RPCConfig struct {
 	// Otherwise, HTTP server is run.
 	TLSKeyFile string `mapstructure:"tls_key_file"`
 
+	// The path to a file containing the CA certificates the TLS client
+	// certificates are verified against (mTLS). The verified clients are
+	// authenticated by their certificate.
+	// Might be either absolute path or path related to Ostracon's config directory.
+	//
+	// NOTE: it only applies to the HTTPS server, see tls_cert_file.
+	TLSClientCAFile string `mapstructure:"tls_client_ca_file"`
+
+	// A list of the common names of the TLS client certificates granted the
+	// admin role. The other verified client certificates are granted the user role.
+	TLSAdminCommonNames []string `mapstructure:"tls_admin_common_names"`
+
+	// A list of bearer tokens granting the admin role, which is required to
+	// call the unsafe routes. If any token or client CA is set, the unsafe
+	// routes are only available to the admins.
+	AdminTokens []string `mapstructure:"admin_tokens"`
+
+	// A list of bearer tokens granting the user role. The users are rate
+	// limited per token instead of per IP.
+	UserTokens []string `mapstructure:"user_tokens"`
+
+	// Maximum number of calls per second from each IP, for the callers
+	// without credentials. Over HTTP and websocket alike, each request of a
+	// batch counts as a call.
+	// 0 - unlimited.
+	RateLimitPerIP float64 `mapstructure:"rate_limit_per_ip"`
+
+	// Maximum number of calls in a burst from each IP. It defaults to
+	// rate_limit_per_ip.
+	RateLimitBurstPerIP int `mapstructure:"rate_limit_burst_per_ip"`
+
+	// Maximum number of calls per second from each authenticated caller,
+	// whether by token or by client certificate.
+	// 0 - unlimited.
+	RateLimitPerToken float64 `mapstructure:"rate_limit_per_token"`
+
+	// Maximum number of calls in a burst from each authenticated caller. It
+	// defaults to rate_limit_per_token.
+	RateLimitBurstPerToken int `mapstructure:"rate_limit_burst_per_token"`
+
 	// pprof listen address (https://golang.org/pkg/net/http/pprof)
 	PprofListenAddress string `mapstructure:"pprof_laddr"`
 }
@@ -502,13 +592,24 @@ func DefaultRPCConfig() *RPCConfig {
 		SubscriptionBufferSize:    defaultSubscriptionBufferSize,
 		TimeoutBroadcastTxCommit:  10 * time.Second,
 		WebSocketWriteBufferSize:  defaultSubscriptionBufferSize,
+		MaxReplayHeights:          1000,
 
 		MaxBodyBytes:       int64(1000000), // 1MB
 		MaxBatchRequestNum: 10,
 		MaxHeaderBytes:     1 << 20, // same as the net/http default
 
-		TLSCertFile: "",
-		TLSKeyFile:  "",
+		TLSCertFile:         "",
+		TLSKeyFile:          "",
+		TLSClientCAFile:     "",
+		TLSAdminCommonNames: []string{},
+
+		AdminTokens: []string{},
+		UserTokens:  []string{},
+
+		RateLimitPerIP:         0, // unlimited
+		RateLimitBurstPerIP:    0,
+		RateLimitPerToken:      0, // unlimited
+		RateLimitBurstPerToken: 0,
 	}
 }
 
@@ -557,6 +658,9 @@ func (cfg *RPCConfig) ValidateBasic() error {
 			cfg.SubscriptionBufferSize,
 		)
 	}
+	if cfg.MaxReplayHeights < 0 {
+		return errors.New("max_replay_heights can't be negative")
+	}
 	if cfg.TimeoutBroadcastTxCommit < 0 {
 		return errors.New("timeout_broadcast_tx_commit can't be negative")
 	}
@@ -569,6 +673,26 @@ func (cfg *RPCConfig) ValidateBasic() error {
 	if cfg.MaxHeaderBytes < 0 {
 		return errors.New("max_header_bytes can't be negative")
 	}
+	for _, token := range append(cfg.AdminTokens, cfg.UserTokens...) {
+		if token == "" {
+			return errors.New("admin_tokens and user_tokens can't contain empty tokens")
+		}
+	}
+	if cfg.TLSClientCAFile != "" && !cfg.IsTLSEnabled() {
+		return errors.New("tls_client_ca_file requires tls_cert_file and tls_key_file")
+	}
+	if cfg.RateLimitPerIP < 0 {
+		return errors.New("rate_limit_per_ip can't be negative")
+	}
+	if cfg.RateLimitBurstPerIP < 0 {
+		return errors.New("rate_limit_burst_per_ip can't be negative")
+	}
+	if cfg.RateLimitPerToken < 0 {
+		return errors.New("rate_limit_per_token can't be negative")
+	}
+	if cfg.RateLimitBurstPerToken < 0 {
+		return errors.New("rate_limit_burst_per_token can't be negative")
+	}
 	return nil
 }
 
@@ -597,6 +721,26 @@ func (cfg RPCConfig) IsTLSEnabled() bool {
 	return cfg.TLSCertFile != "" && cfg.TLSKeyFile != ""
 }
 
+func (cfg RPCConfig) ClientCAFile() string {
+	path := cfg.TLSClientCAFile
+	if path == "" || filepath.IsAbs(path) {
+		return path
+	}
+	return rootify(filepath.Join(defaultConfigDir, path), cfg.RootDir)
+}
+
+// IsAuthEnabled returns true if the callers may authenticate, by token or by
+// TLS client certificate.
+func (cfg RPCConfig) IsAuthEnabled() bool {
+	return len(cfg.AdminTokens) != 0 || len(cfg.UserTokens) != 0 || cfg.TLSClientCAFile != ""
+}
+
+// IsAccessControlEnabled returns true if the callers are authenticated or
+// rate limited.
+func (cfg RPCConfig) IsAccessControlEnabled() bool {
+	return cfg.IsAuthEnabled() || cfg.RateLimitPerIP > 0 || cfg.RateLimitPerToken > 0
+}
+
 //-----------------------------------------------------------------------------
 // P2PConfig
 
@@ -835,6 +979,13 @@ type MempoolConfig struct {
 	// has existed in the mempool at least TTLNumBlocks number of blocks or if
 	// it's insertion time into the mempool is beyond TTLDuration.
 	TTLNumBlocks int64 `mapstructure:"ttl-num-blocks"`
+
+	// MaxConsecutiveInvalidTxs is the number of invalid txs in a row after
+	// which a peer is reported for flooding us with invalid txs. Only the txs
+	// which are invalid regardless of the state of the app are counted, i.e.
+	// too large or failing the precheck, not the txs rejected by the app's
+	// CheckTx. 0 disables the reports.
+	MaxConsecutiveInvalidTxs int `mapstructure:"max_consecutive_invalid_txs"`
 }
 
 // DefaultMempoolConfig returns a default configuration for the Ostracon mempool
@@ -852,6 +1003,8 @@ func DefaultMempoolConfig() *MempoolConfig {
 		MaxTxBytes:   1024 * 1024, // 1MB
 		TTLDuration:  0 * time.Second,
 		TTLNumBlocks: 0,
+
+		MaxConsecutiveInvalidTxs: 100,
 	}
 }
 
@@ -887,6 +1040,9 @@ func (cfg *MempoolConfig) ValidateBasic() error {
 	if cfg.MaxTxBytes < 0 {
 		return errors.New("max_tx_bytes can't be negative")
 	}
+	if cfg.MaxConsecutiveInvalidTxs < 0 {
+		return errors.New("max_consecutive_invalid_txs can't be negative")
+	}
 	return nil
 }
 
@@ -904,6 +1060,13 @@ type StateSyncConfig struct {
 	DiscoveryTime       time.Duration `mapstructure:"discovery_time"`
 	ChunkRequestTimeout time.Duration `mapstructure:"chunk_request_timeout"`
 	ChunkFetchers       int32         `mapstructure:"chunk_fetchers"`
+
+	// If true, the progress of a snapshot restoration is persisted, and resumed
+	// if the node restarts before it completes and the app reports the
+	// "statesync:resumable" token in the data of its Info response: the app is
+	// offered the snapshot again, and given the chunks following the ones it
+	// applied.
+	ResumeRestoration bool `mapstructure:"resume_restoration"`
 }
 
 func (cfg *StateSyncConfig) TrustHashBytes() []byte {
@@ -933,11 +1096,8 @@ func TestStateSyncConfig() *StateSyncConfig {
 // ValidateBasic performs basic validation.
 func (cfg *StateSyncConfig) ValidateBasic() error {
 	if cfg.Enable {
-		if len(cfg.RPCServers) == 0 {
-			return errors.New("rpc_servers is required")
-		}
-
-		if len(cfg.RPCServers) < 2 {
+		// Without rpc_servers, the light blocks are fetched from peers.
+		if len(cfg.RPCServers) == 1 {
 			return errors.New("at least two rpc_servers entries is required")
 		}
 
@@ -1056,6 +1216,11 @@ type ConsensusConfig struct {
 	PeerGossipSleepDuration     time.Duration `mapstructure:"peer_gossip_sleep_duration"`
 	PeerQueryMaj23SleepDuration time.Duration `mapstructure:"peer_query_maj23_sleep_duration"`
 
+	// If true, the proposal is sent to the half of the peers delivering us the
+	// fewest messages first one gossip sleep after the other peers. The
+	// persistent and unconditional peers, e.g. sentries, are never deferred.
+	PeerGossipPrioritization bool `mapstructure:"peer_gossip_prioritization"`
+
 	DoubleSignCheckHeight int64 `mapstructure:"double_sign_check_height"`
 }
 
@@ -1075,6 +1240,7 @@ func DefaultConsensusConfig() *ConsensusConfig {
 		CreateEmptyBlocksInterval:   0 * time.Second,
 		PeerGossipSleepDuration:     100 * time.Millisecond,
 		PeerQueryMaj23SleepDuration: 2000 * time.Millisecond,
+		PeerGossipPrioritization:    false,
 		DoubleSignCheckHeight:       int64(0),
 	}
 }
@@ -1230,11 +1396,23 @@ type TxIndexConfig struct {
 	//   2) "kv" (default) - the simplest possible indexer,
 	//      backed by key-value storage (defaults to levelDB; see DBBackend).
 	//   3) "psql" - the indexer services backed by PostgreSQL.
+	//   4) "sqlite" - the indexer services backed by an SQLite database file
+	//      in the db directory, with the schema of "psql".
 	Indexer string `mapstructure:"indexer"`
 
 	// The PostgreSQL connection configuration, the connection format:
 	// postgresql://<user>:<password>@<host>:<port>/<db>?<opts>
 	PsqlConn string `mapstructure:"psql-conn"`
+
+	// The composite keys ("type.key") of the event attributes to index, among
+	// the ones the application flags for indexing. A "*" in a key stands for
+	// any sequence of characters, e.g. "transfer.*". All the attributes are
+	// indexed if empty.
+	IndexKeys []string `mapstructure:"index_keys"`
+
+	// The composite keys of the event attributes not to index, even if they
+	// are in IndexKeys. The same wildcards are supported.
+	ExcludeKeys []string `mapstructure:"exclude_keys"`
 }
 
 // DefaultTxIndexConfig returns a default configuration for the transaction indexer.
@@ -1249,6 +1427,22 @@ func TestTxIndexConfig() *TxIndexConfig {
 	return DefaultTxIndexConfig()
 }
 
+// ValidateBasic performs basic validation (checking param bounds, etc.) and
+// returns an error if any check fails.
+func (cfg *TxIndexConfig) ValidateBasic() error {
+	for _, key := range cfg.IndexKeys {
+		if strings.TrimSpace(key) == "" {
+			return errors.New("index_keys can't contain an empty key")
+		}
+	}
+	for _, key := range cfg.ExcludeKeys {
+		if strings.TrimSpace(key) == "" {
+			return errors.New("exclude_keys can't contain an empty key")
+		}
+	}
+	return nil
+}
+
 //-----------------------------------------------------------------------------
 // InstrumentationConfig
 
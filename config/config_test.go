@@ -61,6 +61,14 @@ func TestBaseConfigValidateBasic(t *testing.T) {
 	// tamper with log format
 	cfg.LogFormat = "invalid"
 	assert.Error(t, cfg.ValidateBasic())
+
+	// the remote signer security options are all set or none
+	cfg = TestBaseConfig()
+	cfg.PrivValidatorClientCertificate = "client.crt"
+	assert.Error(t, cfg.ValidateBasic())
+	cfg.PrivValidatorClientKey = "client.key"
+	cfg.PrivValidatorRootCA = "ca.crt"
+	assert.NoError(t, cfg.ValidateBasic())
 }
 
 func TestRPCConfigValidateBasic(t *testing.T) {
@@ -72,10 +80,13 @@ func TestRPCConfigValidateBasic(t *testing.T) {
 		"MaxOpenConnections",
 		"MaxSubscriptionClients",
 		"MaxSubscriptionsPerClient",
+		"MaxReplayHeights",
 		"TimeoutBroadcastTxCommit",
 		"MaxBodyBytes",
 		"MaxBatchRequestNum",
 		"MaxHeaderBytes",
+		"RateLimitBurstPerIP",
+		"RateLimitBurstPerToken",
 	}
 
 	for _, fieldName := range fieldsToTest {
@@ -83,6 +94,25 @@ func TestRPCConfigValidateBasic(t *testing.T) {
 		assert.Error(t, cfg.ValidateBasic())
 		reflect.ValueOf(cfg).Elem().FieldByName(fieldName).SetInt(0)
 	}
+
+	for _, fieldName := range []string{"RateLimitPerIP", "RateLimitPerToken"} {
+		reflect.ValueOf(cfg).Elem().FieldByName(fieldName).SetFloat(-1)
+		assert.Error(t, cfg.ValidateBasic())
+		reflect.ValueOf(cfg).Elem().FieldByName(fieldName).SetFloat(0.5)
+		assert.NoError(t, cfg.ValidateBasic())
+		reflect.ValueOf(cfg).Elem().FieldByName(fieldName).SetFloat(0)
+	}
+
+	cfg.AdminTokens = []string{"secret", ""}
+	assert.Error(t, cfg.ValidateBasic())
+	cfg.AdminTokens = []string{"secret"}
+	assert.NoError(t, cfg.ValidateBasic())
+	assert.True(t, cfg.IsAuthEnabled())
+
+	cfg.TLSClientCAFile = "ca.pem"
+	assert.Error(t, cfg.ValidateBasic())
+	cfg.TLSCertFile, cfg.TLSKeyFile = "cert.pem", "key.pem"
+	assert.NoError(t, cfg.ValidateBasic())
 }
 
 func TestP2PConfigValidateBasic(t *testing.T) {
@@ -114,6 +144,7 @@ func TestMempoolConfigValidateBasic(t *testing.T) {
 		"MaxTxsBytes",
 		"CacheSize",
 		"MaxTxBytes",
+		"MaxConsecutiveInvalidTxs",
 	}
 
 	for _, fieldName := range fieldsToTest {
@@ -135,7 +166,6 @@ func TestStateSyncConfigValidateBasic(t *testing.T) {
 
 	// Enabled
 	cfg.Enable = true
-	testVerify("rpc_servers is required")
 	cfg.RPCServers = []string{""}
 	testVerify("at least two rpc_servers entries is required")
 	cfg.RPCServers = []string{"", ""}
@@ -155,6 +185,9 @@ func TestStateSyncConfigValidateBasic(t *testing.T) {
 	cfg.TrustHash = "00"
 	// Success with Enabled
 	require.NoError(t, cfg.ValidateBasic())
+	// Success without rpc_servers, fetching the light blocks from peers
+	cfg.RPCServers = nil
+	require.NoError(t, cfg.ValidateBasic())
 }
 
 func TestFastSyncConfigValidateBasic(t *testing.T) {
@@ -212,6 +245,22 @@ func TestConsensusConfig_ValidateBasic(t *testing.T) {
 	}
 }
 
+func TestTxIndexConfigValidateBasic(t *testing.T) {
+	cfg := TestTxIndexConfig()
+	assert.NoError(t, cfg.ValidateBasic())
+
+	cfg.IndexKeys = []string{"transfer.*", "message.action"}
+	cfg.ExcludeKeys = []string{"*.memo"}
+	assert.NoError(t, cfg.ValidateBasic())
+
+	cfg.IndexKeys = []string{"transfer.*", ""}
+	assert.Error(t, cfg.ValidateBasic())
+
+	cfg.IndexKeys = nil
+	cfg.ExcludeKeys = []string{" "}
+	assert.Error(t, cfg.ValidateBasic())
+}
+
 func TestInstrumentationConfigValidateBasic(t *testing.T) {
 	cfg := TestInstrumentationConfig()
 	assert.NoError(t, cfg.ValidateBasic())
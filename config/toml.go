@@ -155,11 +155,19 @@ priv_validator_key_file = "{{ js .BaseConfig.PrivValidatorKey }}"
 priv_validator_state_file = "{{ js .BaseConfig.PrivValidatorState }}"
 
 # TCP or UNIX socket address for Ostracon to listen on for
-# connections from an external PrivValidator process
+# connections from an external PrivValidator process,
+# or gRPC address of an external PrivValidator process to connect to
 # If this value is set, key file(priv_validator_key.json) will not be generated.
 # example) tcp://0.0.0.0:26659
+# example) grpc://127.0.0.1:26659
 priv_validator_laddr = "{{ .BaseConfig.PrivValidatorListenAddr }}"
 
+# Client certificate, client key and root CA certificate files for mutual TLS
+# with a gRPC PrivValidator process. If they are not set, the connection is insecure.
+priv_validator_client_certificate_file = "{{ js .BaseConfig.PrivValidatorClientCertificate }}"
+priv_validator_client_key_file = "{{ js .BaseConfig.PrivValidatorClientKey }}"
+priv_validator_root_ca_file = "{{ js .BaseConfig.PrivValidatorRootCA }}"
+
 # Validator's remote address to allow a connection
 # List of addresses in TOML array format to allow
 # ostracon only allows a connection from these listed addresses
@@ -202,7 +210,9 @@ cors_allowed_methods = [{{ range .RPC.CORSAllowedMethods }}{{ printf "%q, " . }}
 cors_allowed_headers = [{{ range .RPC.CORSAllowedHeaders }}{{ printf "%q, " . }}{{end}}]
 
 # TCP or UNIX socket address for the gRPC server to listen on
-# NOTE: This server only supports /broadcast_tx_commit
+# NOTE: The calls are subject to the same roles and rate limits as the RPC
+# server ones. The callers authenticate with the bearer token of their
+# "authorization" metadata: TLS client certificates are not supported.
 grpc_laddr = "{{ .RPC.GRPCListenAddress }}"
 
 # Maximum number of simultaneous connections.
@@ -286,6 +296,10 @@ experimental_websocket_write_buffer_size = {{ .RPC.WebSocketWriteBufferSize }}
 # predictability in subscription behaviour.
 experimental_close_on_slow_client = {{ .RPC.CloseOnSlowClient }}
 
+# Maximum number of committed blocks whose events can be replayed by /subscribe
+# with from_height, counting back from the latest one. 0 means no limit.
+max_replay_heights = {{ .RPC.MaxReplayHeights }}
+
 # How long to wait for a tx to be committed during /broadcast_tx_commit.
 # WARNING: Using a value larger than 'WriteTimeout' will result in increasing the
 # global HTTP write timeout, which applies to all connections and endpoints.
@@ -316,6 +330,41 @@ tls_cert_file = "{{ .RPC.TLSCertFile }}"
 # Otherwise, HTTP server is run.
 tls_key_file = "{{ .RPC.TLSKeyFile }}"
 
+# The path to a file containing the CA certificates the TLS client certificates are verified
+# against (mTLS). The verified clients are authenticated by their certificate.
+# Might be either absolute path or path related to Ostracon's config directory.
+# NOTE: it only applies to the HTTPS server, see tls_cert_file.
+tls_client_ca_file = "{{ .RPC.TLSClientCAFile }}"
+
+# A list of the common names of the TLS client certificates granted the admin role.
+# The other verified client certificates are granted the user role.
+tls_admin_common_names = [{{ range .RPC.TLSAdminCommonNames }}{{ printf "%q, " . }}{{end}}]
+
+# A list of bearer tokens granting the admin role, which is required to call the unsafe routes.
+# If any token or client CA is set, the unsafe routes are only available to the admins.
+admin_tokens = [{{ range .RPC.AdminTokens }}{{ printf "%q, " . }}{{end}}]
+
+# A list of bearer tokens granting the user role.
+# The users are rate limited per token instead of per IP.
+user_tokens = [{{ range .RPC.UserTokens }}{{ printf "%q, " . }}{{end}}]
+
+# Maximum number of calls per second from each IP, for the callers without credentials.
+# Over HTTP and websocket alike, each request of a batch counts as a call.
+# 0 - unlimited.
+rate_limit_per_ip = {{ .RPC.RateLimitPerIP }}
+
+# Maximum number of calls in a burst from each IP. It defaults to rate_limit_per_ip.
+rate_limit_burst_per_ip = {{ .RPC.RateLimitBurstPerIP }}
+
+# Maximum number of calls per second from each authenticated caller,
+# whether by token or by client certificate.
+# 0 - unlimited.
+rate_limit_per_token = {{ .RPC.RateLimitPerToken }}
+
+# Maximum number of calls in a burst from each authenticated caller.
+# It defaults to rate_limit_per_token.
+rate_limit_burst_per_token = {{ .RPC.RateLimitBurstPerToken }}
+
 # pprof listen address (https://golang.org/pkg/net/http/pprof)
 pprof_laddr = "{{ .RPC.PprofListenAddress }}"
 
@@ -459,6 +508,12 @@ ttl-duration = "{{ .Mempool.TTLDuration }}"
 # it's insertion time into the mempool is beyond ttl-duration.
 ttl-num-blocks = {{ .Mempool.TTLNumBlocks }}
 
+# Number of invalid txs in a row after which a peer is reported for flooding us
+# with invalid txs. Only the txs which are invalid regardless of the state of the
+# app are counted, i.e. too large or failing the precheck, not the txs rejected
+# by the app's CheckTx. 0 disables the reports.
+max_consecutive_invalid_txs = {{ .Mempool.MaxConsecutiveInvalidTxs }}
+
 #######################################################
 ###         State Sync Configuration Options        ###
 #######################################################
@@ -473,6 +528,7 @@ enable = {{ .StateSync.Enable }}
 # RPC servers (comma-separated) for light client verification of the synced state machine and
 # retrieval of state data for node bootstrapping. Also needs a trusted height and corresponding
 # header hash obtained from a trusted source, and a period during which validators can be trusted.
+# If empty, the light blocks and state data are fetched from at least two peers instead.
 #
 # For Cosmos SDK-based chains, trust_period should usually be about 2/3 of the unbonding time (~2
 # weeks) during which they can be financially punished (slashed) for misbehavior.
@@ -489,12 +545,25 @@ discovery_time = "{{ .StateSync.DiscoveryTime }}"
 temp_dir = "{{ .StateSync.TempDir }}"
 
 # The timeout duration before re-requesting a chunk, possibly from a different
-# peer (default: 1 minute).
+# peer (default: 1 minute). A chunk is also requested from a second peer if the
+# first one takes more than 3 times its usual latency to respond, or half this
+# timeout before it responded once.
 chunk_request_timeout = "{{ .StateSync.ChunkRequestTimeout }}"
 
-# The number of concurrent chunk fetchers to run (default: 1).
+# The number of concurrent chunk fetchers to run (default: 1). Each chunk is
+# requested from the peer expected to respond first, given the latency and
+# throughput measured, the timeouts and the requests in flight.
 chunk_fetchers = "{{ .StateSync.ChunkFetchers }}"
 
+# If true, the progress of a snapshot restoration is persisted, and resumed if
+# the node restarts before it completes: the app is offered the snapshot again,
+# and given the chunks following the ones it applied. The restoration starts
+# over unless the app reports the "statesync:resumable" token among the
+# whitespace-separated fields of the data of its Info response, which it is to
+# do only if it keeps the chunks it applied across restarts, or if it doesn't
+# accept the snapshot.
+resume_restoration = {{ .StateSync.ResumeRestoration }}
+
 #######################################################
 ###       Fast Sync Configuration Connections       ###
 #######################################################
@@ -550,6 +619,11 @@ max_txs = {{ .Consensus.MaxTxs }}
 peer_gossip_sleep_duration = "{{ .Consensus.PeerGossipSleepDuration }}"
 peer_query_maj23_sleep_duration = "{{ .Consensus.PeerQueryMaj23SleepDuration }}"
 
+# If true, the proposal is sent to the half of the peers delivering the fewest
+# consensus messages first one peer_gossip_sleep_duration after the other peers.
+# The persistent and unconditional peers, e.g. sentries, are never deferred.
+peer_gossip_prioritization = {{ .Consensus.PeerGossipPrioritization }}
+
 #######################################################
 ###         Storage Configuration Options           ###
 #######################################################
@@ -576,13 +650,28 @@ discard_abci_responses = {{ .Storage.DiscardABCIResponses}}
 #   2) "kv" (default) - the simplest possible indexer, backed by key-value storage (defaults to levelDB; see DBBackend).
 # 		- When "kv" is chosen "tx.height" and "tx.hash" will always be indexed.
 #   3) "psql" - the indexer services backed by PostgreSQL.
-# When "kv" or "psql" is chosen "tx.height" and "tx.hash" will always be indexed.
+#   4) "sqlite" - the indexer services backed by an SQLite database file in the db directory,
+#      with the schema of "psql".
+# When "kv", "psql" or "sqlite" is chosen "tx.height" and "tx.hash" will always be indexed.
 indexer = "{{ .TxIndex.Indexer }}"
 
 # The PostgreSQL connection configuration, the connection format:
 #   postgresql://<user>:<password>@<host>:<port>/<db>?<opts>
 psql-conn = "{{ .TxIndex.PsqlConn }}"
 
+# The composite keys ("type.key") of the event attributes to index, among the
+# ones the application flags for indexing. A "*" in a key stands for any
+# sequence of characters, e.g. "transfer.*" selects all the attributes of the
+# transfer events. All the attributes are indexed if empty.
+# "tx.height", "tx.hash" and "block.height" are always indexed.
+# Run "ostracon reindex-event --replace" after changing it to apply it to the
+# events already indexed.
+index_keys = [{{ range .TxIndex.IndexKeys }}{{ printf "%q, " . }}{{end}}]
+
+# The composite keys of the event attributes not to index, even if they match
+# index_keys. The same wildcards are supported, e.g. "*.memo".
+exclude_keys = [{{ range .TxIndex.ExcludeKeys }}{{ printf "%q, " . }}{{end}}]
+
 #######################################################
 ###       Instrumentation Configuration Options     ###
 #######################################################
@@ -26,8 +26,7 @@ import (
 
 	cfg "github.com/Finschia/ostracon/config"
 	mempoolv0 "github.com/Finschia/ostracon/mempool/v0"
-
-	//mempoolv1 "github.com/Finschia/ostracon/mempool/v1"
+	mempoolv1 "github.com/Finschia/ostracon/mempool/v1"
 	"github.com/Finschia/ostracon/p2p"
 	sm "github.com/Finschia/ostracon/state"
 	"github.com/Finschia/ostracon/store"
@@ -81,17 +80,14 @@ func TestByzantinePrevoteEquivocation(t *testing.T) {
 				state.LastBlockHeight,
 				mempoolv0.WithPreCheck(sm.TxPreCheck(state)),
 				mempoolv0.WithPostCheck(sm.TxPostCheck(state)))
-		case cfg.MempoolV1: // XXX Deprecated
-			panic("Deprecated MempoolV1")
-			/*
-				mempool = mempoolv1.NewTxMempool(logger,
-					config.Mempool,
-					proxyAppConnConMem,
-					state.LastBlockHeight,
-					mempoolv1.WithPreCheck(sm.TxPreCheck(state)),
-					mempoolv1.WithPostCheck(sm.TxPostCheck(state)),
-				)
-			*/
+		case cfg.MempoolV1:
+			mempool = mempoolv1.NewTxMempool(logger,
+				config.Mempool,
+				proxyAppConnConMem,
+				state.LastBlockHeight,
+				mempoolv1.WithPreCheck(sm.TxPreCheck(state)),
+				mempoolv1.WithPostCheck(sm.TxPostCheck(state)),
+			)
 		}
 
 		if thisConfig.Consensus.WaitForTxs() {
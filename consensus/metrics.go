@@ -61,6 +61,17 @@ type Metrics struct {
 	// Number of blockparts transmitted by peer.
 	BlockParts metrics.Counter
 
+	// Number of proposals, block parts and votes received from a peer.
+	PeerGossipReceived metrics.Counter
+	// Number of proposals, block parts and votes a peer delivered before any other peer.
+	PeerGossipFirstDeliveries metrics.Counter
+	// Share of the proposals, block parts and votes received from a peer which were already
+	// delivered by another peer.
+	PeerGossipDuplicateRatio metrics.Gauge
+	// Moving average of the time since the start of the round step, when a peer delivered a
+	// proposal, block part or vote first.
+	PeerGossipLatencySeconds metrics.Gauge
+
 	// QuroumPrevoteMessageDelay is the interval in seconds between the proposal
 	// timestamp and the timestamp of the earliest prevote that achieved a quorum
 	// during the prevote step.
@@ -229,6 +240,32 @@ func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
 			Name:      "block_parts",
 			Help:      "Number of blockparts transmitted by peer.",
 		}, append(labels, "peer_id")).With(labelsAndValues...),
+		PeerGossipReceived: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "peer_gossip_received",
+			Help:      "Number of proposals, block parts and votes received from a peer.",
+		}, append(labels, "peer_id", "message_type")).With(labelsAndValues...),
+		PeerGossipFirstDeliveries: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "peer_gossip_first_deliveries",
+			Help:      "Number of proposals, block parts and votes a peer delivered before any other peer.",
+		}, append(labels, "peer_id", "message_type")).With(labelsAndValues...),
+		PeerGossipDuplicateRatio: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "peer_gossip_duplicate_ratio",
+			Help: "Share of the proposals, block parts and votes received from a peer " +
+				"which were already delivered by another peer.",
+		}, append(labels, "peer_id", "message_type")).With(labelsAndValues...),
+		PeerGossipLatencySeconds: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "peer_gossip_latency_seconds",
+			Help: "Moving average of the time since the start of the round step, " +
+				"when a peer delivered a proposal, block part or vote first.",
+		}, append(labels, "peer_id", "message_type")).With(labelsAndValues...),
 		QuorumPrevoteMessageDelay: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
 			Namespace: namespace,
 			Subsystem: MetricsSubsystem,
@@ -377,6 +414,10 @@ func NopMetrics() *Metrics {
 		FastSyncing:               discard.NewGauge(),
 		StateSyncing:              discard.NewGauge(),
 		BlockParts:                discard.NewCounter(),
+		PeerGossipReceived:        discard.NewCounter(),
+		PeerGossipFirstDeliveries: discard.NewCounter(),
+		PeerGossipDuplicateRatio:  discard.NewGauge(),
+		PeerGossipLatencySeconds:  discard.NewGauge(),
 		QuorumPrevoteMessageDelay: discard.NewGauge(),
 		FullPrevoteMessageDelay:   discard.NewGauge(),
 
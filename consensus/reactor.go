@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"sort"
 	"sync"
 	"time"
 
@@ -11,6 +12,7 @@ import (
 	tmcons "github.com/tendermint/tendermint/proto/tendermint/consensus"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
+	"github.com/Finschia/ostracon/behaviour"
 	cstypes "github.com/Finschia/ostracon/consensus/types"
 	"github.com/Finschia/ostracon/libs/bits"
 	tmevents "github.com/Finschia/ostracon/libs/events"
@@ -33,6 +35,20 @@ const (
 
 	blocksToContributeToBecomeGoodPeer = 10000
 	votesToContributeToBecomeGoodPeer  = 10000
+
+	// gossipLatencyWeight is the weight of a new sample in the moving average of the latency of
+	// the messages a peer delivers first.
+	gossipLatencyWeight = 0.2
+	// gossipPriorityInterval is how often the peers are ranked by the messages they deliver first.
+	gossipPriorityInterval = 10 * time.Second
+	// minGossipSamples is how many proposals, block parts and votes a peer must have sent us to
+	// be ranked.
+	minGossipSamples = 100
+
+	// The kinds of messages the gossip statistics of a peer are kept for.
+	gossipProposal  = "proposal"
+	gossipBlockPart = "block_part"
+	gossipVote      = "vote"
 )
 
 //-----------------------------------------------------------------------------
@@ -47,6 +63,7 @@ type Reactor struct {
 	waitSync bool
 	eventBus *types.EventBus
 	rs       *cstypes.RoundState
+	reporter behaviour.Reporter
 
 	Metrics *Metrics
 }
@@ -76,6 +93,10 @@ func NewReactor(consensusState *State, waitSync bool, async bool, recvBufSize in
 func (conR *Reactor) OnStart() error {
 	conR.Logger.Info("Reactor ", "waitSync", conR.WaitSync())
 
+	if conR.reporter == nil {
+		conR.reporter = behaviour.NewSwitchReporter(conR.Switch)
+	}
+
 	// call BaseReactor's OnStart()
 	err := conR.BaseReactor.OnStart()
 	if err != nil {
@@ -98,6 +119,12 @@ func (conR *Reactor) OnStart() error {
 	return nil
 }
 
+// SetReporter sets the reporter the peer behaviours are reported to.
+// It must be called before the reactor is started.
+func (conR *Reactor) SetReporter(reporter behaviour.Reporter) {
+	conR.reporter = reporter
+}
+
 // OnStop implements BaseService by unsubscribing from events and stopping
 // state.
 func (conR *Reactor) OnStop() {
@@ -245,13 +272,20 @@ func (conR *Reactor) ReceiveEnvelope(e p2p.Envelope) {
 	msg, err := MsgFromProto(m.(*tmcons.Message))
 	if err != nil {
 		conR.Logger.Error("Error decoding message", "src", e.Src, "chId", e.ChannelID, "err", err)
-		conR.Switch.StopPeerForError(e.Src, err)
+		conR.report(behaviour.BadMessage(e.Src.ID(), err.Error()))
 		return
 	}
 
 	if err = msg.ValidateBasic(); err != nil {
 		conR.Logger.Error("Peer sent us invalid msg", "peer", e.Src, "msg", e.Message, "err", err)
-		conR.Switch.StopPeerForError(e.Src, err)
+		switch msg.(type) {
+		case *ProposalMessage:
+			conR.report(behaviour.BadProposal(e.Src.ID(), err.Error()))
+		case *VoteMessage:
+			conR.report(behaviour.InvalidVote(e.Src.ID(), err.Error()))
+		default:
+			conR.report(behaviour.BadMessage(e.Src.ID(), err.Error()))
+		}
 		return
 	}
 
@@ -272,7 +306,7 @@ func (conR *Reactor) ReceiveEnvelope(e p2p.Envelope) {
 			conR.conS.mtx.Unlock()
 			if err = msg.ValidateHeight(initialHeight); err != nil {
 				conR.Logger.Error("Peer sent us invalid msg", "peer", e.Src, "msg", msg, "err", err)
-				conR.Switch.StopPeerForError(e.Src, err)
+				conR.report(behaviour.BadMessage(e.Src.ID(), err.Error()))
 				return
 			}
 			ps.ApplyNewRoundStepMessage(msg)
@@ -291,7 +325,7 @@ func (conR *Reactor) ReceiveEnvelope(e p2p.Envelope) {
 			// Peer claims to have a maj23 for some BlockID at H,R,S,
 			err := votes.SetPeerMaj23(msg.Round, msg.Type, ps.peer.ID(), msg.BlockID)
 			if err != nil {
-				conR.Switch.StopPeerForError(e.Src, err)
+				conR.report(behaviour.BadMessage(e.Src.ID(), err.Error()))
 				return
 			}
 			// Respond with a VoteSetBitsMessage showing which votes we have.
@@ -330,12 +364,14 @@ func (conR *Reactor) ReceiveEnvelope(e p2p.Envelope) {
 		switch msg := msg.(type) {
 		case *ProposalMessage:
 			ps.SetHasProposal(msg.Proposal)
+			conR.recordReceived(e.Src.ID(), ps, msg)
 			conR.conS.peerMsgQueue <- msgInfo{msg, e.Src.ID()}
 		case *ProposalPOLMessage:
 			ps.ApplyProposalPOLMessage(msg)
 		case *BlockPartMessage:
 			ps.SetHasProposalBlockPart(msg.Height, msg.Round, int(msg.Part.Index))
 			conR.Metrics.BlockParts.With("peer_id", string(e.Src.ID())).Add(1)
+			conR.recordReceived(e.Src.ID(), ps, msg)
 			conR.conS.peerMsgQueue <- msgInfo{msg, e.Src.ID()}
 		default:
 			conR.Logger.Error(fmt.Sprintf("Unknown message type %v", reflect.TypeOf(msg)))
@@ -355,6 +391,7 @@ func (conR *Reactor) ReceiveEnvelope(e p2p.Envelope) {
 			ps.EnsureVoteBitArrays(height, valSize)
 			ps.EnsureVoteBitArrays(height-1, lastCommitSize)
 			ps.SetHasVote(msg.Vote)
+			conR.recordReceived(e.Src.ID(), ps, msg)
 
 			cs.peerMsgQueue <- msgInfo{msg, e.Src.ID()}
 
@@ -635,6 +672,11 @@ OUTER_LOOP:
 
 		// Send Proposal && ProposalPOL BitArray?
 		if rs.Proposal != nil && !prs.Proposal {
+			// Give the peers which deliver us messages first a head start on the proposal.
+			if ps.deferProposal(rs.Height, rs.Round) {
+				time.Sleep(conR.conS.config.PeerGossipSleepDuration)
+				continue OUTER_LOOP
+			}
 			// Proposal: share the proposal metadata with peer.
 			{
 				logger.Debug("Sending proposal", "height", prs.Height, "round", prs.Round)
@@ -954,6 +996,9 @@ OUTER_LOOP:
 }
 
 func (conR *Reactor) peerStatsRoutine() {
+	ticker := time.NewTicker(gossipPriorityInterval)
+	defer ticker.Stop()
+
 	for {
 		if !conR.IsRunning() {
 			conR.Logger.Info("Stopping peerStatsRoutine")
@@ -974,16 +1019,19 @@ func (conR *Reactor) peerStatsRoutine() {
 			if !ok {
 				panic(fmt.Sprintf("Peer %v has no state", peer))
 			}
+			conR.recordFirstDelivery(peer.ID(), ps, msg.Msg, msg.Latency)
 			switch msg.Msg.(type) {
 			case *VoteMessage:
 				if numVotes := ps.RecordVote(); numVotes%votesToContributeToBecomeGoodPeer == 0 {
-					conR.Switch.MarkPeerAsGood(peer)
+					conR.report(behaviour.ConsensusVote(peer.ID(), "contributed votes"))
 				}
 			case *BlockPartMessage:
 				if numParts := ps.RecordBlockPart(); numParts%blocksToContributeToBecomeGoodPeer == 0 {
-					conR.Switch.MarkPeerAsGood(peer)
+					conR.report(behaviour.BlockPart(peer.ID(), "contributed block parts"))
 				}
 			}
+		case <-ticker.C:
+			conR.updateGossipPriorities()
 		case <-conR.conS.Quit():
 			return
 
@@ -993,6 +1041,73 @@ func (conR *Reactor) peerStatsRoutine() {
 	}
 }
 
+// recordReceived records a proposal, block part or vote received from the peer in its gossip
+// statistics.
+func (conR *Reactor) recordReceived(peerID p2p.ID, ps *PeerState, msg Message) {
+	stats, kind := ps.RecordReceived(msg)
+	if kind == "" {
+		return
+	}
+	conR.Metrics.PeerGossipReceived.With("peer_id", string(peerID), "message_type", kind).Add(1)
+	conR.Metrics.PeerGossipDuplicateRatio.With("peer_id", string(peerID), "message_type", kind).
+		Set(stats.DuplicateRatio)
+}
+
+// recordFirstDelivery records a proposal, block part or vote the peer delivered first in its
+// gossip statistics.
+func (conR *Reactor) recordFirstDelivery(peerID p2p.ID, ps *PeerState, msg Message, latency time.Duration) {
+	stats, kind := ps.RecordFirstDelivery(msg, latency)
+	if kind == "" {
+		return
+	}
+	conR.Metrics.PeerGossipFirstDeliveries.With("peer_id", string(peerID), "message_type", kind).Add(1)
+	conR.Metrics.PeerGossipDuplicateRatio.With("peer_id", string(peerID), "message_type", kind).
+		Set(stats.DuplicateRatio)
+	conR.Metrics.PeerGossipLatencySeconds.With("peer_id", string(peerID), "message_type", kind).
+		Set(stats.Latency.Seconds())
+}
+
+// updateGossipPriorities ranks the peers by the share of the messages they sent us which they
+// delivered first, and lowers the priority of the bottom half in gossipDataRoutine, if enabled by
+// the config. The peers which didn't send enough messages to be ranked keep the normal priority,
+// as do the persistent and unconditional ones: they may be downstream of us, e.g. sentries, and
+// rarely deliver first because of it.
+func (conR *Reactor) updateGossipPriorities() {
+	if !conR.conS.config.PeerGossipPrioritization {
+		return
+	}
+
+	type rankedPeer struct {
+		ps    *PeerState
+		share float64
+	}
+	ranked := make([]rankedPeer, 0)
+	for _, peer := range conR.Switch.Peers().List() {
+		ps, ok := peer.Get(types.PeerStateKey).(*PeerState)
+		if !ok {
+			continue
+		}
+		share, received := ps.GetGossipStats().firstDeliveryShare()
+		if received < minGossipSamples || peer.IsPersistent() || conR.Switch.IsPeerUnconditional(peer.ID()) {
+			ps.SetLowGossipPriority(false)
+			continue
+		}
+		ranked = append(ranked, rankedPeer{ps: ps, share: share})
+	}
+	sort.SliceStable(ranked, func(i, j int) bool {
+		return ranked[i].share > ranked[j].share
+	})
+	for i, peer := range ranked {
+		peer.ps.SetLowGossipPriority(i >= (len(ranked)+1)/2)
+	}
+}
+
+func (conR *Reactor) report(pb behaviour.PeerBehaviour) {
+	if err := conR.reporter.Report(pb); err != nil {
+		conR.Logger.Debug("Failed to report peer behaviour", "err", err)
+	}
+}
+
 // String returns a string representation of the Reactor.
 // NOTE: For now, it is just a hard-coded string to avoid accessing unprotected shared variables.
 // TODO: improve!
@@ -1039,17 +1154,77 @@ type PeerState struct {
 	mtx   sync.Mutex             // NOTE: Modify below using setters, never directly.
 	PRS   cstypes.PeerRoundState `json:"round_state"` // Exposed.
 	Stats *peerStateStats        `json:"stats"`       // Exposed.
+
+	lowGossipPriority bool  // whether the proposal is sent to the peer after the other peers
+	deferredHeight    int64 // height and round the proposal was last deferred for
+	deferredRound     int32
 }
 
 // peerStateStats holds internal statistics for a peer.
 type peerStateStats struct {
-	Votes      int `json:"votes"`
-	BlockParts int `json:"block_parts"`
+	Votes      int             `json:"votes"`
+	BlockParts int             `json:"block_parts"`
+	Gossip     PeerGossipStats `json:"gossip"`
 }
 
 func (pss peerStateStats) String() string {
-	return fmt.Sprintf("peerStateStats{votes: %d, blockParts: %d}",
-		pss.Votes, pss.BlockParts)
+	return fmt.Sprintf("peerStateStats{votes: %d, blockParts: %d, gossip: %v}",
+		pss.Votes, pss.BlockParts, pss.Gossip)
+}
+
+// gossip returns the gossip statistics of the kind of the message, with the name of the kind,
+// or nil if they aren't kept for it.
+func (pss *peerStateStats) gossip(msg Message) (*GossipStats, string) {
+	switch msg.(type) {
+	case *ProposalMessage:
+		return &pss.Gossip.Proposals, gossipProposal
+	case *BlockPartMessage:
+		return &pss.Gossip.BlockParts, gossipBlockPart
+	case *VoteMessage:
+		return &pss.Gossip.Votes, gossipVote
+	default:
+		return nil, ""
+	}
+}
+
+// PeerGossipStats is what a peer delivered to us of each kind of consensus message.
+type PeerGossipStats struct {
+	Proposals  GossipStats `json:"proposals"`
+	BlockParts GossipStats `json:"block_parts"`
+	Votes      GossipStats `json:"votes"`
+}
+
+// firstDeliveryShare returns the share of the messages received from the peer which it
+// delivered first, and the number of messages received.
+func (pgs PeerGossipStats) firstDeliveryShare() (float64, int) {
+	received := pgs.Proposals.Received + pgs.BlockParts.Received + pgs.Votes.Received
+	if received == 0 {
+		return 0, 0
+	}
+	first := pgs.Proposals.FirstDeliveries + pgs.BlockParts.FirstDeliveries + pgs.Votes.FirstDeliveries
+	return float64(first) / float64(received), received
+}
+
+// GossipStats is what a peer delivered to us of a kind of consensus message.
+type GossipStats struct {
+	// Number of messages received from the peer.
+	Received int `json:"received"`
+	// Number of messages the peer delivered before any other peer.
+	FirstDeliveries int `json:"first_deliveries"`
+	// Share of the messages received from the peer which were already delivered by another
+	// peer, or were not useful.
+	DuplicateRatio float64 `json:"duplicate_ratio"`
+	// Moving average of the time since the start of our round step, when the peer delivered a
+	// message first.
+	Latency time.Duration `json:"latency"`
+}
+
+func (gs *GossipStats) updateDuplicateRatio() {
+	if gs.Received == 0 || gs.FirstDeliveries >= gs.Received {
+		gs.DuplicateRatio = 0
+		return
+	}
+	gs.DuplicateRatio = float64(gs.Received-gs.FirstDeliveries) / float64(gs.Received)
 }
 
 // NewPeerState returns a new PeerState for the given Peer
@@ -1348,6 +1523,72 @@ func (ps *PeerState) BlockPartsSent() int {
 	return ps.Stats.BlockParts
 }
 
+// RecordReceived increments the number of messages of the kind of the given proposal, block
+// part or vote received from this peer. It returns the gossip statistics of the kind and its
+// name, or an empty name if they aren't kept for the message.
+func (ps *PeerState) RecordReceived(msg Message) (GossipStats, string) {
+	ps.mtx.Lock()
+	defer ps.mtx.Unlock()
+
+	stats, kind := ps.Stats.gossip(msg)
+	if stats == nil {
+		return GossipStats{}, ""
+	}
+	stats.Received++
+	stats.updateDuplicateRatio()
+	return *stats, kind
+}
+
+// RecordFirstDelivery records a proposal, block part or vote this peer delivered before any
+// other peer, with the time since the start of our round step. It returns the gossip statistics
+// of the kind and its name, or an empty name if they aren't kept for the message.
+func (ps *PeerState) RecordFirstDelivery(msg Message, latency time.Duration) (GossipStats, string) {
+	ps.mtx.Lock()
+	defer ps.mtx.Unlock()
+
+	stats, kind := ps.Stats.gossip(msg)
+	if stats == nil {
+		return GossipStats{}, ""
+	}
+	if stats.FirstDeliveries == 0 {
+		stats.Latency = latency
+	} else {
+		stats.Latency += time.Duration(gossipLatencyWeight * float64(latency-stats.Latency))
+	}
+	stats.FirstDeliveries++
+	stats.updateDuplicateRatio()
+	return *stats, kind
+}
+
+// GetGossipStats returns a copy of the gossip statistics of this peer.
+func (ps *PeerState) GetGossipStats() PeerGossipStats {
+	ps.mtx.Lock()
+	defer ps.mtx.Unlock()
+
+	return ps.Stats.Gossip
+}
+
+// SetLowGossipPriority sets whether the proposal is sent to this peer after the other peers.
+func (ps *PeerState) SetLowGossipPriority(low bool) {
+	ps.mtx.Lock()
+	defer ps.mtx.Unlock()
+
+	ps.lowGossipPriority = low
+}
+
+// deferProposal returns whether to defer sending the proposal of the given round to this peer,
+// which happens once per round if the peer has a low gossip priority.
+func (ps *PeerState) deferProposal(height int64, round int32) bool {
+	ps.mtx.Lock()
+	defer ps.mtx.Unlock()
+
+	if !ps.lowGossipPriority || (ps.deferredHeight == height && ps.deferredRound == round) {
+		return false
+	}
+	ps.deferredHeight, ps.deferredRound = height, round
+	return true
+}
+
 // SetHasVote sets the given vote as known by the peer
 func (ps *PeerState) SetHasVote(vote *types.Vote) {
 	ps.mtx.Lock()
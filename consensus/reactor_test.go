@@ -33,8 +33,7 @@ import (
 	tmsync "github.com/Finschia/ostracon/libs/sync"
 	mempl "github.com/Finschia/ostracon/mempool"
 	mempoolv0 "github.com/Finschia/ostracon/mempool/v0"
-
-	//mempoolv1 "github.com/Finschia/ostracon/mempool/v1"
+	mempoolv1 "github.com/Finschia/ostracon/mempool/v1"
 	"github.com/Finschia/ostracon/p2p"
 	p2pmock "github.com/Finschia/ostracon/p2p/mock"
 	sm "github.com/Finschia/ostracon/state"
@@ -176,18 +175,15 @@ func TestReactorWithEvidence(t *testing.T) {
 				mempoolv0.WithMetrics(memplMetrics),
 				mempoolv0.WithPreCheck(sm.TxPreCheck(state)),
 				mempoolv0.WithPostCheck(sm.TxPostCheck(state)))
-		case cfg.MempoolV1: // XXX Deprecated MempoolV1
-			panic("Deprecated MempoolV1")
-			/*
-				mempool = mempoolv1.NewTxMempool(logger,
-					config.Mempool,
-					proxyAppConnConMem,
-					state.LastBlockHeight,
-					mempoolv1.WithMetrics(memplMetrics),
-					mempoolv1.WithPreCheck(sm.TxPreCheck(state)),
-					mempoolv1.WithPostCheck(sm.TxPostCheck(state)),
-				)
-			*/
+		case cfg.MempoolV1:
+			mempool = mempoolv1.NewTxMempool(logger,
+				config.Mempool,
+				proxyAppConnConMem,
+				state.LastBlockHeight,
+				mempoolv1.WithMetrics(memplMetrics),
+				mempoolv1.WithPreCheck(sm.TxPreCheck(state)),
+				mempoolv1.WithPostCheck(sm.TxPostCheck(state)),
+			)
 		}
 		if thisConfig.Consensus.WaitForTxs() {
 			mempool.EnableTxsAvailable()
@@ -377,6 +373,67 @@ func TestReactorRecordsVotesAndBlockParts(t *testing.T) {
 	assert.Equal(t, true, ps.VotesSent() > 0, "number of votes sent should have increased")
 	assert.Equal(t, true, ps.BlockPartsSent() > 0,
 		fmt.Sprintf("number of votes sent should have increased: %d", ps.BlockPartsSent()))
+
+	// the proposer delivers its proposal and block parts first
+	stats := ps.GetGossipStats()
+	assert.Positive(t, stats.Proposals.FirstDeliveries)
+	assert.Positive(t, stats.BlockParts.FirstDeliveries)
+	assert.Positive(t, stats.Votes.FirstDeliveries)
+	assert.GreaterOrEqual(t, stats.Votes.Received, stats.Votes.FirstDeliveries)
+}
+
+// Ensure the blocks are still committed when the proposal is deferred for all the peers
+func TestReactorGossipPrioritization(t *testing.T) {
+	N := 4
+	css, cleanup := randConsensusNet(N, "consensus_reactor_test", newMockTickerFunc(true), newCounter,
+		func(c *cfg.Config) { c.Consensus.PeerGossipPrioritization = true })
+	defer cleanup()
+	reactors, blocksSubs, eventBuses := startConsensusNet(t, css, N)
+	defer stopConsensusNet(log.TestingLogger(), reactors, eventBuses)
+
+	for _, reactor := range reactors {
+		for _, peer := range reactor.Switch.Peers().List() {
+			peer.Get(types.PeerStateKey).(*PeerState).SetLowGossipPriority(true)
+		}
+	}
+	for i := 0; i < 3; i++ {
+		timeoutWaitGroup(t, N, func(j int) {
+			<-blocksSubs[j].Out()
+		}, css)
+	}
+}
+
+func TestPeerStateGossipStats(t *testing.T) {
+	ps := NewPeerState(p2pmock.NewPeer(nil))
+	vote := &VoteMessage{Vote: &types.Vote{}}
+
+	for i := 0; i < 4; i++ {
+		_, kind := ps.RecordReceived(vote)
+		assert.Equal(t, gossipVote, kind)
+	}
+	stats, kind := ps.RecordFirstDelivery(vote, 100*time.Millisecond)
+	assert.Equal(t, gossipVote, kind)
+	assert.Equal(t, 1, stats.FirstDeliveries)
+	assert.Equal(t, 0.75, stats.DuplicateRatio)
+	assert.Equal(t, 100*time.Millisecond, stats.Latency)
+
+	stats, _ = ps.RecordFirstDelivery(vote, 200*time.Millisecond)
+	assert.Equal(t, 0.5, stats.DuplicateRatio)
+	assert.Equal(t, 120*time.Millisecond, stats.Latency)
+
+	// the stats are only kept for proposals, block parts and votes
+	_, kind = ps.RecordReceived(&HasVoteMessage{})
+	assert.Empty(t, kind)
+	assert.Equal(t, PeerGossipStats{Votes: stats}, ps.GetGossipStats())
+
+	// the proposal is deferred once per round for a peer with a low priority
+	assert.False(t, ps.deferProposal(1, 0))
+	ps.SetLowGossipPriority(true)
+	assert.True(t, ps.deferProposal(1, 0))
+	assert.False(t, ps.deferProposal(1, 0))
+	assert.True(t, ps.deferProposal(1, 1))
+	ps.SetLowGossipPriority(false)
+	assert.False(t, ps.deferProposal(2, 0))
 }
 
 //-------------------------------------------------------------
@@ -39,7 +39,8 @@ var (
 	ErrAddingVote                 = errors.New("error adding vote")
 	ErrSignatureFoundInPastBlocks = errors.New("found signature from the same key")
 
-	errPubKeyIsNotSet = errors.New("pubkey is not set. Look for \"Can't get private validator pubkey\" errors")
+	errPubKeyIsNotSet  = errors.New("pubkey is not set. Look for \"Can't get private validator pubkey\" errors")
+	errVRFNotSupported = errors.New("priv validator key type does not support VRF prove, so it can't propose a block")
 )
 
 var msgQueueSize = 1000
@@ -50,6 +51,13 @@ type msgInfo struct {
 	PeerID p2p.ID  `json:"peer_key"`
 }
 
+// msgs which were added to the state, with the time since the start of the round step they were
+// added in, so statistics can be computed by the reactor
+type statsInfo struct {
+	msgInfo
+	Latency time.Duration
+}
+
 // internally generated messages which may update the state
 type timeoutInfo struct {
 	Duration time.Duration         `json:"duration"`
@@ -109,6 +117,14 @@ func (st *StepTimes) StartWaiting() time.Time {
 	return now
 }
 
+// Elapsed returns the time since the start of the current step, or 0 before the first round.
+func (st *StepTimes) Elapsed() time.Duration {
+	if st.Current == nil || st.Current.Start.IsZero() {
+		return 0
+	}
+	return tmtime.Now().Sub(st.Current.Start)
+}
+
 // interface to the mempool
 type txNotifier interface {
 	TxsAvailable() <-chan struct{}
@@ -158,9 +174,9 @@ type State struct {
 	internalMsgQueue chan msgInfo
 	timeoutTicker    TimeoutTicker
 
-	// information about about added votes and block parts are written on this channel
+	// information about about added proposals, votes and block parts are written on this channel
 	// so statistics can be computed by reactor
-	statsMsgQueue chan msgInfo
+	statsMsgQueue chan statsInfo
 
 	// we use eventBus to trigger msg broadcasts in the reactor,
 	// and to notify external subscribers, eg. through a websocket
@@ -215,7 +231,7 @@ func NewState(
 		peerMsgQueue:     make(chan msgInfo, msgQueueSize),
 		internalMsgQueue: make(chan msgInfo, msgQueueSize),
 		timeoutTicker:    NewTimeoutTicker(),
-		statsMsgQueue:    make(chan msgInfo, msgQueueSize),
+		statsMsgQueue:    make(chan statsInfo, msgQueueSize),
 		done:             make(chan struct{}),
 		doWALCatchup:     true,
 		wal:              nilWAL{},
@@ -314,6 +330,14 @@ func (cs *State) GetValidators() (int64, []*types.Validator) {
 	return cs.state.LastBlockHeight, cs.state.Validators.Copy().Validators
 }
 
+// GetUpcomingProposers returns the height being decided and the proposers
+// elected for its rounds [0, rounds).
+func (cs *State) GetUpcomingProposers(rounds int32) (int64, []*types.Validator) {
+	cs.mtx.RLock()
+	defer cs.mtx.RUnlock()
+	return cs.state.UpcomingProposers(rounds)
+}
+
 // SetPrivValidator sets the private validator account for signing votes. It
 // immediately requests pubkey and caches it.
 func (cs *State) SetPrivValidator(priv types.PrivValidator) {
@@ -854,12 +878,18 @@ func (cs *State) handleMsg(mi msgInfo) {
 	)
 
 	msg, peerID := mi.Msg, mi.PeerID
+	// the latency of the msg, before it triggers a transition
+	latency := cs.stepTimes.Elapsed()
 
 	switch msg := msg.(type) {
 	case *ProposalMessage:
 		// will not cause transition.
 		// once proposal is set, we can receive block parts
+		hadProposal := cs.Proposal != nil
 		err = cs.setProposal(msg.Proposal)
+		if !hadProposal && cs.Proposal != nil {
+			cs.statsMsgQueue <- statsInfo{mi, latency}
+		}
 
 	case *BlockPartMessage:
 		// if the proposal is complete, we'll enterPrevote or tryFinalizeCommit
@@ -883,7 +913,7 @@ func (cs *State) handleMsg(mi msgInfo) {
 			cs.handleCompleteProposal(msg.Height)
 		}
 		if added {
-			cs.statsMsgQueue <- mi
+			cs.statsMsgQueue <- statsInfo{mi, latency}
 		}
 
 		if err != nil && msg.Round != cs.Round {
@@ -901,7 +931,7 @@ func (cs *State) handleMsg(mi msgInfo) {
 		// if the vote gives us a 2/3-any or 2/3-one, we transition
 		added, err = cs.tryAddVote(msg.Vote, peerID)
 		if added {
-			cs.statsMsgQueue <- mi
+			cs.statsMsgQueue <- statsInfo{mi, latency}
 		}
 
 		// if err == ErrAddingVote {
@@ -1266,6 +1296,14 @@ func (cs *State) createProposalBlock(round int32) (block *types.Block, blockPart
 
 	message := cs.state.MakeHashMessage(round)
 
+	if !types.IsVRFSupportedKeyType(cs.privValidatorPubKey.Type()) {
+		// This validator has been elected as proposer, but it will never be
+		// able to propose a block.
+		cs.Logger.Error("propose step; cannot generate vrf proof",
+			"key_type", cs.privValidatorPubKey.Type(), "err", errVRFNotSupported)
+		return
+	}
+
 	proof, err := cs.privValidator.GenerateVRFProof(message)
 	if err != nil {
 		cs.Logger.Error(fmt.Sprintf("enterPropose: Cannot generate vrf proof: %s", err.Error()))
@@ -1779,6 +1817,7 @@ func (cs *State) pruneBlocks(retainHeight int64) (uint64, error) {
 	if err != nil {
 		return 0, fmt.Errorf("failed to prune state database: %w", err)
 	}
+	cs.blockExec.PruneIndexes(retainHeight)
 	return pruned, nil
 }
 
@@ -112,9 +112,13 @@ func TestStateProposerSelection2(t *testing.T) {
 
 	ensureNewRound(newRoundCh, height, round) // wait for the new round
 
+	upcomingHeight, upcoming := cs1.GetUpcomingProposers(round + int32(len(vss)))
+	require.Equal(t, height, upcomingHeight)
+
 	// everyone just votes nil. we get a new proposer each round
 	for i := int32(0); int(i) < len(vss); i++ {
 		prop := cs1.GetRoundState().Proposer
+		require.Equal(t, upcoming[i+round].Address, prop.Address)
 		addr := cs1.Validators.SelectProposer(cs1.state.LastProofHash, height, i+round).PubKey.Address()
 		correctProposer := addr
 		if !bytes.Equal(prop.Address, correctProposer) {
@@ -8,6 +8,7 @@ import (
 	"github.com/Finschia/ostracon/crypto"
 	"github.com/Finschia/ostracon/crypto/ed25519"
 	"github.com/Finschia/ostracon/crypto/secp256k1"
+	"github.com/Finschia/ostracon/crypto/sr25519"
 	"github.com/Finschia/ostracon/libs/json"
 )
 
@@ -33,6 +34,10 @@ func PubKeyToProto(k crypto.PubKey) (pc.PublicKey, error) {
 				Secp256K1: k,
 			},
 		}
+	case sr25519.PubKey:
+		// tendermint.crypto.PublicKey has no sr25519 variant, so the key can't
+		// be carried by validator sets, ValidatorUpdates or the stores.
+		return kp, fmt.Errorf("toproto: key type %v is not supported by tendermint.crypto.PublicKey", k.Type())
 	default:
 		return kp, fmt.Errorf("toproto: key type %v is not supported", k)
 	}
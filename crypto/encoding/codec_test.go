@@ -6,6 +6,7 @@ import (
 
 	"github.com/Finschia/ostracon/crypto"
 	"github.com/Finschia/ostracon/crypto/ed25519"
+	"github.com/Finschia/ostracon/crypto/sr25519"
 )
 
 func testPubKeyFromToProto(t *testing.T, sk crypto.PrivKey) {
@@ -29,3 +30,10 @@ func testPubKeyFromToProto(t *testing.T, sk crypto.PrivKey) {
 func TestPubKeyFromToProto(t *testing.T) {
 	testPubKeyFromToProto(t, ed25519.GenPrivKey())
 }
+
+func TestPubKeyToProtoSr25519(t *testing.T) {
+	_, err := PubKeyToProto(sr25519.GenPrivKey().PubKey())
+	if err == nil {
+		t.Fatal("sr25519 public key must not be converted to a ProtocolBuffers format")
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"crypto/sha256"
 	"crypto/subtle"
+	"encoding/hex"
 	"fmt"
 	"io"
 	"math/big"
@@ -39,9 +40,10 @@ func (privKey PrivKey) Bytes() []byte {
 	return []byte(privKey)
 }
 
-// VRFProve is not supported in Secp256k1.
-func (privKey PrivKey) VRFProve(seed []byte) (crypto.Proof, error) {
-	return nil, fmt.Errorf("VRF prove is not supported by the secp256k1")
+// VRFProve generates a VRF Proof for given message to generate a verifiable random.
+// The proof is generated by ECVRF-SECP256K1-SHA256-TAI.
+func (privKey PrivKey) VRFProve(message []byte) (crypto.Proof, error) {
+	return vrfProve(privKey, message)
 }
 
 // PubKey performs the point-scalar multiplication from the privKey on the
@@ -186,9 +188,15 @@ func (pubKey PubKey) String() string {
 	return fmt.Sprintf("PubKeySecp256k1{%X}", []byte(pubKey))
 }
 
-// VRFVerify is not supported in Secp256k1.
-func (pubKey PubKey) VRFVerify(_ []byte, _ []byte) (crypto.Output, error) {
-	return nil, fmt.Errorf("VRF verify is not supported by the secp256k1")
+// VRFVerify verifies the VRF proof generated by ECVRF-SECP256K1-SHA256-TAI
+// and returns its output.
+func (pubKey PubKey) VRFVerify(proof []byte, message []byte) (crypto.Output, error) {
+	output, err := vrfVerify(pubKey, proof, message)
+	if err != nil {
+		return nil, fmt.Errorf("either Public Key or Proof is an invalid value.: %w: proof: %s",
+			err, hex.EncodeToString(proof))
+	}
+	return output, nil
 }
 
 func (pubKey PubKey) Equals(other crypto.PubKey) bool {
@@ -0,0 +1,262 @@
+package secp256k1
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"math/big"
+
+	secp256k1 "github.com/btcsuite/btcd/btcec"
+)
+
+// ECVRF-SECP256K1-SHA256-TAI.
+//
+// The suite follows ECVRF-P256-SHA256-TAI of RFC 9381 with the curve
+// replaced by secp256k1: try-and-increment encode to curve, RFC 6979 nonce
+// generation with SHA-256, 16 bytes challenge and compressed SEC1 points.
+// secp256k1 has cofactor 1, so no cofactor clearing is needed.
+//
+// See https://www.rfc-editor.org/rfc/rfc9381.html
+const (
+	// ProofSize is the size, in bytes, of a VRF proof: Gamma (a compressed
+	// point), the challenge c and the scalar s.
+	ProofSize = PubKeySize + vrfChallengeSize + vrfScalarSize
+	// OutputSize is the size, in bytes, of a VRF output (beta).
+	OutputSize = sha256.Size
+
+	// vrfSuite is the suite string; it is not registered by RFC 9381.
+	vrfSuite         = byte(0xFE)
+	vrfChallengeSize = 16
+	vrfScalarSize    = 32
+
+	vrfEncodeToCurveFront  = byte(0x01)
+	vrfChallengeFront      = byte(0x02)
+	vrfProofToHashFront    = byte(0x03)
+	vrfDomainSeparatorBack = byte(0x00)
+)
+
+var errInvalidVRFProof = errors.New("invalid VRF proof")
+
+// ValidateProof returns an error if the size of the proof != ProofSize.
+func ValidateProof(proof []byte) error {
+	if len(proof) != ProofSize {
+		return fmt.Errorf("expected size to be %d bytes, got %d bytes", ProofSize, len(proof))
+	}
+	return nil
+}
+
+// ProofToHash returns the VRF output (beta) of the proof. It does not
+// verify the proof.
+func ProofToHash(proof []byte) ([]byte, error) {
+	gammaX, gammaY, _, _, err := decodeProof(proof)
+	if err != nil {
+		return nil, err
+	}
+	return gammaToHash(gammaX, gammaY), nil
+}
+
+// vrfProve generates the VRF proof (pi) of the message (alpha) with the
+// private key.
+func vrfProve(privKey PrivKey, message []byte) ([]byte, error) {
+	curve := secp256k1.S256()
+
+	x := new(big.Int).SetBytes(privKey)
+	if len(privKey) != PrivKeySize || x.Sign() == 0 || x.Cmp(curve.N) >= 0 {
+		return nil, errors.New("invalid private key")
+	}
+	yx, yy := curve.ScalarBaseMult(privKey)
+	pk := pointToString(yx, yy)
+
+	hx, hy, err := encodeToCurve(pk, message)
+	if err != nil {
+		return nil, err
+	}
+	h := pointToString(hx, hy)
+
+	gammaX, gammaY := curve.ScalarMult(hx, hy, privKey)
+	k := nonceRFC6979(x, h)
+	kb := intToString(k, vrfScalarSize)
+	ux, uy := curve.ScalarBaseMult(kb)
+	vx, vy := curve.ScalarMult(hx, hy, kb)
+
+	c := challenge(pk, h, pointToString(gammaX, gammaY), pointToString(ux, uy), pointToString(vx, vy))
+
+	s := new(big.Int).Mul(new(big.Int).SetBytes(c), x)
+	s.Add(s, k)
+	s.Mod(s, curve.N)
+
+	proof := make([]byte, 0, ProofSize)
+	proof = append(proof, pointToString(gammaX, gammaY)...)
+	proof = append(proof, c...)
+	proof = append(proof, intToString(s, vrfScalarSize)...)
+	return proof, nil
+}
+
+// vrfVerify verifies the VRF proof (pi) of the message (alpha) with the
+// public key and returns the VRF output (beta).
+func vrfVerify(pubKey PubKey, proof []byte, message []byte) ([]byte, error) {
+	curve := secp256k1.S256()
+
+	if len(pubKey) != PubKeySize {
+		return nil, errors.New("invalid public key")
+	}
+	y, err := secp256k1.ParsePubKey(pubKey, curve)
+	if err != nil {
+		return nil, fmt.Errorf("invalid public key: %w", err)
+	}
+
+	gammaX, gammaY, c, s, err := decodeProof(proof)
+	if err != nil {
+		return nil, err
+	}
+
+	hx, hy, err := encodeToCurve(pubKey, message)
+	if err != nil {
+		return nil, err
+	}
+
+	sb := intToString(s, vrfScalarSize)
+	cb := intToString(c, vrfScalarSize)
+
+	// U = s*B - c*Y
+	sbx, sby := curve.ScalarBaseMult(sb)
+	cyx, cyy := curve.ScalarMult(y.X, y.Y, cb)
+	ux, uy := curve.Add(sbx, sby, cyx, negY(cyy))
+
+	// V = s*H - c*Gamma
+	shx, shy := curve.ScalarMult(hx, hy, sb)
+	cgx, cgy := curve.ScalarMult(gammaX, gammaY, cb)
+	vx, vy := curve.Add(shx, shy, cgx, negY(cgy))
+
+	if isInfinity(ux, uy) || isInfinity(vx, vy) {
+		return nil, errInvalidVRFProof
+	}
+
+	expected := challenge(pubKey, pointToString(hx, hy), pointToString(gammaX, gammaY),
+		pointToString(ux, uy), pointToString(vx, vy))
+	if !bytes.Equal(expected, intToString(c, vrfChallengeSize)) {
+		return nil, errInvalidVRFProof
+	}
+
+	return gammaToHash(gammaX, gammaY), nil
+}
+
+// decodeProof decodes the proof into Gamma, c and s.
+func decodeProof(proof []byte) (gammaX, gammaY, c, s *big.Int, err error) {
+	if err := ValidateProof(proof); err != nil {
+		return nil, nil, nil, nil, err
+	}
+	gamma, err := secp256k1.ParsePubKey(proof[:PubKeySize], secp256k1.S256())
+	if err != nil {
+		return nil, nil, nil, nil, fmt.Errorf("invalid Gamma: %w", err)
+	}
+	c = new(big.Int).SetBytes(proof[PubKeySize : PubKeySize+vrfChallengeSize])
+	s = new(big.Int).SetBytes(proof[PubKeySize+vrfChallengeSize:])
+	if s.Cmp(secp256k1.S256().N) >= 0 {
+		return nil, nil, nil, nil, errors.New("invalid s")
+	}
+	return gamma.X, gamma.Y, c, s, nil
+}
+
+// encodeToCurve implements ECVRF_encode_to_curve_try_and_increment with the
+// public key as salt.
+func encodeToCurve(salt []byte, message []byte) (*big.Int, *big.Int, error) {
+	for ctr := 0; ctr < 256; ctr++ {
+		h := sha256.New()
+		h.Write([]byte{vrfSuite, vrfEncodeToCurveFront})
+		h.Write(salt)
+		h.Write(message)
+		h.Write([]byte{byte(ctr), vrfDomainSeparatorBack})
+		sum := h.Sum(nil)
+
+		// interpret_hash_value_as_a_point: string_to_point(0x02 || hash)
+		p, err := secp256k1.ParsePubKey(append([]byte{0x02}, sum...), secp256k1.S256())
+		if err == nil {
+			return p.X, p.Y, nil
+		}
+	}
+	return nil, nil, errors.New("failed to encode the message to a curve point")
+}
+
+// challenge implements ECVRF_challenge_generation. The points must be
+// encoded by pointToString.
+func challenge(points ...[]byte) []byte {
+	h := sha256.New()
+	h.Write([]byte{vrfSuite, vrfChallengeFront})
+	for _, p := range points {
+		h.Write(p)
+	}
+	h.Write([]byte{vrfDomainSeparatorBack})
+	return h.Sum(nil)[:vrfChallengeSize]
+}
+
+// gammaToHash implements the last step of ECVRF_proof_to_hash.
+func gammaToHash(gammaX, gammaY *big.Int) []byte {
+	h := sha256.New()
+	h.Write([]byte{vrfSuite, vrfProofToHashFront})
+	h.Write(pointToString(gammaX, gammaY))
+	h.Write([]byte{vrfDomainSeparatorBack})
+	return h.Sum(nil)
+}
+
+// nonceRFC6979 generates the nonce for the private key x and the encoded
+// point h as described in section 3.2 of RFC 6979, with SHA-256 as the hash
+// function.
+func nonceRFC6979(x *big.Int, h []byte) *big.Int {
+	q := secp256k1.S256().N
+	h1 := sha256.Sum256(h)
+
+	bx := intToString(x, vrfScalarSize)
+	bh := intToString(new(big.Int).Mod(new(big.Int).SetBytes(h1[:]), q), vrfScalarSize)
+
+	v := bytes.Repeat([]byte{0x01}, sha256.Size)
+	k := make([]byte, sha256.Size)
+
+	k = hmacSHA256(k, v, []byte{0x00}, bx, bh)
+	v = hmacSHA256(k, v)
+	k = hmacSHA256(k, v, []byte{0x01}, bx, bh)
+	v = hmacSHA256(k, v)
+
+	for {
+		v = hmacSHA256(k, v)
+		nonce := new(big.Int).SetBytes(v)
+		if nonce.Sign() > 0 && nonce.Cmp(q) < 0 {
+			return nonce
+		}
+		k = hmacSHA256(k, v, []byte{0x00})
+		v = hmacSHA256(k, v)
+	}
+}
+
+func hmacSHA256(key []byte, data ...[]byte) []byte {
+	mac := hmac.New(sha256.New, key)
+	for _, d := range data {
+		mac.Write(d)
+	}
+	return mac.Sum(nil)
+}
+
+// pointToString encodes the point in SEC1 compressed form.
+func pointToString(x, y *big.Int) []byte {
+	return (&secp256k1.PublicKey{Curve: secp256k1.S256(), X: x, Y: y}).SerializeCompressed()
+}
+
+// intToString encodes the non-negative integer in big-endian with the given
+// size.
+func intToString(i *big.Int, size int) []byte {
+	b := make([]byte, size)
+	return i.FillBytes(b)
+}
+
+func negY(y *big.Int) *big.Int {
+	if y.Sign() == 0 {
+		return y
+	}
+	return new(big.Int).Sub(secp256k1.S256().P, y)
+}
+
+func isInfinity(x, y *big.Int) bool {
+	return x.Sign() == 0 && y.Sign() == 0
+}
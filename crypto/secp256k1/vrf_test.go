@@ -0,0 +1,120 @@
+package secp256k1_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Finschia/ostracon/crypto/secp256k1"
+)
+
+type vrfData struct {
+	priv    string
+	message string
+	pub     string
+	proof   string
+	output  string
+}
+
+// vrfDataTable is generated by an independent implementation of
+// ECVRF-SECP256K1-SHA256-TAI.
+var vrfDataTable = []vrfData{
+	{
+		priv:    "0000000000000000000000000000000000000000000000000000000000000001",
+		message: "",
+		pub:     "0279be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798",
+		proof: "024192220588c4ef502f5d2ab75552edfbe0256cebb0424efb9c4c58f438c3dcb4" +
+			"3740e701a78589f13a3577908db37b1d" +
+			"db55edaf0706552da59a41b69be3740878407cf6d13675cd94802a33b5e629f7",
+		output: "6bf7eda22a89f87fb8c8e17fa111727ca02d0a23db29fdcbe7ac84280e8bde24",
+	},
+	{
+		priv:    "c9afa9d845ba75166b5c215767b1d6934e50c3db36e89b127b8a622b120f6721",
+		message: "73616d706c65", // "sample"
+		pub:     "032c8c31fc9f990c6b55e3865a184a4ce50e09481f2eaeb3e60ec1cea13a6ae645",
+		proof: "0338ec99b5d0f94ebcc2c704c04af3de8b4289df8798e5fb9f920d7f5d77ac03d7" +
+			"718b9677d1c9348649ac2ec4f7ecbe51" +
+			"9b30dd10c4eb5efc21dd5944709f2f3b7e97a25f6f095334593502d05103bc5b",
+		output: "d466c22e14dc3b7fd169668dd3ee9ac6351429a24aebc5e8af61a0f0de89b65a",
+	},
+	{
+		priv:    "2b1e2a1e0f9e0c86a8a4d7f0b1d6b0c5e3f1a2b3c4d5e6f708192a3b4c5d6e7f",
+		message: "74657374", // "test"
+		pub:     "029ce5770d9d65f28c2519acfb0c16116c28e749494308c38dd99596d3a246c19f",
+		proof: "0279240a3bcb1da57cdc55bef693ee5a1977859d3747a32f6d27364b7394178047" +
+			"0a482e8244d997f92bc228d633c278c4" +
+			"3ab295aed553eb4e128b3fd9505bef361ed48b7da475b4619352d8e03c89caf3",
+		output: "3b38d85bfa3bd91ebb3a03f84fce53adfbdbb6a04088e84d2111a88049d8b8bf",
+	},
+}
+
+func TestVRFProveTestVectors(t *testing.T) {
+	for _, d := range vrfDataTable {
+		privB, _ := hex.DecodeString(d.priv)
+		message, _ := hex.DecodeString(d.message)
+		priv := secp256k1.PrivKey(privB)
+
+		assert.Equal(t, d.pub, hex.EncodeToString(priv.PubKey().Bytes()))
+
+		proof, err := priv.VRFProve(message)
+		require.NoError(t, err)
+		assert.Equal(t, d.proof, hex.EncodeToString(proof))
+
+		output, err := priv.PubKey().VRFVerify(proof, message)
+		require.NoError(t, err)
+		assert.Equal(t, d.output, hex.EncodeToString(output))
+
+		hash, err := secp256k1.ProofToHash(proof)
+		require.NoError(t, err)
+		assert.Equal(t, d.output, hex.EncodeToString(hash))
+	}
+}
+
+func TestVRFProveAndVRFVerify(t *testing.T) {
+	privKey := secp256k1.GenPrivKey()
+	pubKey := privKey.PubKey()
+	message := []byte("hello, world")
+
+	proof, err := privKey.VRFProve(message)
+	require.NoError(t, err)
+	require.NoError(t, secp256k1.ValidateProof(proof))
+
+	output, err := pubKey.VRFVerify(proof, message)
+	require.NoError(t, err)
+	assert.Len(t, output, secp256k1.OutputSize)
+
+	// the proof is deterministic
+	proof2, err := privKey.VRFProve(message)
+	require.NoError(t, err)
+	assert.Equal(t, proof, proof2)
+
+	// another message
+	_, err = pubKey.VRFVerify(proof, []byte("hello, world!"))
+	assert.Error(t, err)
+
+	// another key
+	_, err = secp256k1.GenPrivKey().PubKey().VRFVerify(proof, message)
+	assert.Error(t, err)
+
+	// tampered proof
+	for _, i := range []int{0, secp256k1.PubKeySize, secp256k1.ProofSize - 1} {
+		tampered := make([]byte, len(proof))
+		copy(tampered, proof)
+		tampered[i] ^= 0x01
+		_, err = pubKey.VRFVerify(tampered, message)
+		assert.Error(t, err, "byte %d", i)
+	}
+
+	// wrong size
+	_, err = pubKey.VRFVerify(proof[:len(proof)-1], message)
+	assert.Error(t, err)
+}
+
+func TestValidateProof(t *testing.T) {
+	assert.NoError(t, secp256k1.ValidateProof(make([]byte, secp256k1.ProofSize)))
+	assert.Error(t, secp256k1.ValidateProof(nil))
+	assert.Error(t, secp256k1.ValidateProof(make([]byte, secp256k1.ProofSize-1)))
+	assert.Error(t, secp256k1.ValidateProof(make([]byte, secp256k1.ProofSize+1)))
+}
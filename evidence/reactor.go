@@ -7,6 +7,7 @@ import (
 	"github.com/gogo/protobuf/proto"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
+	"github.com/Finschia/ostracon/behaviour"
 	clist "github.com/Finschia/ostracon/libs/clist"
 	"github.com/Finschia/ostracon/libs/log"
 	"github.com/Finschia/ostracon/p2p"
@@ -32,6 +33,7 @@ type Reactor struct {
 	p2p.BaseReactor
 	evpool   *Pool
 	eventBus *types.EventBus
+	reporter behaviour.Reporter
 }
 
 // NewReactor returns a new Reactor with the given config and evpool.
@@ -49,6 +51,20 @@ func (evR *Reactor) SetLogger(l log.Logger) {
 	evR.evpool.SetLogger(l)
 }
 
+// SetReporter sets the reporter the peer behaviours are reported to.
+// It must be called before the reactor is started.
+func (evR *Reactor) SetReporter(reporter behaviour.Reporter) {
+	evR.reporter = reporter
+}
+
+// OnStart implements p2p.BaseReactor.
+func (evR *Reactor) OnStart() error {
+	if evR.reporter == nil {
+		evR.reporter = behaviour.NewSwitchReporter(evR.Switch)
+	}
+	return evR.BaseReactor.OnStart()
+}
+
 // GetChannels implements Reactor.
 // It returns the list of channels for this reactor.
 func (evR *Reactor) GetChannels() []*p2p.ChannelDescriptor {
@@ -73,7 +89,7 @@ func (evR *Reactor) ReceiveEnvelope(e p2p.Envelope) {
 	evis, err := evidenceListFromProto(e.Message)
 	if err != nil {
 		evR.Logger.Error("Error decoding message", "src", e.Src, "chId", e.ChannelID, "err", err)
-		evR.Switch.StopPeerForError(e.Src, err)
+		evR.report(behaviour.BadMessage(e.Src.ID(), err.Error()))
 		return
 	}
 
@@ -83,7 +99,7 @@ func (evR *Reactor) ReceiveEnvelope(e p2p.Envelope) {
 		case *types.ErrInvalidEvidence:
 			evR.Logger.Error(err.Error())
 			// punish peer
-			evR.Switch.StopPeerForError(e.Src, err)
+			evR.report(behaviour.InvalidEvidence(e.Src.ID(), err.Error()))
 			return
 		case nil:
 		default:
@@ -93,6 +109,12 @@ func (evR *Reactor) ReceiveEnvelope(e p2p.Envelope) {
 	}
 }
 
+func (evR *Reactor) report(pb behaviour.PeerBehaviour) {
+	if err := evR.reporter.Report(pb); err != nil {
+		evR.Logger.Debug("Failed to report peer behaviour", "err", err)
+	}
+}
+
 func (evR *Reactor) Receive(chID byte, peer p2p.Peer, msgBytes []byte) {
 	msg := &tmproto.EvidenceList{}
 	err := proto.Unmarshal(msgBytes, msg)
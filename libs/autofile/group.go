@@ -224,6 +224,14 @@ func (g *Group) Buffered() int {
 	return g.headBuf.Buffered()
 }
 
+// Flush writes any buffered data to the underlying file without committing the
+// content of the file to stable storage.
+func (g *Group) Flush() error {
+	g.mtx.Lock()
+	defer g.mtx.Unlock()
+	return g.headBuf.Flush()
+}
+
 // FlushAndSync writes any buffered data to the underlying file and commits the
 // current content of the file to stable storage (fsync).
 func (g *Group) FlushAndSync() error {
@@ -326,6 +334,28 @@ func (g *Group) RotateFile() {
 	g.maxIndex++
 }
 
+// RemoveFilesBefore removes the rotated files whose index is less than the
+// given index. The head is never removed.
+func (g *Group) RemoveFilesBefore(index int) error {
+	g.mtx.Lock()
+	defer g.mtx.Unlock()
+
+	gInfo := g.readGroupInfo()
+	for i := gInfo.MinIndex; i < index && i < gInfo.MaxIndex; i++ {
+		pathToRemove := filePathForIndex(g.Head.Path, i, gInfo.MaxIndex)
+		if err := os.Remove(pathToRemove); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	if index > g.maxIndex {
+		index = g.maxIndex
+	}
+	if index > g.minIndex {
+		g.minIndex = index
+	}
+	return nil
+}
+
 // NewReader returns a new group reader.
 // CONTRACT: Caller must close the returned GroupReader.
 func (g *Group) NewReader(index int) (*GroupReader, error) {
@@ -288,3 +288,40 @@ func TestMaxIndex(t *testing.T) {
 	// Cleanup
 	destroyTestGroup(t, g)
 }
+
+func TestRemoveFilesBefore(t *testing.T) {
+	g := createTestGroupWithHeadSizeLimit(t, 0)
+	defer destroyTestGroup(t, g)
+
+	for i := 0; i < 3; i++ {
+		err := g.WriteLine("Line")
+		require.NoError(t, err)
+		err = g.Flush()
+		require.NoError(t, err)
+		g.RotateFile()
+	}
+	assertGroupInfo(t, g.ReadGroupInfo(), 0, 3, 15, 0)
+
+	err := g.RemoveFilesBefore(2)
+	require.NoError(t, err)
+	assertGroupInfo(t, g.ReadGroupInfo(), 2, 3, 5, 0)
+	assert.Equal(t, 2, g.MinIndex())
+
+	// the head is never removed
+	err = g.WriteLine("Head")
+	require.NoError(t, err)
+	err = g.Flush()
+	require.NoError(t, err)
+	err = g.RemoveFilesBefore(10)
+	require.NoError(t, err)
+	assert.Equal(t, 3, g.MinIndex())
+	assert.EqualValues(t, 5, g.ReadGroupInfo().TotalSize)
+
+	// the head is read from the min index
+	gr, err := g.NewReader(g.MinIndex())
+	require.NoError(t, err)
+	defer gr.Close()
+	read, err := io.ReadAll(gr)
+	require.NoError(t, err)
+	assert.Equal(t, "Head\n", string(read))
+}
@@ -90,6 +90,31 @@ func TestParser(t *testing.T) {
 		{"root.dummy.v1.EventDummy.descr='\"single quote \\' included\"'", true},
 		{"root.dummy.v1.EventDummy.descr='\"single quote ' included\"'", false},
 		{"root.dummy.v1.EventDummy.descr='{\"object\":\"style\"}'", true},
+
+		{"account.balance=100 OR slashing.amount EXISTS", true},
+		{"account.balance=100 or slashing.amount EXISTS", true},
+		{"account.balance=100 OR", false},
+		{"OR account.balance=100", false},
+		{"account.balance=100 AND account.owner='Ivan' OR slashing.amount EXISTS", true},
+		{"(account.balance=100 OR slashing.amount EXISTS) AND account.owner='Ivan'", true},
+		{"( account.balance=100 OR (slashing.amount EXISTS) )", true},
+		{"(account.balance=100", false},
+		{"account.balance=100)", false},
+		{"()", false},
+		{"NOT account.balance=100", true},
+		{"NOT NOT account.balance=100", true},
+		{"NOT (account.balance=100 OR slashing.amount EXISTS)", true},
+		{"account.balance=100 NOT", false},
+		{"NOT", false},
+		{"note.text='hello'", true},
+
+		{"account.balance IN (100)", true},
+		{"account.balance IN (100, 200.5, 'all', DATE 2013-05-03, TIME 2013-05-03T14:45:00Z)", true},
+		{"account.balance IN(100,200)", true},
+		{"account.balance IN ()", false},
+		{"account.balance IN (100,)", false},
+		{"account.balance IN 100", false},
+		{"account.balance IN (NewBlock)", false},
 	}
 
 	for _, c := range cases {
@@ -2,6 +2,15 @@
 //
 //	abci.invoice.number=22 AND abci.invoice.owner=Ivan
 //
+// Conditions can be combined with AND, OR and NOT, and grouped with
+// parentheses; AND binds tighter than OR:
+//
+//	(abci.invoice.owner='Ivan' OR abci.invoice.owner='Igor') AND NOT abci.invoice.paid EXISTS
+//
+// IN matches any of a list of values:
+//
+//	abci.invoice.number IN (22, 23, 25)
+//
 // See query.peg for the grammar, which is a https://en.wikipedia.org/wiki/Parsing_expression_grammar.
 // More: https://github.com/PhilippeSigaud/Pegged/wiki/PEG-Basics
 //
@@ -21,20 +30,43 @@ var (
 	numRegex = regexp.MustCompile(`([0-9\.]+)`)
 )
 
-// Query holds the query string and the query parser.
+// Query holds the query string and its syntax tree.
 type Query struct {
-	str    string
-	parser *QueryParser
+	str  string
+	expr *Expression
 }
 
 // Condition represents a single condition within a query and consists of composite key
-// (e.g. "tx.gas"), operator (e.g. "=") and operand (e.g. "7").
+// (e.g. "tx.gas"), operator (e.g. "=") and operand (e.g. "7"). The operand of
+// an IN condition is the list of its values ([]interface{}).
 type Condition struct {
 	CompositeKey string
 	Op           Operator
 	Operand      interface{}
 }
 
+// Expression is a node of the syntax tree of a query: either a single
+// condition, or the conjunction, disjunction or negation of sub-expressions.
+type Expression struct {
+	Op          ExpressionOp
+	Condition   Condition     // set if Op is ExprCondition
+	Expressions []*Expression // the sub-expressions; a single one for ExprNot
+}
+
+// ExpressionOp is the logical operator of an Expression.
+type ExpressionOp uint8
+
+const (
+	// a single condition
+	ExprCondition ExpressionOp = iota
+	// "AND"
+	ExprAnd
+	// "OR"
+	ExprOr
+	// "NOT"
+	ExprNot
+)
+
 // New parses the given string and returns a query or error if the string is
 // invalid.
 func New(s string) (*Query, error) {
@@ -48,7 +80,12 @@ func New(s string) (*Query, error) {
 		return nil, err
 	}
 
-	return &Query{str: s, parser: p}, nil
+	expr, err := newExpression(p.AST().up, p.Buffer)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Query{str: s, expr: expr}, nil
 }
 
 // MustParse turns the given string into a query or panics; for tests or others
@@ -85,6 +122,8 @@ const (
 	OpContains
 	// "EXISTS"; used to check if a certain event attribute is present.
 	OpExists
+	// "IN"; used to check if an event attribute is equal to any of the values.
+	OpIn
 )
 
 const (
@@ -94,106 +133,206 @@ const (
 	TimeLayout = time.RFC3339
 )
 
-// Conditions returns a list of conditions. It returns an error if there is any
-// error with the provided grammar in the Query.
-func (q *Query) Conditions() ([]Condition, error) {
-	var (
-		eventAttr string
-		op        Operator
-	)
+// Expression returns the syntax tree of the query.
+func (q *Query) Expression() *Expression {
+	return q.expr
+}
 
+// Conditions returns a list of conditions. It returns an error if the query is
+// not a conjunction of conditions, i.e. if it uses OR or NOT; use Expression
+// for those.
+func (q *Query) Conditions() ([]Condition, error) {
 	conditions := make([]Condition, 0)
-	buffer, begin, end := q.parser.Buffer, 0, 0
-
-	// tokens must be in the following order: tag ("tx.gas") -> operator ("=") -> operand ("7")
-	for _, token := range q.parser.Tokens() {
-		switch token.pegRule {
-		case rulePegText:
-			begin, end = int(token.begin), int(token.end)
-
-		case ruletag:
-			eventAttr = buffer[begin:end]
-
-		case rulele:
-			op = OpLessEqual
+	if !q.expr.conjunction(&conditions) {
+		return nil, fmt.Errorf("query %q is not a conjunction of conditions", q.str)
+	}
+	return conditions, nil
+}
 
-		case rulege:
-			op = OpGreaterEqual
+// conjunction appends the conditions of the expression to conditions and
+// returns true if the expression is a condition or an AND of conditions.
+func (e *Expression) conjunction(conditions *[]Condition) bool {
+	switch e.Op {
+	case ExprCondition:
+		*conditions = append(*conditions, e.Condition)
+		return true
+	case ExprAnd:
+		for _, sub := range e.Expressions {
+			if !sub.conjunction(conditions) {
+				return false
+			}
+		}
+		return true
+	default:
+		return false
+	}
+}
 
-		case rulel:
-			op = OpLess
+// newExpression builds the expression of the AST node of an expression, a
+// term, a factor or a condition.
+func newExpression(node *node32, buffer string) (*Expression, error) {
+	switch node.pegRule {
+	case ruleexpression, ruleterm:
+		op := ExprOr
+		if node.pegRule == ruleterm {
+			op = ExprAnd
+		}
 
-		case ruleg:
-			op = OpGreater
+		expr := &Expression{Op: op}
+		for child := node.up; child != nil; child = child.next {
+			if child.pegRule == ruleor || child.pegRule == ruleand {
+				continue
+			}
 
-		case ruleequal:
-			op = OpEqual
+			sub, err := newExpression(child, buffer)
+			if err != nil {
+				return nil, err
+			}
+			// "a AND (b AND c)" is "a AND b AND c"
+			if sub.Op == op {
+				expr.Expressions = append(expr.Expressions, sub.Expressions...)
+			} else {
+				expr.Expressions = append(expr.Expressions, sub)
+			}
+		}
 
-		case rulecontains:
-			op = OpContains
+		if len(expr.Expressions) == 1 {
+			return expr.Expressions[0], nil
+		}
+		return expr, nil
 
-		case ruleexists:
-			op = OpExists
-			conditions = append(conditions, Condition{eventAttr, op, nil})
+	case rulefactor:
+		child := node.up
+		if child.pegRule != rulenot {
+			return newExpression(child, buffer)
+		}
 
-		case rulevalue:
-			// strip single quotes from value (i.e. "'NewBlock'" -> "NewBlock")
-			valueWithoutSingleQuotes := buffer[begin+1 : end-1]
-			conditions = append(conditions, Condition{eventAttr, op, valueWithoutSingleQuotes})
+		sub, err := newExpression(child.next, buffer)
+		if err != nil {
+			return nil, err
+		}
+		return &Expression{Op: ExprNot, Expressions: []*Expression{sub}}, nil
 
-		case rulenumber:
-			number := buffer[begin:end]
-			if strings.ContainsAny(number, ".") { // if it looks like a floating-point number
-				value, err := strconv.ParseFloat(number, 64)
-				if err != nil {
-					err = fmt.Errorf(
-						"got %v while trying to parse %s as float64 (should never happen if the grammar is correct)",
-						err, number,
-					)
-					return nil, err
-				}
+	case rulecondition:
+		condition, err := newCondition(node, buffer)
+		if err != nil {
+			return nil, err
+		}
+		return &Expression{Op: ExprCondition, Condition: condition}, nil
 
-				conditions = append(conditions, Condition{eventAttr, op, value})
-			} else {
-				value, err := strconv.ParseInt(number, 10, 64)
-				if err != nil {
-					err = fmt.Errorf(
-						"got %v while trying to parse %s as int64 (should never happen if the grammar is correct)",
-						err, number,
-					)
-					return nil, err
-				}
-
-				conditions = append(conditions, Condition{eventAttr, op, value})
-			}
+	default:
+		return nil, fmt.Errorf("unexpected %v (should never happen if the grammar is correct)", rul3s[node.pegRule])
+	}
+}
 
-		case ruletime:
-			value, err := time.Parse(TimeLayout, buffer[begin:end])
+// newCondition builds the condition of the AST node of a condition.
+//
+// children must be in the following order: tag ("tx.gas") -> operator ("=") -> operand ("7")
+func newCondition(node *node32, buffer string) (Condition, error) {
+	var condition Condition
+
+	tag := node.up
+	condition.CompositeKey = buffer[tag.begin:tag.end]
+
+	op := tag.next
+	switch op.pegRule {
+	case rulele:
+		condition.Op = OpLessEqual
+	case rulege:
+		condition.Op = OpGreaterEqual
+	case rulel:
+		condition.Op = OpLess
+	case ruleg:
+		condition.Op = OpGreater
+	case ruleequal:
+		condition.Op = OpEqual
+	case rulecontains:
+		condition.Op = OpContains
+	case ruleexists:
+		condition.Op = OpExists
+		return condition, nil
+	case rulein:
+		condition.Op = OpIn
+		values := make([]interface{}, 0)
+		for operand := op.next; operand != nil; operand = operand.next {
+			value, err := newOperand(operand.up, buffer)
 			if err != nil {
-				err = fmt.Errorf(
-					"got %v while trying to parse %s as time.Time / RFC3339 (should never happen if the grammar is correct)",
-					err, buffer[begin:end],
-				)
-				return nil, err
+				return condition, err
 			}
+			values = append(values, value)
+		}
+		condition.Operand = values
+		return condition, nil
+	}
 
-			conditions = append(conditions, Condition{eventAttr, op, value})
+	value, err := newOperand(op.next, buffer)
+	if err != nil {
+		return condition, err
+	}
+	condition.Operand = value
+	return condition, nil
+}
 
-		case ruledate:
-			value, err := time.Parse("2006-01-02", buffer[begin:end])
+// newOperand parses the AST node of a value, a number, a time or a date.
+func newOperand(node *node32, buffer string) (interface{}, error) {
+	// the text of a time or a date follows the "TIME " or "DATE " prefix
+	text := node.up
+	begin, end := int(text.begin), int(text.end)
+
+	switch node.pegRule {
+	case rulevalue:
+		// strip single quotes from value (i.e. "'NewBlock'" -> "NewBlock")
+		return buffer[begin+1 : end-1], nil
+
+	case rulenumber:
+		number := buffer[begin:end]
+		if strings.ContainsAny(number, ".") { // if it looks like a floating-point number
+			value, err := strconv.ParseFloat(number, 64)
 			if err != nil {
 				err = fmt.Errorf(
-					"got %v while trying to parse %s as time.Time / '2006-01-02' (should never happen if the grammar is correct)",
-					err, buffer[begin:end],
+					"got %v while trying to parse %s as float64 (should never happen if the grammar is correct)",
+					err, number,
 				)
 				return nil, err
 			}
+			return value, nil
+		}
 
-			conditions = append(conditions, Condition{eventAttr, op, value})
+		value, err := strconv.ParseInt(number, 10, 64)
+		if err != nil {
+			err = fmt.Errorf(
+				"got %v while trying to parse %s as int64 (should never happen if the grammar is correct)",
+				err, number,
+			)
+			return nil, err
 		}
-	}
+		return value, nil
 
-	return conditions, nil
+	case ruletime:
+		value, err := time.Parse(TimeLayout, buffer[begin:end])
+		if err != nil {
+			err = fmt.Errorf(
+				"got %v while trying to parse %s as time.Time / RFC3339 (should never happen if the grammar is correct)",
+				err, buffer[begin:end],
+			)
+			return nil, err
+		}
+		return value, nil
+
+	case ruledate:
+		value, err := time.Parse(DateLayout, buffer[begin:end])
+		if err != nil {
+			err = fmt.Errorf(
+				"got %v while trying to parse %s as time.Time / '2006-01-02' (should never happen if the grammar is correct)",
+				err, buffer[begin:end],
+			)
+			return nil, err
+		}
+		return value, nil
+
+	default:
+		return nil, fmt.Errorf("unexpected %v (should never happen if the grammar is correct)", rul3s[node.pegRule])
+	}
 }
 
 // Matches returns true if the query matches against any event in the given set
@@ -208,160 +347,75 @@ func (q *Query) Matches(events map[string][]string) (bool, error) {
 		return false, nil
 	}
 
-	var (
-		eventAttr string
-		op        Operator
-	)
-
-	buffer, begin, end := q.parser.Buffer, 0, 0
-
-	// tokens must be in the following order:
-
-	// tag ("tx.gas") -> operator ("=") -> operand ("7")
-	for _, token := range q.parser.Tokens() {
-		switch token.pegRule {
-		case rulePegText:
-			begin, end = int(token.begin), int(token.end)
-
-		case ruletag:
-			eventAttr = buffer[begin:end]
-
-		case rulele:
-			op = OpLessEqual
-
-		case rulege:
-			op = OpGreaterEqual
-
-		case rulel:
-			op = OpLess
-
-		case ruleg:
-			op = OpGreater
-
-		case ruleequal:
-			op = OpEqual
-
-		case rulecontains:
-			op = OpContains
-		case ruleexists:
-			op = OpExists
-			if strings.Contains(eventAttr, ".") {
-				// Searching for a full "type.attribute" event.
-				_, ok := events[eventAttr]
-				if !ok {
-					return false, nil
-				}
-			} else {
-				foundEvent := false
-
-			loop:
-				for compositeKey := range events {
-					if strings.Index(compositeKey, eventAttr) == 0 {
-						foundEvent = true
-						break loop
-					}
-				}
-				if !foundEvent {
-					return false, nil
-				}
-			}
-
-		case rulevalue:
-			// strip single quotes from value (i.e. "'NewBlock'" -> "NewBlock")
-			valueWithoutSingleQuotes := buffer[begin+1 : end-1]
+	return q.expr.Matches(events)
+}
 
-			// see if the triplet (event attribute, operator, operand) matches any event
-			// "tx.gas", "=", "7", { "tx.gas": 7, "tx.ID": "4AE393495334" }
-			match, err := match(eventAttr, op, reflect.ValueOf(valueWithoutSingleQuotes), events)
-			if err != nil {
+// Matches returns true if the expression matches against the given set of
+// events, see Query.Matches.
+func (e *Expression) Matches(events map[string][]string) (bool, error) {
+	switch e.Op {
+	case ExprAnd:
+		for _, sub := range e.Expressions {
+			match, err := sub.Matches(events)
+			if err != nil || !match {
 				return false, err
 			}
+		}
+		return true, nil
 
-			if !match {
-				return false, nil
-			}
-
-		case rulenumber:
-			number := buffer[begin:end]
-			if strings.ContainsAny(number, ".") { // if it looks like a floating-point number
-				value, err := strconv.ParseFloat(number, 64)
-				if err != nil {
-					err = fmt.Errorf(
-						"got %v while trying to parse %s as float64 (should never happen if the grammar is correct)",
-						err, number,
-					)
-					return false, err
-				}
-
-				match, err := match(eventAttr, op, reflect.ValueOf(value), events)
-				if err != nil {
-					return false, err
-				}
-
-				if !match {
-					return false, nil
-				}
-			} else {
-				value, err := strconv.ParseInt(number, 10, 64)
-				if err != nil {
-					err = fmt.Errorf(
-						"got %v while trying to parse %s as int64 (should never happen if the grammar is correct)",
-						err, number,
-					)
-					return false, err
-				}
-
-				match, err := match(eventAttr, op, reflect.ValueOf(value), events)
-				if err != nil {
-					return false, err
-				}
-
-				if !match {
-					return false, nil
-				}
-			}
-
-		case ruletime:
-			value, err := time.Parse(TimeLayout, buffer[begin:end])
-			if err != nil {
-				err = fmt.Errorf(
-					"got %v while trying to parse %s as time.Time / RFC3339 (should never happen if the grammar is correct)",
-					err, buffer[begin:end],
-				)
-				return false, err
+	case ExprOr:
+		for _, sub := range e.Expressions {
+			match, err := sub.Matches(events)
+			if err != nil || match {
+				return match, err
 			}
+		}
+		return false, nil
 
-			match, err := match(eventAttr, op, reflect.ValueOf(value), events)
-			if err != nil {
-				return false, err
-			}
+	case ExprNot:
+		match, err := e.Expressions[0].Matches(events)
+		if err != nil {
+			return false, err
+		}
+		return !match, nil
 
-			if !match {
-				return false, nil
-			}
+	default:
+		return e.Condition.Matches(events)
+	}
+}
 
-		case ruledate:
-			value, err := time.Parse("2006-01-02", buffer[begin:end])
-			if err != nil {
-				err = fmt.Errorf(
-					"got %v while trying to parse %s as time.Time / '2006-01-02' (should never happen if the grammar is correct)",
-					err, buffer[begin:end],
-				)
-				return false, err
-			}
+// Matches returns true if the condition matches against the given set of
+// events.
+func (c Condition) Matches(events map[string][]string) (bool, error) {
+	switch c.Op {
+	case OpExists:
+		if strings.Contains(c.CompositeKey, ".") {
+			// Searching for a full "type.attribute" event.
+			_, ok := events[c.CompositeKey]
+			return ok, nil
+		}
 
-			match, err := match(eventAttr, op, reflect.ValueOf(value), events)
-			if err != nil {
-				return false, err
+		for compositeKey := range events {
+			if strings.Index(compositeKey, c.CompositeKey) == 0 {
+				return true, nil
 			}
+		}
+		return false, nil
 
-			if !match {
-				return false, nil
+	case OpIn:
+		for _, operand := range c.Operand.([]interface{}) {
+			match, err := match(c.CompositeKey, OpEqual, reflect.ValueOf(operand), events)
+			if err != nil || match {
+				return match, err
 			}
 		}
-	}
+		return false, nil
 
-	return true, nil
+	default:
+		// see if the triplet (event attribute, operator, operand) matches any event
+		// "tx.gas", "=", "7", { "tx.gas": 7, "tx.ID": "4AE393495334" }
+		return match(c.CompositeKey, c.Op, reflect.ValueOf(c.Operand), events)
+	}
 }
 
 // match returns true if the given triplet (attribute, operator, operand) matches
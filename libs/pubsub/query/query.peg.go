@@ -19,7 +19,11 @@ type pegRule uint8
 const (
 	ruleUnknown pegRule = iota
 	rulee
+	ruleexpression
+	ruleterm
+	rulefactor
 	rulecondition
+	ruleoperand
 	ruletag
 	rulevalue
 	rulenumber
@@ -30,9 +34,12 @@ const (
 	rulemonth
 	ruleday
 	ruleand
+	ruleor
+	rulenot
 	ruleequal
 	rulecontains
 	ruleexists
+	rulein
 	rulele
 	rulege
 	rulel
@@ -43,7 +50,11 @@ const (
 var rul3s = [...]string{
 	"Unknown",
 	"e",
+	"expression",
+	"term",
+	"factor",
 	"condition",
+	"operand",
 	"tag",
 	"value",
 	"number",
@@ -54,9 +65,12 @@ var rul3s = [...]string{
 	"month",
 	"day",
 	"and",
+	"or",
+	"not",
 	"equal",
 	"contains",
 	"exists",
+	"in",
 	"le",
 	"ge",
 	"l",
@@ -176,7 +190,7 @@ func (t *tokens32) Tokens() []token32 {
 type QueryParser struct {
 	Buffer string
 	buffer []rune
-	rules  [21]func() bool
+	rules  [28]func() bool
 	parse  func(rule ...int) error
 	reset  func()
 	Pretty bool
@@ -356,7 +370,7 @@ func (p *QueryParser) Init(options ...func(*QueryParser) error) error {
 
 	_rules = [...]func() bool{
 		nil,
-		/* 0 e <- <('"' condition (' '+ and ' '+ condition)* '"' !.)> */
+		/* 0 e <- <('"' expression '"' !.)> */
 		func() bool {
 			position0, tokenIndex0 := position, tokenIndex
 			{
@@ -365,1273 +379,1647 @@ func (p *QueryParser) Init(options ...func(*QueryParser) error) error {
 					goto l0
 				}
 				position++
-				if !_rules[rulecondition]() {
+				if !_rules[ruleexpression]() {
 					goto l0
 				}
-			l2:
+				if buffer[position] != rune('"') {
+					goto l0
+				}
+				position++
+				{
+					position2, tokenIndex2 := position, tokenIndex
+					if !matchDot() {
+						goto l2
+					}
+					goto l0
+				l2:
+					position, tokenIndex = position2, tokenIndex2
+				}
+				add(rulee, position1)
+			}
+			return true
+		l0:
+			position, tokenIndex = position0, tokenIndex0
+			return false
+		},
+		/* 1 expression <- <(term (' '+ or ' '+ term)*)> */
+		func() bool {
+			position3, tokenIndex3 := position, tokenIndex
+			{
+				position4 := position
+				if !_rules[ruleterm]() {
+					goto l3
+				}
+			l5:
+				{
+					position6, tokenIndex6 := position, tokenIndex
+					if buffer[position] != rune(' ') {
+						goto l6
+					}
+					position++
+				l7:
+					{
+						position8, tokenIndex8 := position, tokenIndex
+						if buffer[position] != rune(' ') {
+							goto l8
+						}
+						position++
+						goto l7
+					l8:
+						position, tokenIndex = position8, tokenIndex8
+					}
+					{
+						position9 := position
+						{
+							position10, tokenIndex10 := position, tokenIndex
+							if buffer[position] != rune('o') {
+								goto l11
+							}
+							position++
+							goto l10
+						l11:
+							position, tokenIndex = position10, tokenIndex10
+							if buffer[position] != rune('O') {
+								goto l6
+							}
+							position++
+						}
+					l10:
+						{
+							position12, tokenIndex12 := position, tokenIndex
+							if buffer[position] != rune('r') {
+								goto l13
+							}
+							position++
+							goto l12
+						l13:
+							position, tokenIndex = position12, tokenIndex12
+							if buffer[position] != rune('R') {
+								goto l6
+							}
+							position++
+						}
+					l12:
+						add(ruleor, position9)
+					}
+					if buffer[position] != rune(' ') {
+						goto l6
+					}
+					position++
+				l14:
+					{
+						position15, tokenIndex15 := position, tokenIndex
+						if buffer[position] != rune(' ') {
+							goto l15
+						}
+						position++
+						goto l14
+					l15:
+						position, tokenIndex = position15, tokenIndex15
+					}
+					if !_rules[ruleterm]() {
+						goto l6
+					}
+					goto l5
+				l6:
+					position, tokenIndex = position6, tokenIndex6
+				}
+				add(ruleexpression, position4)
+			}
+			return true
+		l3:
+			position, tokenIndex = position3, tokenIndex3
+			return false
+		},
+		/* 2 term <- <(factor (' '+ and ' '+ factor)*)> */
+		func() bool {
+			position16, tokenIndex16 := position, tokenIndex
+			{
+				position17 := position
+				if !_rules[rulefactor]() {
+					goto l16
+				}
+			l18:
 				{
-					position3, tokenIndex3 := position, tokenIndex
+					position19, tokenIndex19 := position, tokenIndex
 					if buffer[position] != rune(' ') {
-						goto l3
+						goto l19
 					}
 					position++
-				l4:
+				l20:
 					{
-						position5, tokenIndex5 := position, tokenIndex
+						position21, tokenIndex21 := position, tokenIndex
 						if buffer[position] != rune(' ') {
-							goto l5
+							goto l21
 						}
 						position++
-						goto l4
-					l5:
-						position, tokenIndex = position5, tokenIndex5
+						goto l20
+					l21:
+						position, tokenIndex = position21, tokenIndex21
 					}
 					{
-						position6 := position
+						position22 := position
 						{
-							position7, tokenIndex7 := position, tokenIndex
+							position23, tokenIndex23 := position, tokenIndex
 							if buffer[position] != rune('a') {
-								goto l8
+								goto l24
 							}
 							position++
-							goto l7
-						l8:
-							position, tokenIndex = position7, tokenIndex7
+							goto l23
+						l24:
+							position, tokenIndex = position23, tokenIndex23
 							if buffer[position] != rune('A') {
-								goto l3
+								goto l19
 							}
 							position++
 						}
-					l7:
+					l23:
 						{
-							position9, tokenIndex9 := position, tokenIndex
+							position25, tokenIndex25 := position, tokenIndex
 							if buffer[position] != rune('n') {
-								goto l10
+								goto l26
 							}
 							position++
-							goto l9
-						l10:
-							position, tokenIndex = position9, tokenIndex9
+							goto l25
+						l26:
+							position, tokenIndex = position25, tokenIndex25
 							if buffer[position] != rune('N') {
-								goto l3
+								goto l19
 							}
 							position++
 						}
-					l9:
+					l25:
 						{
-							position11, tokenIndex11 := position, tokenIndex
+							position27, tokenIndex27 := position, tokenIndex
 							if buffer[position] != rune('d') {
-								goto l12
+								goto l28
 							}
 							position++
-							goto l11
-						l12:
-							position, tokenIndex = position11, tokenIndex11
+							goto l27
+						l28:
+							position, tokenIndex = position27, tokenIndex27
 							if buffer[position] != rune('D') {
-								goto l3
+								goto l19
 							}
 							position++
 						}
-					l11:
-						add(ruleand, position6)
+					l27:
+						add(ruleand, position22)
 					}
 					if buffer[position] != rune(' ') {
-						goto l3
+						goto l19
 					}
 					position++
-				l13:
+				l29:
 					{
-						position14, tokenIndex14 := position, tokenIndex
+						position30, tokenIndex30 := position, tokenIndex
 						if buffer[position] != rune(' ') {
-							goto l14
+							goto l30
 						}
 						position++
-						goto l13
-					l14:
-						position, tokenIndex = position14, tokenIndex14
-					}
-					if !_rules[rulecondition]() {
-						goto l3
+						goto l29
+					l30:
+						position, tokenIndex = position30, tokenIndex30
 					}
-					goto l2
-				l3:
-					position, tokenIndex = position3, tokenIndex3
-				}
-				if buffer[position] != rune('"') {
-					goto l0
-				}
-				position++
-				{
-					position15, tokenIndex15 := position, tokenIndex
-					if !matchDot() {
-						goto l15
+					if !_rules[rulefactor]() {
+						goto l19
 					}
-					goto l0
-				l15:
-					position, tokenIndex = position15, tokenIndex15
+					goto l18
+				l19:
+					position, tokenIndex = position19, tokenIndex19
 				}
-				add(rulee, position1)
+				add(ruleterm, position17)
 			}
 			return true
-		l0:
-			position, tokenIndex = position0, tokenIndex0
+		l16:
+			position, tokenIndex = position16, tokenIndex16
 			return false
 		},
-		/* 1 condition <- <(tag ' '* ((le ' '* ((&('D' | 'd') date) | (&('T' | 't') time) | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') number))) / (ge ' '* ((&('D' | 'd') date) | (&('T' | 't') time) | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') number))) / ((&('E' | 'e') exists) | (&('=') (equal ' '* ((&('\'') value) | (&('D' | 'd') date) | (&('T' | 't') time) | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') number)))) | (&('>') (g ' '* ((&('D' | 'd') date) | (&('T' | 't') time) | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') number)))) | (&('<') (l ' '* ((&('D' | 'd') date) | (&('T' | 't') time) | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') number)))) | (&('C' | 'c') (contains ' '* value)))))> */
+		/* 3 factor <- <((not ' '+ factor) / ('(' ' '* expression ' '* ')') / condition)> */
 		func() bool {
-			position16, tokenIndex16 := position, tokenIndex
+			position31, tokenIndex31 := position, tokenIndex
 			{
-				position17 := position
+				position32 := position
 				{
-					position18 := position
+					position33, tokenIndex33 := position, tokenIndex
 					{
-						position19 := position
+						position35 := position
 						{
-							position22, tokenIndex22 := position, tokenIndex
-							{
-								switch buffer[position] {
-								case '<':
-									if buffer[position] != rune('<') {
-										goto l22
-									}
-									position++
-								case '>':
-									if buffer[position] != rune('>') {
-										goto l22
-									}
-									position++
-								case '=':
-									if buffer[position] != rune('=') {
-										goto l22
-									}
-									position++
-								case '\'':
-									if buffer[position] != rune('\'') {
-										goto l22
-									}
-									position++
-								case '"':
-									if buffer[position] != rune('"') {
-										goto l22
-									}
-									position++
-								case ')':
-									if buffer[position] != rune(')') {
-										goto l22
-									}
-									position++
-								case '(':
-									if buffer[position] != rune('(') {
-										goto l22
-									}
-									position++
-								case '\\':
-									if buffer[position] != rune('\\') {
-										goto l22
-									}
-									position++
-								case '\r':
-									if buffer[position] != rune('\r') {
-										goto l22
-									}
-									position++
-								case '\n':
-									if buffer[position] != rune('\n') {
-										goto l22
-									}
-									position++
-								case '\t':
-									if buffer[position] != rune('\t') {
-										goto l22
-									}
-									position++
-								default:
-									if buffer[position] != rune(' ') {
-										goto l22
-									}
-									position++
-								}
+							position36, tokenIndex36 := position, tokenIndex
+							if buffer[position] != rune('n') {
+								goto l37
 							}
-
-							goto l16
-						l22:
-							position, tokenIndex = position22, tokenIndex22
+							position++
+							goto l36
+						l37:
+							position, tokenIndex = position36, tokenIndex36
+							if buffer[position] != rune('N') {
+								goto l34
+							}
+							position++
 						}
-						if !matchDot() {
-							goto l16
+					l36:
+						{
+							position38, tokenIndex38 := position, tokenIndex
+							if buffer[position] != rune('o') {
+								goto l39
+							}
+							position++
+							goto l38
+						l39:
+							position, tokenIndex = position38, tokenIndex38
+							if buffer[position] != rune('O') {
+								goto l34
+							}
+							position++
 						}
-					l20:
+					l38:
 						{
-							position21, tokenIndex21 := position, tokenIndex
-							{
-								position24, tokenIndex24 := position, tokenIndex
-								{
-									switch buffer[position] {
-									case '<':
-										if buffer[position] != rune('<') {
-											goto l24
-										}
-										position++
-									case '>':
-										if buffer[position] != rune('>') {
-											goto l24
-										}
-										position++
-									case '=':
-										if buffer[position] != rune('=') {
-											goto l24
-										}
-										position++
-									case '\'':
-										if buffer[position] != rune('\'') {
-											goto l24
-										}
-										position++
-									case '"':
-										if buffer[position] != rune('"') {
-											goto l24
-										}
-										position++
-									case ')':
-										if buffer[position] != rune(')') {
-											goto l24
-										}
-										position++
-									case '(':
-										if buffer[position] != rune('(') {
-											goto l24
-										}
-										position++
-									case '\\':
-										if buffer[position] != rune('\\') {
-											goto l24
-										}
-										position++
-									case '\r':
-										if buffer[position] != rune('\r') {
-											goto l24
-										}
-										position++
-									case '\n':
-										if buffer[position] != rune('\n') {
-											goto l24
-										}
-										position++
-									case '\t':
-										if buffer[position] != rune('\t') {
-											goto l24
-										}
-										position++
-									default:
-										if buffer[position] != rune(' ') {
-											goto l24
-										}
-										position++
-									}
-								}
-
-								goto l21
-							l24:
-								position, tokenIndex = position24, tokenIndex24
+							position40, tokenIndex40 := position, tokenIndex
+							if buffer[position] != rune('t') {
+								goto l41
 							}
-							if !matchDot() {
-								goto l21
+							position++
+							goto l40
+						l41:
+							position, tokenIndex = position40, tokenIndex40
+							if buffer[position] != rune('T') {
+								goto l34
 							}
-							goto l20
-						l21:
-							position, tokenIndex = position21, tokenIndex21
+							position++
 						}
-						add(rulePegText, position19)
+					l40:
+						add(rulenot, position35)
 					}
-					add(ruletag, position18)
-				}
-			l26:
-				{
-					position27, tokenIndex27 := position, tokenIndex
 					if buffer[position] != rune(' ') {
-						goto l27
+						goto l34
 					}
 					position++
-					goto l26
-				l27:
-					position, tokenIndex = position27, tokenIndex27
-				}
-				{
-					position28, tokenIndex28 := position, tokenIndex
+				l42:
 					{
-						position30 := position
-						if buffer[position] != rune('<') {
-							goto l29
-						}
-						position++
-						if buffer[position] != rune('=') {
-							goto l29
-						}
-						position++
-						add(rulele, position30)
-					}
-				l31:
-					{
-						position32, tokenIndex32 := position, tokenIndex
+						position43, tokenIndex43 := position, tokenIndex
 						if buffer[position] != rune(' ') {
-							goto l32
+							goto l43
 						}
 						position++
-						goto l31
-					l32:
-						position, tokenIndex = position32, tokenIndex32
+						goto l42
+					l43:
+						position, tokenIndex = position43, tokenIndex43
 					}
-					{
-						switch buffer[position] {
-						case 'D', 'd':
-							if !_rules[ruledate]() {
-								goto l29
-							}
-						case 'T', 't':
-							if !_rules[ruletime]() {
-								goto l29
-							}
-						default:
-							if !_rules[rulenumber]() {
-								goto l29
-							}
-						}
+					if !_rules[rulefactor]() {
+						goto l34
 					}
-
-					goto l28
-				l29:
-					position, tokenIndex = position28, tokenIndex28
+					goto l33
+				l34:
+					position, tokenIndex = position33, tokenIndex33
+					if buffer[position] != rune('(') {
+						goto l44
+					}
+					position++
+				l45:
 					{
-						position35 := position
-						if buffer[position] != rune('>') {
-							goto l34
-						}
-						position++
-						if buffer[position] != rune('=') {
-							goto l34
+						position46, tokenIndex46 := position, tokenIndex
+						if buffer[position] != rune(' ') {
+							goto l46
 						}
 						position++
-						add(rulege, position35)
+						goto l45
+					l46:
+						position, tokenIndex = position46, tokenIndex46
 					}
-				l36:
+					if !_rules[ruleexpression]() {
+						goto l44
+					}
+				l47:
 					{
-						position37, tokenIndex37 := position, tokenIndex
+						position48, tokenIndex48 := position, tokenIndex
 						if buffer[position] != rune(' ') {
-							goto l37
+							goto l48
 						}
 						position++
-						goto l36
-					l37:
-						position, tokenIndex = position37, tokenIndex37
+						goto l47
+					l48:
+						position, tokenIndex = position48, tokenIndex48
 					}
-					{
-						switch buffer[position] {
-						case 'D', 'd':
-							if !_rules[ruledate]() {
-								goto l34
-							}
-						case 'T', 't':
-							if !_rules[ruletime]() {
-								goto l34
-							}
-						default:
-							if !_rules[rulenumber]() {
-								goto l34
-							}
-						}
+					if buffer[position] != rune(')') {
+						goto l44
 					}
-
-					goto l28
-				l34:
-					position, tokenIndex = position28, tokenIndex28
+					position++
+					goto l33
+				l44:
+					position, tokenIndex = position33, tokenIndex33
 					{
-						switch buffer[position] {
-						case 'E', 'e':
+						position49 := position
+						{
+							position50 := position
 							{
-								position40 := position
+								position51 := position
 								{
-									position41, tokenIndex41 := position, tokenIndex
-									if buffer[position] != rune('e') {
-										goto l42
-									}
-									position++
-									goto l41
-								l42:
-									position, tokenIndex = position41, tokenIndex41
-									if buffer[position] != rune('E') {
-										goto l16
-									}
-									position++
-								}
-							l41:
-								{
-									position43, tokenIndex43 := position, tokenIndex
-									if buffer[position] != rune('x') {
-										goto l44
-									}
-									position++
-									goto l43
-								l44:
-									position, tokenIndex = position43, tokenIndex43
-									if buffer[position] != rune('X') {
-										goto l16
-									}
-									position++
-								}
-							l43:
-								{
-									position45, tokenIndex45 := position, tokenIndex
-									if buffer[position] != rune('i') {
-										goto l46
-									}
-									position++
-									goto l45
-								l46:
-									position, tokenIndex = position45, tokenIndex45
-									if buffer[position] != rune('I') {
-										goto l16
+									position54, tokenIndex54 := position, tokenIndex
+									{
+										switch buffer[position] {
+										case '<':
+											if buffer[position] != rune('<') {
+												goto l54
+											}
+											position++
+										case '>':
+											if buffer[position] != rune('>') {
+												goto l54
+											}
+											position++
+										case '=':
+											if buffer[position] != rune('=') {
+												goto l54
+											}
+											position++
+										case '\'':
+											if buffer[position] != rune('\'') {
+												goto l54
+											}
+											position++
+										case '"':
+											if buffer[position] != rune('"') {
+												goto l54
+											}
+											position++
+										case ')':
+											if buffer[position] != rune(')') {
+												goto l54
+											}
+											position++
+										case '(':
+											if buffer[position] != rune('(') {
+												goto l54
+											}
+											position++
+										case '\\':
+											if buffer[position] != rune('\\') {
+												goto l54
+											}
+											position++
+										case '\r':
+											if buffer[position] != rune('\r') {
+												goto l54
+											}
+											position++
+										case '\n':
+											if buffer[position] != rune('\n') {
+												goto l54
+											}
+											position++
+										case '\t':
+											if buffer[position] != rune('\t') {
+												goto l54
+											}
+											position++
+										default:
+											if buffer[position] != rune(' ') {
+												goto l54
+											}
+											position++
+										}
 									}
-									position++
+
+									goto l31
+								l54:
+									position, tokenIndex = position54, tokenIndex54
 								}
-							l45:
-								{
-									position47, tokenIndex47 := position, tokenIndex
-									if buffer[position] != rune('s') {
-										goto l48
-									}
-									position++
-									goto l47
-								l48:
-									position, tokenIndex = position47, tokenIndex47
-									if buffer[position] != rune('S') {
-										goto l16
-									}
-									position++
+								if !matchDot() {
+									goto l31
 								}
-							l47:
+							l52:
 								{
-									position49, tokenIndex49 := position, tokenIndex
-									if buffer[position] != rune('t') {
-										goto l50
+									position53, tokenIndex53 := position, tokenIndex
+									{
+										position56, tokenIndex56 := position, tokenIndex
+										{
+											switch buffer[position] {
+											case '<':
+												if buffer[position] != rune('<') {
+													goto l56
+												}
+												position++
+											case '>':
+												if buffer[position] != rune('>') {
+													goto l56
+												}
+												position++
+											case '=':
+												if buffer[position] != rune('=') {
+													goto l56
+												}
+												position++
+											case '\'':
+												if buffer[position] != rune('\'') {
+													goto l56
+												}
+												position++
+											case '"':
+												if buffer[position] != rune('"') {
+													goto l56
+												}
+												position++
+											case ')':
+												if buffer[position] != rune(')') {
+													goto l56
+												}
+												position++
+											case '(':
+												if buffer[position] != rune('(') {
+													goto l56
+												}
+												position++
+											case '\\':
+												if buffer[position] != rune('\\') {
+													goto l56
+												}
+												position++
+											case '\r':
+												if buffer[position] != rune('\r') {
+													goto l56
+												}
+												position++
+											case '\n':
+												if buffer[position] != rune('\n') {
+													goto l56
+												}
+												position++
+											case '\t':
+												if buffer[position] != rune('\t') {
+													goto l56
+												}
+												position++
+											default:
+												if buffer[position] != rune(' ') {
+													goto l56
+												}
+												position++
+											}
+										}
+
+										goto l53
+									l56:
+										position, tokenIndex = position56, tokenIndex56
 									}
-									position++
-									goto l49
-								l50:
-									position, tokenIndex = position49, tokenIndex49
-									if buffer[position] != rune('T') {
-										goto l16
+									if !matchDot() {
+										goto l53
 									}
-									position++
+									goto l52
+								l53:
+									position, tokenIndex = position53, tokenIndex53
 								}
-							l49:
-								{
-									position51, tokenIndex51 := position, tokenIndex
-									if buffer[position] != rune('s') {
-										goto l52
-									}
-									position++
-									goto l51
-								l52:
-									position, tokenIndex = position51, tokenIndex51
-									if buffer[position] != rune('S') {
-										goto l16
-									}
-									position++
-								}
-							l51:
-								add(ruleexists, position40)
+								add(rulePegText, position51)
+							}
+							add(ruletag, position50)
+						}
+					l58:
+						{
+							position59, tokenIndex59 := position, tokenIndex
+							if buffer[position] != rune(' ') {
+								goto l59
 							}
-						case '=':
+							position++
+							goto l58
+						l59:
+							position, tokenIndex = position59, tokenIndex59
+						}
+						{
+							position60, tokenIndex60 := position, tokenIndex
 							{
-								position53 := position
+								position62 := position
+								if buffer[position] != rune('<') {
+									goto l61
+								}
+								position++
 								if buffer[position] != rune('=') {
-									goto l16
+									goto l61
 								}
 								position++
-								add(ruleequal, position53)
+								add(rulele, position62)
 							}
-						l54:
+						l63:
 							{
-								position55, tokenIndex55 := position, tokenIndex
+								position64, tokenIndex64 := position, tokenIndex
 								if buffer[position] != rune(' ') {
-									goto l55
+									goto l64
 								}
 								position++
-								goto l54
-							l55:
-								position, tokenIndex = position55, tokenIndex55
+								goto l63
+							l64:
+								position, tokenIndex = position64, tokenIndex64
 							}
 							{
 								switch buffer[position] {
-								case '\'':
-									if !_rules[rulevalue]() {
-										goto l16
-									}
 								case 'D', 'd':
 									if !_rules[ruledate]() {
-										goto l16
+										goto l61
 									}
 								case 'T', 't':
 									if !_rules[ruletime]() {
-										goto l16
+										goto l61
 									}
 								default:
 									if !_rules[rulenumber]() {
-										goto l16
+										goto l61
 									}
 								}
 							}
 
-						case '>':
+							goto l60
+						l61:
+							position, tokenIndex = position60, tokenIndex60
 							{
-								position57 := position
+								position67 := position
 								if buffer[position] != rune('>') {
-									goto l16
+									goto l66
 								}
 								position++
-								add(ruleg, position57)
+								if buffer[position] != rune('=') {
+									goto l66
+								}
+								position++
+								add(rulege, position67)
 							}
-						l58:
+						l68:
 							{
-								position59, tokenIndex59 := position, tokenIndex
+								position69, tokenIndex69 := position, tokenIndex
 								if buffer[position] != rune(' ') {
-									goto l59
+									goto l69
 								}
 								position++
-								goto l58
-							l59:
-								position, tokenIndex = position59, tokenIndex59
+								goto l68
+							l69:
+								position, tokenIndex = position69, tokenIndex69
 							}
 							{
 								switch buffer[position] {
 								case 'D', 'd':
 									if !_rules[ruledate]() {
-										goto l16
+										goto l66
 									}
 								case 'T', 't':
 									if !_rules[ruletime]() {
-										goto l16
+										goto l66
 									}
 								default:
 									if !_rules[rulenumber]() {
-										goto l16
+										goto l66
 									}
 								}
 							}
 
-						case '<':
-							{
-								position61 := position
-								if buffer[position] != rune('<') {
-									goto l16
-								}
-								position++
-								add(rulel, position61)
-							}
-						l62:
-							{
-								position63, tokenIndex63 := position, tokenIndex
-								if buffer[position] != rune(' ') {
-									goto l63
-								}
-								position++
-								goto l62
-							l63:
-								position, tokenIndex = position63, tokenIndex63
-							}
+							goto l60
+						l66:
+							position, tokenIndex = position60, tokenIndex60
 							{
 								switch buffer[position] {
-								case 'D', 'd':
-									if !_rules[ruledate]() {
-										goto l16
-									}
-								case 'T', 't':
-									if !_rules[ruletime]() {
-										goto l16
+								case 'I', 'i':
+									{
+										position72 := position
+										{
+											position73, tokenIndex73 := position, tokenIndex
+											if buffer[position] != rune('i') {
+												goto l74
+											}
+											position++
+											goto l73
+										l74:
+											position, tokenIndex = position73, tokenIndex73
+											if buffer[position] != rune('I') {
+												goto l31
+											}
+											position++
+										}
+									l73:
+										{
+											position75, tokenIndex75 := position, tokenIndex
+											if buffer[position] != rune('n') {
+												goto l76
+											}
+											position++
+											goto l75
+										l76:
+											position, tokenIndex = position75, tokenIndex75
+											if buffer[position] != rune('N') {
+												goto l31
+											}
+											position++
+										}
+									l75:
+										add(rulein, position72)
 									}
-								default:
-									if !_rules[rulenumber]() {
-										goto l16
+								l77:
+									{
+										position78, tokenIndex78 := position, tokenIndex
+										if buffer[position] != rune(' ') {
+											goto l78
+										}
+										position++
+										goto l77
+									l78:
+										position, tokenIndex = position78, tokenIndex78
 									}
-								}
-							}
-
-						default:
-							{
-								position65 := position
-								{
-									position66, tokenIndex66 := position, tokenIndex
-									if buffer[position] != rune('c') {
-										goto l67
+									if buffer[position] != rune('(') {
+										goto l31
 									}
 									position++
-									goto l66
-								l67:
-									position, tokenIndex = position66, tokenIndex66
-									if buffer[position] != rune('C') {
-										goto l16
+								l79:
+									{
+										position80, tokenIndex80 := position, tokenIndex
+										if buffer[position] != rune(' ') {
+											goto l80
+										}
+										position++
+										goto l79
+									l80:
+										position, tokenIndex = position80, tokenIndex80
 									}
-									position++
-								}
-							l66:
-								{
-									position68, tokenIndex68 := position, tokenIndex
-									if buffer[position] != rune('o') {
-										goto l69
+									if !_rules[ruleoperand]() {
+										goto l31
 									}
-									position++
-									goto l68
-								l69:
-									position, tokenIndex = position68, tokenIndex68
-									if buffer[position] != rune('O') {
-										goto l16
+								l81:
+									{
+										position82, tokenIndex82 := position, tokenIndex
+									l83:
+										{
+											position84, tokenIndex84 := position, tokenIndex
+											if buffer[position] != rune(' ') {
+												goto l84
+											}
+											position++
+											goto l83
+										l84:
+											position, tokenIndex = position84, tokenIndex84
+										}
+										if buffer[position] != rune(',') {
+											goto l82
+										}
+										position++
+									l85:
+										{
+											position86, tokenIndex86 := position, tokenIndex
+											if buffer[position] != rune(' ') {
+												goto l86
+											}
+											position++
+											goto l85
+										l86:
+											position, tokenIndex = position86, tokenIndex86
+										}
+										if !_rules[ruleoperand]() {
+											goto l82
+										}
+										goto l81
+									l82:
+										position, tokenIndex = position82, tokenIndex82
 									}
-									position++
-								}
-							l68:
-								{
-									position70, tokenIndex70 := position, tokenIndex
-									if buffer[position] != rune('n') {
-										goto l71
+								l87:
+									{
+										position88, tokenIndex88 := position, tokenIndex
+										if buffer[position] != rune(' ') {
+											goto l88
+										}
+										position++
+										goto l87
+									l88:
+										position, tokenIndex = position88, tokenIndex88
 									}
-									position++
-									goto l70
-								l71:
-									position, tokenIndex = position70, tokenIndex70
-									if buffer[position] != rune('N') {
-										goto l16
+									if buffer[position] != rune(')') {
+										goto l31
 									}
 									position++
-								}
-							l70:
-								{
-									position72, tokenIndex72 := position, tokenIndex
-									if buffer[position] != rune('t') {
-										goto l73
+								case 'E', 'e':
+									{
+										position89 := position
+										{
+											position90, tokenIndex90 := position, tokenIndex
+											if buffer[position] != rune('e') {
+												goto l91
+											}
+											position++
+											goto l90
+										l91:
+											position, tokenIndex = position90, tokenIndex90
+											if buffer[position] != rune('E') {
+												goto l31
+											}
+											position++
+										}
+									l90:
+										{
+											position92, tokenIndex92 := position, tokenIndex
+											if buffer[position] != rune('x') {
+												goto l93
+											}
+											position++
+											goto l92
+										l93:
+											position, tokenIndex = position92, tokenIndex92
+											if buffer[position] != rune('X') {
+												goto l31
+											}
+											position++
+										}
+									l92:
+										{
+											position94, tokenIndex94 := position, tokenIndex
+											if buffer[position] != rune('i') {
+												goto l95
+											}
+											position++
+											goto l94
+										l95:
+											position, tokenIndex = position94, tokenIndex94
+											if buffer[position] != rune('I') {
+												goto l31
+											}
+											position++
+										}
+									l94:
+										{
+											position96, tokenIndex96 := position, tokenIndex
+											if buffer[position] != rune('s') {
+												goto l97
+											}
+											position++
+											goto l96
+										l97:
+											position, tokenIndex = position96, tokenIndex96
+											if buffer[position] != rune('S') {
+												goto l31
+											}
+											position++
+										}
+									l96:
+										{
+											position98, tokenIndex98 := position, tokenIndex
+											if buffer[position] != rune('t') {
+												goto l99
+											}
+											position++
+											goto l98
+										l99:
+											position, tokenIndex = position98, tokenIndex98
+											if buffer[position] != rune('T') {
+												goto l31
+											}
+											position++
+										}
+									l98:
+										{
+											position100, tokenIndex100 := position, tokenIndex
+											if buffer[position] != rune('s') {
+												goto l101
+											}
+											position++
+											goto l100
+										l101:
+											position, tokenIndex = position100, tokenIndex100
+											if buffer[position] != rune('S') {
+												goto l31
+											}
+											position++
+										}
+									l100:
+										add(ruleexists, position89)
 									}
-									position++
-									goto l72
-								l73:
-									position, tokenIndex = position72, tokenIndex72
-									if buffer[position] != rune('T') {
-										goto l16
+								case '=':
+									{
+										position102 := position
+										if buffer[position] != rune('=') {
+											goto l31
+										}
+										position++
+										add(ruleequal, position102)
 									}
-									position++
-								}
-							l72:
-								{
-									position74, tokenIndex74 := position, tokenIndex
-									if buffer[position] != rune('a') {
-										goto l75
+								l103:
+									{
+										position104, tokenIndex104 := position, tokenIndex
+										if buffer[position] != rune(' ') {
+											goto l104
+										}
+										position++
+										goto l103
+									l104:
+										position, tokenIndex = position104, tokenIndex104
 									}
-									position++
-									goto l74
-								l75:
-									position, tokenIndex = position74, tokenIndex74
-									if buffer[position] != rune('A') {
-										goto l16
+									{
+										switch buffer[position] {
+										case '\'':
+											if !_rules[rulevalue]() {
+												goto l31
+											}
+										case 'D', 'd':
+											if !_rules[ruledate]() {
+												goto l31
+											}
+										case 'T', 't':
+											if !_rules[ruletime]() {
+												goto l31
+											}
+										default:
+											if !_rules[rulenumber]() {
+												goto l31
+											}
+										}
 									}
-									position++
-								}
-							l74:
-								{
-									position76, tokenIndex76 := position, tokenIndex
-									if buffer[position] != rune('i') {
-										goto l77
+
+								case '>':
+									{
+										position106 := position
+										if buffer[position] != rune('>') {
+											goto l31
+										}
+										position++
+										add(ruleg, position106)
 									}
-									position++
-									goto l76
-								l77:
-									position, tokenIndex = position76, tokenIndex76
-									if buffer[position] != rune('I') {
-										goto l16
+								l107:
+									{
+										position108, tokenIndex108 := position, tokenIndex
+										if buffer[position] != rune(' ') {
+											goto l108
+										}
+										position++
+										goto l107
+									l108:
+										position, tokenIndex = position108, tokenIndex108
 									}
-									position++
-								}
-							l76:
-								{
-									position78, tokenIndex78 := position, tokenIndex
-									if buffer[position] != rune('n') {
-										goto l79
+									{
+										switch buffer[position] {
+										case 'D', 'd':
+											if !_rules[ruledate]() {
+												goto l31
+											}
+										case 'T', 't':
+											if !_rules[ruletime]() {
+												goto l31
+											}
+										default:
+											if !_rules[rulenumber]() {
+												goto l31
+											}
+										}
 									}
-									position++
-									goto l78
-								l79:
-									position, tokenIndex = position78, tokenIndex78
-									if buffer[position] != rune('N') {
-										goto l16
+
+								case '<':
+									{
+										position110 := position
+										if buffer[position] != rune('<') {
+											goto l31
+										}
+										position++
+										add(rulel, position110)
 									}
-									position++
-								}
-							l78:
-								{
-									position80, tokenIndex80 := position, tokenIndex
-									if buffer[position] != rune('s') {
-										goto l81
+								l111:
+									{
+										position112, tokenIndex112 := position, tokenIndex
+										if buffer[position] != rune(' ') {
+											goto l112
+										}
+										position++
+										goto l111
+									l112:
+										position, tokenIndex = position112, tokenIndex112
 									}
-									position++
-									goto l80
-								l81:
-									position, tokenIndex = position80, tokenIndex80
-									if buffer[position] != rune('S') {
-										goto l16
+									{
+										switch buffer[position] {
+										case 'D', 'd':
+											if !_rules[ruledate]() {
+												goto l31
+											}
+										case 'T', 't':
+											if !_rules[ruletime]() {
+												goto l31
+											}
+										default:
+											if !_rules[rulenumber]() {
+												goto l31
+											}
+										}
+									}
+
+								default:
+									{
+										position114 := position
+										{
+											position115, tokenIndex115 := position, tokenIndex
+											if buffer[position] != rune('c') {
+												goto l116
+											}
+											position++
+											goto l115
+										l116:
+											position, tokenIndex = position115, tokenIndex115
+											if buffer[position] != rune('C') {
+												goto l31
+											}
+											position++
+										}
+									l115:
+										{
+											position117, tokenIndex117 := position, tokenIndex
+											if buffer[position] != rune('o') {
+												goto l118
+											}
+											position++
+											goto l117
+										l118:
+											position, tokenIndex = position117, tokenIndex117
+											if buffer[position] != rune('O') {
+												goto l31
+											}
+											position++
+										}
+									l117:
+										{
+											position119, tokenIndex119 := position, tokenIndex
+											if buffer[position] != rune('n') {
+												goto l120
+											}
+											position++
+											goto l119
+										l120:
+											position, tokenIndex = position119, tokenIndex119
+											if buffer[position] != rune('N') {
+												goto l31
+											}
+											position++
+										}
+									l119:
+										{
+											position121, tokenIndex121 := position, tokenIndex
+											if buffer[position] != rune('t') {
+												goto l122
+											}
+											position++
+											goto l121
+										l122:
+											position, tokenIndex = position121, tokenIndex121
+											if buffer[position] != rune('T') {
+												goto l31
+											}
+											position++
+										}
+									l121:
+										{
+											position123, tokenIndex123 := position, tokenIndex
+											if buffer[position] != rune('a') {
+												goto l124
+											}
+											position++
+											goto l123
+										l124:
+											position, tokenIndex = position123, tokenIndex123
+											if buffer[position] != rune('A') {
+												goto l31
+											}
+											position++
+										}
+									l123:
+										{
+											position125, tokenIndex125 := position, tokenIndex
+											if buffer[position] != rune('i') {
+												goto l126
+											}
+											position++
+											goto l125
+										l126:
+											position, tokenIndex = position125, tokenIndex125
+											if buffer[position] != rune('I') {
+												goto l31
+											}
+											position++
+										}
+									l125:
+										{
+											position127, tokenIndex127 := position, tokenIndex
+											if buffer[position] != rune('n') {
+												goto l128
+											}
+											position++
+											goto l127
+										l128:
+											position, tokenIndex = position127, tokenIndex127
+											if buffer[position] != rune('N') {
+												goto l31
+											}
+											position++
+										}
+									l127:
+										{
+											position129, tokenIndex129 := position, tokenIndex
+											if buffer[position] != rune('s') {
+												goto l130
+											}
+											position++
+											goto l129
+										l130:
+											position, tokenIndex = position129, tokenIndex129
+											if buffer[position] != rune('S') {
+												goto l31
+											}
+											position++
+										}
+									l129:
+										add(rulecontains, position114)
+									}
+								l131:
+									{
+										position132, tokenIndex132 := position, tokenIndex
+										if buffer[position] != rune(' ') {
+											goto l132
+										}
+										position++
+										goto l131
+									l132:
+										position, tokenIndex = position132, tokenIndex132
+									}
+									if !_rules[rulevalue]() {
+										goto l31
 									}
-									position++
-								}
-							l80:
-								add(rulecontains, position65)
-							}
-						l82:
-							{
-								position83, tokenIndex83 := position, tokenIndex
-								if buffer[position] != rune(' ') {
-									goto l83
 								}
-								position++
-								goto l82
-							l83:
-								position, tokenIndex = position83, tokenIndex83
-							}
-							if !_rules[rulevalue]() {
-								goto l16
 							}
+
 						}
+					l60:
+						add(rulecondition, position49)
 					}
-
 				}
-			l28:
-				add(rulecondition, position17)
+			l33:
+				add(rulefactor, position32)
 			}
 			return true
-		l16:
-			position, tokenIndex = position16, tokenIndex16
+		l31:
+			position, tokenIndex = position31, tokenIndex31
 			return false
 		},
-		/* 2 tag <- <<(!((&('<') '<') | (&('>') '>') | (&('=') '=') | (&('\'') '\'') | (&('"') '"') | (&(')') ')') | (&('(') '(') | (&('\\') '\\') | (&('\r') '\r') | (&('\n') '\n') | (&('\t') '\t') | (&(' ') ' ')) .)+>> */
+		/* 4 condition <- <(tag ' '* ((le ' '* ((&('D' | 'd') date) | (&('T' | 't') time) | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') number))) / (ge ' '* ((&('D' | 'd') date) | (&('T' | 't') time) | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') number))) / ((&('I' | 'i') (in ' '* '(' ' '* operand (' '* ',' ' '* operand)* ' '* ')')) | (&('E' | 'e') exists) | (&('=') (equal ' '* ((&('\'') value) | (&('D' | 'd') date) | (&('T' | 't') time) | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') number)))) | (&('>') (g ' '* ((&('D' | 'd') date) | (&('T' | 't') time) | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') number)))) | (&('<') (l ' '* ((&('D' | 'd') date) | (&('T' | 't') time) | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') number)))) | (&('C' | 'c') (contains ' '* value)))))> */
 		nil,
-		/* 3 value <- <<('\'' (('\\' .) / (!'\'' .))* '\'')>> */
+		/* 5 operand <- <((&('\'') value) | (&('D' | 'd') date) | (&('T' | 't') time) | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') number))> */
 		func() bool {
-			position85, tokenIndex85 := position, tokenIndex
+			position134, tokenIndex134 := position, tokenIndex
 			{
-				position86 := position
+				position135 := position
 				{
-					position87 := position
+					switch buffer[position] {
+					case '\'':
+						if !_rules[rulevalue]() {
+							goto l134
+						}
+					case 'D', 'd':
+						if !_rules[ruledate]() {
+							goto l134
+						}
+					case 'T', 't':
+						if !_rules[ruletime]() {
+							goto l134
+						}
+					default:
+						if !_rules[rulenumber]() {
+							goto l134
+						}
+					}
+				}
+
+				add(ruleoperand, position135)
+			}
+			return true
+		l134:
+			position, tokenIndex = position134, tokenIndex134
+			return false
+		},
+		/* 6 tag <- <<(!((&('<') '<') | (&('>') '>') | (&('=') '=') | (&('\'') '\'') | (&('"') '"') | (&(')') ')') | (&('(') '(') | (&('\\') '\\') | (&('\r') '\r') | (&('\n') '\n') | (&('\t') '\t') | (&(' ') ' ')) .)+>> */
+		nil,
+		/* 7 value <- <<('\'' (('\\' .) / (!'\'' .))* '\'')>> */
+		func() bool {
+			position138, tokenIndex138 := position, tokenIndex
+			{
+				position139 := position
+				{
+					position140 := position
 					if buffer[position] != rune('\'') {
-						goto l85
+						goto l138
 					}
 					position++
-				l88:
+				l141:
 					{
-						position89, tokenIndex89 := position, tokenIndex
+						position142, tokenIndex142 := position, tokenIndex
 						{
-							position90, tokenIndex90 := position, tokenIndex
+							position143, tokenIndex143 := position, tokenIndex
 							if buffer[position] != rune('\\') {
-								goto l91
+								goto l144
 							}
 							position++
 							if !matchDot() {
-								goto l91
+								goto l144
 							}
-							goto l90
-						l91:
-							position, tokenIndex = position90, tokenIndex90
+							goto l143
+						l144:
+							position, tokenIndex = position143, tokenIndex143
 							{
-								position92, tokenIndex92 := position, tokenIndex
+								position145, tokenIndex145 := position, tokenIndex
 								if buffer[position] != rune('\'') {
-									goto l92
+									goto l145
 								}
 								position++
-								goto l89
-							l92:
-								position, tokenIndex = position92, tokenIndex92
+								goto l142
+							l145:
+								position, tokenIndex = position145, tokenIndex145
 							}
 							if !matchDot() {
-								goto l89
+								goto l142
 							}
 						}
-					l90:
-						goto l88
-					l89:
-						position, tokenIndex = position89, tokenIndex89
+					l143:
+						goto l141
+					l142:
+						position, tokenIndex = position142, tokenIndex142
 					}
 					if buffer[position] != rune('\'') {
-						goto l85
+						goto l138
 					}
 					position++
-					add(rulePegText, position87)
+					add(rulePegText, position140)
 				}
-				add(rulevalue, position86)
+				add(rulevalue, position139)
 			}
 			return true
-		l85:
-			position, tokenIndex = position85, tokenIndex85
+		l138:
+			position, tokenIndex = position138, tokenIndex138
 			return false
 		},
-		/* 4 number <- <<('0' / ([1-9] digit* ('.' digit*)?))>> */
+		/* 8 number <- <<('0' / ([1-9] digit* ('.' digit*)?))>> */
 		func() bool {
-			position93, tokenIndex93 := position, tokenIndex
+			position146, tokenIndex146 := position, tokenIndex
 			{
-				position94 := position
+				position147 := position
 				{
-					position95 := position
+					position148 := position
 					{
-						position96, tokenIndex96 := position, tokenIndex
+						position149, tokenIndex149 := position, tokenIndex
 						if buffer[position] != rune('0') {
-							goto l97
+							goto l150
 						}
 						position++
-						goto l96
-					l97:
-						position, tokenIndex = position96, tokenIndex96
+						goto l149
+					l150:
+						position, tokenIndex = position149, tokenIndex149
 						if c := buffer[position]; c < rune('1') || c > rune('9') {
-							goto l93
+							goto l146
 						}
 						position++
-					l98:
+					l151:
 						{
-							position99, tokenIndex99 := position, tokenIndex
+							position152, tokenIndex152 := position, tokenIndex
 							if !_rules[ruledigit]() {
-								goto l99
+								goto l152
 							}
-							goto l98
-						l99:
-							position, tokenIndex = position99, tokenIndex99
+							goto l151
+						l152:
+							position, tokenIndex = position152, tokenIndex152
 						}
 						{
-							position100, tokenIndex100 := position, tokenIndex
+							position153, tokenIndex153 := position, tokenIndex
 							if buffer[position] != rune('.') {
-								goto l100
+								goto l153
 							}
 							position++
-						l102:
+						l155:
 							{
-								position103, tokenIndex103 := position, tokenIndex
+								position156, tokenIndex156 := position, tokenIndex
 								if !_rules[ruledigit]() {
-									goto l103
+									goto l156
 								}
-								goto l102
-							l103:
-								position, tokenIndex = position103, tokenIndex103
+								goto l155
+							l156:
+								position, tokenIndex = position156, tokenIndex156
 							}
-							goto l101
-						l100:
-							position, tokenIndex = position100, tokenIndex100
+							goto l154
+						l153:
+							position, tokenIndex = position153, tokenIndex153
 						}
-					l101:
+					l154:
 					}
-				l96:
-					add(rulePegText, position95)
+				l149:
+					add(rulePegText, position148)
 				}
-				add(rulenumber, position94)
+				add(rulenumber, position147)
 			}
 			return true
-		l93:
-			position, tokenIndex = position93, tokenIndex93
+		l146:
+			position, tokenIndex = position146, tokenIndex146
 			return false
 		},
-		/* 5 digit <- <[0-9]> */
+		/* 9 digit <- <[0-9]> */
 		func() bool {
-			position104, tokenIndex104 := position, tokenIndex
+			position157, tokenIndex157 := position, tokenIndex
 			{
-				position105 := position
+				position158 := position
 				if c := buffer[position]; c < rune('0') || c > rune('9') {
-					goto l104
+					goto l157
 				}
 				position++
-				add(ruledigit, position105)
+				add(ruledigit, position158)
 			}
 			return true
-		l104:
-			position, tokenIndex = position104, tokenIndex104
+		l157:
+			position, tokenIndex = position157, tokenIndex157
 			return false
 		},
-		/* 6 time <- <(('t' / 'T') ('i' / 'I') ('m' / 'M') ('e' / 'E') ' ' <(year '-' month '-' day 'T' digit digit ':' digit digit ':' digit digit ((('-' / '+') digit digit ':' digit digit) / 'Z'))>)> */
+		/* 10 time <- <(('t' / 'T') ('i' / 'I') ('m' / 'M') ('e' / 'E') ' ' <(year '-' month '-' day 'T' digit digit ':' digit digit ':' digit digit ((('-' / '+') digit digit ':' digit digit) / 'Z'))>)> */
 		func() bool {
-			position106, tokenIndex106 := position, tokenIndex
+			position159, tokenIndex159 := position, tokenIndex
 			{
-				position107 := position
+				position160 := position
 				{
-					position108, tokenIndex108 := position, tokenIndex
+					position161, tokenIndex161 := position, tokenIndex
 					if buffer[position] != rune('t') {
-						goto l109
+						goto l162
 					}
 					position++
-					goto l108
-				l109:
-					position, tokenIndex = position108, tokenIndex108
+					goto l161
+				l162:
+					position, tokenIndex = position161, tokenIndex161
 					if buffer[position] != rune('T') {
-						goto l106
+						goto l159
 					}
 					position++
 				}
-			l108:
+			l161:
 				{
-					position110, tokenIndex110 := position, tokenIndex
+					position163, tokenIndex163 := position, tokenIndex
 					if buffer[position] != rune('i') {
-						goto l111
+						goto l164
 					}
 					position++
-					goto l110
-				l111:
-					position, tokenIndex = position110, tokenIndex110
+					goto l163
+				l164:
+					position, tokenIndex = position163, tokenIndex163
 					if buffer[position] != rune('I') {
-						goto l106
+						goto l159
 					}
 					position++
 				}
-			l110:
+			l163:
 				{
-					position112, tokenIndex112 := position, tokenIndex
+					position165, tokenIndex165 := position, tokenIndex
 					if buffer[position] != rune('m') {
-						goto l113
+						goto l166
 					}
 					position++
-					goto l112
-				l113:
-					position, tokenIndex = position112, tokenIndex112
+					goto l165
+				l166:
+					position, tokenIndex = position165, tokenIndex165
 					if buffer[position] != rune('M') {
-						goto l106
+						goto l159
 					}
 					position++
 				}
-			l112:
+			l165:
 				{
-					position114, tokenIndex114 := position, tokenIndex
+					position167, tokenIndex167 := position, tokenIndex
 					if buffer[position] != rune('e') {
-						goto l115
+						goto l168
 					}
 					position++
-					goto l114
-				l115:
-					position, tokenIndex = position114, tokenIndex114
+					goto l167
+				l168:
+					position, tokenIndex = position167, tokenIndex167
 					if buffer[position] != rune('E') {
-						goto l106
+						goto l159
 					}
 					position++
 				}
-			l114:
+			l167:
 				if buffer[position] != rune(' ') {
-					goto l106
+					goto l159
 				}
 				position++
 				{
-					position116 := position
+					position169 := position
 					if !_rules[ruleyear]() {
-						goto l106
+						goto l159
 					}
 					if buffer[position] != rune('-') {
-						goto l106
+						goto l159
 					}
 					position++
 					if !_rules[rulemonth]() {
-						goto l106
+						goto l159
 					}
 					if buffer[position] != rune('-') {
-						goto l106
+						goto l159
 					}
 					position++
 					if !_rules[ruleday]() {
-						goto l106
+						goto l159
 					}
 					if buffer[position] != rune('T') {
-						goto l106
+						goto l159
 					}
 					position++
 					if !_rules[ruledigit]() {
-						goto l106
+						goto l159
 					}
 					if !_rules[ruledigit]() {
-						goto l106
+						goto l159
 					}
 					if buffer[position] != rune(':') {
-						goto l106
+						goto l159
 					}
 					position++
 					if !_rules[ruledigit]() {
-						goto l106
+						goto l159
 					}
 					if !_rules[ruledigit]() {
-						goto l106
+						goto l159
 					}
 					if buffer[position] != rune(':') {
-						goto l106
+						goto l159
 					}
 					position++
 					if !_rules[ruledigit]() {
-						goto l106
+						goto l159
 					}
 					if !_rules[ruledigit]() {
-						goto l106
+						goto l159
 					}
 					{
-						position117, tokenIndex117 := position, tokenIndex
+						position170, tokenIndex170 := position, tokenIndex
 						{
-							position119, tokenIndex119 := position, tokenIndex
+							position172, tokenIndex172 := position, tokenIndex
 							if buffer[position] != rune('-') {
-								goto l120
+								goto l173
 							}
 							position++
-							goto l119
-						l120:
-							position, tokenIndex = position119, tokenIndex119
+							goto l172
+						l173:
+							position, tokenIndex = position172, tokenIndex172
 							if buffer[position] != rune('+') {
-								goto l118
+								goto l171
 							}
 							position++
 						}
-					l119:
+					l172:
 						if !_rules[ruledigit]() {
-							goto l118
+							goto l171
 						}
 						if !_rules[ruledigit]() {
-							goto l118
+							goto l171
 						}
 						if buffer[position] != rune(':') {
-							goto l118
+							goto l171
 						}
 						position++
 						if !_rules[ruledigit]() {
-							goto l118
+							goto l171
 						}
 						if !_rules[ruledigit]() {
-							goto l118
+							goto l171
 						}
-						goto l117
-					l118:
-						position, tokenIndex = position117, tokenIndex117
+						goto l170
+					l171:
+						position, tokenIndex = position170, tokenIndex170
 						if buffer[position] != rune('Z') {
-							goto l106
+							goto l159
 						}
 						position++
 					}
-				l117:
-					add(rulePegText, position116)
+				l170:
+					add(rulePegText, position169)
 				}
-				add(ruletime, position107)
+				add(ruletime, position160)
 			}
 			return true
-		l106:
-			position, tokenIndex = position106, tokenIndex106
+		l159:
+			position, tokenIndex = position159, tokenIndex159
 			return false
 		},
-		/* 7 date <- <(('d' / 'D') ('a' / 'A') ('t' / 'T') ('e' / 'E') ' ' <(year '-' month '-' day)>)> */
+		/* 11 date <- <(('d' / 'D') ('a' / 'A') ('t' / 'T') ('e' / 'E') ' ' <(year '-' month '-' day)>)> */
 		func() bool {
-			position121, tokenIndex121 := position, tokenIndex
+			position174, tokenIndex174 := position, tokenIndex
 			{
-				position122 := position
+				position175 := position
 				{
-					position123, tokenIndex123 := position, tokenIndex
+					position176, tokenIndex176 := position, tokenIndex
 					if buffer[position] != rune('d') {
-						goto l124
+						goto l177
 					}
 					position++
-					goto l123
-				l124:
-					position, tokenIndex = position123, tokenIndex123
+					goto l176
+				l177:
+					position, tokenIndex = position176, tokenIndex176
 					if buffer[position] != rune('D') {
-						goto l121
+						goto l174
 					}
 					position++
 				}
-			l123:
+			l176:
 				{
-					position125, tokenIndex125 := position, tokenIndex
+					position178, tokenIndex178 := position, tokenIndex
 					if buffer[position] != rune('a') {
-						goto l126
+						goto l179
 					}
 					position++
-					goto l125
-				l126:
-					position, tokenIndex = position125, tokenIndex125
+					goto l178
+				l179:
+					position, tokenIndex = position178, tokenIndex178
 					if buffer[position] != rune('A') {
-						goto l121
+						goto l174
 					}
 					position++
 				}
-			l125:
+			l178:
 				{
-					position127, tokenIndex127 := position, tokenIndex
+					position180, tokenIndex180 := position, tokenIndex
 					if buffer[position] != rune('t') {
-						goto l128
+						goto l181
 					}
 					position++
-					goto l127
-				l128:
-					position, tokenIndex = position127, tokenIndex127
+					goto l180
+				l181:
+					position, tokenIndex = position180, tokenIndex180
 					if buffer[position] != rune('T') {
-						goto l121
+						goto l174
 					}
 					position++
 				}
-			l127:
+			l180:
 				{
-					position129, tokenIndex129 := position, tokenIndex
+					position182, tokenIndex182 := position, tokenIndex
 					if buffer[position] != rune('e') {
-						goto l130
+						goto l183
 					}
 					position++
-					goto l129
-				l130:
-					position, tokenIndex = position129, tokenIndex129
+					goto l182
+				l183:
+					position, tokenIndex = position182, tokenIndex182
 					if buffer[position] != rune('E') {
-						goto l121
+						goto l174
 					}
 					position++
 				}
-			l129:
+			l182:
 				if buffer[position] != rune(' ') {
-					goto l121
+					goto l174
 				}
 				position++
 				{
-					position131 := position
+					position184 := position
 					if !_rules[ruleyear]() {
-						goto l121
+						goto l174
 					}
 					if buffer[position] != rune('-') {
-						goto l121
+						goto l174
 					}
 					position++
 					if !_rules[rulemonth]() {
-						goto l121
+						goto l174
 					}
 					if buffer[position] != rune('-') {
-						goto l121
+						goto l174
 					}
 					position++
 					if !_rules[ruleday]() {
-						goto l121
+						goto l174
 					}
-					add(rulePegText, position131)
+					add(rulePegText, position184)
 				}
-				add(ruledate, position122)
+				add(ruledate, position175)
 			}
 			return true
-		l121:
-			position, tokenIndex = position121, tokenIndex121
+		l174:
+			position, tokenIndex = position174, tokenIndex174
 			return false
 		},
-		/* 8 year <- <(('1' / '2') digit digit digit)> */
+		/* 12 year <- <(('1' / '2') digit digit digit)> */
 		func() bool {
-			position132, tokenIndex132 := position, tokenIndex
+			position185, tokenIndex185 := position, tokenIndex
 			{
-				position133 := position
+				position186 := position
 				{
-					position134, tokenIndex134 := position, tokenIndex
+					position187, tokenIndex187 := position, tokenIndex
 					if buffer[position] != rune('1') {
-						goto l135
+						goto l188
 					}
 					position++
-					goto l134
-				l135:
-					position, tokenIndex = position134, tokenIndex134
+					goto l187
+				l188:
+					position, tokenIndex = position187, tokenIndex187
 					if buffer[position] != rune('2') {
-						goto l132
+						goto l185
 					}
 					position++
 				}
-			l134:
+			l187:
 				if !_rules[ruledigit]() {
-					goto l132
+					goto l185
 				}
 				if !_rules[ruledigit]() {
-					goto l132
+					goto l185
 				}
 				if !_rules[ruledigit]() {
-					goto l132
+					goto l185
 				}
-				add(ruleyear, position133)
+				add(ruleyear, position186)
 			}
 			return true
-		l132:
-			position, tokenIndex = position132, tokenIndex132
+		l185:
+			position, tokenIndex = position185, tokenIndex185
 			return false
 		},
-		/* 9 month <- <(('0' / '1') digit)> */
+		/* 13 month <- <(('0' / '1') digit)> */
 		func() bool {
-			position136, tokenIndex136 := position, tokenIndex
+			position189, tokenIndex189 := position, tokenIndex
 			{
-				position137 := position
+				position190 := position
 				{
-					position138, tokenIndex138 := position, tokenIndex
+					position191, tokenIndex191 := position, tokenIndex
 					if buffer[position] != rune('0') {
-						goto l139
+						goto l192
 					}
 					position++
-					goto l138
-				l139:
-					position, tokenIndex = position138, tokenIndex138
+					goto l191
+				l192:
+					position, tokenIndex = position191, tokenIndex191
 					if buffer[position] != rune('1') {
-						goto l136
+						goto l189
 					}
 					position++
 				}
-			l138:
+			l191:
 				if !_rules[ruledigit]() {
-					goto l136
+					goto l189
 				}
-				add(rulemonth, position137)
+				add(rulemonth, position190)
 			}
 			return true
-		l136:
-			position, tokenIndex = position136, tokenIndex136
+		l189:
+			position, tokenIndex = position189, tokenIndex189
 			return false
 		},
-		/* 10 day <- <(((&('3') '3') | (&('2') '2') | (&('1') '1') | (&('0') '0')) digit)> */
+		/* 14 day <- <(((&('3') '3') | (&('2') '2') | (&('1') '1') | (&('0') '0')) digit)> */
 		func() bool {
-			position140, tokenIndex140 := position, tokenIndex
+			position193, tokenIndex193 := position, tokenIndex
 			{
-				position141 := position
+				position194 := position
 				{
 					switch buffer[position] {
 					case '3':
 						if buffer[position] != rune('3') {
-							goto l140
+							goto l193
 						}
 						position++
 					case '2':
 						if buffer[position] != rune('2') {
-							goto l140
+							goto l193
 						}
 						position++
 					case '1':
 						if buffer[position] != rune('1') {
-							goto l140
+							goto l193
 						}
 						position++
 					default:
 						if buffer[position] != rune('0') {
-							goto l140
+							goto l193
 						}
 						position++
 					}
 				}
 
 				if !_rules[ruledigit]() {
-					goto l140
+					goto l193
 				}
-				add(ruleday, position141)
+				add(ruleday, position194)
 			}
 			return true
-		l140:
-			position, tokenIndex = position140, tokenIndex140
+		l193:
+			position, tokenIndex = position193, tokenIndex193
 			return false
 		},
-		/* 11 and <- <(('a' / 'A') ('n' / 'N') ('d' / 'D'))> */
+		/* 15 and <- <(('a' / 'A') ('n' / 'N') ('d' / 'D'))> */
+		nil,
+		/* 16 or <- <(('o' / 'O') ('r' / 'R'))> */
+		nil,
+		/* 17 not <- <(('n' / 'N') ('o' / 'O') ('t' / 'T'))> */
+		nil,
+		/* 18 equal <- <'='> */
 		nil,
-		/* 12 equal <- <'='> */
+		/* 19 contains <- <(('c' / 'C') ('o' / 'O') ('n' / 'N') ('t' / 'T') ('a' / 'A') ('i' / 'I') ('n' / 'N') ('s' / 'S'))> */
 		nil,
-		/* 13 contains <- <(('c' / 'C') ('o' / 'O') ('n' / 'N') ('t' / 'T') ('a' / 'A') ('i' / 'I') ('n' / 'N') ('s' / 'S'))> */
+		/* 20 exists <- <(('e' / 'E') ('x' / 'X') ('i' / 'I') ('s' / 'S') ('t' / 'T') ('s' / 'S'))> */
 		nil,
-		/* 14 exists <- <(('e' / 'E') ('x' / 'X') ('i' / 'I') ('s' / 'S') ('t' / 'T') ('s' / 'S'))> */
+		/* 21 in <- <(('i' / 'I') ('n' / 'N'))> */
 		nil,
-		/* 15 le <- <('<' '=')> */
+		/* 22 le <- <('<' '=')> */
 		nil,
-		/* 16 ge <- <('>' '=')> */
+		/* 23 ge <- <('>' '=')> */
 		nil,
-		/* 17 l <- <'<'> */
+		/* 24 l <- <'<'> */
 		nil,
-		/* 18 g <- <'>'> */
+		/* 25 g <- <'>'> */
 		nil,
 		nil,
 	}
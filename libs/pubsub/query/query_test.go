@@ -157,6 +157,37 @@ func TestMatches(t *testing.T) {
 			true,
 			false,
 		},
+		{"tx.gas < 7 OR tx.gas > 9", map[string][]string{"tx.gas": {"10"}}, false, true, false},
+		{"tx.gas < 7 OR tx.gas > 9", map[string][]string{"tx.gas": {"8"}}, false, false, false},
+		{
+			"tm.events.type='NewBlock' AND app.name = 'fuzzed' OR app.name = 'other'",
+			map[string][]string{"tm.events.type": {"NewHeader"}, "app.name": {"other"}},
+			false,
+			true,
+			false,
+		},
+		{
+			"tm.events.type='NewBlock' AND (app.name = 'fuzzed' OR app.name = 'other')",
+			map[string][]string{"tm.events.type": {"NewHeader"}, "app.name": {"other"}},
+			false,
+			false,
+			false,
+		},
+		{"NOT tx.gas > 7", map[string][]string{"tx.gas": {"8"}}, false, false, false},
+		{"NOT tx.gas > 7", map[string][]string{"tx.gas": {"6"}}, false, true, false},
+		{
+			"slash EXISTS AND NOT slash.reason = 'double_sign'",
+			map[string][]string{"slash.reason": {"missing_signature"}, "slash.power": {"6000"}},
+			false,
+			true,
+			false,
+		},
+		{"NOT (tx.gas < 7 OR tx.gas > 9)", map[string][]string{"tx.gas": {"8"}}, false, true, false},
+		{"abci.owner.name IN ('Igor', 'Ivan')", map[string][]string{"abci.owner.name": {"Ivan"}}, false, true, false},
+		{"abci.owner.name IN ('Igor', 'Ivan')", map[string][]string{"abci.owner.name": {"Pavel"}}, false, false, false},
+		{"tx.gas IN (7, 8.5, 9)", map[string][]string{"tx.gas": {"9"}}, false, true, false},
+		{"tx.date IN (DATE 2016-01-01, DATE 2017-01-01)", map[string][]string{"tx.date": {txDate}}, false, true, false},
+		{"tx.gas > 7 OR tx.gas > 9", map[string][]string{"tx.gas": {"gas"}}, false, false, true},
 	}
 
 	for _, tc := range testCases {
@@ -216,6 +247,14 @@ func TestConditions(t *testing.T) {
 				{CompositeKey: "slashing", Op: query.OpExists},
 			},
 		},
+		{
+			s: "tx.gas IN (7, 'seven') AND (tx.gas > 5 AND slashing EXISTS)",
+			conditions: []query.Condition{
+				{CompositeKey: "tx.gas", Op: query.OpIn, Operand: []interface{}{int64(7), "seven"}},
+				{CompositeKey: "tx.gas", Op: query.OpGreater, Operand: int64(5)},
+				{CompositeKey: "slashing", Op: query.OpExists},
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -227,3 +266,49 @@ func TestConditions(t *testing.T) {
 		assert.Equal(t, tc.conditions, c)
 	}
 }
+
+func TestConditionsNotConjunction(t *testing.T) {
+	for _, s := range []string{
+		"tx.gas > 7 OR tx.gas < 9",
+		"NOT tx.gas > 7",
+		"slashing EXISTS AND (tx.gas > 7 OR tx.gas < 9)",
+	} {
+		q, err := query.New(s)
+		require.NoError(t, err)
+
+		_, err = q.Conditions()
+		assert.Error(t, err, "Query '%s' should not be a conjunction", s)
+	}
+}
+
+func TestExpression(t *testing.T) {
+	q, err := query.New("a.x = 1 AND (b.x = 2 OR b.y EXISTS OR (c.x < 3 OR c.y > 4)) AND NOT d.x IN ('d')")
+	require.NoError(t, err)
+
+	condition := func(key string, op query.Operator, operand interface{}) *query.Expression {
+		return &query.Expression{
+			Op:        query.ExprCondition,
+			Condition: query.Condition{CompositeKey: key, Op: op, Operand: operand},
+		}
+	}
+	expected := &query.Expression{
+		Op: query.ExprAnd,
+		Expressions: []*query.Expression{
+			condition("a.x", query.OpEqual, int64(1)),
+			{
+				Op: query.ExprOr,
+				Expressions: []*query.Expression{
+					condition("b.x", query.OpEqual, int64(2)),
+					condition("b.y", query.OpExists, nil),
+					condition("c.x", query.OpLess, int64(3)),
+					condition("c.y", query.OpGreater, int64(4)),
+				},
+			},
+			{
+				Op:          query.ExprNot,
+				Expressions: []*query.Expression{condition("d.x", query.OpIn, []interface{}{"d"})},
+			},
+		},
+	}
+	assert.Equal(t, expected, q.Expression())
+}
@@ -69,6 +69,20 @@ func SkippingVerification(trustLevel tmmath.Fraction) Option {
 	}
 }
 
+// VRFVerification option configures the light client to also verify that
+// every new header was proposed by the validator elected via VRF (see
+// VerifyProposer). The entropy of each block is requested from the primary,
+// so the primary and all the witnesses must implement
+// provider.EntropyProvider.
+//
+// The proof hash of the trusted block given by TrustOptions is taken from
+// its entropy without verification, in the same way as its hash is trusted.
+func VRFVerification() Option {
+	return func(c *Client) {
+		c.verifyVRF = true
+	}
+}
+
 // PruningSize option sets the maximum amount of light blocks that the light
 // client stores. When Prune() is run, all light blocks that are earlier than
 // the h amount of light blocks will be removed from the store.
@@ -135,6 +149,7 @@ type Client struct {
 	trustingPeriod   time.Duration // see TrustOptions.Period
 	verificationMode mode
 	trustLevel       tmmath.Fraction
+	verifyVRF        bool   // see VRFVerification option
 	maxRetryAttempts uint16 // see MaxRetryAttempts option
 	maxClockDrift    time.Duration
 	maxBlockLag      time.Duration
@@ -256,6 +271,13 @@ func NewClientFromTrustedStore(
 		return nil, err
 	}
 
+	// Verify all providers are able to provide entropy.
+	if c.verifyVRF {
+		if err := validateEntropyProviders(primary, witnesses); err != nil {
+			return nil, err
+		}
+	}
+
 	if err := c.restoreTrustedLightBlock(); err != nil {
 		return nil, err
 	}
@@ -388,7 +410,18 @@ func (c *Client) initializeWithTrustOptions(ctx context.Context, options TrustOp
 		return err
 	}
 
-	// 4) Persist both of them and continue.
+	// 4) Persist the proof hash if VRF verification is enabled.
+	if c.verifyVRF {
+		proofHash, err := c.proofHashFromPrimary(ctx, l)
+		if err != nil {
+			return err
+		}
+		if err := c.saveProofHash(l.Height, proofHash); err != nil {
+			return err
+		}
+	}
+
+	// 5) Persist both of them and continue.
 	return c.updateTrustedLightBlock(l)
 }
 
@@ -621,8 +654,18 @@ func (c *Client) verifySequential(
 		interimBlock  *types.LightBlock
 		err           error
 		trace         = []*types.LightBlock{trustedBlock}
+
+		proofHash        []byte
+		interimProofHash []byte
 	)
 
+	if c.verifyVRF {
+		proofHash, err = c.trustedProofHash(ctx, trustedBlock)
+		if err != nil {
+			return ErrVerificationFailed{From: trustedBlock.Height, To: trustedBlock.Height + 1, Reason: err}
+		}
+	}
+
 	for height := trustedBlock.Height + 1; height <= newLightBlock.Height; height++ {
 		// 1) Fetch interim light block if needed.
 		if height == newLightBlock.Height { // last light block
@@ -643,6 +686,9 @@ func (c *Client) verifySequential(
 
 		err = VerifyAdjacent(verifiedBlock.SignedHeader, interimBlock.SignedHeader, interimBlock.ValidatorSet,
 			c.trustingPeriod, now, c.maxClockDrift)
+		if err == nil && c.verifyVRF {
+			interimProofHash, err = c.verifyProposer(ctx, interimBlock, proofHash)
+		}
 		if err != nil {
 			err := ErrVerificationFailed{From: verifiedBlock.Height, To: interimBlock.Height, Reason: err}
 
@@ -683,6 +729,7 @@ func (c *Client) verifySequential(
 
 		// 3) Update verifiedBlock
 		verifiedBlock = interimBlock
+		proofHash = interimProofHash
 
 		// 4) Add verifiedBlock to trace
 		trace = append(trace, verifiedBlock)
@@ -693,7 +740,11 @@ func (c *Client) verifySequential(
 	//
 	// CORRECTNESS ASSUMPTION: there's at least 1 correct full node
 	// (primary or one of the witnesses).
-	return c.detectDivergence(ctx, trace, now)
+	if err := c.detectDivergence(ctx, trace, now); err != nil {
+		return err
+	}
+
+	return c.saveProofHash(newLightBlock.Height, proofHash)
 }
 
 // see VerifyHeader
@@ -812,6 +863,19 @@ func (c *Client) verifySkippingAgainstPrimary(
 		// attempt to verify the header again
 		return c.verifySkippingAgainstPrimary(ctx, trustedBlock, replacementBlock, now)
 	case nil:
+		// Verify the proposer of the new header against the proof hash of its
+		// predecessor.
+		var proofHash []byte
+		if c.verifyVRF {
+			lastProofHash, vrfErr := c.predecessorProofHash(ctx, newLightBlock)
+			if vrfErr == nil {
+				proofHash, vrfErr = c.verifyProposer(ctx, newLightBlock, lastProofHash)
+			}
+			if vrfErr != nil {
+				return ErrVerificationFailed{From: trustedBlock.Height, To: newLightBlock.Height, Reason: vrfErr}
+			}
+		}
+
 		// Compare header with the witnesses to ensure it's not a fork.
 		// More witnesses we have, more chance to notice one.
 		//
@@ -820,11 +884,11 @@ func (c *Client) verifySkippingAgainstPrimary(
 		if cmpErr := c.detectDivergence(ctx, trace, now); cmpErr != nil {
 			return cmpErr
 		}
+
+		return c.saveProofHash(newLightBlock.Height, proofHash)
 	default:
 		return err
 	}
-
-	return nil
 }
 
 // LastTrustedHeight returns a last trusted height. -1 and nil are returned if
@@ -1161,3 +1161,86 @@ func TestClientHandlesContexts(t *testing.T) {
 	require.True(t, errors.Is(err, context.Canceled))
 
 }
+
+func TestClient_VRFVerification(t *testing.T) {
+	vrfHeaders, vrfVals, vrfEntropies := keys.GenVRFChain(chainID, 5, vals, bTime)
+	vrfTrustOptions := light.TrustOptions{
+		Period: 4 * time.Hour,
+		Height: 1,
+		Hash:   vrfHeaders[1].Hash(),
+	}
+
+	// the entropy at height 4 is replaced with the one at height 3, so that
+	// the proof doesn't match the VRF message of height 4.
+	badEntropies := make(map[int64]*types.Entropy, len(vrfEntropies))
+	for h, e := range vrfEntropies {
+		badEntropies[h] = e
+	}
+	badEntropies[4] = vrfEntropies[3]
+
+	testCases := []struct {
+		name      string
+		mode      light.Option
+		entropies map[int64]*types.Entropy
+		verifyErr bool
+	}{
+		{"sequential", light.SequentialVerification(), vrfEntropies, false},
+		{"skipping", light.SkippingVerification(light.DefaultTrustLevel), vrfEntropies, false},
+		{"sequential with invalid proof", light.SequentialVerification(), badEntropies, true},
+		{"skipping with invalid proof", light.SkippingVerification(light.DefaultTrustLevel), badEntropies, true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			node := mockp.New(chainID, vrfHeaders, vrfVals)
+			for h, e := range tc.entropies {
+				node.AddEntropy(h, e)
+			}
+			trustedStore := dbs.New(dbm.NewMemDB(), chainID)
+
+			c, err := light.NewClient(
+				ctx,
+				chainID,
+				vrfTrustOptions,
+				node,
+				[]provider.Provider{node.Copy(chainID)},
+				trustedStore,
+				tc.mode,
+				light.VRFVerification(),
+				light.Logger(log.TestingLogger()),
+			)
+			require.NoError(t, err)
+
+			// the proof hash of the trusted block is persisted on initialization
+			proofHash, err := trustedStore.ProofHash(1)
+			require.NoError(t, err)
+			assert.NotEmpty(t, proofHash)
+
+			_, err = c.VerifyLightBlockAtHeight(ctx, 5, bTime.Add(1*time.Hour))
+			if tc.verifyErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			proofHash, err = trustedStore.ProofHash(5)
+			require.NoError(t, err)
+			assert.NotEmpty(t, proofHash)
+		})
+	}
+
+	t.Run("provider without entropy", func(t *testing.T) {
+		_, err := light.NewClient(
+			ctx,
+			chainID,
+			vrfTrustOptions,
+			fullNode,
+			[]provider.Provider{deadNode},
+			dbs.New(dbm.NewMemDB(), chainID),
+			light.VRFVerification(),
+			light.Logger(log.TestingLogger()),
+		)
+		assert.Error(t, err)
+	})
+}
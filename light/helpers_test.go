@@ -234,6 +234,52 @@ func (pkz privKeys) GenSignedHeaderLastBlockID(chainID string, height int64, bTi
 	}
 }
 
+// GenVRFChain generates numBlocks consecutive signed headers along with their
+// entropies, whose proposers are elected via VRF as the consensus does.
+func (pkz privKeys) GenVRFChain(chainID string, numBlocks int64, valset *types.ValidatorSet, bTime time.Time) (
+	map[int64]*types.SignedHeader, map[int64]*types.ValidatorSet, map[int64]*types.Entropy) {
+
+	var (
+		headers     = make(map[int64]*types.SignedHeader, numBlocks)
+		valsets     = make(map[int64]*types.ValidatorSet, numBlocks)
+		entropies   = make(map[int64]*types.Entropy, numBlocks)
+		lastBlockID types.BlockID
+		proofHash   = hash("genesis")
+	)
+
+	for height := int64(1); height <= numBlocks; height++ {
+		header := genHeader(chainID, height, bTime.Add(time.Duration(height)*time.Minute), nil, valset, valset,
+			hash("app_hash"), hash("cons_hash"), hash("results_hash"), proofHash)
+		header.LastBlockID = lastBlockID
+
+		var proposer crypto.PrivKey
+		for _, k := range pkz {
+			if bytes.Equal(k.PubKey().Address(), header.ProposerAddress) {
+				proposer = k
+			}
+		}
+		proof, err := proposer.VRFProve(types.MakeRoundHash(proofHash, height-1, 0))
+		if err != nil {
+			panic(err)
+		}
+
+		headers[height] = &types.SignedHeader{
+			Header: header,
+			Commit: pkz.signHeader(header, valset, 0, len(pkz)),
+		}
+		valsets[height] = valset
+		entropies[height] = &types.Entropy{Round: 0, Proof: tmbytes.HexBytes(proof)}
+
+		lastBlockID = types.BlockID{Hash: header.Hash()}
+		proofHash, err = ed25519.ProofToHash(proof)
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	return headers, valsets, entropies
+}
+
 func (pkz privKeys) ChangeKeys(delta int) privKeys {
 	newKeys := pkz[delta:]
 	return newKeys.Extend(delta)
@@ -30,6 +30,8 @@ type http struct {
 	client  rpcclient.RemoteClient
 }
 
+var _ provider.EntropyProvider = (*http)(nil)
+
 // New creates a HTTP provider, which is using the rpchttp.HTTP client under
 // the hood. If no scheme is provided in the remote URL, http will be used by
 // default. The 5s timeout is used for all requests.
@@ -101,6 +103,45 @@ func (p *http) LightBlock(ctx context.Context, height int64) (*types.LightBlock,
 	return lb, nil
 }
 
+// Entropy fetches the block at the given height and returns its Entropy.
+func (p *http) Entropy(ctx context.Context, height int64) (*types.Entropy, error) {
+	if height <= 0 {
+		return nil, provider.ErrBadLightBlock{Reason: fmt.Errorf("expected height > 0, got height %d", height)}
+	}
+
+	for attempt := 1; attempt <= maxRetryAttempts; attempt++ {
+		res, err := p.client.Block(ctx, &height)
+		switch {
+		case err == nil:
+			if res.Block == nil {
+				return nil, provider.ErrBadLightBlock{Reason: fmt.Errorf("block at height %d is nil", height)}
+			}
+			if res.Block.Height != height {
+				return nil, provider.ErrBadLightBlock{
+					Reason: fmt.Errorf("height %d responded doesn't match height %d requested", res.Block.Height, height),
+				}
+			}
+			return &res.Block.Entropy, nil
+
+		case regexpTooHigh.MatchString(err.Error()):
+			return nil, provider.ErrHeightTooHigh
+
+		case regexpMissingHeight.MatchString(err.Error()):
+			return nil, provider.ErrLightBlockNotFound
+
+		case regexpTimedOut.MatchString(err.Error()):
+			// we wait and try again with exponential backoff
+			time.Sleep(backoffTimeout(uint16(attempt)))
+			continue
+
+		// either context was cancelled or connection refused.
+		default:
+			return nil, err
+		}
+	}
+	return nil, provider.ErrNoResponse
+}
+
 // ReportEvidence calls `/broadcast_evidence` endpoint.
 func (p *http) ReportEvidence(ctx context.Context, ev types.Evidence) error {
 	_, err := p.client.BroadcastEvidence(ctx, ev)
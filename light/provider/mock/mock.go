@@ -18,11 +18,12 @@ type Mock struct {
 	mtx              sync.Mutex
 	headers          map[int64]*types.SignedHeader
 	vals             map[int64]*types.ValidatorSet
+	entropies        map[int64]*types.Entropy
 	evidenceToReport map[string]types.Evidence // hash => evidence
 	latestHeight     int64
 }
 
-var _ provider.Provider = (*Mock)(nil)
+var _ provider.EntropyProvider = (*Mock)(nil)
 
 // New creates a mock provider with the given set of headers and validator
 // sets.
@@ -41,6 +42,7 @@ func New(
 		chainID:          chainID,
 		headers:          headers,
 		vals:             vals,
+		entropies:        make(map[int64]*types.Entropy),
 		evidenceToReport: make(map[string]types.Evidence),
 		latestHeight:     height,
 	}
@@ -106,6 +108,21 @@ func (p *Mock) LightBlock(ctx context.Context, height int64) (*types.LightBlock,
 	return lb, nil
 }
 
+func (p *Mock) Entropy(ctx context.Context, height int64) (*types.Entropy, error) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	if height > p.latestHeight {
+		return nil, provider.ErrHeightTooHigh
+	}
+
+	entropy, ok := p.entropies[height]
+	if !ok {
+		return nil, provider.ErrLightBlockNotFound
+	}
+	return entropy, nil
+}
+
 func (p *Mock) ReportEvidence(_ context.Context, ev types.Evidence) error {
 	p.evidenceToReport[string(ev.Hash())] = ev
 	return nil
@@ -130,6 +147,17 @@ func (p *Mock) AddLightBlock(lb *types.LightBlock) {
 	}
 }
 
+func (p *Mock) AddEntropy(height int64, entropy *types.Entropy) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	p.entropies[height] = entropy
+}
+
 func (p *Mock) Copy(id string) *Mock {
-	return New(id, p.headers, p.vals)
+	cp := New(id, p.headers, p.vals)
+	for h, e := range p.entropies {
+		cp.entropies[h] = e
+	}
+	return cp
 }
@@ -27,3 +27,18 @@ type Provider interface {
 	// ReportEvidence reports an evidence of misbehavior.
 	ReportEvidence(context.Context, types.Evidence) error
 }
+
+// EntropyProvider is a Provider which is also able to return the Entropy (the
+// VRF proof and the round) of a block. It is required by the light client
+// when VRF verification is enabled.
+type EntropyProvider interface {
+	Provider
+
+	// Entropy returns the Entropy of the block at the given height.
+	//
+	// height must be > 0.
+	//
+	// If there's no block for the given height, ErrLightBlockNotFound error is
+	// returned.
+	Entropy(ctx context.Context, height int64) (*types.Entropy, error)
+}
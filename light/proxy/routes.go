@@ -29,11 +29,15 @@ func RPCRoutes(c *lrpc.Client) map[string]*rpcserver.RPCFunc {
 		"block_results":        rpcserver.NewRPCFunc(makeBlockResultsFunc(c), "height", rpcserver.Cacheable("height")),
 		"commit":               rpcserver.NewRPCFunc(makeCommitFunc(c), "height", rpcserver.Cacheable("height")),
 		"tx":                   rpcserver.NewRPCFunc(makeTxFunc(c), "hash,prove", rpcserver.Cacheable()),
-		"tx_search":            rpcserver.NewRPCFunc(makeTxSearchFunc(c), "query,prove,page,per_page,order_by"),
-		"block_search":         rpcserver.NewRPCFunc(makeBlockSearchFunc(c), "query,page,per_page,order_by"),
+		"tx_search":            rpcserver.NewRPCFunc(makeTxSearchFunc(c), "query,prove,page,per_page,order_by,cursor"),
+		"block_search":         rpcserver.NewRPCFunc(makeBlockSearchFunc(c), "query,page,per_page,order_by,cursor"),
+		"tx_count":             rpcserver.NewRPCFunc(makeTxCountFunc(c), "query"),
+		"block_count":          rpcserver.NewRPCFunc(makeBlockCountFunc(c), "query"),
 		"validators":           rpcserver.NewRPCFunc(makeValidatorsFunc(c), "height,page,per_page", rpcserver.Cacheable("height")),
+		"proposer_election":    rpcserver.NewRPCFunc(makeProposerElectionFunc(c), "height,round", rpcserver.Cacheable("height")),
 		"dump_consensus_state": rpcserver.NewRPCFunc(makeDumpConsensusStateFunc(c), ""),
 		"consensus_state":      rpcserver.NewRPCFunc(makeConsensusStateFunc(c), ""),
+		"upcoming_proposers":   rpcserver.NewRPCFunc(makeUpcomingProposersFunc(c), "rounds"),
 		"consensus_params":     rpcserver.NewRPCFunc(makeConsensusParamsFunc(c), "height", rpcserver.Cacheable("height")),
 		"unconfirmed_txs":      rpcserver.NewRPCFunc(makeUnconfirmedTxsFunc(c), "limit"),
 		"num_unconfirmed_txs":  rpcserver.NewRPCFunc(makeNumUnconfirmedTxsFunc(c), ""),
@@ -147,6 +151,7 @@ type rpcTxSearchFunc func(
 	prove bool,
 	page, perPage *int,
 	orderBy string,
+	cursor *string,
 ) (*ctypes.ResultTxSearch, error)
 
 func makeTxSearchFunc(c *lrpc.Client) rpcTxSearchFunc {
@@ -156,7 +161,11 @@ func makeTxSearchFunc(c *lrpc.Client) rpcTxSearchFunc {
 		prove bool,
 		page, perPage *int,
 		orderBy string,
+		cursor *string,
 	) (*ctypes.ResultTxSearch, error) {
+		if cursor != nil {
+			return c.TxSearchCursor(ctx.Context(), query, prove, *cursor, perPage, orderBy)
+		}
 		return c.TxSearch(ctx.Context(), query, prove, page, perPage, orderBy)
 	}
 }
@@ -164,23 +173,42 @@ func makeTxSearchFunc(c *lrpc.Client) rpcTxSearchFunc {
 type rpcBlockSearchFunc func(
 	ctx *rpctypes.Context,
 	query string,
-	prove bool,
 	page, perPage *int,
 	orderBy string,
+	cursor *string,
 ) (*ctypes.ResultBlockSearch, error)
 
 func makeBlockSearchFunc(c *lrpc.Client) rpcBlockSearchFunc {
 	return func(
 		ctx *rpctypes.Context,
 		query string,
-		prove bool,
 		page, perPage *int,
 		orderBy string,
+		cursor *string,
 	) (*ctypes.ResultBlockSearch, error) {
+		if cursor != nil {
+			return c.BlockSearchCursor(ctx.Context(), query, *cursor, perPage, orderBy)
+		}
 		return c.BlockSearch(ctx.Context(), query, page, perPage, orderBy)
 	}
 }
 
+type rpcTxCountFunc func(ctx *rpctypes.Context, query string) (*ctypes.ResultTxCount, error)
+
+func makeTxCountFunc(c *lrpc.Client) rpcTxCountFunc {
+	return func(ctx *rpctypes.Context, query string) (*ctypes.ResultTxCount, error) {
+		return c.TxCount(ctx.Context(), query)
+	}
+}
+
+type rpcBlockCountFunc func(ctx *rpctypes.Context, query string) (*ctypes.ResultBlockCount, error)
+
+func makeBlockCountFunc(c *lrpc.Client) rpcBlockCountFunc {
+	return func(ctx *rpctypes.Context, query string) (*ctypes.ResultBlockCount, error) {
+		return c.BlockCount(ctx.Context(), query)
+	}
+}
+
 type rpcValidatorsFunc func(ctx *rpctypes.Context, height *int64,
 	page, perPage *int) (*ctypes.ResultValidators, error)
 
@@ -190,6 +218,15 @@ func makeValidatorsFunc(c *lrpc.Client) rpcValidatorsFunc {
 	}
 }
 
+type rpcProposerElectionFunc func(ctx *rpctypes.Context, height *int64,
+	round *int32) (*ctypes.ResultProposerElection, error)
+
+func makeProposerElectionFunc(c *lrpc.Client) rpcProposerElectionFunc {
+	return func(ctx *rpctypes.Context, height *int64, round *int32) (*ctypes.ResultProposerElection, error) {
+		return c.ProposerElection(ctx.Context(), height, round)
+	}
+}
+
 type rpcDumpConsensusStateFunc func(ctx *rpctypes.Context) (*ctypes.ResultDumpConsensusState, error)
 
 func makeDumpConsensusStateFunc(c *lrpc.Client) rpcDumpConsensusStateFunc {
@@ -206,6 +243,14 @@ func makeConsensusStateFunc(c *lrpc.Client) rpcConsensusStateFunc {
 	}
 }
 
+type rpcUpcomingProposersFunc func(ctx *rpctypes.Context, rounds *int32) (*ctypes.ResultUpcomingProposers, error)
+
+func makeUpcomingProposersFunc(c *lrpc.Client) rpcUpcomingProposersFunc {
+	return func(ctx *rpctypes.Context, rounds *int32) (*ctypes.ResultUpcomingProposers, error) {
+		return c.UpcomingProposers(ctx.Context(), rounds)
+	}
+}
+
 type rpcConsensusParamsFunc func(ctx *rpctypes.Context, height *int64) (*ctypes.ResultConsensusParams, error)
 
 func makeConsensusParamsFunc(c *lrpc.Client) rpcConsensusParamsFunc {
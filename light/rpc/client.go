@@ -228,6 +228,10 @@ func (c *Client) ConsensusState(ctx context.Context) (*ctypes.ResultConsensusSta
 	return c.next.ConsensusState(ctx)
 }
 
+func (c *Client) UpcomingProposers(ctx context.Context, rounds *int32) (*ctypes.ResultUpcomingProposers, error) {
+	return c.next.UpcomingProposers(ctx, rounds)
+}
+
 func (c *Client) ConsensusParams(ctx context.Context, height *int64) (*ctypes.ResultConsensusParams, error) {
 	res, err := c.next.ConsensusParams(ctx, height)
 	if err != nil {
@@ -497,6 +501,35 @@ func (c *Client) BlockSearch(
 	return c.next.BlockSearch(ctx, query, page, perPage, orderBy)
 }
 
+func (c *Client) TxSearchCursor(
+	ctx context.Context,
+	query string,
+	prove bool,
+	cursor string,
+	perPage *int,
+	orderBy string,
+) (*ctypes.ResultTxSearch, error) {
+	return c.next.TxSearchCursor(ctx, query, prove, cursor, perPage, orderBy)
+}
+
+func (c *Client) BlockSearchCursor(
+	ctx context.Context,
+	query string,
+	cursor string,
+	perPage *int,
+	orderBy string,
+) (*ctypes.ResultBlockSearch, error) {
+	return c.next.BlockSearchCursor(ctx, query, cursor, perPage, orderBy)
+}
+
+func (c *Client) TxCount(ctx context.Context, query string) (*ctypes.ResultTxCount, error) {
+	return c.next.TxCount(ctx, query)
+}
+
+func (c *Client) BlockCount(ctx context.Context, query string) (*ctypes.ResultBlockCount, error) {
+	return c.next.BlockCount(ctx, query)
+}
+
 // Validators fetches and verifies validators.
 //
 // WARNING: only full validator sets are verified (when length of validators is
@@ -531,6 +564,74 @@ func (c *Client) Validators(
 		Total:       totalCount}, nil
 }
 
+// ProposerElection calls rpcclient#ProposerElection and then verifies the
+// result. The previous proof hash is verified through the VRF proof of the
+// verified block, and the election is recomputed from the trusted validator
+// set.
+func (c *Client) ProposerElection(
+	ctx context.Context,
+	height *int64,
+	round *int32,
+) (*ctypes.ResultProposerElection, error) {
+	res, err := c.next.ProposerElection(ctx, height, round)
+	if err != nil {
+		return nil, err
+	}
+
+	// Verify the block, which carries the proof.
+	resBlock, err := c.Block(ctx, &res.Height)
+	if err != nil {
+		return nil, err
+	}
+	block := resBlock.Block
+
+	// The light block is trusted by now.
+	l, err := c.updateLightClientIfNeededTo(ctx, &res.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	electionRound := block.Round
+	if round != nil {
+		electionRound = *round
+	}
+	if res.Round != electionRound {
+		return nil, fmt.Errorf("round %d does not match with expected round %d", res.Round, electionRound)
+	}
+
+	// The proof only verifies over the hash message made from the right
+	// previous proof hash.
+	_, blockProposer := l.ValidatorSet.GetByAddress(block.ProposerAddress)
+	if blockProposer == nil {
+		return nil, fmt.Errorf("proposer %X of block %d is not in the trusted validator set",
+			block.ProposerAddress, block.Height)
+	}
+	message := types.MakeRoundHash(res.PreviousProofHash, block.Height-1, block.Round)
+	output, err := blockProposer.PubKey.VRFVerify(block.Proof, message)
+	if err != nil {
+		return nil, fmt.Errorf("invalid previous proof hash %X: %w", res.PreviousProofHash, err)
+	}
+	if !bytes.Equal(output, res.VRFOutput) {
+		return nil, fmt.Errorf("VRF output %X does not match with verified output %X", res.VRFOutput, output)
+	}
+
+	roundHash := types.MakeRoundHash(res.PreviousProofHash, block.Height, electionRound)
+	seed := types.ProposerSeed(roundHash)
+	totalVotingPower := l.ValidatorSet.TotalVotingPower()
+	return &ctypes.ResultProposerElection{
+		Height:            block.Height,
+		Round:             electionRound,
+		PreviousProofHash: res.PreviousProofHash,
+		RoundHash:         roundHash,
+		Seed:              seed,
+		Threshold:         types.ProposerThreshold(seed, totalVotingPower),
+		TotalVotingPower:  totalVotingPower,
+		Proposer:          l.ValidatorSet.SelectProposer(res.PreviousProofHash, block.Height, electionRound),
+		Proof:             block.Proof,
+		VRFOutput:         tmbytes.HexBytes(output),
+	}, nil
+}
+
 func (c *Client) BroadcastEvidence(ctx context.Context, ev types.Evidence) (*ctypes.ResultBroadcastEvidence, error) {
 	return c.next.BroadcastEvidence(ctx, ev)
 }
@@ -93,6 +93,9 @@ func (s *dbs) DeleteLightBlock(height int64) error {
 	if err := b.Delete(s.lbKey(height)); err != nil {
 		return err
 	}
+	if err := b.Delete(s.phKey(height)); err != nil {
+		return err
+	}
 	if err := b.Set(sizeKey, marshalSize(s.size-1)); err != nil {
 		return err
 	}
@@ -134,6 +137,40 @@ func (s *dbs) LightBlock(height int64) (*types.LightBlock, error) {
 	return lightBlock, err
 }
 
+// SaveProofHash persists the VRF proof hash of the block at the given height
+// to the db.
+//
+// Safe for concurrent use by multiple goroutines.
+func (s *dbs) SaveProofHash(height int64, proofHash []byte) error {
+	if height <= 0 {
+		panic("negative or zero height")
+	}
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	return s.db.SetSync(s.phKey(height), proofHash)
+}
+
+// ProofHash retrieves the VRF proof hash of the block at the given height.
+//
+// Safe for concurrent use by multiple goroutines.
+func (s *dbs) ProofHash(height int64) ([]byte, error) {
+	if height <= 0 {
+		panic("negative or zero height")
+	}
+
+	bz, err := s.db.Get(s.phKey(height))
+	if err != nil {
+		panic(err)
+	}
+	if len(bz) == 0 {
+		return nil, store.ErrProofHashNotFound
+	}
+
+	return bz, nil
+}
+
 // LastLightBlockHeight returns the last LightBlock height stored.
 //
 // Safe for concurrent use by multiple goroutines.
@@ -253,6 +290,9 @@ func (s *dbs) Prune(size uint16) error {
 			if err = b.Delete(s.lbKey(height)); err != nil {
 				return err
 			}
+			if err = b.Delete(s.phKey(height)); err != nil {
+				return err
+			}
 		}
 		itr.Next()
 		numToPrune--
@@ -293,7 +333,11 @@ func (s *dbs) lbKey(height int64) []byte {
 	return []byte(fmt.Sprintf("lb/%s/%020d", s.prefix, height))
 }
 
-var keyPattern = regexp.MustCompile(`^(lb)/([^/]*)/([0-9]+)$`)
+func (s *dbs) phKey(height int64) []byte {
+	return []byte(fmt.Sprintf("ph/%s/%020d", s.prefix, height))
+}
+
+var keyPattern = regexp.MustCompile(`^(lb|ph)/([^/]*)/([0-9]+)$`)
 
 func parseKey(key []byte) (part string, prefix string, height int64, ok bool) {
 	submatch := keyPattern.FindSubmatch(key)
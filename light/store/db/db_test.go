@@ -14,6 +14,7 @@ import (
 	"github.com/Finschia/ostracon/crypto"
 	"github.com/Finschia/ostracon/crypto/tmhash"
 	tmrand "github.com/Finschia/ostracon/libs/rand"
+	"github.com/Finschia/ostracon/light/store"
 	"github.com/Finschia/ostracon/types"
 	"github.com/Finschia/ostracon/version"
 )
@@ -195,3 +196,51 @@ func randLightBlock(height int64) *types.LightBlock {
 		ValidatorSet: vals,
 	}
 }
+
+func Test_SaveProofHash(t *testing.T) {
+	dbStore := New(dbm.NewMemDB(), "Test_SaveProofHash")
+
+	// Empty store
+	proofHash, err := dbStore.ProofHash(1)
+	require.Equal(t, store.ErrProofHashNotFound, err)
+	assert.Nil(t, proofHash)
+
+	// 1 key
+	err = dbStore.SaveLightBlock(randLightBlock(1))
+	require.NoError(t, err)
+	expected := tmrand.Bytes(tmhash.Size)
+	err = dbStore.SaveProofHash(1, expected)
+	require.NoError(t, err)
+
+	proofHash, err = dbStore.ProofHash(1)
+	require.NoError(t, err)
+	assert.Equal(t, expected, proofHash)
+
+	// proof hash doesn't count for the size and the heights
+	assert.Equal(t, uint16(1), dbStore.Size())
+	height, err := dbStore.LastLightBlockHeight()
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, height)
+
+	// Delete the light block along with the proof hash
+	err = dbStore.DeleteLightBlock(1)
+	require.NoError(t, err)
+
+	proofHash, err = dbStore.ProofHash(1)
+	require.Equal(t, store.ErrProofHashNotFound, err)
+	assert.Nil(t, proofHash)
+
+	// Prune the light blocks along with the proof hashes
+	for h := int64(1); h <= 3; h++ {
+		require.NoError(t, dbStore.SaveLightBlock(randLightBlock(h)))
+		require.NoError(t, dbStore.SaveProofHash(h, expected))
+	}
+	err = dbStore.Prune(1)
+	require.NoError(t, err)
+
+	_, err = dbStore.ProofHash(2)
+	require.Equal(t, store.ErrProofHashNotFound, err)
+	proofHash, err = dbStore.ProofHash(3)
+	require.NoError(t, err)
+	assert.Equal(t, expected, proofHash)
+}
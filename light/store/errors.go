@@ -6,4 +6,8 @@ var (
 	// ErrLightBlockNotFound is returned when a store does not have the
 	// requested header.
 	ErrLightBlockNotFound = errors.New("light block not found")
+
+	// ErrProofHashNotFound is returned when a store does not have the
+	// requested proof hash.
+	ErrProofHashNotFound = errors.New("proof hash not found")
 )
@@ -24,6 +24,19 @@ type Store interface {
 	// If LightBlock is not found, ErrLightBlockNotFound is returned.
 	LightBlock(height int64) (*types.LightBlock, error)
 
+	// SaveProofHash saves the VRF proof hash of the block at the given
+	// height. It is deleted along with the LightBlock at the same height.
+	//
+	// height must be > 0.
+	SaveProofHash(height int64, proofHash []byte) error
+
+	// ProofHash returns the VRF proof hash of the block at the given height.
+	//
+	// height must be > 0.
+	//
+	// If the proof hash is not found, ErrProofHashNotFound is returned.
+	ProofHash(height int64) ([]byte, error)
+
 	// LastLightBlockHeight returns the last (newest) LightBlock height.
 	//
 	// If the store is empty, -1 and nil error are returned.
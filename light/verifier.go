@@ -243,3 +243,51 @@ func VerifyBackwards(untrustedHeader, trustedHeader *types.Header) error {
 
 	return nil
 }
+
+// VerifyProposer verifies that untrustedHeader was proposed by the validator
+// elected via VRF and that the entropy carries a valid VRF proof of that
+// validator. It ensures that:
+//
+//	a) the proposer of untrustedHeader equals the one selected by
+//	   untrustedVals.SelectProposer(lastProofHash, height, entropy.Round)
+//	b) entropy.Proof is verified by the proposer's public key for the message
+//	   types.MakeRoundHash(lastProofHash, height-1, entropy.Round)
+//
+// lastProofHash is the proof hash of the block at height-1. For any of these
+// cases ErrInvalidHeader is returned. On success, the proof hash of
+// untrustedHeader is returned, which must be used as lastProofHash for the
+// next height.
+func VerifyProposer(
+	untrustedHeader *types.SignedHeader, // height=X
+	untrustedVals *types.ValidatorSet, // height=X
+	entropy *types.Entropy, // height=X
+	lastProofHash []byte) ([]byte, error) { // height=X-1
+
+	if entropy == nil {
+		return nil, ErrInvalidHeader{errors.New("missing entropy")}
+	}
+	if err := entropy.ValidateBasic(); err != nil {
+		return nil, ErrInvalidHeader{fmt.Errorf("invalid entropy: %w", err)}
+	}
+	if len(lastProofHash) == 0 {
+		return nil, ErrInvalidHeader{errors.New("missing last proof hash")}
+	}
+
+	proposer := untrustedVals.SelectProposer(lastProofHash, untrustedHeader.Height, entropy.Round)
+	if !bytes.Equal(untrustedHeader.ProposerAddress, proposer.Address) {
+		return nil, ErrInvalidHeader{fmt.Errorf("expected proposer %X at height %d, round %d, got %X",
+			proposer.Address,
+			untrustedHeader.Height,
+			entropy.Round,
+			untrustedHeader.ProposerAddress)}
+	}
+
+	message := types.MakeRoundHash(lastProofHash, untrustedHeader.Height-1, entropy.Round)
+	output, err := proposer.PubKey.VRFVerify(entropy.Proof, message)
+	if err != nil {
+		return nil, ErrInvalidHeader{fmt.Errorf("invalid VRF proof %X of proposer %X: %w",
+			entropy.Proof, proposer.Address, err)}
+	}
+
+	return output, nil
+}
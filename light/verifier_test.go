@@ -1,12 +1,14 @@
 package light_test
 
 import (
+	"bytes"
 	"fmt"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 
+	"github.com/Finschia/ostracon/crypto/ed25519"
 	tmmath "github.com/Finschia/ostracon/libs/math"
 	"github.com/Finschia/ostracon/light"
 	"github.com/Finschia/ostracon/types"
@@ -333,3 +335,53 @@ func TestValidateTrustLevel(t *testing.T) {
 		}
 	}
 }
+
+func TestVerifyProposer(t *testing.T) {
+	const chainID = "TestVerifyProposer"
+
+	var (
+		keys                      = genPrivKeys(4)
+		vals                      = keys.ToValidators(20, 10)
+		bTime, _                  = time.Parse(time.RFC3339, "2006-01-02T15:04:05Z")
+		headers, _, entropies     = keys.GenVRFChain(chainID, 3, vals, bTime)
+		lastProofHash, _          = ed25519.ProofToHash(entropies[2].Proof)
+		expectedProofHash, _      = ed25519.ProofToHash(entropies[3].Proof)
+		wrongProposerHeader       = *headers[3].Header
+		wrongProposerSignedHeader = &types.SignedHeader{Header: &wrongProposerHeader, Commit: headers[3].Commit}
+	)
+	for _, v := range vals.Validators {
+		if !bytes.Equal(v.Address, headers[3].ProposerAddress) {
+			wrongProposerHeader.ProposerAddress = v.Address
+			break
+		}
+	}
+
+	testCases := []struct {
+		name          string
+		header        *types.SignedHeader
+		entropy       *types.Entropy
+		lastProofHash []byte
+		expErr        bool
+	}{
+		{"valid proof of the elected proposer", headers[3], entropies[3], lastProofHash, false},
+		{"missing entropy", headers[3], nil, lastProofHash, true},
+		{"missing last proof hash", headers[3], entropies[3], nil, true},
+		{"wrong last proof hash", headers[3], entropies[3], hash("wrong"), true},
+		{"proof for another height", headers[3], entropies[2], lastProofHash, true},
+		{"not the elected proposer", wrongProposerSignedHeader, entropies[3], lastProofHash, true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			proofHash, err := light.VerifyProposer(tc.header, vals, tc.entropy, tc.lastProofHash)
+			if tc.expErr {
+				assert.Error(t, err)
+				assert.IsType(t, light.ErrInvalidHeader{}, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, expectedProofHash, proofHash)
+		})
+	}
+}
@@ -0,0 +1,138 @@
+package light
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Finschia/ostracon/light/provider"
+	"github.com/Finschia/ostracon/light/store"
+	"github.com/Finschia/ostracon/types"
+)
+
+// validateEntropyProviders returns an error if any of the providers can not
+// provide entropy.
+func validateEntropyProviders(primary provider.Provider, witnesses []provider.Provider) error {
+	if _, ok := primary.(provider.EntropyProvider); !ok {
+		return fmt.Errorf("primary %v does not provide entropy", primary)
+	}
+	for i, w := range witnesses {
+		if _, ok := w.(provider.EntropyProvider); !ok {
+			return fmt.Errorf("witness #%d: %v does not provide entropy", i, w)
+		}
+	}
+	return nil
+}
+
+// verifyProposer fetches the entropy of the given light block from the
+// primary and verifies its proposer against lastProofHash (see
+// VerifyProposer). It returns the proof hash of the light block.
+func (c *Client) verifyProposer(ctx context.Context, l *types.LightBlock, lastProofHash []byte) ([]byte, error) {
+	entropy, err := c.entropyFromPrimary(ctx, l.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	c.logger.Debug("Verify proposer of newLightBlock",
+		"height", l.Height,
+		"round", entropy.Round,
+		"proposer", l.ProposerAddress)
+
+	return VerifyProposer(l.SignedHeader, l.ValidatorSet, entropy, lastProofHash)
+}
+
+// trustedProofHash returns the proof hash of the trusted light block. If the
+// store doesn't have it (e.g. the block was trusted before VRF verification
+// was enabled), it is taken from the entropy provided by the primary.
+func (c *Client) trustedProofHash(ctx context.Context, trusted *types.LightBlock) ([]byte, error) {
+	proofHash, err := c.trustedStore.ProofHash(trusted.Height)
+	switch {
+	case err == nil:
+		return proofHash, nil
+	case errors.Is(err, store.ErrProofHashNotFound):
+		return c.proofHashFromPrimary(ctx, trusted)
+	default:
+		return nil, fmt.Errorf("can't get proof hash at height %d: %w", trusted.Height, err)
+	}
+}
+
+// predecessorProofHash returns the proof hash of the block right before the
+// given light block. It is taken from the trusted store if the previous block
+// has been verified. Otherwise, the previous light block is fetched from the
+// primary, bound to the given light block via LastBlockID, and its proof hash
+// is taken from its entropy.
+//
+// NOTE: the entropy of the previous block is not verified by itself, but a
+// forged proof hash would result in a different VRF message, which the
+// proposer of the given light block never signs. Thus, VerifyProposer fails.
+func (c *Client) predecessorProofHash(ctx context.Context, l *types.LightBlock) ([]byte, error) {
+	height := l.Height - 1
+	if height <= 0 {
+		return nil, ErrInvalidHeader{fmt.Errorf("no block before height %d", l.Height)}
+	}
+
+	if trusted, err := c.trustedStore.LightBlock(height); err == nil &&
+		bytes.Equal(trusted.Hash(), l.LastBlockID.Hash) {
+		return c.trustedProofHash(ctx, trusted)
+	}
+
+	prev, err := c.lightBlockFromPrimary(ctx, height)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(prev.Hash(), l.LastBlockID.Hash) {
+		return nil, ErrInvalidHeader{fmt.Errorf("expected last block %X at height %d, got %X",
+			l.LastBlockID.Hash, height, prev.Hash())}
+	}
+
+	return c.proofHashFromPrimary(ctx, prev)
+}
+
+// proofHashFromPrimary returns the proof hash derived from the entropy of the
+// given light block provided by the primary.
+func (c *Client) proofHashFromPrimary(ctx context.Context, l *types.LightBlock) ([]byte, error) {
+	entropy, err := c.entropyFromPrimary(ctx, l.Height)
+	if err != nil {
+		return nil, err
+	}
+	if err := entropy.ValidateBasic(); err != nil {
+		return nil, provider.ErrBadLightBlock{Reason: fmt.Errorf("invalid entropy: %w", err)}
+	}
+
+	_, proposer := l.ValidatorSet.GetByAddress(l.ProposerAddress)
+	if proposer == nil {
+		return nil, provider.ErrBadLightBlock{Reason: fmt.Errorf("proposer %X is not in the validator set",
+			l.ProposerAddress)}
+	}
+	proofHash, err := types.ProofToHash(proposer.PubKey, entropy.Proof)
+	if err != nil {
+		return nil, provider.ErrBadLightBlock{Reason: fmt.Errorf("invalid proof: %w", err)}
+	}
+	return proofHash, nil
+}
+
+// entropyFromPrimary retrieves the entropy of the block at the given height
+// from the primary provider.
+func (c *Client) entropyFromPrimary(ctx context.Context, height int64) (*types.Entropy, error) {
+	c.providerMutex.Lock()
+	defer c.providerMutex.Unlock()
+
+	ep, ok := c.primary.(provider.EntropyProvider)
+	if !ok {
+		return nil, fmt.Errorf("primary %v does not provide entropy", c.primary)
+	}
+	return ep.Entropy(ctx, height)
+}
+
+// saveProofHash persists the proof hash of the verified block if VRF
+// verification is enabled.
+func (c *Client) saveProofHash(height int64, proofHash []byte) error {
+	if !c.verifyVRF {
+		return nil
+	}
+	if err := c.trustedStore.SaveProofHash(height, proofHash); err != nil {
+		return fmt.Errorf("failed to save proof hash: %w", err)
+	}
+	return nil
+}
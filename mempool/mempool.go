@@ -101,6 +101,18 @@ type Mempool interface {
 
 	// SizeBytes returns the total size of all txs in the mempool.
 	SizeBytes() int64
+
+	// InitWAL creates a directory for the WAL file and opens it. The pending
+	// txs recorded in the WAL are replayed through CheckTxAsync.
+	//
+	// NOTE:
+	// 1. Should only be called once, on startup, before the txs are received
+	// from the peers.
+	InitWAL() error
+
+	// CloseWAL closes the underlying WAL file. Any further admissions and
+	// removals of txs will not be recorded.
+	CloseWAL()
 }
 
 // PreCheckFunc is an optional filter executed before CheckTx and rejects
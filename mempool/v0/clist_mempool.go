@@ -61,6 +61,9 @@ type CListMempool struct {
 	// This reduces the pressure on the proxyApp.
 	cache mempool.TxCache
 
+	// Write-ahead log of the admitted and removed txs (nil if disabled).
+	wal *mempool.WAL
+
 	logger  log.Logger
 	metrics *mempool.Metrics
 }
@@ -138,6 +141,66 @@ func WithMetrics(metrics *mempool.Metrics) CListMempoolOption {
 	return func(mem *CListMempool) { mem.metrics = metrics }
 }
 
+// InitWAL opens the WAL in the directory given by `wal_dir` and replays the
+// pending txs recorded in it through CheckTxAsync. Before replaying, the WAL
+// is compacted so that it only contains the pending txs, which also discards
+// a record partially written by a crash.
+//
+// NOTE: should only be called once, on startup, before the txs are received
+// from the peers
+func (mem *CListMempool) InitWAL() error {
+	wal, err := mempool.OpenWAL(mem.config.WalDir(), mem.config.MaxTxBytes)
+	if err != nil {
+		return err
+	}
+
+	txs, err := wal.ReadAll()
+	if err != nil {
+		if !mempool.IsWALCorruptionError(err) {
+			wal.Close()
+			return err
+		}
+		mem.logger.Error("Mempool WAL is corrupted, discarding the rest of it", "err", err)
+	}
+	if err := wal.Checkpoint(txs); err != nil {
+		wal.Close()
+		return err
+	}
+	mem.updateMtx.Lock()
+	mem.wal = wal
+	mem.updateMtx.Unlock()
+
+	mem.logger.Info("Replaying txs from mempool WAL", "numtxs", len(txs))
+	for _, tx := range txs {
+		tx := tx
+		mem.CheckTxAsync(tx, mempool.TxInfo{SenderID: mempool.UnknownPeerID},
+			func(err error) {
+				if err != nil {
+					mem.removeTxFromWAL(tx)
+				}
+			},
+			func(res *ocabci.Response) {
+				if r := res.GetCheckTx(); r == nil || r.Code != ocabci.CodeTypeOK {
+					mem.removeTxFromWAL(tx)
+				}
+			})
+	}
+
+	return nil
+}
+
+// CloseWAL closes the WAL. Any further admissions and removals of txs are not
+// recorded.
+func (mem *CListMempool) CloseWAL() {
+	mem.updateMtx.Lock()
+	defer mem.updateMtx.Unlock()
+
+	if mem.wal != nil {
+		mem.wal.Close()
+		mem.wal = nil
+	}
+}
+
 // Safe for concurrent use by multiple goroutines.
 func (mem *CListMempool) Lock() {
 	mem.updateMtx.Lock()
@@ -181,6 +244,12 @@ func (mem *CListMempool) Flush() {
 		mem.txsMap.Delete(key)
 		return true
 	})
+
+	if mem.wal != nil {
+		if err := mem.wal.Checkpoint(nil); err != nil {
+			mem.logger.Error("Error compacting mempool WAL", "err", err)
+		}
+	}
 }
 
 // TxsFront returns the first transaction in the ordered list for peer
@@ -406,6 +475,12 @@ func (mem *CListMempool) addTx(memTx *mempoolTx) {
 	mem.txsMap.Store(memTx.tx.Key(), e)
 	atomic.AddInt64(&mem.txsBytes, int64(len(memTx.tx)))
 	mem.metrics.TxSizeBytes.Observe(float64(len(memTx.tx)))
+
+	if mem.wal != nil {
+		if err := mem.wal.WriteTx(memTx.tx); err != nil {
+			mem.logger.Error("Error writing to mempool WAL", "err", err)
+		}
+	}
 }
 
 // Called from:
@@ -420,6 +495,18 @@ func (mem *CListMempool) removeTx(tx types.Tx, elem *clist.CElement, removeFromC
 	if removeFromCache {
 		mem.cache.Remove(tx)
 	}
+
+	mem.removeTxFromWAL(tx)
+}
+
+// removeTxFromWAL records the removal of the tx, if the WAL is enabled.
+func (mem *CListMempool) removeTxFromWAL(tx types.Tx) {
+	if mem.wal == nil {
+		return
+	}
+	if err := mem.wal.WriteRemoveTx(tx.Key()); err != nil {
+		mem.logger.Error("Error writing to mempool WAL", "err", err)
+	}
 }
 
 // RemoveTxByKey removes a transaction from the mempool by its TxKey index.
@@ -770,12 +857,37 @@ func (mem *CListMempool) Update(
 		mem.notifyTxsAvailable()
 	}
 
+	if mem.wal != nil {
+		mem.syncWAL()
+	}
+
 	// Update metrics
 	mem.metrics.Size.Set(float64(mem.Size()))
 
 	return err
 }
 
+// syncWAL persists the removals of txs in the WAL, compacting it when its
+// head file reached the size limit.
+//
+// Lock() must be held by the caller during execution.
+func (mem *CListMempool) syncWAL() {
+	if !mem.wal.HeadSizeLimitReached() {
+		if err := mem.wal.Sync(); err != nil {
+			mem.logger.Error("Error syncing mempool WAL", "err", err)
+		}
+		return
+	}
+
+	txs := make(types.Txs, 0, mem.Size())
+	for e := mem.txs.Front(); e != nil; e = e.Next() {
+		txs = append(txs, e.Value.(*mempoolTx).tx)
+	}
+	if err := mem.wal.Checkpoint(txs); err != nil {
+		mem.logger.Error("Error compacting mempool WAL", "err", err)
+	}
+}
+
 func (mem *CListMempool) recheckTxs() {
 	if mem.Size() == 0 {
 		return
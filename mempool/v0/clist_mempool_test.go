@@ -783,3 +783,32 @@ func TestTxMempoolPostCheckError(t *testing.T) {
 		})
 	}
 }
+
+func TestMempoolWAL(t *testing.T) {
+	app := kvstore.NewApplication()
+	cc := proxy.NewLocalClientCreator(app)
+	cfg := config.ResetTestRoot("mempool_test")
+	cfg.Mempool.WalPath = "data/mempool.wal"
+	defer os.RemoveAll(cfg.RootDir)
+
+	mp, _ := newMempoolWithAppAndConfig(cc, cfg)
+	require.NoError(t, mp.InitWAL())
+
+	txs := checkTxs(t, mp, 5, mempool.UnknownPeerID)
+	require.Equal(t, 5, mp.Size())
+
+	// txs[0] and txs[1] are committed
+	mp.Lock()
+	err := mp.Update(newTestBlock(1, txs[:2]), abciResponses(2, ocabci.CodeTypeOK), nil, nil)
+	mp.Unlock()
+	require.NoError(t, err)
+	mp.CloseWAL()
+
+	// the pending txs are replayed on restart
+	mp, _ = newMempoolWithAppAndConfig(cc, cfg)
+	require.NoError(t, mp.InitWAL())
+	defer mp.CloseWAL()
+
+	require.Eventually(t, func() bool { return mp.Size() == 3 }, time.Second, 10*time.Millisecond)
+	assert.Equal(t, txs[2:], mp.ReapMaxTxs(-1))
+}
@@ -9,6 +9,8 @@ import (
 
 	protomem "github.com/tendermint/tendermint/proto/tendermint/mempool"
 
+	ocabci "github.com/Finschia/ostracon/abci/types"
+	"github.com/Finschia/ostracon/behaviour"
 	cfg "github.com/Finschia/ostracon/config"
 	"github.com/Finschia/ostracon/libs/clist"
 	"github.com/Finschia/ostracon/libs/log"
@@ -23,9 +25,13 @@ import (
 // peers you received it from.
 type Reactor struct {
 	p2p.BaseReactor
-	config  *cfg.MempoolConfig
-	mempool *CListMempool
-	ids     *mempoolIDs
+	config   *cfg.MempoolConfig
+	mempool  *CListMempool
+	ids      *mempoolIDs
+	reporter behaviour.Reporter
+
+	invalidTxsMtx tmsync.Mutex
+	invalidTxs    map[p2p.ID]int // number of invalid txs in a row received from each peer
 }
 
 type mempoolIDs struct {
@@ -94,9 +100,10 @@ func newMempoolIDs() *mempoolIDs {
 // NewReactor returns a new Reactor with the given config and mempool.
 func NewReactor(config *cfg.MempoolConfig, async bool, recvBufSize int, mempool *CListMempool) *Reactor {
 	memR := &Reactor{
-		config:  config,
-		mempool: mempool,
-		ids:     newMempoolIDs(),
+		config:     config,
+		mempool:    mempool,
+		ids:        newMempoolIDs(),
+		invalidTxs: make(map[p2p.ID]int),
 	}
 	memR.BaseReactor = *p2p.NewBaseReactor("Mempool", memR, async, recvBufSize)
 	return memR
@@ -114,8 +121,18 @@ func (memR *Reactor) SetLogger(l log.Logger) {
 	memR.mempool.SetLogger(l)
 }
 
+// SetReporter sets the reporter the peer behaviours are reported to.
+// It must be called before the reactor is started.
+func (memR *Reactor) SetReporter(reporter behaviour.Reporter) {
+	memR.reporter = reporter
+}
+
 // OnStart implements p2p.BaseReactor.
 func (memR *Reactor) OnStart() error {
+	if memR.reporter == nil {
+		memR.reporter = behaviour.NewSwitchReporter(memR.Switch)
+	}
+
 	// call BaseReactor's OnStart()
 	err := memR.BaseReactor.OnStart()
 	if err != nil {
@@ -159,6 +176,9 @@ func (memR *Reactor) AddPeer(peer p2p.Peer) {
 // RemovePeer implements Reactor.
 func (memR *Reactor) RemovePeer(peer p2p.Peer, reason interface{}) {
 	memR.ids.Reclaim(peer)
+	memR.invalidTxsMtx.Lock()
+	delete(memR.invalidTxs, peer.ID())
+	memR.invalidTxsMtx.Unlock()
 	// broadcast routine checks if peer is gone and returns
 }
 
@@ -187,18 +207,59 @@ func (memR *Reactor) ReceiveEnvelope(e p2p.Envelope) {
 					memR.Logger.Debug("Tx already exists in cache", "tx", ntx.String())
 				} else if err != nil {
 					memR.Logger.Info("Could not check tx", "tx", ntx.String(), "err", err)
+					if errors.As(err, &mempool.ErrTxTooLarge{}) || mempool.IsPreCheckError(err) {
+						memR.recordCheckTx(txInfo.SenderP2PID, false)
+					}
 				}
-			}, nil)
+			}, func(res *ocabci.Response) {
+				// a tx rejected by the app may have been valid when the peer relayed it, so
+				// only the accepted txs are recorded
+				if r := res.GetCheckTx(); r != nil && r.Code == ocabci.CodeTypeOK {
+					memR.recordCheckTx(txInfo.SenderP2PID, true)
+				}
+			})
 		}
 	default:
 		memR.Logger.Error("unknown message type", "src", e.Src, "chId", e.ChannelID, "msg", e.Message)
-		memR.Switch.StopPeerForError(e.Src, fmt.Errorf("mempool cannot handle message of type: %T", e.Message))
+		memR.report(behaviour.BadMessage(e.Src.ID(), fmt.Sprintf("mempool cannot handle message of type: %T", e.Message)))
 		return
 	}
 
 	// broadcasting happens from go routines per peer
 }
 
+// recordCheckTx records whether a tx received from the peer was valid, and
+// reports the peer once it sent max_consecutive_invalid_txs invalid txs in a row.
+func (memR *Reactor) recordCheckTx(peerID p2p.ID, valid bool) {
+	if peerID == "" || memR.config.MaxConsecutiveInvalidTxs == 0 {
+		return
+	}
+
+	memR.invalidTxsMtx.Lock()
+	if valid {
+		delete(memR.invalidTxs, peerID)
+		memR.invalidTxsMtx.Unlock()
+		return
+	}
+	memR.invalidTxs[peerID]++
+	flood := memR.invalidTxs[peerID] >= memR.config.MaxConsecutiveInvalidTxs
+	if flood {
+		delete(memR.invalidTxs, peerID)
+	}
+	memR.invalidTxsMtx.Unlock()
+
+	if flood {
+		memR.report(behaviour.InvalidTxFlood(peerID,
+			fmt.Sprintf("sent %d invalid txs in a row", memR.config.MaxConsecutiveInvalidTxs)))
+	}
+}
+
+func (memR *Reactor) report(pb behaviour.PeerBehaviour) {
+	if err := memR.reporter.Report(pb); err != nil {
+		memR.Logger.Debug("Failed to report peer behaviour", "err", err)
+	}
+}
+
 func (memR *Reactor) Receive(chID byte, peer p2p.Peer, msgBytes []byte) {
 	msg := &protomem.Message{}
 	err := proto.Unmarshal(msgBytes, msg)
@@ -3,6 +3,7 @@ package v0
 import (
 	"encoding/hex"
 	"errors"
+	"fmt"
 	"net"
 	"sync"
 	"testing"
@@ -18,6 +19,8 @@ import (
 	memproto "github.com/tendermint/tendermint/proto/tendermint/mempool"
 
 	"github.com/Finschia/ostracon/abci/example/kvstore"
+	ocabci "github.com/Finschia/ostracon/abci/types"
+	"github.com/Finschia/ostracon/behaviour"
 	cfg "github.com/Finschia/ostracon/config"
 	"github.com/Finschia/ostracon/libs/log"
 	tmrand "github.com/Finschia/ostracon/libs/rand"
@@ -339,6 +342,96 @@ func mempoolLogger() log.Logger {
 	})
 }
 
+func TestReactorReportsInvalidTxFlood(t *testing.T) {
+	config := cfg.TestConfig()
+	const N = 1
+	reactors := makeAndConnectReactors(config, N)
+	var (
+		reactor  = reactors[0]
+		peer     = mock.NewPeer(nil)
+		reporter = behaviour.NewMockReporter()
+	)
+	defer func() {
+		err := reactor.Stop()
+		assert.NoError(t, err)
+	}()
+	reactor.reporter = reporter
+	reactor.mempool.preCheck = func(tx types.Tx) error {
+		if len(tx) == 1 {
+			return errors.New("invalid tx")
+		}
+		return nil
+	}
+	reactor.InitPeer(peer)
+
+	txs := make([][]byte, config.Mempool.MaxConsecutiveInvalidTxs)
+	for i := range txs {
+		txs[i] = []byte{byte(i)}
+	}
+
+	// a valid tx in between resets the count
+	reactor.ReceiveEnvelope(p2p.Envelope{
+		ChannelID: mempool.MempoolChannel,
+		Src:       peer,
+		Message:   &memproto.Txs{Txs: append(append(txs[1:], []byte("valid")), txs[0])},
+	})
+	time.Sleep(100 * time.Millisecond)
+	assert.Empty(t, reporter.GetBehaviours(peer.ID()))
+
+	reactor.ReceiveEnvelope(p2p.Envelope{
+		ChannelID: mempool.MempoolChannel,
+		Src:       peer,
+		Message:   &memproto.Txs{Txs: txs},
+	})
+	require.Eventually(t, func() bool {
+		return len(reporter.GetBehaviours(peer.ID())) > 0
+	}, time.Second, 10*time.Millisecond)
+	assert.Equal(t, []behaviour.PeerBehaviour{
+		behaviour.InvalidTxFlood(peer.ID(), fmt.Sprintf("sent %d invalid txs in a row", config.Mempool.MaxConsecutiveInvalidTxs)),
+	}, reporter.GetBehaviours(peer.ID()))
+}
+
+// rejectingApp rejects the txs of a single byte in CheckTx.
+type rejectingApp struct {
+	*kvstore.Application
+}
+
+func (app rejectingApp) CheckTxAsync(req abci.RequestCheckTx, callback ocabci.CheckTxCallback) {
+	if len(req.Tx) == 1 {
+		callback(ocabci.ResponseCheckTx{Code: 1})
+		return
+	}
+	app.Application.CheckTxAsync(req, callback)
+}
+
+func TestReactorDoesNotReportTxsRejectedByApp(t *testing.T) {
+	config := cfg.TestConfig()
+	mp, cleanup := newMempoolWithApp(proxy.NewLocalClientCreator(rejectingApp{kvstore.NewApplication()}))
+	defer cleanup()
+	var (
+		reactor  = NewReactor(config.Mempool, config.P2P.RecvAsync, config.P2P.MempoolRecvBufSize, mp)
+		peer     = mock.NewPeer(nil)
+		reporter = behaviour.NewMockReporter()
+	)
+	reactor.SetLogger(mempoolLogger())
+	reactor.reporter = reporter
+	reactor.InitPeer(peer)
+
+	// the txs may have been valid when the peer relayed them
+	txs := make([][]byte, 2*config.Mempool.MaxConsecutiveInvalidTxs)
+	for i := range txs {
+		txs[i] = []byte{byte(i)}
+	}
+	reactor.ReceiveEnvelope(p2p.Envelope{
+		ChannelID: mempool.MempoolChannel,
+		Src:       peer,
+		Message:   &memproto.Txs{Txs: txs},
+	})
+	time.Sleep(100 * time.Millisecond)
+	assert.Zero(t, mp.Size())
+	assert.Empty(t, reporter.GetBehaviours(peer.ID()))
+}
+
 // connect N mempool reactors through N switches
 func makeAndConnectReactors(config *cfg.Config, n int) []*Reactor {
 	reactors := make([]*Reactor, n)
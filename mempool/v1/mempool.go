@@ -1,24 +1,22 @@
-//go:build deprecated
-
 package v1
 
 import (
 	"fmt"
-	"runtime"
 	"sort"
 	"sync"
 	"sync/atomic"
 	"time"
 
-	"github.com/creachadair/taskgroup"
-
 	abci "github.com/tendermint/tendermint/abci/types"
-	"github.com/tendermint/tendermint/config"
-	"github.com/tendermint/tendermint/libs/clist"
-	"github.com/tendermint/tendermint/libs/log"
-	"github.com/tendermint/tendermint/mempool"
-	"github.com/tendermint/tendermint/proxy"
-	"github.com/tendermint/tendermint/types"
+
+	ocabci "github.com/Finschia/ostracon/abci/types"
+	"github.com/Finschia/ostracon/config"
+	"github.com/Finschia/ostracon/libs/clist"
+	"github.com/Finschia/ostracon/libs/log"
+	tmsync "github.com/Finschia/ostracon/libs/sync"
+	"github.com/Finschia/ostracon/mempool"
+	"github.com/Finschia/ostracon/proxy"
+	"github.com/Finschia/ostracon/types"
 )
 
 var _ mempool.Mempool = (*TxMempool)(nil)
@@ -46,17 +44,34 @@ type TxMempool struct {
 	// Atomically-updated fields
 	txsBytes int64 // atomic: the total size of all transactions in the mempool, in bytes
 
+	// Exclusive mutex for Update method to prevent concurrent execution of
+	// CheckTx or Reap methods. It is read-locked until the application
+	// responds to CheckTx, so the fields below are protected by it.
+	updateMtx tmsync.RWMutex
+	preCheck  mempool.PreCheckFunc
+	postCheck mempool.PostCheckFunc
+	height    int64 // the latest height passed to Update
+
+	chReqCheckTx chan *requestCheckTxAsync
+
 	// Synchronized fields, protected by mtx.
-	mtx                  *sync.RWMutex
+	mtx                  tmsync.Mutex
 	notifiedTxsAvailable bool
 	txsAvailable         chan struct{} // one value sent per height when mempool is not empty
-	preCheck             mempool.PreCheckFunc
-	postCheck            mempool.PostCheckFunc
-	height               int64 // the latest height passed to Update
 
 	txs        *clist.CList // valid transactions (passed CheckTx)
 	txByKey    map[types.TxKey]*clist.CElement
 	txBySender map[string]*clist.CElement // for sender != ""
+
+	// Write-ahead log of the admitted and removed txs (nil if disabled).
+	wal *mempool.WAL
+}
+
+type requestCheckTxAsync struct {
+	tx        types.Tx
+	txInfo    mempool.TxInfo
+	prepareCb func(error)
+	checkTxCb func(*ocabci.Response)
 }
 
 // NewTxMempool constructs a new, empty priority mempool at the specified
@@ -76,8 +91,8 @@ func NewTxMempool(
 		metrics:      mempool.NopMetrics(),
 		cache:        mempool.NopTxCache{},
 		txs:          clist.New(),
-		mtx:          new(sync.RWMutex),
 		height:       height,
+		chReqCheckTx: make(chan *requestCheckTxAsync, cfg.Size),
 		txByKey:      make(map[types.TxKey]*clist.CElement),
 		txBySender:   make(map[string]*clist.CElement),
 	}
@@ -89,6 +104,7 @@ func NewTxMempool(
 		opt(txmp)
 	}
 
+	go txmp.checkTxAsyncReactor()
 	return txmp
 }
 
@@ -111,12 +127,79 @@ func WithMetrics(metrics *mempool.Metrics) TxMempoolOption {
 	return func(txmp *TxMempool) { txmp.metrics = metrics }
 }
 
+// InitWAL opens the WAL in the directory given by `wal_dir` and replays the
+// pending transactions recorded in it through CheckTxAsync. Before replaying,
+// the WAL is compacted so that it only contains the pending transactions.
+//
+// NOTE: should only be called once, on startup, before the transactions are
+// received from the peers
+func (txmp *TxMempool) InitWAL() error {
+	wal, err := mempool.OpenWAL(txmp.config.WalDir(), txmp.config.MaxTxBytes)
+	if err != nil {
+		return err
+	}
+
+	txs, err := wal.ReadAll()
+	if err != nil {
+		if !mempool.IsWALCorruptionError(err) {
+			wal.Close()
+			return err
+		}
+		txmp.logger.Error("Mempool WAL is corrupted, discarding the rest of it", "err", err)
+	}
+	if err := wal.Checkpoint(txs); err != nil {
+		wal.Close()
+		return err
+	}
+	txmp.updateMtx.Lock()
+	txmp.mtx.Lock()
+	txmp.wal = wal
+	txmp.mtx.Unlock()
+	txmp.updateMtx.Unlock()
+
+	txmp.logger.Info("Replaying txs from mempool WAL", "num_txs", len(txs))
+	for _, tx := range txs {
+		tx := tx
+		txmp.CheckTxAsync(tx, mempool.TxInfo{SenderID: mempool.UnknownPeerID},
+			func(err error) {
+				if err != nil {
+					txmp.mtx.Lock()
+					defer txmp.mtx.Unlock()
+					txmp.removeTxFromWAL(tx)
+				}
+			},
+			func(res *ocabci.Response) {
+				txmp.mtx.Lock()
+				defer txmp.mtx.Unlock()
+				if _, ok := txmp.txByKey[tx.Key()]; !ok {
+					txmp.removeTxFromWAL(tx)
+				}
+			})
+	}
+
+	return nil
+}
+
+// CloseWAL closes the WAL. Any further admissions and removals of
+// transactions are not recorded.
+func (txmp *TxMempool) CloseWAL() {
+	txmp.updateMtx.Lock()
+	defer txmp.updateMtx.Unlock()
+	txmp.mtx.Lock()
+	defer txmp.mtx.Unlock()
+
+	if txmp.wal != nil {
+		txmp.wal.Close()
+		txmp.wal = nil
+	}
+}
+
 // Lock obtains a write-lock on the mempool. A caller must be sure to explicitly
 // release the lock when finished.
-func (txmp *TxMempool) Lock() { txmp.mtx.Lock() }
+func (txmp *TxMempool) Lock() { txmp.updateMtx.Lock() }
 
 // Unlock releases a write-lock on the mempool.
-func (txmp *TxMempool) Unlock() { txmp.mtx.Unlock() }
+func (txmp *TxMempool) Unlock() { txmp.updateMtx.Unlock() }
 
 // Size returns the number of valid transactions in the mempool. It is
 // thread-safe.
@@ -131,16 +214,8 @@ func (txmp *TxMempool) SizeBytes() int64 { return atomic.LoadInt64(&txmp.txsByte
 // The caller must hold an exclusive mempool lock (by calling txmp.Lock) before
 // calling FlushAppConn.
 func (txmp *TxMempool) FlushAppConn() error {
-	// N.B.: We have to issue the call outside the lock so that its callback can
-	// fire.  It's safe to do this, the flush will block until complete.
-	//
-	// We could just not require the caller to hold the lock at all, but the
-	// semantics of the Mempool interface require the caller to hold it, and we
-	// can't change that without disrupting existing use.
-	txmp.mtx.Unlock()
-	defer txmp.mtx.Lock()
-
-	return txmp.proxyAppConn.FlushSync()
+	_, err := txmp.proxyAppConn.FlushSync()
+	return err
 }
 
 // EnableTxsAvailable enables the mempool to trigger events when transactions
@@ -156,87 +231,158 @@ func (txmp *TxMempool) EnableTxsAvailable() {
 // when transactions are available in the mempool. It is thread-safe.
 func (txmp *TxMempool) TxsAvailable() <-chan struct{} { return txmp.txsAvailable }
 
-// CheckTx adds the given transaction to the mempool if it fits and passes the
-// application's ABCI CheckTx method.
+// CheckTxSync adds the given transaction to the mempool if it fits and passes
+// the application's ABCI CheckTx method. It blocks if we're waiting on Update()
+// or Reap().
 //
-// CheckTx reports an error without adding tx if:
+// CheckTxSync reports an error without adding tx if:
 //
+// - The transaction already exists in the mempool or the cache.
 // - The size of tx exceeds the configured maximum transaction size.
 // - The pre-check hook is defined and reports an error for tx.
-// - The transaction already exists in the cache.
 // - The proxy connection to the application fails.
 //
-// If tx passes all of the above conditions, it is passed (asynchronously) to
-// the application's ABCI CheckTx method and this CheckTx method returns nil.
-// If cb != nil, it is called when the ABCI request completes to report the
-// application response.
+// If tx passes all of the above conditions, it is passed to the application's
+// ABCI CheckTx method and this CheckTxSync method returns nil. If cb != nil,
+// it is called when the ABCI request completes to report the application
+// response.
 //
 // If the application accepts the transaction and the mempool is full, the
 // mempool evicts one or more of the lowest-priority transaction whose priority
 // is (strictly) lower than the priority of tx and whose size together exceeds
 // the size of tx, and adds tx instead. If no such transactions exist, tx is
 // discarded.
-func (txmp *TxMempool) CheckTx(tx types.Tx, cb func(*abci.Response), txInfo mempool.TxInfo) error {
-
-	// During the initial phase of CheckTx, we do not need to modify any state.
-	// A transaction will not actually be added to the mempool until it survives
-	// a call to the ABCI CheckTx method and size constraint checks.
-	height, err := func() (int64, error) {
-		txmp.mtx.RLock()
-		defer txmp.mtx.RUnlock()
-
-		// Reject transactions in excess of the configured maximum transaction size.
-		if len(tx) > txmp.config.MaxTxBytes {
-			return 0, mempool.ErrTxTooLarge{Max: txmp.config.MaxTxBytes, Actual: len(tx)}
-		}
+func (txmp *TxMempool) CheckTxSync(tx types.Tx, cb func(*ocabci.Response), txInfo mempool.TxInfo) error {
+	txmp.updateMtx.RLock()
+	// use defer to unlock mutex because application (*local client*) might panic
+	defer txmp.updateMtx.RUnlock()
 
-		// If a precheck hook is defined, call it before invoking the application.
-		if txmp.preCheck != nil {
-			if err := txmp.preCheck(tx); err != nil {
-				return 0, mempool.ErrPreCheck{Reason: err}
-			}
-		}
+	if err := txmp.prepareCheckTx(tx, txInfo); err != nil {
+		return err
+	}
 
-		// Early exit if the proxy connection has an error.
-		if err := txmp.proxyAppConn.Error(); err != nil {
-			return 0, err
-		}
+	// Invoke an ABCI CheckTx for this transaction.
+	rsp, err := txmp.proxyAppConn.CheckTxSync(abci.RequestCheckTx{Tx: tx})
+	if err != nil {
+		txmp.cache.Remove(tx)
+		return err
+	}
+	txmp.addNewTransaction(txmp.newWrappedTx(tx, txInfo), rsp)
+	if cb != nil {
+		cb(ocabci.ToResponseCheckTx(*rsp))
+	}
+	return nil
+}
 
-		txKey := tx.Key()
+// CheckTxAsync is the asynchronous version of CheckTxSync. The checks done
+// before invoking the application are reported to prepareCb; if they pass,
+// checkTxCb is called with the application response once the transaction has
+// been handled by the mempool. Either callback may be nil.
+//
+// It is safe for concurrent use by multiple goroutines.
+func (txmp *TxMempool) CheckTxAsync(
+	tx types.Tx,
+	txInfo mempool.TxInfo,
+	prepareCb func(error),
+	checkTxCb func(*ocabci.Response),
+) {
+	txmp.chReqCheckTx <- &requestCheckTxAsync{tx: tx, txInfo: txInfo, prepareCb: prepareCb, checkTxCb: checkTxCb}
+}
 
-		// Check for the transaction in the cache.
-		if !txmp.cache.Push(tx) {
-			// If the cached transaction is also in the pool, record its sender.
-			if elt, ok := txmp.txByKey[txKey]; ok {
-				w := elt.Value.(*WrappedTx)
-				w.SetPeer(txInfo.SenderID)
-			}
-			return 0, mempool.ErrTxInCache
+func (txmp *TxMempool) checkTxAsyncReactor() {
+	for req := range txmp.chReqCheckTx {
+		txmp.checkTxAsync(req.tx, req.txInfo, req.prepareCb, req.checkTxCb)
+	}
+}
+
+// It blocks if we're waiting on Update() or Reap().
+func (txmp *TxMempool) checkTxAsync(
+	tx types.Tx,
+	txInfo mempool.TxInfo,
+	prepareCb func(error),
+	checkTxCb func(*ocabci.Response),
+) {
+	txmp.updateMtx.RLock()
+	defer func() {
+		if r := recover(); r != nil {
+			txmp.updateMtx.RUnlock()
+			panic(r)
 		}
-		return txmp.height, nil
 	}()
+
+	err := txmp.prepareCheckTx(tx, txInfo)
+	if prepareCb != nil {
+		prepareCb(err)
+	}
 	if err != nil {
-		return err
+		txmp.updateMtx.RUnlock()
+		return
 	}
 
-	// Invoke an ABCI CheckTx for this transaction.
-	rsp, err := txmp.proxyAppConn.CheckTxSync(abci.RequestCheckTx{Tx: tx})
-	if err != nil {
-		txmp.cache.Remove(tx)
+	wtx := txmp.newWrappedTx(tx, txInfo)
+	txmp.proxyAppConn.CheckTxAsync(abci.RequestCheckTx{Tx: tx}, func(res *ocabci.Response) {
+		if r, ok := res.Value.(*ocabci.Response_CheckTx); ok {
+			txmp.addNewTransaction(wtx, r.CheckTx)
+		}
+		if checkTxCb != nil {
+			checkTxCb(res)
+		}
+		txmp.updateMtx.RUnlock()
+	})
+}
+
+// prepareCheckTx does the checks of tx before it is passed to the
+// application.
+//
+// CONTRACT: `caller` should held `txmp.updateMtx.RLock()`
+func (txmp *TxMempool) prepareCheckTx(tx types.Tx, txInfo mempool.TxInfo) error {
+	txKey := tx.Key()
+
+	// If the transaction is already in the pool, record its sender.
+	txmp.mtx.Lock()
+	elt, ok := txmp.txByKey[txKey]
+	txmp.mtx.Unlock()
+	if ok {
+		elt.Value.(*WrappedTx).SetPeer(txInfo.SenderID)
+		return mempool.ErrTxInMap
+	}
+
+	// Reject transactions in excess of the configured maximum transaction size.
+	if len(tx) > txmp.config.MaxTxBytes {
+		return mempool.ErrTxTooLarge{Max: txmp.config.MaxTxBytes, Actual: len(tx)}
+	}
+
+	// If a precheck hook is defined, call it before invoking the application.
+	if txmp.preCheck != nil {
+		if err := txmp.preCheck(tx); err != nil {
+			return mempool.ErrPreCheck{Reason: err}
+		}
+	}
+
+	// Early exit if the proxy connection has an error.
+	if err := txmp.proxyAppConn.Error(); err != nil {
 		return err
 	}
+
+	// Check for the transaction in the cache.
+	if !txmp.cache.Push(tx) {
+		return mempool.ErrTxInCache
+	}
+	return nil
+}
+
+// newWrappedTx wraps tx received from the given peer at the current height.
+//
+// CONTRACT: `caller` should held `txmp.updateMtx.RLock()`
+func (txmp *TxMempool) newWrappedTx(tx types.Tx, txInfo mempool.TxInfo) *WrappedTx {
 	wtx := &WrappedTx{
 		tx:        tx,
 		hash:      tx.Key(),
 		timestamp: time.Now().UTC(),
-		height:    height,
+		height:    txmp.height,
 	}
 	wtx.SetPeer(txInfo.SenderID)
-	txmp.addNewTransaction(wtx, rsp)
-	if cb != nil {
-		cb(&abci.Response{Value: &abci.Response_CheckTx{CheckTx: rsp}})
-	}
-	return nil
+	return wtx
 }
 
 // RemoveTxByKey removes the transaction with the specified key from the
@@ -252,13 +398,7 @@ func (txmp *TxMempool) RemoveTxByKey(txKey types.TxKey) error {
 // The caller must hold txmp.mtx excluxively.
 func (txmp *TxMempool) removeTxByKey(key types.TxKey) error {
 	if elt, ok := txmp.txByKey[key]; ok {
-		w := elt.Value.(*WrappedTx)
-		delete(txmp.txByKey, key)
-		delete(txmp.txBySender, w.sender)
-		txmp.txs.Remove(elt)
-		elt.DetachPrev()
-		elt.DetachNext()
-		atomic.AddInt64(&txmp.txsBytes, -w.Size())
+		txmp.removeTxByElement(elt)
 		return nil
 	}
 	return fmt.Errorf("transaction %x not found", key)
@@ -269,16 +409,32 @@ func (txmp *TxMempool) removeTxByKey(key types.TxKey) error {
 func (txmp *TxMempool) removeTxByElement(elt *clist.CElement) {
 	w := elt.Value.(*WrappedTx)
 	delete(txmp.txByKey, w.tx.Key())
-	delete(txmp.txBySender, w.sender)
+	if s := w.Sender(); s != "" {
+		delete(txmp.txBySender, s)
+	}
 	txmp.txs.Remove(elt)
 	elt.DetachPrev()
 	elt.DetachNext()
 	atomic.AddInt64(&txmp.txsBytes, -w.Size())
+	txmp.removeTxFromWAL(w.tx)
+}
+
+// removeTxFromWAL records the removal of the transaction, if the WAL is
+// enabled. The caller must hold txmp.mtx exclusively.
+func (txmp *TxMempool) removeTxFromWAL(tx types.Tx) {
+	if txmp.wal == nil {
+		return
+	}
+	if err := txmp.wal.WriteRemoveTx(tx.Key()); err != nil {
+		txmp.logger.Error("Error writing to mempool WAL", "err", err)
+	}
 }
 
 // Flush purges the contents of the mempool and the cache, leaving both empty.
 // The current height is not modified by this operation.
 func (txmp *TxMempool) Flush() {
+	txmp.updateMtx.Lock()
+	defer txmp.updateMtx.Unlock()
 	txmp.mtx.Lock()
 	defer txmp.mtx.Unlock()
 
@@ -291,14 +447,20 @@ func (txmp *TxMempool) Flush() {
 		cur = next
 	}
 	txmp.cache.Reset()
+
+	if txmp.wal != nil {
+		if err := txmp.wal.Checkpoint(nil); err != nil {
+			txmp.logger.Error("Error compacting mempool WAL", "err", err)
+		}
+	}
 }
 
 // allEntriesSorted returns a slice of all the transactions currently in the
 // mempool, sorted in nonincreasing order by priority with ties broken by
 // increasing order of arrival time.
 func (txmp *TxMempool) allEntriesSorted() []*WrappedTx {
-	txmp.mtx.RLock()
-	defer txmp.mtx.RUnlock()
+	txmp.mtx.Lock()
+	defer txmp.mtx.Unlock()
 
 	all := make([]*WrappedTx, 0, len(txmp.txByKey))
 	for _, tx := range txmp.txByKey {
@@ -324,13 +486,34 @@ func (txmp *TxMempool) allEntriesSorted() []*WrappedTx {
 // If the mempool is empty or has no transactions fitting within the given
 // constraints, the result will also be empty.
 func (txmp *TxMempool) ReapMaxBytesMaxGas(maxBytes, maxGas int64) types.Txs {
+	txmp.updateMtx.RLock()
+	defer txmp.updateMtx.RUnlock()
+
+	return txmp.reapMaxBytesMaxGasMaxTxs(maxBytes, maxGas, -1)
+}
+
+// ReapMaxBytesMaxGasMaxTxs is the same as ReapMaxBytesMaxGas, but also returns
+// at most maxTxs transactions. If maxTxs <= 0, no limit is set on the number
+// of transactions.
+func (txmp *TxMempool) ReapMaxBytesMaxGasMaxTxs(maxBytes, maxGas, maxTxs int64) types.Txs {
+	txmp.updateMtx.RLock()
+	defer txmp.updateMtx.RUnlock()
+
+	return txmp.reapMaxBytesMaxGasMaxTxs(maxBytes, maxGas, maxTxs)
+}
+
+func (txmp *TxMempool) reapMaxBytesMaxGasMaxTxs(maxBytes, maxGas, maxTxs int64) types.Txs {
 	var totalGas, totalBytes int64
 
 	var keep []types.Tx //nolint:prealloc
 	for _, w := range txmp.allEntriesSorted() {
+		if maxTxs > 0 && int64(len(keep)) >= maxTxs {
+			break
+		}
+
 		// N.B. When computing byte size, we need to include the overhead for
 		// encoding as protobuf to send to the application.
-		totalGas += w.gasWanted
+		totalGas += w.GasWanted()
 		totalBytes += types.ComputeProtoSizeForTxs([]types.Tx{w.tx})
 		if (maxGas >= 0 && totalGas > maxGas) || (maxBytes >= 0 && totalBytes > maxBytes) {
 			break
@@ -357,6 +540,9 @@ func (txmp *TxMempool) TxsFront() *clist.CElement { return txmp.txs.Front() }
 // The result may have fewer than max elements (possibly zero) if the mempool
 // does not have that many transactions available.
 func (txmp *TxMempool) ReapMaxTxs(max int) types.Txs {
+	txmp.updateMtx.RLock()
+	defer txmp.updateMtx.RUnlock()
+
 	var keep []types.Tx //nolint:prealloc
 
 	for _, w := range txmp.allEntriesSorted() {
@@ -368,32 +554,31 @@ func (txmp *TxMempool) ReapMaxTxs(max int) types.Txs {
 	return keep
 }
 
-// Update removes all the given transactions from the mempool and the cache,
-// and updates the current block height. The blockTxs and deliverTxResponses
-// must have the same length with each response corresponding to the tx at the
-// same offset.
+// Update removes all the transactions of the given block from the mempool and
+// the cache, and updates the current block height. The block txs and
+// deliverTxResponses must have the same length with each response
+// corresponding to the tx at the same offset.
 //
 // If the configuration enables recheck, Update sends each remaining
-// transaction after removing blockTxs to the ABCI CheckTx method.  Any
-// transactions marked as invalid during recheck are also removed.
+// transaction after removing the block txs to the ABCI CheckTx method, in a
+// batch surrounded by BeginRecheckTx and EndRecheckTx. Any transactions marked
+// as invalid during recheck are also removed.
 //
 // The caller must hold an exclusive mempool lock (by calling txmp.Lock) before
 // calling Update.
 func (txmp *TxMempool) Update(
-	blockHeight int64,
-	blockTxs types.Txs,
+	block *types.Block,
 	deliverTxResponses []*abci.ResponseDeliverTx,
 	newPreFn mempool.PreCheckFunc,
 	newPostFn mempool.PostCheckFunc,
-) error {
+) (err error) {
 	// Safety check: Transactions and responses must match in number.
-	if len(blockTxs) != len(deliverTxResponses) {
+	if len(block.Txs) != len(deliverTxResponses) {
 		panic(fmt.Sprintf("mempool: got %d transactions but %d DeliverTx responses",
-			len(blockTxs), len(deliverTxResponses)))
+			len(block.Txs), len(deliverTxResponses)))
 	}
 
-	txmp.height = blockHeight
-	txmp.notifiedTxsAvailable = false
+	txmp.height = block.Height
 
 	if newPreFn != nil {
 		txmp.preCheck = newPreFn
@@ -402,11 +587,13 @@ func (txmp *TxMempool) Update(
 		txmp.postCheck = newPostFn
 	}
 
-	for i, tx := range blockTxs {
+	txmp.mtx.Lock()
+	txmp.notifiedTxsAvailable = false
+	for i, tx := range block.Txs {
 		// Add successful committed transactions to the cache (if they are not
 		// already present).  Transactions that failed to commit are removed from
 		// the cache unless the operator has explicitly requested we keep them.
-		if deliverTxResponses[i].Code == abci.CodeTypeOK {
+		if deliverTxResponses[i].Code == ocabci.CodeTypeOK {
 			_ = txmp.cache.Push(tx)
 		} else if !txmp.config.KeepInvalidTxsInCache {
 			txmp.cache.Remove(tx)
@@ -416,21 +603,62 @@ func (txmp *TxMempool) Update(
 		_ = txmp.removeTxByKey(tx.Key())
 	}
 
-	txmp.purgeExpiredTxs(blockHeight)
+	txmp.purgeExpiredTxs(block.Height)
+	txmp.mtx.Unlock()
+
+	if txmp.config.Recheck {
+		// recheck non-committed txs to see if they became invalid
+		recheckStartTime := time.Now().UnixNano()
 
-	// If there any uncommitted transactions left in the mempool, we either
-	// initiate re-CheckTx per remaining transaction or notify that remaining
-	// transactions are left.
-	size := txmp.Size()
-	txmp.metrics.Size.Set(float64(size))
-	if size > 0 {
-		if txmp.config.Recheck {
-			txmp.recheckTransactions()
-		} else {
-			txmp.notifyTxsAvailable()
+		_, err = txmp.proxyAppConn.BeginRecheckTxSync(ocabci.RequestBeginRecheckTx{
+			Header: types.OC2PB.Header(&block.Header),
+		})
+		if err != nil {
+			txmp.logger.Error("error in proxyAppConn.BeginRecheckTxSync", "err", err)
+		}
+		txmp.recheckTransactions()
+		_, err = txmp.proxyAppConn.EndRecheckTxSync(ocabci.RequestEndRecheckTx{Height: block.Height})
+		if err != nil {
+			txmp.logger.Error("error in proxyAppConn.EndRecheckTxSync", "err", err)
 		}
+
+		recheckEndTime := time.Now().UnixNano()
+
+		recheckTimeMs := float64(recheckEndTime-recheckStartTime) / 1000000
+		txmp.metrics.RecheckTime.Set(recheckTimeMs)
+	}
+
+	txmp.mtx.Lock()
+	// notify there're some txs left.
+	txmp.notifyTxsAvailable()
+	if txmp.wal != nil {
+		txmp.syncWAL()
+	}
+	txmp.mtx.Unlock()
+
+	txmp.metrics.Size.Set(float64(txmp.Size()))
+	return err
+}
+
+// syncWAL persists the removals of transactions in the WAL, compacting it
+// when its head file reached the size limit.
+//
+// The caller must hold txmp.mtx exclusively.
+func (txmp *TxMempool) syncWAL() {
+	if !txmp.wal.HeadSizeLimitReached() {
+		if err := txmp.wal.Sync(); err != nil {
+			txmp.logger.Error("Error syncing mempool WAL", "err", err)
+		}
+		return
+	}
+
+	txs := make(types.Txs, 0, txmp.Size())
+	for e := txmp.txs.Front(); e != nil; e = e.Next() {
+		txs = append(txs, e.Value.(*WrappedTx).tx)
+	}
+	if err := txmp.wal.Checkpoint(txs); err != nil {
+		txmp.logger.Error("Error compacting mempool WAL", "err", err)
 	}
-	return nil
 }
 
 // addNewTransaction handles the ABCI CheckTx response for the first time a
@@ -440,13 +668,20 @@ func (txmp *TxMempool) Update(
 // If either the application rejected the transaction or a post-check hook is
 // defined and rejects the transaction, it is discarded.
 //
+// If the application assigned a sender to the transaction and the mempool
+// already has a transaction of that sender, the existing one is evicted when
+// the new transaction has a (strictly) higher priority. Otherwise, the new
+// transaction is discarded.
+//
 // Otherwise, if the mempool is full, check for lower-priority transactions
 // that can be evicted to make room for the new one. If no such transactions
 // exist, this transaction is logged and dropped; otherwise the selected
 // transactions are evicted.
 //
 // Finally, the new transaction is added and size stats updated.
-func (txmp *TxMempool) addNewTransaction(wtx *WrappedTx, checkTxRes *abci.ResponseCheckTx) {
+//
+// CONTRACT: `caller` should held `txmp.updateMtx.RLock()`
+func (txmp *TxMempool) addNewTransaction(wtx *WrappedTx, checkTxRes *ocabci.ResponseCheckTx) {
 	txmp.mtx.Lock()
 	defer txmp.mtx.Unlock()
 
@@ -455,7 +690,7 @@ func (txmp *TxMempool) addNewTransaction(wtx *WrappedTx, checkTxRes *abci.Respon
 		err = txmp.postCheck(wtx.tx, checkTxRes)
 	}
 
-	if err != nil || checkTxRes.Code != abci.CodeTypeOK {
+	if err != nil || checkTxRes.Code != ocabci.CodeTypeOK {
 		txmp.logger.Info(
 			"rejected bad transaction",
 			"priority", wtx.Priority(),
@@ -481,26 +716,47 @@ func (txmp *TxMempool) addNewTransaction(wtx *WrappedTx, checkTxRes *abci.Respon
 		return
 	}
 
+	// The same transaction may have been checked concurrently if the cache is
+	// disabled.
+	if _, ok := txmp.txByKey[wtx.tx.Key()]; ok {
+		return
+	}
+
 	priority := checkTxRes.Priority
 	sender := checkTxRes.Sender
 
 	// Disallow multiple concurrent transactions from the same sender assigned
-	// by the ABCI application. As a special case, an empty sender is not
-	// restricted.
+	// by the ABCI application, unless the new one has a higher priority. As a
+	// special case, an empty sender is not restricted.
 	if sender != "" {
 		elt, ok := txmp.txBySender[sender]
 		if ok {
 			w := elt.Value.(*WrappedTx)
+			if w.Priority() >= priority {
+				txmp.cache.Remove(wtx.tx)
+				txmp.logger.Debug(
+					"rejected valid incoming transaction; tx already exists for sender",
+					"tx", fmt.Sprintf("%X", w.tx.Hash()),
+					"sender", sender,
+				)
+				checkTxRes.MempoolError =
+					fmt.Sprintf("rejected valid incoming transaction; tx already exists for sender %q (%X)",
+						sender, w.tx.Hash())
+				txmp.metrics.RejectedTxs.Add(1)
+				return
+			}
+
 			txmp.logger.Debug(
-				"rejected valid incoming transaction; tx already exists for sender",
-				"tx", fmt.Sprintf("%X", w.tx.Hash()),
+				"evicted valid existing transaction; higher-priority tx of the same sender",
+				"old_tx", fmt.Sprintf("%X", w.tx.Hash()),
+				"old_priority", w.Priority(),
+				"new_tx", fmt.Sprintf("%X", wtx.tx.Hash()),
+				"new_priority", priority,
 				"sender", sender,
 			)
-			checkTxRes.MempoolError =
-				fmt.Sprintf("rejected valid incoming transaction; tx already exists for sender %q (%X)",
-					sender, w.tx.Hash())
-			txmp.metrics.RejectedTxs.Add(1)
-			return
+			txmp.removeTxByElement(elt)
+			txmp.cache.Remove(w.tx)
+			txmp.metrics.EvictedTxs.Add(1)
 		}
 	}
 
@@ -515,7 +771,7 @@ func (txmp *TxMempool) addNewTransaction(wtx *WrappedTx, checkTxRes *abci.Respon
 		var victimBytes int64         // total size of victims
 		for cur := txmp.txs.Front(); cur != nil; cur = cur.Next() {
 			cw := cur.Value.(*WrappedTx)
-			if cw.priority < priority {
+			if cw.Priority() < priority {
 				victims = append(victims, cur)
 				victimBytes += cw.Size()
 			}
@@ -562,7 +818,7 @@ func (txmp *TxMempool) addNewTransaction(wtx *WrappedTx, checkTxRes *abci.Respon
 			txmp.logger.Debug(
 				"evicted valid existing transaction; mempool full",
 				"old_tx", fmt.Sprintf("%X", w.tx.Hash()),
-				"old_priority", w.priority,
+				"old_priority", w.Priority(),
 			)
 			txmp.removeTxByElement(vic)
 			txmp.cache.Remove(w.tx)
@@ -594,6 +850,8 @@ func (txmp *TxMempool) addNewTransaction(wtx *WrappedTx, checkTxRes *abci.Respon
 	txmp.notifyTxsAvailable()
 }
 
+// insertTx inserts wtx into the mempool.
+// The caller must hold txmp.mtx exclusively.
 func (txmp *TxMempool) insertTx(wtx *WrappedTx) {
 	elt := txmp.txs.PushBack(wtx)
 	txmp.txByKey[wtx.tx.Key()] = elt
@@ -602,6 +860,12 @@ func (txmp *TxMempool) insertTx(wtx *WrappedTx) {
 	}
 
 	atomic.AddInt64(&txmp.txsBytes, wtx.Size())
+
+	if txmp.wal != nil {
+		if err := txmp.wal.WriteTx(wtx.tx); err != nil {
+			txmp.logger.Error("Error writing to mempool WAL", "err", err)
+		}
+	}
 }
 
 // handleRecheckResult handles the responses from ABCI CheckTx calls issued
@@ -610,7 +874,7 @@ func (txmp *TxMempool) insertTx(wtx *WrappedTx) {
 //
 // This method is NOT executed for the initial CheckTx on a new transaction;
 // that case is handled by addNewTransaction instead.
-func (txmp *TxMempool) handleRecheckResult(tx types.Tx, checkTxRes *abci.ResponseCheckTx) {
+func (txmp *TxMempool) handleRecheckResult(tx types.Tx, checkTxRes *ocabci.ResponseCheckTx) {
 	txmp.metrics.RecheckTimes.Add(1)
 	txmp.mtx.Lock()
 	defer txmp.mtx.Unlock()
@@ -630,7 +894,7 @@ func (txmp *TxMempool) handleRecheckResult(tx types.Tx, checkTxRes *abci.Respons
 		err = txmp.postCheck(tx, checkTxRes)
 	}
 
-	if checkTxRes.Code == abci.CodeTypeOK && err == nil {
+	if checkTxRes.Code == ocabci.CodeTypeOK && err == nil {
 		wtx.SetPriority(checkTxRes.Priority)
 		return // N.B. Size of mempool did not change
 	}
@@ -650,58 +914,52 @@ func (txmp *TxMempool) handleRecheckResult(tx types.Tx, checkTxRes *abci.Respons
 	txmp.metrics.Size.Set(float64(txmp.Size()))
 }
 
-// recheckTransactions initiates re-CheckTx ABCI calls for all the transactions
-// currently in the mempool. It reports the number of recheck calls that were
-// successfully initiated.
+// recheckTransactions re-checks all the transactions currently in the mempool
+// in a single batch: the re-CheckTx requests are sent asynchronously, flushed
+// at once, and recheckTransactions returns when all of the responses have been
+// handled by handleRecheckResult.
 //
-// Precondition: The mempool is not empty.
-// The caller must hold txmp.mtx exclusively.
+// The caller must hold an exclusive mempool lock (by calling txmp.Lock).
 func (txmp *TxMempool) recheckTransactions() {
-	if txmp.Size() == 0 {
-		panic("mempool: cannot run recheck on an empty mempool")
+	// Collect transactions currently in the mempool requiring recheck.
+	txmp.mtx.Lock()
+	wtxs := make([]*WrappedTx, 0, txmp.txs.Len())
+	for e := txmp.txs.Front(); e != nil; e = e.Next() {
+		wtxs = append(wtxs, e.Value.(*WrappedTx))
+	}
+	txmp.mtx.Unlock()
+
+	if len(wtxs) == 0 {
+		return
 	}
 	txmp.logger.Debug(
 		"executing re-CheckTx for all remaining transactions",
-		"num_txs", txmp.Size(),
+		"num_txs", len(wtxs),
 		"height", txmp.height,
 	)
 
-	// Collect transactions currently in the mempool requiring recheck.
-	wtxs := make([]*WrappedTx, 0, txmp.txs.Len())
-	for e := txmp.txs.Front(); e != nil; e = e.Next() {
-		wtxs = append(wtxs, e.Value.(*WrappedTx))
-	}
+	wg := sync.WaitGroup{}
 
-	// Issue CheckTx calls for each remaining transaction, and when all the
-	// rechecks are complete signal watchers that transactions may be available.
-	go func() {
-		g, start := taskgroup.New(nil).Limit(2 * runtime.NumCPU())
+	// Push txs to proxyAppConn
+	// NOTE: the callbacks may be called concurrently.
+	for _, wtx := range wtxs {
+		wtx := wtx
+		wg.Add(1)
 
-		for _, wtx := range wtxs {
-			wtx := wtx
-			start(func() error {
-				// The response for this CheckTx is handled by the default recheckTxCallback.
-				rsp, err := txmp.proxyAppConn.CheckTxSync(abci.RequestCheckTx{
-					Tx:   wtx.tx,
-					Type: abci.CheckTxType_Recheck,
-				})
-				if err != nil {
-					txmp.logger.Error("failed to execute CheckTx during recheck",
-						"err", err, "hash", fmt.Sprintf("%x", wtx.tx.Hash()))
-				} else {
-					txmp.handleRecheckResult(wtx.tx, rsp)
-				}
-				return nil
-			})
+		req := abci.RequestCheckTx{
+			Tx:   wtx.tx,
+			Type: abci.CheckTxType_Recheck,
 		}
-		_ = txmp.proxyAppConn.FlushAsync()
+		txmp.proxyAppConn.CheckTxAsync(req, func(res *ocabci.Response) {
+			if r, ok := res.Value.(*ocabci.Response_CheckTx); ok {
+				txmp.handleRecheckResult(wtx.tx, r.CheckTx)
+			}
+			wg.Done()
+		})
+	}
 
-		// When recheck is complete, trigger a notification for more transactions.
-		_ = g.Wait()
-		txmp.mtx.Lock()
-		defer txmp.mtx.Unlock()
-		txmp.notifyTxsAvailable()
-	}()
+	txmp.proxyAppConn.FlushAsync(func(res *ocabci.Response) {})
+	wg.Wait()
 }
 
 // canAddTx returns an error if we cannot insert the provided *WrappedTx into
@@ -754,6 +1012,9 @@ func (txmp *TxMempool) purgeExpiredTxs(blockHeight int64) {
 	}
 }
 
+// notifyTxsAvailable fires the TxsAvailable channel once per height, if the
+// mempool is not empty.
+// The caller must hold txmp.mtx exclusively.
 func (txmp *TxMempool) notifyTxsAvailable() {
 	if txmp.Size() == 0 {
 		return // nothing to do
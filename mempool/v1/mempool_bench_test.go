@@ -1,5 +1,3 @@
-//go:build deprecated
-
 package v1
 
 import (
@@ -10,7 +8,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 
-	"github.com/tendermint/tendermint/mempool"
+	"github.com/Finschia/ostracon/mempool"
 )
 
 func BenchmarkTxMempool_CheckTx(b *testing.B) {
@@ -29,6 +27,6 @@ func BenchmarkTxMempool_CheckTx(b *testing.B) {
 		tx := []byte(fmt.Sprintf("%X=%d", prefix, priority))
 		b.StartTimer()
 
-		require.NoError(b, txmp.CheckTx(tx, nil, mempool.TxInfo{}))
+		require.NoError(b, txmp.CheckTxSync(tx, nil, mempool.TxInfo{}))
 	}
 }
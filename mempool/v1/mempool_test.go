@@ -1,5 +1,3 @@
-//go:build deprecated
-
 package v1
 
 import (
@@ -17,14 +15,16 @@ import (
 
 	"github.com/stretchr/testify/require"
 
-	"github.com/tendermint/tendermint/abci/example/code"
-	"github.com/tendermint/tendermint/abci/example/kvstore"
 	abci "github.com/tendermint/tendermint/abci/types"
-	"github.com/tendermint/tendermint/config"
-	"github.com/tendermint/tendermint/libs/log"
-	"github.com/tendermint/tendermint/mempool"
-	"github.com/tendermint/tendermint/proxy"
-	"github.com/tendermint/tendermint/types"
+
+	"github.com/Finschia/ostracon/abci/example/code"
+	"github.com/Finschia/ostracon/abci/example/kvstore"
+	ocabci "github.com/Finschia/ostracon/abci/types"
+	"github.com/Finschia/ostracon/config"
+	"github.com/Finschia/ostracon/libs/log"
+	"github.com/Finschia/ostracon/mempool"
+	"github.com/Finschia/ostracon/proxy"
+	"github.com/Finschia/ostracon/types"
 )
 
 // application extends the KV store application by overriding CheckTx to provide
@@ -38,7 +38,15 @@ type testTx struct {
 	priority int64
 }
 
-func (app *application) CheckTx(req abci.RequestCheckTx) abci.ResponseCheckTx {
+func (app *application) CheckTxSync(req abci.RequestCheckTx) ocabci.ResponseCheckTx {
+	return app.checkTx(req)
+}
+
+func (app *application) CheckTxAsync(req abci.RequestCheckTx, callback ocabci.CheckTxCallback) {
+	callback(app.checkTx(req))
+}
+
+func (app *application) checkTx(req abci.RequestCheckTx) ocabci.ResponseCheckTx {
 	var (
 		priority int64
 		sender   string
@@ -49,7 +57,7 @@ func (app *application) CheckTx(req abci.RequestCheckTx) abci.ResponseCheckTx {
 	if len(parts) == 3 {
 		v, err := strconv.ParseInt(string(parts[2]), 10, 64)
 		if err != nil {
-			return abci.ResponseCheckTx{
+			return ocabci.ResponseCheckTx{
 				Priority:  priority,
 				Code:      100,
 				GasWanted: 1,
@@ -59,14 +67,14 @@ func (app *application) CheckTx(req abci.RequestCheckTx) abci.ResponseCheckTx {
 		priority = v
 		sender = string(parts[0])
 	} else {
-		return abci.ResponseCheckTx{
+		return ocabci.ResponseCheckTx{
 			Priority:  priority,
 			Code:      101,
 			GasWanted: 1,
 		}
 	}
 
-	return abci.ResponseCheckTx{
+	return ocabci.ResponseCheckTx{
 		Priority:  priority,
 		Sender:    sender,
 		Code:      code.CodeTypeOK,
@@ -95,11 +103,11 @@ func setup(t testing.TB, cacheSize int, options ...TxMempoolOption) *TxMempool {
 	return NewTxMempool(log.TestingLogger().With("test", t.Name()), cfg.Mempool, appConnMem, 0, options...)
 }
 
-// mustCheckTx invokes txmp.CheckTx for the given transaction and waits until
-// its callback has finished executing. It fails t if CheckTx fails.
+// mustCheckTx invokes txmp.CheckTxSync for the given transaction and waits
+// until its callback has finished executing. It fails t if CheckTx fails.
 func mustCheckTx(t *testing.T, txmp *TxMempool, spec string) {
 	done := make(chan struct{})
-	if err := txmp.CheckTx([]byte(spec), func(*abci.Response) {
+	if err := txmp.CheckTxSync([]byte(spec), func(*ocabci.Response) {
 		close(done)
 	}, mempool.TxInfo{}); err != nil {
 		t.Fatalf("CheckTx for %q failed: %v", spec, err)
@@ -107,6 +115,17 @@ func mustCheckTx(t *testing.T, txmp *TxMempool, spec string) {
 	<-done
 }
 
+func newTestBlock(height int64, txs types.Txs) *types.Block {
+	return &types.Block{
+		Header: types.Header{
+			Height: height,
+		},
+		Data: types.Data{
+			Txs: txs,
+		},
+	}
+}
+
 func checkTxs(t *testing.T, txmp *TxMempool, numTxs int, peerID uint16) []testTx {
 	txs := make([]testTx, numTxs)
 	txInfo := mempool.TxInfo{SenderID: peerID}
@@ -124,7 +143,7 @@ func checkTxs(t *testing.T, txmp *TxMempool, numTxs int, peerID uint16) []testTx
 			tx:       []byte(fmt.Sprintf("sender-%d-%d=%X=%d", i, peerID, prefix, priority)),
 			priority: priority,
 		}
-		require.NoError(t, txmp.CheckTx(txs[i].tx, nil, txInfo))
+		require.NoError(t, txmp.CheckTxSync(txs[i].tx, nil, txInfo))
 	}
 
 	return txs
@@ -173,7 +192,7 @@ func TestTxMempool_TxsAvailable(t *testing.T) {
 
 	// commit half the transactions and ensure we fire an event
 	txmp.Lock()
-	require.NoError(t, txmp.Update(1, rawTxs[:50], responses, nil, nil))
+	require.NoError(t, txmp.Update(newTestBlock(1, rawTxs[:50]), responses, nil, nil))
 	txmp.Unlock()
 	ensureTxFire()
 	ensureNoTxFire()
@@ -201,7 +220,7 @@ func TestTxMempool_Size(t *testing.T) {
 	}
 
 	txmp.Lock()
-	require.NoError(t, txmp.Update(1, rawTxs[:50], responses, nil, nil))
+	require.NoError(t, txmp.Update(newTestBlock(1, rawTxs[:50]), responses, nil, nil))
 	txmp.Unlock()
 
 	require.Equal(t, len(rawTxs)/2, txmp.Size())
@@ -213,8 +232,8 @@ func TestTxMempool_Eviction(t *testing.T) {
 	txmp.config.Size = 5
 	txmp.config.MaxTxsBytes = 60
 	txExists := func(spec string) bool {
-		txmp.Lock()
-		defer txmp.Unlock()
+		txmp.mtx.Lock()
+		defer txmp.mtx.Unlock()
 		key := types.Tx(spec).Key()
 		_, ok := txmp.txByKey[key]
 		return ok
@@ -296,7 +315,7 @@ func TestTxMempool_Flush(t *testing.T) {
 	}
 
 	txmp.Lock()
-	require.NoError(t, txmp.Update(1, rawTxs[:50], responses, nil, nil))
+	require.NoError(t, txmp.Update(newTestBlock(1, rawTxs[:50]), responses, nil, nil))
 	txmp.Unlock()
 
 	txmp.Flush()
@@ -411,13 +430,13 @@ func TestTxMempool_CheckTxExceedsMaxSize(t *testing.T) {
 	_, err := rng.Read(tx)
 	require.NoError(t, err)
 
-	require.Error(t, txmp.CheckTx(tx, nil, mempool.TxInfo{SenderID: 0}))
+	require.Error(t, txmp.CheckTxSync(tx, nil, mempool.TxInfo{SenderID: 0}))
 
 	tx = make([]byte, txmp.config.MaxTxBytes-1)
 	_, err = rng.Read(tx)
 	require.NoError(t, err)
 
-	require.NoError(t, txmp.CheckTx(tx, nil, mempool.TxInfo{SenderID: 0}))
+	require.NoError(t, txmp.CheckTxSync(tx, nil, mempool.TxInfo{SenderID: 0}))
 }
 
 func TestTxMempool_CheckTxSamePeer(t *testing.T) {
@@ -431,8 +450,8 @@ func TestTxMempool_CheckTxSamePeer(t *testing.T) {
 
 	tx := []byte(fmt.Sprintf("sender-0=%X=%d", prefix, 50))
 
-	require.NoError(t, txmp.CheckTx(tx, nil, mempool.TxInfo{SenderID: peerID}))
-	require.Error(t, txmp.CheckTx(tx, nil, mempool.TxInfo{SenderID: peerID}))
+	require.NoError(t, txmp.CheckTxSync(tx, nil, mempool.TxInfo{SenderID: peerID}))
+	require.Error(t, txmp.CheckTxSync(tx, nil, mempool.TxInfo{SenderID: peerID}))
 }
 
 func TestTxMempool_CheckTxSameSender(t *testing.T) {
@@ -451,9 +470,9 @@ func TestTxMempool_CheckTxSameSender(t *testing.T) {
 	tx1 := []byte(fmt.Sprintf("sender-0=%X=%d", prefix1, 50))
 	tx2 := []byte(fmt.Sprintf("sender-0=%X=%d", prefix2, 50))
 
-	require.NoError(t, txmp.CheckTx(tx1, nil, mempool.TxInfo{SenderID: peerID}))
+	require.NoError(t, txmp.CheckTxSync(tx1, nil, mempool.TxInfo{SenderID: peerID}))
 	require.Equal(t, 1, txmp.Size())
-	require.NoError(t, txmp.CheckTx(tx2, nil, mempool.TxInfo{SenderID: peerID}))
+	require.NoError(t, txmp.CheckTxSync(tx2, nil, mempool.TxInfo{SenderID: peerID}))
 	require.Equal(t, 1, txmp.Size())
 }
 
@@ -501,7 +520,7 @@ func TestTxMempool_ConcurrentTxs(t *testing.T) {
 				}
 
 				txmp.Lock()
-				require.NoError(t, txmp.Update(height, reapedTxs, responses, nil, nil))
+				require.NoError(t, txmp.Update(newTestBlock(height, reapedTxs), responses, nil, nil))
 				txmp.Unlock()
 
 				height++
@@ -523,7 +542,7 @@ func TestTxMempool_ConcurrentTxs(t *testing.T) {
 
 func TestTxMempool_ExpiredTxs_Timestamp(t *testing.T) {
 	txmp := setup(t, 5000)
-	txmp.config.TTLDuration = 5 * time.Millisecond
+	txmp.config.TTLDuration = 100 * time.Millisecond
 
 	added1 := checkTxs(t, txmp, 10, 0)
 	require.Equal(t, len(added1), txmp.Size())
@@ -531,25 +550,25 @@ func TestTxMempool_ExpiredTxs_Timestamp(t *testing.T) {
 	// Wait a while, then add some more transactions that should not be expired
 	// when the first batch TTLs out.
 	//
-	// ms: 0   1   2   3   4   5   6
-	//     ^           ^       ^   ^
-	//     |           |       |   +-- Update (triggers pruning)
-	//     |           |       +------ first batch expires
-	//     |           +-------------- second batch added
-	//     +-------------------------- first batch added
+	// 20ms: 0   1   2   3   4   5   6
+	//       ^           ^       ^   ^
+	//       |           |       |   +-- Update (triggers pruning)
+	//       |           |       +------ first batch expires
+	//       |           +-------------- second batch added
+	//       +-------------------------- first batch added
 	//
 	// The exact intervals are not important except that the delta should be
 	// large relative to the cost of CheckTx (ms vs. ns is fine here).
-	time.Sleep(3 * time.Millisecond)
+	time.Sleep(60 * time.Millisecond)
 	added2 := checkTxs(t, txmp, 10, 1)
 
 	// Wait a while longer, so that the first batch will expire.
-	time.Sleep(3 * time.Millisecond)
+	time.Sleep(60 * time.Millisecond)
 
 	// Trigger an update so that pruning will occur.
 	txmp.Lock()
 	defer txmp.Unlock()
-	require.NoError(t, txmp.Update(txmp.height+1, nil, nil, nil, nil))
+	require.NoError(t, txmp.Update(newTestBlock(txmp.height+1, nil), nil, nil, nil))
 
 	// All the transactions in the original set should have been purged.
 	for _, tx := range added1 {
@@ -585,7 +604,7 @@ func TestTxMempool_ExpiredTxs_NumBlocks(t *testing.T) {
 	}
 
 	txmp.Lock()
-	require.NoError(t, txmp.Update(txmp.height+1, reapedTxs, responses, nil, nil))
+	require.NoError(t, txmp.Update(newTestBlock(txmp.height+1, reapedTxs), responses, nil, nil))
 	txmp.Unlock()
 
 	require.Equal(t, 95, txmp.Size())
@@ -609,7 +628,7 @@ func TestTxMempool_ExpiredTxs_NumBlocks(t *testing.T) {
 	}
 
 	txmp.Lock()
-	require.NoError(t, txmp.Update(txmp.height+10, reapedTxs, responses, nil, nil))
+	require.NoError(t, txmp.Update(newTestBlock(txmp.height+10, reapedTxs), responses, nil, nil))
 	txmp.Unlock()
 
 	require.GreaterOrEqual(t, txmp.Size(), 45)
@@ -632,7 +651,7 @@ func TestTxMempool_CheckTxPostCheckError(t *testing.T) {
 	for _, tc := range cases {
 		testCase := tc
 		t.Run(testCase.name, func(t *testing.T) {
-			postCheckFn := func(_ types.Tx, _ *abci.ResponseCheckTx) error {
+			postCheckFn := func(_ types.Tx, _ *ocabci.ResponseCheckTx) error {
 				return testCase.err
 			}
 			txmp := setup(t, 0, WithPostCheck(postCheckFn))
@@ -641,8 +660,8 @@ func TestTxMempool_CheckTxPostCheckError(t *testing.T) {
 			_, err := rng.Read(tx)
 			require.NoError(t, err)
 
-			callback := func(res *abci.Response) {
-				checkTxRes, ok := res.Value.(*abci.Response_CheckTx)
+			callback := func(res *ocabci.Response) {
+				checkTxRes, ok := res.Value.(*ocabci.Response_CheckTx)
 				require.True(t, ok)
 				expectedErrString := ""
 				if testCase.err != nil {
@@ -650,7 +669,133 @@ func TestTxMempool_CheckTxPostCheckError(t *testing.T) {
 				}
 				require.Equal(t, expectedErrString, checkTxRes.CheckTx.MempoolError)
 			}
-			require.NoError(t, txmp.CheckTx(tx, callback, mempool.TxInfo{SenderID: 0}))
+			require.NoError(t, txmp.CheckTxSync(tx, callback, mempool.TxInfo{SenderID: 0}))
+		})
+	}
+}
+
+func TestTxMempool_CheckTxAsync(t *testing.T) {
+	txmp := setup(t, 100)
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+	txmp.CheckTxAsync([]byte("sender-0=0000=10"), mempool.TxInfo{SenderID: 1},
+		func(err error) { require.NoError(t, err) },
+		func(res *ocabci.Response) {
+			require.Equal(t, code.CodeTypeOK, res.GetCheckTx().Code)
+			wg.Done()
+		})
+	// rejected by the application
+	txmp.CheckTxAsync([]byte("invalid"), mempool.TxInfo{SenderID: 1},
+		func(err error) { require.NoError(t, err) },
+		func(res *ocabci.Response) {
+			require.NotEqual(t, code.CodeTypeOK, res.GetCheckTx().Code)
+			wg.Done()
 		})
+	wg.Wait()
+	require.Equal(t, 1, txmp.Size())
+
+	// rejected before invoking the application
+	errCh := make(chan error, 1)
+	txmp.CheckTxAsync([]byte("sender-0=0000=10"), mempool.TxInfo{SenderID: 2},
+		func(err error) { errCh <- err },
+		func(res *ocabci.Response) { require.Fail(t, "unexpected CheckTx response") })
+	require.ErrorIs(t, <-errCh, mempool.ErrTxInMap)
+	require.Equal(t, 1, txmp.Size())
+
+	wtx := txmp.TxsFront().Value.(*WrappedTx)
+	require.True(t, wtx.HasPeer(1))
+	require.True(t, wtx.HasPeer(2))
+}
+
+func TestTxMempool_CheckTxSameSenderHigherPriority(t *testing.T) {
+	txmp := setup(t, 100)
+
+	mustCheckTx(t, txmp, "sender-0=0000=50")
+	mustCheckTx(t, txmp, "sender-1=0001=10")
+	require.Equal(t, 2, txmp.Size())
+
+	// a higher-priority tx evicts the tx of the same sender
+	mustCheckTx(t, txmp, "sender-0=0002=60")
+	require.Equal(t, 2, txmp.Size())
+	require.Equal(t, types.Txs{[]byte("sender-0=0002=60"), []byte("sender-1=0001=10")}, txmp.ReapMaxTxs(-1))
+	require.False(t, txmp.cache.Has([]byte("sender-0=0000=50")))
+
+	// a tx with the same priority is rejected
+	mustCheckTx(t, txmp, "sender-0=0003=60")
+	require.Equal(t, types.Txs{[]byte("sender-0=0002=60"), []byte("sender-1=0001=10")}, txmp.ReapMaxTxs(-1))
+}
+
+func TestTxMempool_ReapMaxBytesMaxGasMaxTxs(t *testing.T) {
+	txmp := setup(t, 0)
+	tTxs := checkTxs(t, txmp, 100, 0) // all txs request 1 gas unit
+	require.Equal(t, len(tTxs), txmp.Size())
+
+	require.Len(t, txmp.ReapMaxBytesMaxGasMaxTxs(-1, -1, 0), 100)
+	require.Len(t, txmp.ReapMaxBytesMaxGasMaxTxs(-1, -1, 30), 30)
+	require.Len(t, txmp.ReapMaxBytesMaxGasMaxTxs(-1, 20, 30), 20)
+	require.Equal(t, txmp.ReapMaxTxs(10), txmp.ReapMaxBytesMaxGasMaxTxs(-1, -1, 10))
+}
+
+func TestTxMempool_Recheck(t *testing.T) {
+	txmp := setup(t, 100)
+	require.True(t, txmp.config.Recheck)
+
+	mustCheckTx(t, txmp, "sender-0=0000=10")
+	mustCheckTx(t, txmp, "sender-1=0001=20")
+	mustCheckTx(t, txmp, "sender-2=0002=30")
+	require.Equal(t, 3, txmp.Size())
+
+	// the post-check of the next block invalidates the tx with priority 20
+	postCheckFn := func(tx types.Tx, res *ocabci.ResponseCheckTx) error {
+		if res.Priority == 20 {
+			return errors.New("invalidated")
+		}
+		return nil
+	}
+	responses := []*abci.ResponseDeliverTx{{Code: abci.CodeTypeOK}}
+	txmp.Lock()
+	require.NoError(t, txmp.Update(newTestBlock(1, types.Txs{[]byte("sender-2=0002=30")}), responses, nil, postCheckFn))
+	txmp.Unlock()
+
+	require.Equal(t, types.Txs{[]byte("sender-0=0000=10")}, txmp.ReapMaxTxs(-1))
+	require.False(t, txmp.cache.Has([]byte("sender-1=0001=20")))
+	require.True(t, txmp.cache.Has([]byte("sender-2=0002=30")))
+}
+
+func TestTxMempool_WAL(t *testing.T) {
+	app := &application{kvstore.NewApplication()}
+	cc := proxy.NewLocalClientCreator(app)
+	cfg := config.ResetTestRoot(strings.ReplaceAll(t.Name(), "/", "|"))
+	cfg.Mempool.WalPath = "data/mempool.wal"
+	t.Cleanup(func() { os.RemoveAll(cfg.RootDir) })
+
+	newMempool := func() *TxMempool {
+		appConnMem, err := cc.NewABCIClient()
+		require.NoError(t, err)
+		require.NoError(t, appConnMem.Start())
+		t.Cleanup(func() { require.NoError(t, appConnMem.Stop()) })
+
+		txmp := NewTxMempool(log.TestingLogger(), cfg.Mempool, appConnMem, 0)
+		require.NoError(t, txmp.InitWAL())
+		return txmp
 	}
+
+	txmp := newMempool()
+	mustCheckTx(t, txmp, "sender-0=0000=10")
+	mustCheckTx(t, txmp, "sender-1=0001=20")
+	mustCheckTx(t, txmp, "sender-2=0002=30")
+
+	responses := []*abci.ResponseDeliverTx{{Code: abci.CodeTypeOK}}
+	txmp.Lock()
+	require.NoError(t, txmp.Update(newTestBlock(1, types.Txs{[]byte("sender-1=0001=20")}), responses, nil, nil))
+	txmp.Unlock()
+	txmp.CloseWAL()
+
+	// the pending txs are replayed on restart
+	txmp = newMempool()
+	defer txmp.CloseWAL()
+
+	require.Eventually(t, func() bool { return txmp.Size() == 2 }, time.Second, 10*time.Millisecond)
+	require.Equal(t, types.Txs{[]byte("sender-2=0002=30"), []byte("sender-0=0000=10")}, txmp.ReapMaxTxs(-1))
 }
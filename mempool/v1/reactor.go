@@ -1,5 +1,3 @@
-//go:build deprecated
-
 package v1
 
 import (
@@ -9,14 +7,17 @@ import (
 
 	"github.com/gogo/protobuf/proto"
 
-	cfg "github.com/tendermint/tendermint/config"
-	"github.com/tendermint/tendermint/libs/clist"
-	"github.com/tendermint/tendermint/libs/log"
-	tmsync "github.com/tendermint/tendermint/libs/sync"
-	"github.com/tendermint/tendermint/mempool"
-	"github.com/tendermint/tendermint/p2p"
 	protomem "github.com/tendermint/tendermint/proto/tendermint/mempool"
-	"github.com/tendermint/tendermint/types"
+
+	ocabci "github.com/Finschia/ostracon/abci/types"
+	"github.com/Finschia/ostracon/behaviour"
+	cfg "github.com/Finschia/ostracon/config"
+	"github.com/Finschia/ostracon/libs/clist"
+	"github.com/Finschia/ostracon/libs/log"
+	tmsync "github.com/Finschia/ostracon/libs/sync"
+	"github.com/Finschia/ostracon/mempool"
+	"github.com/Finschia/ostracon/p2p"
+	"github.com/Finschia/ostracon/types"
 )
 
 // Reactor handles mempool tx broadcasting amongst peers.
@@ -24,9 +25,13 @@ import (
 // peers you received it from.
 type Reactor struct {
 	p2p.BaseReactor
-	config  *cfg.MempoolConfig
-	mempool *TxMempool
-	ids     *mempoolIDs
+	config   *cfg.MempoolConfig
+	mempool  *TxMempool
+	ids      *mempoolIDs
+	reporter behaviour.Reporter
+
+	invalidTxsMtx tmsync.Mutex
+	invalidTxs    map[p2p.ID]int // number of invalid txs in a row received from each peer
 }
 
 type mempoolIDs struct {
@@ -93,13 +98,14 @@ func newMempoolIDs() *mempoolIDs {
 }
 
 // NewReactor returns a new Reactor with the given config and mempool.
-func NewReactor(config *cfg.MempoolConfig, mempool *TxMempool) *Reactor {
+func NewReactor(config *cfg.MempoolConfig, async bool, recvBufSize int, mempool *TxMempool) *Reactor {
 	memR := &Reactor{
-		config:  config,
-		mempool: mempool,
-		ids:     newMempoolIDs(),
+		config:     config,
+		mempool:    mempool,
+		ids:        newMempoolIDs(),
+		invalidTxs: make(map[p2p.ID]int),
 	}
-	memR.BaseReactor = *p2p.NewBaseReactor("Mempool", memR)
+	memR.BaseReactor = *p2p.NewBaseReactor("Mempool", memR, async, recvBufSize)
 	return memR
 }
 
@@ -114,8 +120,24 @@ func (memR *Reactor) SetLogger(l log.Logger) {
 	memR.Logger = l
 }
 
+// SetReporter sets the reporter the peer behaviours are reported to.
+// It must be called before the reactor is started.
+func (memR *Reactor) SetReporter(reporter behaviour.Reporter) {
+	memR.reporter = reporter
+}
+
 // OnStart implements p2p.BaseReactor.
 func (memR *Reactor) OnStart() error {
+	if memR.reporter == nil {
+		memR.reporter = behaviour.NewSwitchReporter(memR.Switch)
+	}
+
+	// call BaseReactor's OnStart()
+	err := memR.BaseReactor.OnStart()
+	if err != nil {
+		return err
+	}
+
 	if !memR.config.Broadcast {
 		memR.Logger.Info("Tx broadcasting is disabled")
 	}
@@ -153,6 +175,9 @@ func (memR *Reactor) AddPeer(peer p2p.Peer) {
 // RemovePeer implements Reactor.
 func (memR *Reactor) RemovePeer(peer p2p.Peer, reason interface{}) {
 	memR.ids.Reclaim(peer)
+	memR.invalidTxsMtx.Lock()
+	delete(memR.invalidTxs, peer.ID())
+	memR.invalidTxsMtx.Unlock()
 	// broadcast routine checks if peer is gone and returns
 }
 
@@ -164,7 +189,7 @@ func (memR *Reactor) ReceiveEnvelope(e p2p.Envelope) {
 	case *protomem.Txs:
 		protoTxs := msg.GetTxs()
 		if len(protoTxs) == 0 {
-			memR.Logger.Error("received tmpty txs from peer", "src", e.Src)
+			memR.Logger.Error("received empty txs from peer", "src", e.Src)
 			return
 		}
 		txInfo := mempool.TxInfo{SenderID: memR.ids.GetForPeer(e.Src)}
@@ -172,25 +197,68 @@ func (memR *Reactor) ReceiveEnvelope(e p2p.Envelope) {
 			txInfo.SenderP2PID = e.Src.ID()
 		}
 
-		var err error
 		for _, tx := range protoTxs {
 			ntx := types.Tx(tx)
-			err = memR.mempool.CheckTx(ntx, nil, txInfo)
-			if errors.Is(err, mempool.ErrTxInCache) {
-				memR.Logger.Debug("Tx already exists in cache", "tx", ntx.String())
-			} else if err != nil {
-				memR.Logger.Info("Could not check tx", "tx", ntx.String(), "err", err)
-			}
+			memR.mempool.CheckTxAsync(ntx, txInfo, func(err error) {
+				if errors.Is(err, mempool.ErrTxInMap) {
+					memR.Logger.Debug("Tx already exists in Map", "tx", ntx.String())
+				} else if errors.Is(err, mempool.ErrTxInCache) {
+					memR.Logger.Debug("Tx already exists in cache", "tx", ntx.String())
+				} else if err != nil {
+					memR.Logger.Info("Could not check tx", "tx", ntx.String(), "err", err)
+					if errors.As(err, &mempool.ErrTxTooLarge{}) || mempool.IsPreCheckError(err) {
+						memR.recordCheckTx(txInfo.SenderP2PID, false)
+					}
+				}
+			}, func(res *ocabci.Response) {
+				// a tx rejected by the app may have been valid when the peer relayed it, so
+				// only the accepted txs are recorded
+				if r := res.GetCheckTx(); r != nil && r.Code == ocabci.CodeTypeOK {
+					memR.recordCheckTx(txInfo.SenderP2PID, true)
+				}
+			})
 		}
 	default:
 		memR.Logger.Error("unknown message type", "src", e.Src, "chId", e.ChannelID, "msg", e.Message)
-		memR.Switch.StopPeerForError(e.Src, fmt.Errorf("mempool cannot handle message of type: %T", e.Message))
+		memR.report(behaviour.BadMessage(e.Src.ID(), fmt.Sprintf("mempool cannot handle message of type: %T", e.Message)))
 		return
 	}
 
 	// broadcasting happens from go routines per peer
 }
 
+// recordCheckTx records whether a tx received from the peer was valid, and
+// reports the peer once it sent max_consecutive_invalid_txs invalid txs in a row.
+func (memR *Reactor) recordCheckTx(peerID p2p.ID, valid bool) {
+	if peerID == "" || memR.config.MaxConsecutiveInvalidTxs == 0 {
+		return
+	}
+
+	memR.invalidTxsMtx.Lock()
+	if valid {
+		delete(memR.invalidTxs, peerID)
+		memR.invalidTxsMtx.Unlock()
+		return
+	}
+	memR.invalidTxs[peerID]++
+	flood := memR.invalidTxs[peerID] >= memR.config.MaxConsecutiveInvalidTxs
+	if flood {
+		delete(memR.invalidTxs, peerID)
+	}
+	memR.invalidTxsMtx.Unlock()
+
+	if flood {
+		memR.report(behaviour.InvalidTxFlood(peerID,
+			fmt.Sprintf("sent %d invalid txs in a row", memR.config.MaxConsecutiveInvalidTxs)))
+	}
+}
+
+func (memR *Reactor) report(pb behaviour.PeerBehaviour) {
+	if err := memR.reporter.Report(pb); err != nil {
+		memR.Logger.Debug("Failed to report peer behaviour", "err", err)
+	}
+}
+
 func (memR *Reactor) Receive(chID byte, peer p2p.Peer, msgBytes []byte) {
 	msg := &protomem.Message{}
 	err := proto.Unmarshal(msgBytes, msg)
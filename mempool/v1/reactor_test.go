@@ -1,9 +1,9 @@
-//go:build deprecated
-
 package v1
 
 import (
 	"encoding/hex"
+	"errors"
+	"fmt"
 	"os"
 	"sync"
 	"testing"
@@ -14,17 +14,17 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
-	"github.com/tendermint/tendermint/abci/example/kvstore"
-	"github.com/tendermint/tendermint/p2p/mock"
-
-	cfg "github.com/tendermint/tendermint/config"
-
-	"github.com/tendermint/tendermint/libs/log"
-	"github.com/tendermint/tendermint/mempool"
-	"github.com/tendermint/tendermint/p2p"
 	memproto "github.com/tendermint/tendermint/proto/tendermint/mempool"
-	"github.com/tendermint/tendermint/proxy"
-	"github.com/tendermint/tendermint/types"
+
+	"github.com/Finschia/ostracon/abci/example/kvstore"
+	"github.com/Finschia/ostracon/behaviour"
+	cfg "github.com/Finschia/ostracon/config"
+	"github.com/Finschia/ostracon/libs/log"
+	"github.com/Finschia/ostracon/mempool"
+	"github.com/Finschia/ostracon/p2p"
+	"github.com/Finschia/ostracon/p2p/mock"
+	"github.com/Finschia/ostracon/proxy"
+	"github.com/Finschia/ostracon/types"
 )
 
 const (
@@ -126,6 +126,55 @@ func TestLegacyReactorReceiveBasic(t *testing.T) {
 	})
 }
 
+func TestReactorReportsInvalidTxFlood(t *testing.T) {
+	config := cfg.TestConfig()
+	const N = 1
+	reactors := makeAndConnectReactors(config, N)
+	var (
+		reactor  = reactors[0]
+		peer     = mock.NewPeer(nil)
+		reporter = behaviour.NewMockReporter()
+	)
+	defer func() {
+		err := reactor.Stop()
+		assert.NoError(t, err)
+	}()
+	reactor.reporter = reporter
+	reactor.mempool.preCheck = func(tx types.Tx) error {
+		if len(tx) == 1 {
+			return errors.New("invalid tx")
+		}
+		return nil
+	}
+	reactor.InitPeer(peer)
+
+	txs := make([][]byte, config.Mempool.MaxConsecutiveInvalidTxs)
+	for i := range txs {
+		txs[i] = []byte{byte(i)}
+	}
+
+	// a valid tx in between resets the count
+	reactor.ReceiveEnvelope(p2p.Envelope{
+		ChannelID: mempool.MempoolChannel,
+		Src:       peer,
+		Message:   &memproto.Txs{Txs: append(append(txs[1:], []byte("valid")), txs[0])},
+	})
+	time.Sleep(100 * time.Millisecond)
+	assert.Empty(t, reporter.GetBehaviours(peer.ID()))
+
+	reactor.ReceiveEnvelope(p2p.Envelope{
+		ChannelID: mempool.MempoolChannel,
+		Src:       peer,
+		Message:   &memproto.Txs{Txs: txs},
+	})
+	require.Eventually(t, func() bool {
+		return len(reporter.GetBehaviours(peer.ID())) > 0
+	}, time.Second, 10*time.Millisecond)
+	assert.Equal(t, []behaviour.PeerBehaviour{
+		behaviour.InvalidTxFlood(peer.ID(), fmt.Sprintf("sent %d invalid txs in a row", config.Mempool.MaxConsecutiveInvalidTxs)),
+	}, reporter.GetBehaviours(peer.ID()))
+}
+
 func makeAndConnectReactors(config *cfg.Config, n int) []*Reactor {
 	reactors := make([]*Reactor, n)
 	logger := mempoolLogger()
@@ -135,11 +184,12 @@ func makeAndConnectReactors(config *cfg.Config, n int) []*Reactor {
 		mempool, cleanup := newMempoolWithApp(cc)
 		defer cleanup()
 
-		reactors[i] = NewReactor(config.Mempool, mempool) // so we dont start the consensus states
+		// so we dont start the consensus states
+		reactors[i] = NewReactor(config.Mempool, config.P2P.RecvAsync, config.P2P.MempoolRecvBufSize, mempool)
 		reactors[i].SetLogger(logger.With("validator", i))
 	}
 
-	p2p.MakeConnectedSwitches(config.P2P, n, func(i int, s *p2p.Switch) *p2p.Switch {
+	p2p.MakeConnectedSwitches(config.P2P, n, func(i int, s *p2p.Switch, config *cfg.P2PConfig) *p2p.Switch {
 		s.AddReactor("MEMPOOL", reactors[i])
 		return s
 
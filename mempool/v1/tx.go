@@ -1,12 +1,10 @@
-//go:build deprecated
-
 package v1
 
 import (
 	"sync"
 	"time"
 
-	"github.com/tendermint/tendermint/types"
+	"github.com/Finschia/ostracon/types"
 )
 
 // WrappedTx defines a wrapper around a raw transaction with additional metadata
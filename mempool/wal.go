@@ -0,0 +1,260 @@
+package mempool
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"path/filepath"
+
+	auto "github.com/Finschia/ostracon/libs/autofile"
+	tmos "github.com/Finschia/ostracon/libs/os"
+	"github.com/Finschia/ostracon/types"
+)
+
+const (
+	// walFile is the name of the head file of the WAL in the WAL directory.
+	walFile = "wal"
+
+	walRecordTx       = byte(0x01) // a tx admitted to the mempool
+	walRecordRemoveTx = byte(0x02) // a tx removed from the mempool
+
+	// walRecordHeaderSize is the size of the CRC sum and the length.
+	walRecordHeaderSize = 8
+)
+
+var crc32c = crc32.MakeTable(crc32.Castagnoli)
+
+// WAL is the write-ahead log of the mempool. It records the txs admitted to
+// the mempool and the ones removed from it, so that pending txs survive a
+// restart of the node.
+//
+// Each record is encoded as 4 bytes CRC sum + 4 bytes length + 1 byte type +
+// arbitrary-length value. A record, which was partially written because the
+// process crashed, is detected by the CRC sum and discarded on ReadAll.
+//
+// The WAL is compacted by Checkpoint: the head file is rotated, the pending
+// txs are written to the new head and then the older files are removed.
+type WAL struct {
+	group         *auto.Group
+	maxTxBytes    int
+	maxRecordSize uint32
+}
+
+// OpenWAL opens the WAL in walDir, creating the directory if needed. Txs
+// greater than maxTxBytes are rejected.
+func OpenWAL(walDir string, maxTxBytes int, groupOptions ...func(*auto.Group)) (*WAL, error) {
+	if err := tmos.EnsureDir(walDir, 0700); err != nil {
+		return nil, fmt.Errorf("failed to ensure mempool WAL directory is in place: %w", err)
+	}
+
+	group, err := auto.OpenGroup(filepath.Join(walDir, walFile), groupOptions...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open mempool WAL: %w", err)
+	}
+
+	maxRecordSize := maxTxBytes
+	if maxRecordSize < types.TxKeySize {
+		maxRecordSize = types.TxKeySize
+	}
+
+	return &WAL{
+		group:         group,
+		maxTxBytes:    maxTxBytes,
+		maxRecordSize: uint32(maxRecordSize) + 1,
+	}, nil
+}
+
+// WriteTx records the tx admitted to the mempool. The record is flushed to
+// the file, but not synced, so that it survives a crash of the process.
+func (wal *WAL) WriteTx(tx types.Tx) error {
+	if len(tx) > wal.maxTxBytes {
+		return fmt.Errorf("tx is too big: %d bytes, max: %d bytes", len(tx), wal.maxTxBytes)
+	}
+	if err := wal.write(walRecordTx, tx); err != nil {
+		return err
+	}
+	return wal.group.Flush()
+}
+
+// WriteRemoveTx records the tx removed from the mempool. The record is
+// buffered until the next WriteTx or Sync.
+func (wal *WAL) WriteRemoveTx(txKey types.TxKey) error {
+	return wal.write(walRecordRemoveTx, txKey[:])
+}
+
+// Sync writes any buffered records to the file and commits them to stable
+// storage.
+func (wal *WAL) Sync() error {
+	return wal.group.FlushAndSync()
+}
+
+// ReadAll replays all the records and returns the txs which were admitted to
+// the mempool but not removed from it, in the order of admission.
+//
+// If a corrupted record is found (e.g. it was partially written when the
+// process crashed), the txs read so far are returned along with an error
+// for which IsWALCorruptionError returns true.
+func (wal *WAL) ReadAll() (types.Txs, error) {
+	gr, err := wal.group.NewReader(wal.group.MinIndex())
+	if err != nil {
+		return nil, err
+	}
+	defer gr.Close()
+
+	var (
+		txs     = make([]types.Tx, 0)
+		indexes = make(map[types.TxKey]int)
+		readErr error
+	)
+	for {
+		typ, value, err := wal.read(gr)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			readErr = err
+			break
+		}
+
+		switch typ {
+		case walRecordTx:
+			tx := types.Tx(value)
+			if _, ok := indexes[tx.Key()]; !ok {
+				indexes[tx.Key()] = len(txs)
+				txs = append(txs, tx)
+			}
+		case walRecordRemoveTx:
+			var txKey types.TxKey
+			copy(txKey[:], value)
+			if i, ok := indexes[txKey]; ok {
+				txs[i] = nil
+				delete(indexes, txKey)
+			}
+		}
+	}
+
+	pending := make(types.Txs, 0, len(indexes))
+	for _, tx := range txs {
+		if tx != nil {
+			pending = append(pending, tx)
+		}
+	}
+	return pending, readErr
+}
+
+// Checkpoint compacts the WAL so that it only contains the given txs. The
+// head file is rotated, the txs are written to the new head and synced, and
+// only then the older files are removed. So the WAL is never left without the
+// pending txs even if the process crashes in the middle.
+func (wal *WAL) Checkpoint(txs types.Txs) error {
+	wal.group.RotateFile()
+	head := wal.group.MaxIndex()
+
+	for _, tx := range txs {
+		if err := wal.write(walRecordTx, tx); err != nil {
+			return err
+		}
+	}
+	if err := wal.group.FlushAndSync(); err != nil {
+		return err
+	}
+
+	return wal.group.RemoveFilesBefore(head)
+}
+
+// HeadSizeLimitReached returns true if the size of the head file reached the
+// head size limit of the group, i.e. the WAL should be compacted.
+func (wal *WAL) HeadSizeLimitReached() bool {
+	limit := wal.group.HeadSizeLimit()
+	if limit == 0 {
+		return false
+	}
+	size, err := wal.group.Head.Size()
+	if err != nil {
+		return false
+	}
+	return size >= limit
+}
+
+// Close flushes and syncs the buffered records and closes the files.
+func (wal *WAL) Close() {
+	wal.group.Close()
+}
+
+func (wal *WAL) write(typ byte, value []byte) error {
+	length := uint32(len(value)) + 1
+	if length > wal.maxRecordSize {
+		return fmt.Errorf("record is too big: %d bytes, max: %d bytes", length, wal.maxRecordSize)
+	}
+
+	record := make([]byte, walRecordHeaderSize+int(length))
+	record[walRecordHeaderSize] = typ
+	copy(record[walRecordHeaderSize+1:], value)
+	binary.BigEndian.PutUint32(record[0:4], crc32.Checksum(record[walRecordHeaderSize:], crc32c))
+	binary.BigEndian.PutUint32(record[4:8], length)
+
+	_, err := wal.group.Write(record)
+	return err
+}
+
+func (wal *WAL) read(rd io.Reader) (byte, []byte, error) {
+	header := make([]byte, walRecordHeaderSize)
+	n, err := io.ReadFull(rd, header)
+	if errors.Is(err, io.EOF) {
+		return 0, nil, err
+	}
+	if err != nil {
+		return 0, nil, WALCorruptionError{fmt.Errorf("failed to read header: %v (read: %d)", err, n)}
+	}
+	crc := binary.BigEndian.Uint32(header[0:4])
+	length := binary.BigEndian.Uint32(header[4:8])
+
+	if length == 0 || length > wal.maxRecordSize {
+		return 0, nil, WALCorruptionError{fmt.Errorf(
+			"length %d is out of range (0, %d]", length, wal.maxRecordSize)}
+	}
+
+	data := make([]byte, length)
+	n, err = io.ReadFull(rd, data)
+	if err != nil {
+		return 0, nil, WALCorruptionError{fmt.Errorf("failed to read data: %v (read: %d, wanted: %d)", err, n, length)}
+	}
+
+	if actualCRC := crc32.Checksum(data, crc32c); actualCRC != crc {
+		return 0, nil, WALCorruptionError{fmt.Errorf("checksums do not match: read: %v, actual: %v", crc, actualCRC)}
+	}
+
+	switch data[0] {
+	case walRecordTx:
+	case walRecordRemoveTx:
+		if len(data)-1 != types.TxKeySize {
+			return 0, nil, WALCorruptionError{fmt.Errorf("invalid tx key size: %d", len(data)-1)}
+		}
+	default:
+		return 0, nil, WALCorruptionError{fmt.Errorf("unknown record type: %d", data[0])}
+	}
+
+	return data[0], data[1:], nil
+}
+
+// WALCorruptionError is returned if a record of the mempool WAL has been
+// corrupted.
+type WALCorruptionError struct {
+	cause error
+}
+
+func (e WALCorruptionError) Error() string {
+	return fmt.Sprintf("mempool WAL is corrupted: %v", e.cause)
+}
+
+func (e WALCorruptionError) Cause() error {
+	return e.cause
+}
+
+// IsWALCorruptionError returns true if err is due to a corrupted record of
+// the mempool WAL.
+func IsWALCorruptionError(err error) bool {
+	return errors.As(err, &WALCorruptionError{})
+}
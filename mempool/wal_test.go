@@ -0,0 +1,116 @@
+package mempool
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	auto "github.com/Finschia/ostracon/libs/autofile"
+	"github.com/Finschia/ostracon/types"
+)
+
+func TestWALReadAll(t *testing.T) {
+	walDir := t.TempDir()
+
+	wal, err := OpenWAL(walDir, 1024)
+	require.NoError(t, err)
+
+	txs := types.Txs{[]byte("tx1"), []byte("tx2"), []byte("tx3"), []byte("tx4")}
+	for _, tx := range txs {
+		require.NoError(t, wal.WriteTx(tx))
+	}
+	// duplicates are ignored
+	require.NoError(t, wal.WriteTx(txs[0]))
+	require.NoError(t, wal.WriteRemoveTx(txs[1].Key()))
+	require.NoError(t, wal.WriteRemoveTx(txs[3].Key()))
+	// removal of an unknown tx is ignored
+	require.NoError(t, wal.WriteRemoveTx(types.Tx("unknown").Key()))
+	require.NoError(t, wal.Sync())
+	wal.Close()
+
+	wal, err = OpenWAL(walDir, 1024)
+	require.NoError(t, err)
+	defer wal.Close()
+
+	pending, err := wal.ReadAll()
+	require.NoError(t, err)
+	assert.Equal(t, types.Txs{txs[0], txs[2]}, pending)
+
+	// a tx removed and admitted again is pending
+	require.NoError(t, wal.WriteTx(txs[1]))
+	pending, err = wal.ReadAll()
+	require.NoError(t, err)
+	assert.Equal(t, types.Txs{txs[0], txs[2], txs[1]}, pending)
+}
+
+func TestWALTooBigTx(t *testing.T) {
+	wal, err := OpenWAL(t.TempDir(), 4)
+	require.NoError(t, err)
+	defer wal.Close()
+
+	require.NoError(t, wal.WriteTx([]byte("tx01")))
+	require.Error(t, wal.WriteTx([]byte("tx001")))
+	// the record of the removal is always accepted
+	require.NoError(t, wal.WriteRemoveTx(types.Tx("tx01").Key()))
+}
+
+func TestWALCorruptedTail(t *testing.T) {
+	walDir := t.TempDir()
+
+	wal, err := OpenWAL(walDir, 1024)
+	require.NoError(t, err)
+	require.NoError(t, wal.WriteTx([]byte("tx1")))
+	require.NoError(t, wal.WriteTx([]byte("tx2")))
+	wal.Close()
+
+	// cut off the last byte, as if the process crashed while writing tx2
+	path := wal.group.Head.Path
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	require.NoError(t, os.Truncate(path, info.Size()-1))
+
+	wal, err = OpenWAL(walDir, 1024)
+	require.NoError(t, err)
+	defer wal.Close()
+
+	pending, err := wal.ReadAll()
+	require.Error(t, err)
+	assert.True(t, IsWALCorruptionError(err))
+	assert.Equal(t, types.Txs{[]byte("tx1")}, pending)
+
+	// the checkpoint discards the corrupted record
+	require.NoError(t, wal.Checkpoint(pending))
+	require.NoError(t, wal.WriteTx([]byte("tx3")))
+	pending, err = wal.ReadAll()
+	require.NoError(t, err)
+	assert.Equal(t, types.Txs{[]byte("tx1"), []byte("tx3")}, pending)
+}
+
+func TestWALCheckpoint(t *testing.T) {
+	wal, err := OpenWAL(t.TempDir(), 1024, auto.GroupHeadSizeLimit(64))
+	require.NoError(t, err)
+	defer wal.Close()
+
+	txs := make(types.Txs, 0)
+	for i := 0; !wal.HeadSizeLimitReached(); i++ {
+		tx := types.Tx{byte(i), byte(i >> 8)}
+		require.NoError(t, wal.WriteTx(tx))
+		txs = append(txs, tx)
+	}
+	for _, tx := range txs[1:] {
+		require.NoError(t, wal.WriteRemoveTx(tx.Key()))
+	}
+
+	require.NoError(t, wal.Checkpoint(txs[:1]))
+	assert.False(t, wal.HeadSizeLimitReached())
+
+	info := wal.group.ReadGroupInfo()
+	assert.Equal(t, wal.group.MaxIndex(), wal.group.MinIndex())
+	assert.EqualValues(t, 8+1+2, info.TotalSize)
+
+	pending, err := wal.ReadAll()
+	require.NoError(t, err)
+	assert.Equal(t, txs[:1], pending)
+}
@@ -8,6 +8,7 @@ import (
 	"net"
 	"net/http"
 	_ "net/http/pprof" // nolint: gosec // securely exposed on separate, optional port
+	"path/filepath"
 	"strings"
 	"time"
 
@@ -17,6 +18,7 @@ import (
 	abci "github.com/tendermint/tendermint/abci/types"
 	dbm "github.com/tendermint/tm-db"
 
+	"github.com/Finschia/ostracon/behaviour"
 	bcv0 "github.com/Finschia/ostracon/blockchain/v0"
 	bcv1 "github.com/Finschia/ostracon/blockchain/v1"
 	bcv2 "github.com/Finschia/ostracon/blockchain/v2"
@@ -26,16 +28,19 @@ import (
 	"github.com/Finschia/ostracon/evidence"
 	tmjson "github.com/Finschia/ostracon/libs/json"
 	"github.com/Finschia/ostracon/libs/log"
+	tmnet "github.com/Finschia/ostracon/libs/net"
 	tmpubsub "github.com/Finschia/ostracon/libs/pubsub"
 	"github.com/Finschia/ostracon/libs/service"
 	"github.com/Finschia/ostracon/light"
 	mempl "github.com/Finschia/ostracon/mempool"
 	mempoolv0 "github.com/Finschia/ostracon/mempool/v0"
 
-	//mempoolv1 "github.com/Finschia/ostracon/mempool/v1"
+	mempoolv1 "github.com/Finschia/ostracon/mempool/v1"
 	"github.com/Finschia/ostracon/p2p"
 	"github.com/Finschia/ostracon/p2p/pex"
+	"github.com/Finschia/ostracon/p2p/trust"
 	"github.com/Finschia/ostracon/privval"
+	grpcprivval "github.com/Finschia/ostracon/privval/grpc"
 	"github.com/Finschia/ostracon/proxy"
 	rpccore "github.com/Finschia/ostracon/rpc/core"
 	grpccore "github.com/Finschia/ostracon/rpc/grpc"
@@ -45,6 +50,7 @@ import (
 	blockidxkv "github.com/Finschia/ostracon/state/indexer/block/kv"
 	blockidxnull "github.com/Finschia/ostracon/state/indexer/block/null"
 	"github.com/Finschia/ostracon/state/indexer/sink/psql"
+	"github.com/Finschia/ostracon/state/indexer/sink/sqlite"
 	"github.com/Finschia/ostracon/state/txindex"
 	"github.com/Finschia/ostracon/state/txindex/kv"
 	"github.com/Finschia/ostracon/state/txindex/null"
@@ -70,6 +76,10 @@ type DBProvider func(*DBContext) (dbm.DB, error)
 
 const readHeaderTimeout = 10 * time.Second
 
+// lightBlockPeersTimeout is how long state sync waits for peers serving light blocks when it has
+// no RPC servers, before it gives up.
+const lightBlockPeersTimeout = 2 * time.Minute
+
 // DefaultDBProvider returns a database using the DBBackend and DBDir
 // specified in the ctx.Config.
 func DefaultDBProvider(ctx *DBContext) (dbm.DB, error) {
@@ -140,20 +150,29 @@ func NewOstraconNode(config *cfg.Config, logger log.Logger) (*Node, error) {
 	)
 }
 
-// MetricsProvider returns a consensus, p2p and mempool Metrics.
-type MetricsProvider func(chainID string) (*cs.Metrics, *p2p.Metrics, *mempl.Metrics, *sm.Metrics)
+// MetricsProvider returns a consensus, p2p, mempool, state, behaviour,
+// indexer and state sync Metrics.
+type MetricsProvider func(chainID string) (
+	*cs.Metrics, *p2p.Metrics, *mempl.Metrics, *sm.Metrics, *behaviour.Metrics, *txindex.Metrics,
+	*statesync.Metrics)
 
 // DefaultMetricsProvider returns Metrics build using Prometheus client library
 // if Prometheus is enabled. Otherwise, it returns no-op Metrics.
 func DefaultMetricsProvider(config *cfg.InstrumentationConfig) MetricsProvider {
-	return func(chainID string) (*cs.Metrics, *p2p.Metrics, *mempl.Metrics, *sm.Metrics) {
+	return func(chainID string) (
+		*cs.Metrics, *p2p.Metrics, *mempl.Metrics, *sm.Metrics, *behaviour.Metrics, *txindex.Metrics,
+		*statesync.Metrics) {
 		if config.Prometheus {
 			return cs.PrometheusMetrics(config.Namespace, "chain_id", chainID),
 				p2p.PrometheusMetrics(config.Namespace, "chain_id", chainID),
 				mempl.PrometheusMetrics(config.Namespace, "chain_id", chainID),
-				sm.PrometheusMetrics(config.Namespace, "chain_id", chainID)
+				sm.PrometheusMetrics(config.Namespace, "chain_id", chainID),
+				behaviour.PrometheusMetrics(config.Namespace, "chain_id", chainID),
+				txindex.PrometheusMetrics(config.Namespace, "chain_id", chainID),
+				statesync.PrometheusMetrics(config.Namespace, "chain_id", chainID)
 		}
-		return cs.NopMetrics(), p2p.NopMetrics(), mempl.NopMetrics(), sm.NopMetrics()
+		return cs.NopMetrics(), p2p.NopMetrics(), mempl.NopMetrics(), sm.NopMetrics(), behaviour.NopMetrics(),
+			txindex.NopMetrics(), statesync.NopMetrics()
 	}
 }
 
@@ -223,8 +242,9 @@ type Node struct {
 
 	// network
 	transport   *p2p.MultiplexTransport
-	sw          *p2p.Switch  // p2p connections
-	addrBook    pex.AddrBook // known peers
+	sw          *p2p.Switch        // p2p connections
+	addrBook    pex.AddrBook       // known peers
+	trustStore  *trust.MetricStore // trust history of peers
 	nodeInfo    p2p.NodeInfo
 	nodeKey     *p2p.NodeKey // our node privkey
 	isListening bool
@@ -291,6 +311,7 @@ func createAndStartIndexerService(
 	chainID string,
 	dbProvider DBProvider,
 	eventBus *types.EventBus,
+	metrics *txindex.Metrics,
 	logger log.Logger,
 ) (*txindex.IndexerService, txindex.TxIndexer, indexer.BlockIndexer, error) {
 	var (
@@ -298,6 +319,8 @@ func createAndStartIndexerService(
 		blockIndexer indexer.BlockIndexer
 	)
 
+	filter := indexer.NewKeyFilter(config.TxIndex.IndexKeys, config.TxIndex.ExcludeKeys)
+
 	switch config.TxIndex.Indexer {
 	case "kv":
 		store, err := dbProvider(&DBContext{"tx_index", config})
@@ -305,26 +328,36 @@ func createAndStartIndexerService(
 			return nil, nil, nil, err
 		}
 
-		txIndexer = kv.NewTxIndex(store)
-		blockIndexer = blockidxkv.New(dbm.NewPrefixDB(store, []byte("block_events")))
+		txIndexer = kv.NewTxIndex(store, kv.WithKeyFilter(filter))
+		blockIndexer = blockidxkv.New(dbm.NewPrefixDB(store, []byte("block_events")), blockidxkv.WithKeyFilter(filter))
 
 	case "psql":
 		if config.TxIndex.PsqlConn == "" {
 			return nil, nil, nil, errors.New(`no psql-conn is set for the "psql" indexer`)
 		}
-		es, err := psql.NewEventSink(config.TxIndex.PsqlConn, chainID)
+		es, err := psql.NewEventSink(config.TxIndex.PsqlConn, chainID, psql.WithKeyFilter(filter))
 		if err != nil {
 			return nil, nil, nil, fmt.Errorf("creating psql indexer: %w", err)
 		}
 		txIndexer = es.TxIndexer()
 		blockIndexer = es.BlockIndexer()
 
+	case "sqlite":
+		es, err := sqlite.NewEventSink(filepath.Join(config.DBDir(), sqlite.DBFileName), chainID,
+			sqlite.WithKeyFilter(filter))
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("creating sqlite indexer: %w", err)
+		}
+		txIndexer = es.TxIndexer()
+		blockIndexer = es.BlockIndexer()
+
 	default:
 		txIndexer = &null.TxIndex{}
 		blockIndexer = &blockidxnull.BlockerIndexer{}
 	}
 
-	indexerService := txindex.NewIndexerService(txIndexer, blockIndexer, eventBus, false)
+	indexerService := txindex.NewIndexerService(txIndexer, blockIndexer, eventBus, false,
+		txindex.WithMetrics(metrics))
 	indexerService.SetLogger(logger.With("module", "txindex"))
 
 	if err := indexerService.Start(); err != nil {
@@ -395,31 +428,32 @@ func createMempoolAndMempoolReactor(
 	logger log.Logger,
 ) (mempl.Mempool, p2p.Reactor) {
 	switch config.Mempool.Version {
-	case cfg.MempoolV1: // XXX Deprecated MempoolV1
-		panic("Deprecated MempoolV1")
-		/*
-			// TODO(thane): Remove log once https://github.com/tendermint/tendermint/issues/8775 is resolved.
-			logger.Error("While the prioritized mempool API is stable, there is a critical bug in it that is currently under investigation. See https://github.com/tendermint/tendermint/issues/8775 for details")
-			mp := mempoolv1.NewTxMempool(
-				logger,
-				config.Mempool,
-				proxyApp.Mempool(),
-				state.LastBlockHeight,
-				mempoolv1.WithMetrics(memplMetrics),
-				mempoolv1.WithPreCheck(sm.TxPreCheck(state)),
-				mempoolv1.WithPostCheck(sm.TxPostCheck(state)),
-			)
-
-			reactor := mempoolv1.NewReactor(
-				config.Mempool,
-				mp,
-			)
-			if config.Consensus.WaitForTxs() {
-				mp.EnableTxsAvailable()
-			}
+	case cfg.MempoolV1:
+		// TODO(thane): Remove log once https://github.com/tendermint/tendermint/issues/8775 is resolved.
+		logger.Error("While the prioritized mempool API is stable, there is a critical bug in it that is currently under investigation. See https://github.com/tendermint/tendermint/issues/8775 for details")
+		mp := mempoolv1.NewTxMempool(
+			logger,
+			config.Mempool,
+			proxyApp.Mempool(),
+			state.LastBlockHeight,
+			mempoolv1.WithMetrics(memplMetrics),
+			mempoolv1.WithPreCheck(sm.TxPreCheck(state)),
+			mempoolv1.WithPostCheck(sm.TxPostCheck(state)),
+		)
+
+		reactor := mempoolv1.NewReactor(
+			config.Mempool,
+			config.P2P.RecvAsync,
+			config.P2P.MempoolRecvBufSize,
+			mp,
+		)
+
+		if config.Consensus.WaitForTxs() {
+			mp.EnableTxsAvailable()
+		}
+
+		return mp, reactor
 
-			return mp, reactor
-		*/
 	case cfg.MempoolV0:
 		mp := mempoolv0.NewCListMempool(
 			config.Mempool,
@@ -599,6 +633,8 @@ func createTransport(
 func createSwitch(config *cfg.Config,
 	transport p2p.Transport,
 	p2pMetrics *p2p.Metrics,
+	bhMetrics *behaviour.Metrics,
+	trustStore *trust.MetricStore,
 	peerFilters []p2p.PeerFilterFunc,
 	mempoolReactor p2p.Reactor,
 	bcReactor p2p.Reactor,
@@ -613,6 +649,7 @@ func createSwitch(config *cfg.Config,
 		config.P2P,
 		transport,
 		p2p.WithMetrics(p2pMetrics),
+		p2p.WithTrustMetricStore(trustStore),
 		p2p.SwitchPeerFilters(peerFilters...),
 	)
 	sw.SetLogger(p2pLogger)
@@ -622,6 +659,15 @@ func createSwitch(config *cfg.Config,
 	sw.AddReactor("EVIDENCE", evidenceReactor)
 	sw.AddReactor("STATESYNC", stateSyncReactor)
 
+	// Report the behaviour of peers through a single reporter, so that every
+	// reactor follows the same policy.
+	reporter := behaviour.NewSwitchReporter(sw, behaviour.WithMetrics(bhMetrics))
+	for _, reactor := range sw.Reactors() {
+		if r, ok := reactor.(interface{ SetReporter(behaviour.Reporter) }); ok {
+			r.SetReporter(reporter)
+		}
+	}
+
 	sw.SetNodeInfo(nodeInfo)
 	sw.SetNodeKey(nodeKey)
 
@@ -629,11 +675,12 @@ func createSwitch(config *cfg.Config,
 	return sw
 }
 
-func createAddrBookAndSetOnSwitch(config *cfg.Config, sw *p2p.Switch,
+func createAddrBookAndSetOnSwitch(config *cfg.Config, sw *p2p.Switch, trustStore *trust.MetricStore,
 	p2pLogger log.Logger, nodeKey *p2p.NodeKey,
 ) (pex.AddrBook, error) {
 	addrBook := pex.NewAddrBook(config.P2P.AddrBookFile(), config.P2P.AddrBookStrict)
 	addrBook.SetLogger(p2pLogger.With("book", config.P2P.AddrBookFile()))
+	addrBook.SetTrustMetricStore(trustStore)
 
 	// Add ourselves to addrbook to prevent dialing ourselves
 	if config.P2P.ExternalAddress != "" {
@@ -686,24 +733,40 @@ func startStateSync(ssR *statesync.Reactor, bcR fastSyncReactor, conR *cs.Reacto
 ) error {
 	ssR.Logger.Info("Starting state sync")
 
-	if stateProvider == nil {
+	trustOptions := light.TrustOptions{
+		Period: config.TrustPeriod,
+		Height: config.TrustHeight,
+		Hash:   config.TrustHashBytes(),
+	}
+	if stateProvider == nil && len(config.RPCServers) > 0 {
 		var err error
 		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 		defer cancel()
 		stateProvider, err = statesync.NewLightClientStateProvider(
 			ctx,
 			state.ChainID, state.Version, state.InitialHeight,
-			config.RPCServers, light.TrustOptions{
-				Period: config.TrustPeriod,
-				Height: config.TrustHeight,
-				Hash:   config.TrustHashBytes(),
-			}, ssR.Logger.With("module", "light"))
+			config.RPCServers, trustOptions, ssR.Logger.With("module", "light"))
 		if err != nil {
 			return fmt.Errorf("failed to set up light client state provider: %w", err)
 		}
 	}
 
 	go func() {
+		if stateProvider == nil {
+			// Without RPC servers, the light blocks are fetched from peers, once connected.
+			var err error
+			ctx, cancel := context.WithTimeout(context.Background(), lightBlockPeersTimeout)
+			stateProvider, err = statesync.NewP2PStateProvider(
+				ctx,
+				state.ChainID, state.Version, state.InitialHeight,
+				ssR, trustOptions, ssR.Logger.With("module", "light"))
+			cancel()
+			if err != nil {
+				ssR.Logger.Error("Failed to set up P2P state provider", "err", err)
+				return
+			}
+		}
+
 		state, previousState, commit, err := ssR.Sync(stateProvider, config.DiscoveryTime)
 		if err != nil {
 			ssR.Logger.Error("State sync failed", "err", err)
@@ -783,19 +846,28 @@ func NewNode(config *cfg.Config,
 		return nil, err
 	}
 
+	csMetrics, p2pMetrics, memplMetrics, smMetrics, bhMetrics, txMetrics, ssMetrics := metricsProvider(genDoc.ChainID)
+
 	indexerService, txIndexer, blockIndexer, err := createAndStartIndexerService(config,
-		genDoc.ChainID, dbProvider, eventBus, logger)
+		genDoc.ChainID, dbProvider, eventBus, txMetrics, logger)
 	if err != nil {
 		return nil, err
 	}
 
 	// If an address is provided, listen on the socket for a connection from an
-	// external signing process.
+	// external signing process, or dial it over gRPC.
 	if strings.TrimSpace(config.PrivValidatorListenAddr) != "" {
-		// FIXME: we should start services inside OnStart
-		privValidator, err = CreateAndStartPrivValidatorSocketClient(config, genDoc.ChainID, logger)
-		if err != nil {
-			return nil, fmt.Errorf("error with private validator socket client: %w", err)
+		if protocol, _ := tmnet.ProtocolAndAddress(config.PrivValidatorListenAddr); protocol == "grpc" {
+			privValidator, err = createPrivValidatorGRPCClient(config, genDoc.ChainID, logger)
+			if err != nil {
+				return nil, fmt.Errorf("error with private validator grpc client: %w", err)
+			}
+		} else {
+			// FIXME: we should start services inside OnStart
+			privValidator, err = CreateAndStartPrivValidatorSocketClient(config, genDoc.ChainID, logger)
+			if err != nil {
+				return nil, fmt.Errorf("error with private validator socket client: %w", err)
+			}
 		}
 	}
 
@@ -834,8 +906,6 @@ func NewNode(config *cfg.Config,
 
 	logNodeStartupInfo(state, pubKey, logger, consensusLogger)
 
-	csMetrics, p2pMetrics, memplMetrics, smMetrics := metricsProvider(genDoc.ChainID)
-
 	// Make MempoolReactor
 	mempool, mempoolReactor := createMempoolAndMempoolReactor(config, proxyApp, state, memplMetrics, logger)
 
@@ -853,6 +923,7 @@ func NewNode(config *cfg.Config,
 		mempool,
 		evidencePool,
 		sm.BlockExecutorWithMetrics(smMetrics),
+		sm.BlockExecutorWithIndexPruner(indexerService),
 	)
 
 	// Make BlockchainReactor. Don't start fast sync if we're doing a state sync first.
@@ -877,12 +948,20 @@ func NewNode(config *cfg.Config,
 	// FIXME The way we do phased startups (e.g. replay -> fast sync -> consensus) is very messy,
 	// we should clean this whole thing up. See:
 	// https://github.com/tendermint/tendermint/issues/4644
+	stateSyncOptions := []statesync.ReactorOption{statesync.ReactorMetrics(ssMetrics)}
+	if config.StateSync.ResumeRestoration {
+		stateSyncOptions = append(stateSyncOptions,
+			statesync.ReactorResumeFile(filepath.Join(config.DBDir(), statesync.ResumeFileName)))
+	}
 	stateSyncReactor := statesync.NewReactor(
 		*config.StateSync,
 		proxyApp.Snapshot(),
 		proxyApp.Query(),
+		stateStore,
+		blockStore,
 		config.P2P.RecvAsync,
-		config.P2P.StatesyncRecvBufSize)
+		config.P2P.StatesyncRecvBufSize,
+		stateSyncOptions...)
 	stateSyncReactor.SetLogger(logger.With("module", "statesync"))
 
 	nodeInfo, err := makeNodeInfo(config, nodeKey, txIndexer, genDoc, state)
@@ -893,10 +972,18 @@ func NewNode(config *cfg.Config,
 	// Setup Transport.
 	transport, peerFilters := createTransport(config, nodeInfo, nodeKey, proxyApp)
 
-	// Setup Switch.
+	// Setup the trust history of peers, fed by the switch.
 	p2pLogger := logger.With("module", "p2p")
+	trustHistoryDB, err := dbProvider(&DBContext{"trusthistory", config})
+	if err != nil {
+		return nil, err
+	}
+	trustStore := trust.NewTrustMetricStore(trustHistoryDB, trust.DefaultConfig())
+	trustStore.SetLogger(p2pLogger.With("module", "trust"))
+
+	// Setup Switch.
 	sw := createSwitch(
-		config, transport, p2pMetrics, peerFilters, mempoolReactor, bcReactor,
+		config, transport, p2pMetrics, bhMetrics, trustStore, peerFilters, mempoolReactor, bcReactor,
 		stateSyncReactor, consensusReactor, evidenceReactor, nodeInfo, nodeKey, p2pLogger,
 	)
 
@@ -910,7 +997,7 @@ func NewNode(config *cfg.Config,
 		return nil, fmt.Errorf("could not add peer ids from unconditional_peer_ids field: %w", err)
 	}
 
-	addrBook, err := createAddrBookAndSetOnSwitch(config, sw, p2pLogger, nodeKey)
+	addrBook, err := createAddrBookAndSetOnSwitch(config, sw, trustStore, p2pLogger, nodeKey)
 	if err != nil {
 		return nil, fmt.Errorf("could not create addrbook: %w", err)
 	}
@@ -945,11 +1032,12 @@ func NewNode(config *cfg.Config,
 		genesisDoc:    genDoc,
 		privValidator: privValidator,
 
-		transport: transport,
-		sw:        sw,
-		addrBook:  addrBook,
-		nodeInfo:  nodeInfo,
-		nodeKey:   nodeKey,
+		transport:  transport,
+		sw:         sw,
+		addrBook:   addrBook,
+		trustStore: trustStore,
+		nodeInfo:   nodeInfo,
+		nodeKey:    nodeKey,
 
 		stateStore:       stateStore,
 		blockStore:       blockStore,
@@ -1016,6 +1104,19 @@ func (n *Node) OnStart() error {
 
 	n.isListening = true
 
+	// Start the trust history of peers before the switch feeds it.
+	if err := n.trustStore.Start(); err != nil {
+		return err
+	}
+
+	// Replay the mempool WAL before the mempool reactor receives txs.
+	if n.config.Mempool.WalEnabled() {
+		err = n.mempool.InitWAL()
+		if err != nil {
+			return fmt.Errorf("init mempool WAL: %w", err)
+		}
+	}
+
 	// Start the switch (the P2P server).
 	err = n.sw.Start()
 	if err != nil {
@@ -1062,6 +1163,14 @@ func (n *Node) OnStop() {
 	if err := n.sw.Stop(); err != nil {
 		n.Logger.Error("Error closing switch", "err", err)
 	}
+	if err := n.trustStore.Stop(); err != nil {
+		n.Logger.Error("Error closing trust metric store", "err", err)
+	}
+
+	// stop mempool WAL
+	if n.config.Mempool.WalEnabled() {
+		n.mempool.CloseWAL()
+	}
 
 	if err := n.transport.Close(); err != nil {
 		n.Logger.Error("Error closing transport", "err", err)
@@ -1123,6 +1232,7 @@ func (n *Node) ConfigureRPC() error {
 		TxIndexer:        n.txIndexer,
 		BlockIndexer:     n.blockIndexer,
 		ConsensusReactor: n.consensusReactor,
+		StateSyncReactor: n.stateSyncReactor,
 		EventBus:         n.eventBus,
 		Mempool:          n.mempool,
 
@@ -1157,6 +1267,7 @@ func (n *Node) startRPC() ([]net.Listener, error) {
 	config.ReadTimeout = n.config.RPC.ReadTimeout
 	config.WriteTimeout = n.config.RPC.WriteTimeout
 	config.IdleTimeout = n.config.RPC.IdleTimeout
+	config.TLSClientCAFile = n.config.RPC.ClientCAFile()
 	// If necessary adjust global WriteTimeout to ensure it's greater than
 	// TimeoutBroadcastTxCommit.
 	// See https://github.com/tendermint/tendermint/issues/3435
@@ -1164,6 +1275,11 @@ func (n *Node) startRPC() ([]net.Listener, error) {
 		config.WriteTimeout = n.config.RPC.TimeoutBroadcastTxCommit + 1*time.Second
 	}
 
+	var accessControl *rpcserver.AccessControl
+	if n.config.RPC.IsAccessControlEnabled() {
+		accessControl = createRPCAccessControl(n.config.RPC, n.Logger.With("module", "rpc-server"))
+	}
+
 	// we may expose the rpc over both a unix and tcp socket
 	listeners := make([]net.Listener, len(listenAddrs))
 	for i, listenAddr := range listenAddrs {
@@ -1192,13 +1308,16 @@ func (n *Node) startRPC() ([]net.Listener, error) {
 		}
 
 		var rootHandler http.Handler = mux
+		if accessControl != nil {
+			rootHandler = accessControl.Handler(rootHandler)
+		}
 		if n.config.RPC.IsCorsEnabled() {
 			corsMiddleware := cors.New(cors.Options{
 				AllowedOrigins: n.config.RPC.CORSAllowedOrigins,
 				AllowedMethods: n.config.RPC.CORSAllowedMethods,
 				AllowedHeaders: n.config.RPC.CORSAllowedHeaders,
 			})
-			rootHandler = corsMiddleware.Handler(mux)
+			rootHandler = corsMiddleware.Handler(rootHandler)
 		}
 		if n.config.RPC.IsTLSEnabled() {
 			go func() {
@@ -1248,8 +1367,12 @@ func (n *Node) startRPC() ([]net.Listener, error) {
 		if err != nil {
 			return nil, err
 		}
+		var grpcOptions []grpccore.Option
+		if accessControl != nil {
+			grpcOptions = append(grpcOptions, grpccore.WithAccessControl(accessControl))
+		}
 		go func() {
-			if err := grpccore.StartGRPCServer(listener); err != nil {
+			if err := grpccore.StartGRPCServer(listener, grpcOptions...); err != nil {
 				n.Logger.Error("Error starting gRPC server", "err", err)
 			}
 		}()
@@ -1260,6 +1383,42 @@ func (n *Node) startRPC() ([]net.Listener, error) {
 	return listeners, nil
 }
 
+// createRPCAccessControl returns the access control of the RPC server. If
+// the callers may authenticate, the unsafe routes are only available to the
+// admins.
+func createRPCAccessControl(config *cfg.RPCConfig, logger log.Logger) *rpcserver.AccessControl {
+	options := []rpcserver.AccessControlOption{
+		rpcserver.WithIPRateLimit(config.RateLimitPerIP, config.RateLimitBurstPerIP),
+		rpcserver.WithCallerRateLimit(config.RateLimitPerToken, config.RateLimitBurstPerToken),
+	}
+	if !config.IsAuthEnabled() {
+		// nobody may authenticate, so that all the routes stay available
+		options = append(options, rpcserver.WithAnonymousRole(rpcserver.RoleAdmin))
+	}
+
+	if len(config.AdminTokens) != 0 || len(config.UserTokens) != 0 {
+		tokens := make(map[string]rpcserver.Role, len(config.AdminTokens)+len(config.UserTokens))
+		for _, token := range config.UserTokens {
+			tokens[token] = rpcserver.RoleUser
+		}
+		for _, token := range config.AdminTokens {
+			tokens[token] = rpcserver.RoleAdmin
+		}
+		options = append(options, rpcserver.WithAuthenticator(rpcserver.NewBearerTokenAuthenticator(tokens)))
+	}
+
+	if config.TLSClientCAFile != "" {
+		names := make(map[string]rpcserver.Role, len(config.TLSAdminCommonNames))
+		for _, name := range config.TLSAdminCommonNames {
+			names[name] = rpcserver.RoleAdmin
+		}
+		options = append(options,
+			rpcserver.WithAuthenticator(rpcserver.NewTLSClientAuthenticator(names, rpcserver.RoleUser)))
+	}
+
+	return rpcserver.NewAccessControl(logger, options...)
+}
+
 // startPrometheusServer starts a Prometheus HTTP server, listening for metrics
 // collectors on addr.
 func (n *Node) startPrometheusServer(addr string) *http.Server {
@@ -1548,6 +1707,21 @@ func CreateAndStartPrivValidatorSocketClient(config *cfg.Config, chainID string,
 	return pvscWithRetries, nil
 }
 
+func createPrivValidatorGRPCClient(config *cfg.Config, chainID string, logger log.Logger) (types.PrivValidator, error) {
+	pvsc, err := grpcprivval.DialRemoteSigner(config, chainID, logger.With("module", "privval"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to start private validator: %w", err)
+	}
+
+	// try to get a pubkey from private validate first time
+	_, err = pvsc.GetPubKey()
+	if err != nil {
+		return nil, fmt.Errorf("can't get pubkey: %w", err)
+	}
+
+	return pvsc, nil
+}
+
 // splitAndTrimEmpty slices s into all subslices separated by sep and returns a
 // slice of the string s with all leading and trailing Unicode code points
 // contained in cutset removed. If sep is empty, SplitAndTrim splits after each
@@ -26,8 +26,7 @@ import (
 	tmrand "github.com/Finschia/ostracon/libs/rand"
 	mempl "github.com/Finschia/ostracon/mempool"
 	mempoolv0 "github.com/Finschia/ostracon/mempool/v0"
-
-	//mempoolv1 "github.com/Finschia/ostracon/mempool/v1"
+	mempoolv1 "github.com/Finschia/ostracon/mempool/v1"
 	"github.com/Finschia/ostracon/p2p"
 	"github.com/Finschia/ostracon/p2p/conn"
 	p2pmock "github.com/Finschia/ostracon/p2p/mock"
@@ -102,6 +101,32 @@ func TestNodeStartStop(t *testing.T) {
 	}
 }
 
+func TestNodeStartStopMempoolV1(t *testing.T) {
+	config := cfg.ResetTestRoot("node_mempool_v1_test")
+	defer os.RemoveAll(config.RootDir)
+	config.Mempool.Version = cfg.MempoolV1
+
+	n, err := DefaultNewNode(config, log.TestingLogger())
+	require.NoError(t, err)
+	require.IsType(t, &mempoolv1.TxMempool{}, n.Mempool())
+	require.IsType(t, &mempoolv1.Reactor{}, n.Switch().Reactor("MEMPOOL"))
+
+	err = n.Start()
+	require.NoError(t, err)
+	defer n.Stop() //nolint:errcheck // ignore for tests
+
+	// wait for the node to produce a block
+	blocksSub, err := n.EventBus().Subscribe(context.Background(), "node_test", types.EventQueryNewBlock)
+	require.NoError(t, err)
+	select {
+	case <-blocksSub.Out():
+	case <-blocksSub.Cancelled():
+		t.Fatal("blocksSub was cancelled")
+	case <-time.After(10 * time.Second):
+		t.Fatal("timed out waiting for the node to produce a block")
+	}
+}
+
 func TestSplitAndTrimEmpty(t *testing.T) {
 	testCases := []struct {
 		s        string
@@ -288,18 +313,15 @@ func TestCreateProposalBlock(t *testing.T) {
 			mempoolv0.WithMetrics(memplMetrics),
 			mempoolv0.WithPreCheck(sm.TxPreCheck(state)),
 			mempoolv0.WithPostCheck(sm.TxPostCheck(state)))
-	case cfg.MempoolV1: // XXX Deprecated MempoolV1
-		panic("Deprecated MempoolV1")
-		/*
-			mempool = mempoolv1.NewTxMempool(logger,
-				config.Mempool,
-				proxyApp.Mempool(),
-				state.LastBlockHeight,
-				mempoolv1.WithMetrics(memplMetrics),
-				mempoolv1.WithPreCheck(sm.TxPreCheck(state)),
-				mempoolv1.WithPostCheck(sm.TxPostCheck(state)),
-			)
-		*/
+	case cfg.MempoolV1:
+		mempool = mempoolv1.NewTxMempool(logger,
+			config.Mempool,
+			proxyApp.Mempool(),
+			state.LastBlockHeight,
+			mempoolv1.WithMetrics(memplMetrics),
+			mempoolv1.WithPreCheck(sm.TxPreCheck(state)),
+			mempoolv1.WithPostCheck(sm.TxPostCheck(state)),
+		)
 	}
 
 	// Make EvidencePool
@@ -400,21 +422,19 @@ func TestMaxProposalBlockSize(t *testing.T) {
 			mempoolv0.WithMetrics(memplMetrics),
 			mempoolv0.WithPreCheck(sm.TxPreCheck(state)),
 			mempoolv0.WithPostCheck(sm.TxPostCheck(state)))
-	case cfg.MempoolV1: // XXX Deprecated
-		/*
-			mempool = mempoolv1.NewTxMempool(logger,
-				config.Mempool,
-				proxyApp.Mempool(),
-				state.LastBlockHeight,
-				mempoolv1.WithMetrics(memplMetrics),
-				mempoolv1.WithPreCheck(sm.TxPreCheck(state)),
-				mempoolv1.WithPostCheck(sm.TxPostCheck(state)),
-			)
-		*/
+	case cfg.MempoolV1:
+		mempool = mempoolv1.NewTxMempool(logger,
+			config.Mempool,
+			proxyApp.Mempool(),
+			state.LastBlockHeight,
+			mempoolv1.WithMetrics(memplMetrics),
+			mempoolv1.WithPreCheck(sm.TxPreCheck(state)),
+			mempoolv1.WithPostCheck(sm.TxPostCheck(state)),
+		)
 	}
 
 	// fill the mempool with one txs just below the maximum size
-	txLength := int(types.MaxDataBytesNoEvidence(maxBytes, 1))
+	txLength := int(types.MaxDataBytesNoEvidence(maxBytes, 1, state.ConsensusParams.Validator.PubKeyTypes))
 	tx := tmrand.Bytes(txLength - 4) // to account for the varint
 	err = mempool.CheckTxSync(tx, nil, mempl.TxInfo{})
 	assert.NoError(t, err)
@@ -525,6 +545,13 @@ func TestNodeNewNodeTxIndexIndexer(t *testing.T) {
 		require.NoError(t, err)
 		require.NotNil(t, n)
 	}
+	{
+		// Change to sqlite for test
+		config.TxIndex.Indexer = "sqlite"
+		n, err := doTest(DefaultDBProvider)
+		require.NoError(t, err)
+		require.NotNil(t, n)
+	}
 	{
 		// Change to psql for test
 		config.TxIndex.Indexer = "psql"
@@ -22,6 +22,7 @@ import (
 	"github.com/Finschia/ostracon/libs/service"
 	tmsync "github.com/Finschia/ostracon/libs/sync"
 	"github.com/Finschia/ostracon/p2p"
+	"github.com/Finschia/ostracon/p2p/trust"
 )
 
 const (
@@ -78,6 +79,9 @@ type AddrBook interface {
 
 	// Persist to disk
 	Save()
+
+	// Use the trust history of peers to pick and evict addresses
+	SetTrustMetricStore(*trust.MetricStore)
 }
 
 var _ AddrBook = (*addrBook)(nil)
@@ -98,6 +102,7 @@ type addrBook struct {
 	bucketsNew []map[string]*knownAddress
 	nOld       int
 	nNew       int
+	trustStore *trust.MetricStore
 
 	// immutable after creation
 	filePath          string
@@ -307,6 +312,9 @@ func (a *addrBook) PickAddress(biasTowardsNewAddrs int) *p2p.NetAddress {
 			bucket = a.bucketsNew[a.rand.Intn(len(a.bucketsNew))]
 		}
 	}
+	if a.trustStore != nil {
+		return a.pickTrusted(bucket).Addr
+	}
 	// pick a random index and loop over the map to return that index
 	randIndex := a.rand.Intn(len(bucket))
 	for _, ka := range bucket {
@@ -318,6 +326,15 @@ func (a *addrBook) PickAddress(biasTowardsNewAddrs int) *p2p.NetAddress {
 	return nil
 }
 
+// SetTrustMetricStore implements AddrBook - PickAddress prefers, and the
+// eviction from full buckets spares, the addresses of more trusted peers.
+func (a *addrBook) SetTrustMetricStore(store *trust.MetricStore) {
+	a.mtx.Lock()
+	defer a.mtx.Unlock()
+
+	a.trustStore = store
+}
+
 // MarkGood implements AddrBook - it marks the peer as good and
 // moves it into an "old" bucket.
 func (a *addrBook) MarkGood(id p2p.ID) {
@@ -626,17 +643,58 @@ func (a *addrBook) removeFromAllBuckets(ka *knownAddress) {
 
 //----------------------------------------------------------
 
+// pickOldest returns the least trusted address of the bucket, the oldest
+// one among equally trusted addresses.
 func (a *addrBook) pickOldest(bucketType byte, bucketIdx int) *knownAddress {
 	bucket := a.getBucket(bucketType, bucketIdx)
-	var oldest *knownAddress
+	var (
+		oldest      *knownAddress
+		oldestScore int
+	)
 	for _, ka := range bucket {
-		if oldest == nil || ka.LastAttempt.Before(oldest.LastAttempt) {
-			oldest = ka
+		score := a.trustScore(ka)
+		if oldest == nil || score < oldestScore ||
+			(score == oldestScore && ka.LastAttempt.Before(oldest.LastAttempt)) {
+			oldest, oldestScore = ka, score
 		}
 	}
 	return oldest
 }
 
+// pickTrusted picks a random address of the non-empty bucket, with a
+// probability proportional to the trust score of the peer.
+func (a *addrBook) pickTrusted(bucket map[string]*knownAddress) *knownAddress {
+	var (
+		kas     = make([]*knownAddress, 0, len(bucket))
+		weights = make([]int, 0, len(bucket))
+		total   = 0
+	)
+	for _, ka := range bucket {
+		// +1 so that distrusted peers still have a chance to redeem themselves
+		weight := a.trustScore(ka) + 1
+		kas = append(kas, ka)
+		weights = append(weights, weight)
+		total += weight
+	}
+	r := a.rand.Intn(total)
+	for i, weight := range weights {
+		if r < weight {
+			return kas[i]
+		}
+		r -= weight
+	}
+	return kas[len(kas)-1]
+}
+
+// trustScore returns the trust score of the peer at the address, or the
+// maximum score if there is no trust metric store.
+func (a *addrBook) trustScore(ka *knownAddress) int {
+	if a.trustStore == nil {
+		return 100
+	}
+	return a.trustStore.PeerTrustScore(string(ka.ID()))
+}
+
 // adds the address to a "new" bucket. if its already in one,
 // it only adds it probabilistically
 func (a *addrBook) addAddress(addr, src *p2p.NetAddress) error {
@@ -11,11 +11,13 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
 
 	"github.com/Finschia/ostracon/libs/log"
 	tmmath "github.com/Finschia/ostracon/libs/math"
 	tmrand "github.com/Finschia/ostracon/libs/rand"
 	"github.com/Finschia/ostracon/p2p"
+	"github.com/Finschia/ostracon/p2p/trust"
 )
 
 // FIXME These tests should not rely on .(*addrBook) assertions
@@ -57,6 +59,48 @@ func TestAddrBookPickAddress(t *testing.T) {
 	assert.Nil(t, addr, "did not expected an address")
 }
 
+func TestAddrBookPrefersTrustedAddresses(t *testing.T) {
+	fname := createTempFileName("addrbook_test")
+	defer deleteTempFile(fname)
+
+	trustStore := trust.NewTrustMetricStore(dbm.NewMemDB(), trust.DefaultConfig())
+	trustStore.SetLogger(log.TestingLogger())
+	require.NoError(t, trustStore.Start())
+	defer trustStore.Stop() // nolint:errcheck // ignore for tests
+
+	book := NewAddrBook(fname, true).(*addrBook)
+	book.SetLogger(log.TestingLogger())
+	book.SetTrustMetricStore(trustStore)
+
+	// put a trusted and a distrusted address in the same bucket
+	randAddrs := randNetAddressPairs(t, 2)
+	trusted := newKnownAddress(randAddrs[0].addr, randAddrs[0].src)
+	distrusted := newKnownAddress(randAddrs[1].addr, randAddrs[1].src)
+	// the distrusted address is the most recently attempted one
+	trusted.LastAttempt = time.Now().Add(-time.Hour)
+	distrusted.LastAttempt = time.Now()
+	require.NoError(t, book.addToNewBucket(trusted, 0))
+	require.NoError(t, book.addToNewBucket(distrusted, 0))
+
+	// without any history, the oldest address is evicted
+	assert.Equal(t, trusted, book.pickOldest(bucketTypeNew, 0))
+
+	trustStore.GetPeerTrustMetric(string(distrusted.ID())).BadEvents(10)
+	score := trustStore.PeerTrustScore(string(distrusted.ID()))
+	require.Less(t, score, 100)
+
+	// the least trusted address is evicted first
+	assert.Equal(t, distrusted, book.pickOldest(bucketTypeNew, 0))
+
+	// the trusted address is picked proportionally more often
+	picks := make(map[p2p.ID]int)
+	for i := 0; i < 1000; i++ {
+		picks[book.PickAddress(100).ID]++
+	}
+	assert.Greater(t, picks[trusted.ID()], picks[distrusted.ID()])
+	assert.Equal(t, 1000, picks[trusted.ID()]+picks[distrusted.ID()])
+}
+
 func TestAddrBookSaveLoad(t *testing.T) {
 	fname := createTempFileName("addrbook_test")
 	defer deleteTempFile(fname)
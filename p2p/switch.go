@@ -13,6 +13,7 @@ import (
 	"github.com/Finschia/ostracon/libs/rand"
 	"github.com/Finschia/ostracon/libs/service"
 	"github.com/Finschia/ostracon/p2p/conn"
+	"github.com/Finschia/ostracon/p2p/trust"
 )
 
 const (
@@ -29,6 +30,10 @@ const (
 	// ie. 3**10 = 16hrs
 	reconnectBackOffAttempts    = 10
 	reconnectBackOffBaseSeconds = 3
+
+	// peers with a trust score below the maximum are dialed and reconnected
+	// after an extra delay, ie. up to 100 * 500ms = 50s for a score of 0
+	untrustedDialDelayPerPoint = 500 * time.Millisecond
 )
 
 // MConnConfig returns an MConnConfig with fields updated
@@ -95,6 +100,8 @@ type Switch struct {
 
 	metrics *Metrics
 	mlc     *metricsLabelCache
+
+	trustStore *trust.MetricStore // optional; keeps per-peer trust history
 }
 
 // NetAddress returns the address the switch is listening on.
@@ -157,6 +164,12 @@ func WithMetrics(metrics *Metrics) SwitchOption {
 	return func(sw *Switch) { sw.metrics = metrics }
 }
 
+// WithTrustMetricStore sets the store used to keep track of the trust
+// history of peers. The caller is responsible for starting and stopping it.
+func WithTrustMetricStore(store *trust.MetricStore) SwitchOption {
+	return func(sw *Switch) { sw.trustStore = store }
+}
+
 //---------------------------------------------------------------------
 // Switch setup
 
@@ -365,7 +378,8 @@ func (sw *Switch) Peers() IPeerSet {
 
 // StopPeerForError disconnects from a peer due to external error.
 // If the peer is persistent, it will attempt to reconnect.
-// TODO: make record depending on reason.
+// It does not lower the trust of the peer, as the error may be a mere
+// connection error or timeout; see MarkPeerAsBad.
 func (sw *Switch) StopPeerForError(peer Peer, reason interface{}) {
 	if !peer.IsRunning() {
 		return
@@ -419,6 +433,10 @@ func (sw *Switch) stopAndRemovePeer(peer Peer, reason interface{}) {
 		// We keep this message here as information to the developer.
 		sw.Logger.Debug("error on peer removal", ",", "peer", peer.ID())
 	}
+
+	if sw.trustStore != nil {
+		sw.trustStore.PeerDisconnected(string(peer.ID()))
+	}
 }
 
 // reconnectToPeer tries to reconnect to the addr, first repeatedly
@@ -438,6 +456,11 @@ func (sw *Switch) reconnectToPeer(addr *NetAddress) {
 
 	start := time.Now()
 	sw.Logger.Info("Reconnecting to peer", "addr", addr)
+	// do not redial misbehaving peers at once
+	if delay := sw.untrustedDialDelay(addr.ID); delay > 0 {
+		sw.Logger.Info("Delaying reconnection to untrusted peer", "addr", addr, "delay", delay)
+		sw.randomSleep(delay)
+	}
 	for i := 0; i < reconnectAttempts; i++ {
 		if !sw.IsRunning() {
 			return
@@ -486,11 +509,38 @@ func (sw *Switch) SetAddrBook(addrBook AddrBook) {
 // MarkPeerAsGood marks the given peer as good when it did something useful
 // like contributed to consensus.
 func (sw *Switch) MarkPeerAsGood(peer Peer) {
+	if sw.trustStore != nil {
+		sw.trustStore.GetPeerTrustMetric(string(peer.ID())).GoodEvents(1)
+	}
 	if sw.addrBook != nil {
 		sw.addrBook.MarkGood(peer.ID())
 	}
 }
 
+// MarkPeerAsBad lowers the trust of the given peer when it misbehaved,
+// like sent an invalid message.
+func (sw *Switch) MarkPeerAsBad(peer Peer) {
+	if sw.trustStore != nil {
+		sw.trustStore.GetPeerTrustMetric(string(peer.ID())).BadEvents(1)
+	}
+}
+
+// PeerTrustScore returns the trust score, between 0 and 100, of the peer
+// with the given ID. Peers are fully trusted until they misbehave, so the
+// score is 100 for unknown peers or if the switch has no trust metric store.
+func (sw *Switch) PeerTrustScore(id ID) int {
+	if sw.trustStore == nil {
+		return 100
+	}
+	return sw.trustStore.PeerTrustScore(string(id))
+}
+
+// untrustedDialDelay returns how long to wait before dialing the peer with
+// the given ID, based on its trust score.
+func (sw *Switch) untrustedDialDelay(id ID) time.Duration {
+	return time.Duration(100-sw.PeerTrustScore(id)) * untrustedDialDelayPerPoint
+}
+
 //---------------------------------------------------------------------
 // Dialing
 
@@ -552,6 +602,7 @@ func (sw *Switch) dialPeersAsync(netAddrs []*NetAddress) {
 	}
 
 	// permute the list, dial them in random order.
+	// Less trusted peers are dialed after an extra delay.
 	perm := sw.rng.Perm(len(netAddrs))
 	for i := 0; i < len(perm); i++ {
 		go func(i int) {
@@ -563,7 +614,7 @@ func (sw *Switch) dialPeersAsync(netAddrs []*NetAddress) {
 				return
 			}
 
-			sw.randomSleep(0)
+			sw.randomSleep(sw.untrustedDialDelay(addr.ID))
 
 			err := sw.DialPeerWithAddress(addr)
 			if err != nil {
@@ -19,6 +19,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	p2pproto "github.com/tendermint/tendermint/proto/tendermint/p2p"
+	dbm "github.com/tendermint/tm-db"
 
 	"github.com/Finschia/ostracon/config"
 	"github.com/Finschia/ostracon/crypto/ed25519"
@@ -26,6 +27,7 @@ import (
 	tmnet "github.com/Finschia/ostracon/libs/net"
 	tmsync "github.com/Finschia/ostracon/libs/sync"
 	"github.com/Finschia/ostracon/p2p/conn"
+	"github.com/Finschia/ostracon/p2p/trust"
 )
 
 var cfg *config.P2PConfig
@@ -488,6 +490,67 @@ func TestSwitchStopPeerForError(t *testing.T) {
 	assert.EqualValues(t, 0, peersMetricValue())
 }
 
+func TestSwitchTrustMetricStore(t *testing.T) {
+	trustDB := dbm.NewMemDB()
+	trustStore := trust.NewTrustMetricStore(trustDB, trust.DefaultConfig())
+	trustStore.SetLogger(log.TestingLogger())
+	require.NoError(t, trustStore.Start())
+	t.Cleanup(func() {
+		if err := trustStore.Stop(); err != nil {
+			t.Error(err)
+		}
+	})
+
+	sw := MakeSwitch(cfg, 1, "testing", "123.123.123", initSwitchFunc, WithTrustMetricStore(trustStore))
+	err := sw.Start()
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		if err := sw.Stop(); err != nil {
+			t.Error(err)
+		}
+	})
+
+	// simulate remote peer
+	rp := &remotePeer{PrivKey: ed25519.GenPrivKey(), Config: cfg}
+	rp.Start()
+	defer rp.Stop()
+
+	// unknown peers are fully trusted
+	assert.Equal(t, 100, sw.PeerTrustScore(rp.ID()))
+	assert.Zero(t, sw.untrustedDialDelay(rp.ID()))
+
+	err = sw.DialPeerWithAddress(rp.Addr())
+	require.NoError(t, err)
+	p := sw.Peers().Get(rp.ID())
+	require.NotNil(t, p)
+
+	sw.MarkPeerAsGood(p)
+	assert.Equal(t, 100, sw.PeerTrustScore(rp.ID()))
+
+	// plain errors do not lower the trust
+	sw.StopPeerForError(p, fmt.Errorf("some err"))
+	assert.Nil(t, sw.Peers().Get(rp.ID()))
+	assert.Equal(t, 100, sw.PeerTrustScore(rp.ID()))
+
+	err = sw.DialPeerWithAddress(rp.Addr())
+	require.NoError(t, err)
+	p = sw.Peers().Get(rp.ID())
+	require.NotNil(t, p)
+
+	// misbehaviours do
+	sw.MarkPeerAsBad(p)
+	sw.StopPeerForError(p, fmt.Errorf("bad message"))
+	assert.Nil(t, sw.Peers().Get(rp.ID()))
+
+	score := sw.PeerTrustScore(rp.ID())
+	assert.Less(t, score, 100)
+	assert.Equal(t, time.Duration(100-score)*untrustedDialDelayPerPoint, sw.untrustedDialDelay(rp.ID()))
+
+	// the trust history is kept across switches
+	sw2 := MakeSwitch(cfg, 2, "testing", "123.123.123", initSwitchFunc, WithTrustMetricStore(trustStore))
+	assert.Equal(t, score, sw2.PeerTrustScore(rp.ID()))
+}
+
 func TestSwitchReconnectsToOutboundPersistentPeer(t *testing.T) {
 	sw := MakeSwitch(cfg, 1, "testing", "123.123.123", initSwitchFunc)
 	err := sw.Start()
@@ -25,6 +25,9 @@ type MetricStore struct {
 	// Maps a Peer.Key to that peer's TrustMetric
 	peerMetrics map[string]*Metric
 
+	// Maps a Peer.Key to the time that peer disconnected, if it did
+	disconnectedAt map[string]time.Time
+
 	// Mutex that protects the map and history data file
 	mtx tmsync.Mutex
 
@@ -40,9 +43,10 @@ type MetricStore struct {
 // Use Start to to initialize the trust metric store
 func NewTrustMetricStore(db dbm.DB, tmc MetricConfig) *MetricStore {
 	tms := &MetricStore{
-		peerMetrics: make(map[string]*Metric),
-		db:          db,
-		config:      tmc,
+		peerMetrics:    make(map[string]*Metric),
+		disconnectedAt: make(map[string]time.Time),
+		db:             db,
+		config:         tmc,
 	}
 
 	tms.BaseService = *service.NewBaseService(nil, "MetricStore", tms)
@@ -106,6 +110,7 @@ func (tms *MetricStore) GetPeerTrustMetric(key string) *Metric {
 	tms.mtx.Lock()
 	defer tms.mtx.Unlock()
 
+	delete(tms.disconnectedAt, key)
 	tm, ok := tms.peerMetrics[key]
 	if !ok {
 		// If the metric is not available, we will create it
@@ -119,6 +124,19 @@ func (tms *MetricStore) GetPeerTrustMetric(key string) *Metric {
 	return tm
 }
 
+// PeerTrustScore returns the trust score of the peer identified by the key,
+// between 0 and 100. Unlike GetPeerTrustMetric it does not create a metric
+// for unknown peers, which have the maximum score
+func (tms *MetricStore) PeerTrustScore(key string) int {
+	tms.mtx.Lock()
+	defer tms.mtx.Unlock()
+
+	if tm, ok := tms.peerMetrics[key]; ok {
+		return tm.TrustScore()
+	}
+	return 100
+}
+
 // PeerDisconnected pauses the trust metric associated with the peer identified by the key
 func (tms *MetricStore) PeerDisconnected(key string) {
 	tms.mtx.Lock()
@@ -127,6 +145,31 @@ func (tms *MetricStore) PeerDisconnected(key string) {
 	// If the Peer that disconnected has a metric, pause it
 	if tm, ok := tms.peerMetrics[key]; ok {
 		tm.Pause()
+		tms.disconnectedAt[key] = time.Now()
+	}
+}
+
+// RemovePeerTrustMetric stops and removes the trust metric associated with
+// the peer identified by the key, forgetting its history
+func (tms *MetricStore) RemovePeerTrustMetric(key string) {
+	tms.mtx.Lock()
+	defer tms.mtx.Unlock()
+
+	tms.removePeerTrustMetric(key)
+}
+
+// ExpirePeerTrustMetrics removes the trust metrics of the peers which have
+// been disconnected for longer than the tracking window, so that the store
+// does not grow without bound. Their history would be mostly faded anyway
+func (tms *MetricStore) ExpirePeerTrustMetrics(now time.Time) {
+	tms.mtx.Lock()
+	defer tms.mtx.Unlock()
+
+	window := customConfig(tms.config).TrackingWindow
+	for key, at := range tms.disconnectedAt {
+		if now.Sub(at) > window {
+			tms.removePeerTrustMetric(key)
+		}
 	}
 }
 
@@ -146,6 +189,18 @@ func (tms *MetricStore) size() int {
 	return len(tms.peerMetrics)
 }
 
+// removePeerTrustMetric stops and removes the trust metric of the peer
+// without acquiring the mutex
+func (tms *MetricStore) removePeerTrustMetric(key string) {
+	if tm, ok := tms.peerMetrics[key]; ok {
+		if err := tm.Stop(); err != nil {
+			tms.Logger.Error("unable to stop metric", "error", err)
+		}
+		delete(tms.peerMetrics, key)
+	}
+	delete(tms.disconnectedAt, key)
+}
+
 /* Loading & Saving */
 /* Both loadFromDB and savetoDB assume the mutex has been acquired */
 
@@ -168,7 +223,8 @@ func (tms *MetricStore) loadFromDB() bool {
 	}
 
 	// If history data exists in the file,
-	// load it into trust metric
+	// load it into trust metric. None of the peers is connected yet
+	now := time.Now()
 	for key, p := range peers {
 		tm := NewMetricWithConfig(tms.config)
 
@@ -178,6 +234,7 @@ func (tms *MetricStore) loadFromDB() bool {
 		tm.Init(p)
 		// Load the peer trust metric into the store
 		tms.peerMetrics[key] = tm
+		tms.disconnectedAt[key] = now
 	}
 	return true
 }
@@ -212,6 +269,7 @@ loop:
 	for {
 		select {
 		case <-t.C:
+			tms.ExpirePeerTrustMetrics(time.Now())
 			tms.SaveToDB()
 		case <-tms.Quit():
 			break loop
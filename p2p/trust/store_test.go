@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/Finschia/ostracon/libs/log"
 
@@ -161,6 +162,49 @@ func TestTrustMetricStorePeerScore(t *testing.T) {
 	// We will remember our experiences with this peer
 	tm = store.GetPeerTrustMetric(key)
 	assert.NotEqual(t, 100, tm.TrustScore())
+	assert.Equal(t, tm.TrustScore(), store.PeerTrustScore(key))
+
+	// Looking up the score of an unknown peer does not create a metric
+	size := store.Size()
+	assert.Equal(t, 100, store.PeerTrustScore("UnknownKey"))
+	assert.Equal(t, size, store.Size())
+	err = store.Stop()
+	require.NoError(t, err)
+}
+
+func TestTrustMetricStoreExpire(t *testing.T) {
+	historyDB, err := dbm.NewDB("", "memdb", "")
+	require.NoError(t, err)
+
+	config := DefaultConfig()
+	store := NewTrustMetricStore(historyDB, config)
+	store.SetLogger(log.TestingLogger())
+	err = store.Start()
+	require.NoError(t, err)
+
+	store.GetPeerTrustMetric("connected").BadEvents(1)
+	store.GetPeerTrustMetric("disconnected").BadEvents(1)
+	store.GetPeerTrustMetric("reconnected").BadEvents(1)
+	store.PeerDisconnected("disconnected")
+	store.PeerDisconnected("reconnected")
+	store.GetPeerTrustMetric("reconnected")
+	assert.Equal(t, 3, store.Size())
+
+	// The metrics are kept within the tracking window
+	store.ExpirePeerTrustMetrics(time.Now())
+	assert.Equal(t, 3, store.Size())
+
+	// Only the metric of the disconnected peer expires after it
+	store.ExpirePeerTrustMetrics(time.Now().Add(config.TrackingWindow + time.Minute))
+	assert.Equal(t, 2, store.Size())
+	assert.Equal(t, 100, store.PeerTrustScore("disconnected"))
+	assert.NotEqual(t, 100, store.PeerTrustScore("connected"))
+
+	// Removing a metric forgets the history of the peer
+	store.RemovePeerTrustMetric("reconnected")
+	assert.Equal(t, 1, store.Size())
+	assert.Equal(t, 100, store.PeerTrustScore("reconnected"))
+
 	err = store.Stop()
 	require.NoError(t, err)
 }
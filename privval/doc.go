@@ -23,5 +23,12 @@ SignerDialerEndpoint is a simple wrapper around a net.Conn. It's used by both IP
 SignerClient handles remote validator connections that provide signing services.
 In production, it's recommended to wrap it with RetrySignerClient to avoid
 termination in case of temporary errors.
+
+# gRPC
+
+The grpc subpackage provides the same over gRPC: its SignerClient dials an
+external process serving the PrivValidatorAPI service, which SignerServer
+implements on top of any PrivValidator. It replaces SignerListenerEndpoint when
+priv_validator_laddr has the grpc:// scheme.
 */
 package privval
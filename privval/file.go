@@ -12,6 +12,7 @@ import (
 
 	"github.com/Finschia/ostracon/crypto"
 	"github.com/Finschia/ostracon/crypto/ed25519"
+	"github.com/Finschia/ostracon/crypto/secp256k1"
 	tmbytes "github.com/Finschia/ostracon/libs/bytes"
 	tmjson "github.com/Finschia/ostracon/libs/json"
 	tmos "github.com/Finschia/ostracon/libs/os"
@@ -122,6 +123,104 @@ func (lss *FilePVLastSignState) CheckHRS(height int64, round int32, step int8) (
 	return false, nil
 }
 
+// SignVote checks if the vote is good to sign, signs it with signFn, which
+// must set the vote signature, and persists the new state.
+// It may need to set the timestamp as well if the vote is otherwise the same as
+// a previously signed vote (ie. we crashed after signing but before the vote hit the WAL).
+// In that case signFn is not called.
+func (lss *FilePVLastSignState) SignVote(chainID string, vote *tmproto.Vote, signFn func(*tmproto.Vote) error) error {
+	height, round, step := vote.Height, vote.Round, voteToStep(vote)
+
+	sameHRS, err := lss.CheckHRS(height, round, step)
+	if err != nil {
+		return err
+	}
+
+	signBytes := types.VoteSignBytes(chainID, vote)
+
+	// We might crash before writing to the wal,
+	// causing us to try to re-sign for the same HRS.
+	// If signbytes are the same, use the last signature.
+	// If they only differ by timestamp, use last timestamp and signature
+	// Otherwise, return error
+	if sameHRS {
+		if bytes.Equal(signBytes, lss.SignBytes) {
+			vote.Signature = lss.Signature
+		} else if timestamp, ok := checkVotesOnlyDifferByTimestamp(lss.SignBytes, signBytes); ok {
+			vote.Timestamp = timestamp
+			vote.Signature = lss.Signature
+		} else {
+			err = fmt.Errorf("conflicting data")
+		}
+		return err
+	}
+
+	// It passed the checks. Sign the vote
+	if err := signFn(vote); err != nil {
+		return err
+	}
+	// The signer may have reused its own last timestamp, so take the sign bytes again.
+	lss.saveSigned(height, round, step, types.VoteSignBytes(chainID, vote), vote.Signature)
+	return nil
+}
+
+// SignProposal checks if the proposal is good to sign, signs it with signFn,
+// which must set the proposal signature, and persists the new state.
+// It may need to set the timestamp as well if the proposal is otherwise the same as
+// a previously signed proposal ie. we crashed after signing but before the proposal hit the WAL).
+// In that case signFn is not called.
+func (lss *FilePVLastSignState) SignProposal(
+	chainID string,
+	proposal *tmproto.Proposal,
+	signFn func(*tmproto.Proposal) error,
+) error {
+	height, round, step := proposal.Height, proposal.Round, stepPropose
+
+	sameHRS, err := lss.CheckHRS(height, round, step)
+	if err != nil {
+		return err
+	}
+
+	signBytes := types.ProposalSignBytes(chainID, proposal)
+
+	// We might crash before writing to the wal,
+	// causing us to try to re-sign for the same HRS.
+	// If signbytes are the same, use the last signature.
+	// If they only differ by timestamp, use last timestamp and signature
+	// Otherwise, return error
+	if sameHRS {
+		if bytes.Equal(signBytes, lss.SignBytes) {
+			proposal.Signature = lss.Signature
+		} else if timestamp, ok := checkProposalsOnlyDifferByTimestamp(lss.SignBytes, signBytes); ok {
+			proposal.Timestamp = timestamp
+			proposal.Signature = lss.Signature
+		} else {
+			err = fmt.Errorf("conflicting data")
+		}
+		return err
+	}
+
+	// It passed the checks. Sign the proposal
+	if err := signFn(proposal); err != nil {
+		return err
+	}
+	// The signer may have reused its own last timestamp, so take the sign bytes again.
+	lss.saveSigned(height, round, step, types.ProposalSignBytes(chainID, proposal), proposal.Signature)
+	return nil
+}
+
+// Persist height/round/step and signature
+func (lss *FilePVLastSignState) saveSigned(height int64, round int32, step int8,
+	signBytes []byte, sig []byte,
+) {
+	lss.Height = height
+	lss.Round = round
+	lss.Step = step
+	lss.Signature = sig
+	lss.SignBytes = signBytes
+	lss.Save()
+}
+
 // Save persists the FilePvLastSignState to its filePath.
 func (lss *FilePVLastSignState) Save() {
 	outFile := lss.filePath
@@ -138,6 +237,25 @@ func (lss *FilePVLastSignState) Save() {
 	}
 }
 
+// LoadOrGenFilePVLastSignState loads a FilePVLastSignState from the filePath,
+// or else returns an empty one, which is saved to the filePath on the first
+// signature. It lets other signers share the double signing protection of
+// FilePV.
+func LoadOrGenFilePVLastSignState(filePath string) (*FilePVLastSignState, error) {
+	lss := &FilePVLastSignState{Step: stepNone}
+	if tmos.FileExists(filePath) {
+		stateJSONBytes, err := os.ReadFile(filePath)
+		if err != nil {
+			return nil, err
+		}
+		if err := tmjson.Unmarshal(stateJSONBytes, lss); err != nil {
+			return nil, fmt.Errorf("error reading last sign state from %v: %w", filePath, err)
+		}
+	}
+	lss.filePath = filePath
+	return lss, nil
+}
+
 //-------------------------------------------------------------------------------
 
 // FilePV implements PrivValidator using data persisted to disk
@@ -172,6 +290,21 @@ func GenFilePV(keyFilePath, stateFilePath string) *FilePV {
 	return NewFilePV(ed25519.GenPrivKey(), keyFilePath, stateFilePath)
 }
 
+// GenFilePVWithKeyType generates a new validator with randomly generated
+// private key of the given type and sets the filePaths, but does not call
+// Save(). Only the key types which are able to appear in a validator set are
+// supported.
+func GenFilePVWithKeyType(keyFilePath, stateFilePath, keyType string) (*FilePV, error) {
+	switch keyType {
+	case types.ABCIPubKeyTypeEd25519:
+		return NewFilePV(ed25519.GenPrivKey(), keyFilePath, stateFilePath), nil
+	case types.ABCIPubKeyTypeSecp256k1:
+		return NewFilePV(secp256k1.GenPrivKey(), keyFilePath, stateFilePath), nil
+	default:
+		return nil, fmt.Errorf("key type %q is not supported", keyType)
+	}
+}
+
 // LoadFilePV loads a FilePV from the filePaths.  The FilePV handles double
 // signing prevention by persisting data to the stateFilePath.  If either file path
 // does not exist, the program will exit.
@@ -303,99 +436,27 @@ func (pv *FilePV) String() string {
 //------------------------------------------------------------------------------------
 
 // signVote checks if the vote is good to sign and sets the vote signature.
-// It may need to set the timestamp as well if the vote is otherwise the same as
-// a previously signed vote (ie. we crashed after signing but before the vote hit the WAL).
 func (pv *FilePV) signVote(chainID string, vote *tmproto.Vote) error {
-	height, round, step := vote.Height, vote.Round, voteToStep(vote)
-
-	lss := pv.LastSignState
-
-	sameHRS, err := lss.CheckHRS(height, round, step)
-	if err != nil {
-		return err
-	}
-
-	signBytes := types.VoteSignBytes(chainID, vote)
-
-	// We might crash before writing to the wal,
-	// causing us to try to re-sign for the same HRS.
-	// If signbytes are the same, use the last signature.
-	// If they only differ by timestamp, use last timestamp and signature
-	// Otherwise, return error
-	if sameHRS {
-		if bytes.Equal(signBytes, lss.SignBytes) {
-			vote.Signature = lss.Signature
-		} else if timestamp, ok := checkVotesOnlyDifferByTimestamp(lss.SignBytes, signBytes); ok {
-			vote.Timestamp = timestamp
-			vote.Signature = lss.Signature
-		} else {
-			err = fmt.Errorf("conflicting data")
+	return pv.LastSignState.SignVote(chainID, vote, func(vote *tmproto.Vote) error {
+		sig, err := pv.Key.PrivKey.Sign(types.VoteSignBytes(chainID, vote))
+		if err != nil {
+			return err
 		}
-		return err
-	}
-
-	// It passed the checks. Sign the vote
-	sig, err := pv.Key.PrivKey.Sign(signBytes)
-	if err != nil {
-		return err
-	}
-	pv.saveSigned(height, round, step, signBytes, sig)
-	vote.Signature = sig
-	return nil
+		vote.Signature = sig
+		return nil
+	})
 }
 
 // signProposal checks if the proposal is good to sign and sets the proposal signature.
-// It may need to set the timestamp as well if the proposal is otherwise the same as
-// a previously signed proposal ie. we crashed after signing but before the proposal hit the WAL).
 func (pv *FilePV) signProposal(chainID string, proposal *tmproto.Proposal) error {
-	height, round, step := proposal.Height, proposal.Round, stepPropose
-
-	lss := pv.LastSignState
-
-	sameHRS, err := lss.CheckHRS(height, round, step)
-	if err != nil {
-		return err
-	}
-
-	signBytes := types.ProposalSignBytes(chainID, proposal)
-
-	// We might crash before writing to the wal,
-	// causing us to try to re-sign for the same HRS.
-	// If signbytes are the same, use the last signature.
-	// If they only differ by timestamp, use last timestamp and signature
-	// Otherwise, return error
-	if sameHRS {
-		if bytes.Equal(signBytes, lss.SignBytes) {
-			proposal.Signature = lss.Signature
-		} else if timestamp, ok := checkProposalsOnlyDifferByTimestamp(lss.SignBytes, signBytes); ok {
-			proposal.Timestamp = timestamp
-			proposal.Signature = lss.Signature
-		} else {
-			err = fmt.Errorf("conflicting data")
+	return pv.LastSignState.SignProposal(chainID, proposal, func(proposal *tmproto.Proposal) error {
+		sig, err := pv.Key.PrivKey.Sign(types.ProposalSignBytes(chainID, proposal))
+		if err != nil {
+			return err
 		}
-		return err
-	}
-
-	// It passed the checks. Sign the proposal
-	sig, err := pv.Key.PrivKey.Sign(signBytes)
-	if err != nil {
-		return err
-	}
-	pv.saveSigned(height, round, step, signBytes, sig)
-	proposal.Signature = sig
-	return nil
-}
-
-// Persist height/round/step and signature
-func (pv *FilePV) saveSigned(height int64, round int32, step int8,
-	signBytes []byte, sig []byte,
-) {
-	pv.LastSignState.Height = height
-	pv.LastSignState.Round = round
-	pv.LastSignState.Step = step
-	pv.LastSignState.Signature = sig
-	pv.LastSignState.SignBytes = signBytes
-	pv.LastSignState.Save()
+		proposal.Signature = sig
+		return nil
+	})
 }
 
 //-----------------------------------------------------------------------------------------
@@ -32,6 +32,32 @@ func TestGenFilePV(t *testing.T) {
 	require.Equal(t, ed25519.KeyType, privVal.Key.PubKey.Type())
 }
 
+func TestGenFilePVWithKeyType(t *testing.T) {
+	tempKeyFile, err := os.CreateTemp("", "priv_validator_key_")
+	require.Nil(t, err)
+	tempStateFile, err := os.CreateTemp("", "priv_validator_state_")
+	require.Nil(t, err)
+
+	for _, keyType := range []string{types.ABCIPubKeyTypeEd25519, types.ABCIPubKeyTypeSecp256k1} {
+		privVal, err := GenFilePVWithKeyType(tempKeyFile.Name(), tempStateFile.Name(), keyType)
+		require.Nil(t, err)
+		require.Equal(t, keyType, privVal.Key.PubKey.Type())
+
+		// the key round trips through the JSON key file
+		privVal.Save()
+		loaded := LoadFilePV(tempKeyFile.Name(), tempStateFile.Name())
+		require.Equal(t, privVal.Key.PrivKey, loaded.Key.PrivKey)
+		require.Equal(t, privVal.Key.PubKey, loaded.Key.PubKey)
+		require.Equal(t, privVal.Key.Address, loaded.Key.Address)
+	}
+
+	// sr25519 keys can't be encoded in validator sets yet
+	for _, keyType := range []string{types.ABCIPubKeyTypeSr25519, "potatoes"} {
+		_, err = GenFilePVWithKeyType(tempKeyFile.Name(), tempStateFile.Name(), keyType)
+		require.Error(t, err)
+	}
+}
+
 func TestGenLoadValidator(t *testing.T) {
 	assert := assert.New(t)
 
@@ -285,17 +311,24 @@ func TestGenerateVRFProof(t *testing.T) {
 	tempStateFile, err := os.CreateTemp("", "priv_validator_state_")
 	require.Nil(t, err)
 
-	privVal := GenFilePV(tempKeyFile.Name(), tempStateFile.Name())
-	success := [][]byte{{}, {0x00}, make([]byte, 100)}
-	for _, msg := range success {
-		proof, err := privVal.GenerateVRFProof(msg)
-		require.Nil(t, err)
-		t.Log("  Message    : ", hex.EncodeToString(msg), " -> ", hex.EncodeToString(proof[:]))
-		pubKey, err := privVal.GetPubKey()
-		require.NoError(t, err)
-		output, err := pubKey.VRFVerify(proof, msg)
+	for _, keyType := range []string{types.ABCIPubKeyTypeEd25519, types.ABCIPubKeyTypeSecp256k1} {
+		privVal, err := GenFilePVWithKeyType(tempKeyFile.Name(), tempStateFile.Name(), keyType)
 		require.Nil(t, err)
-		require.NotNil(t, output)
+		success := [][]byte{{}, {0x00}, make([]byte, 100)}
+		for _, msg := range success {
+			proof, err := privVal.GenerateVRFProof(msg)
+			require.Nil(t, err)
+			t.Log("  Message    : ", hex.EncodeToString(msg), " -> ", hex.EncodeToString(proof[:]))
+			require.NoError(t, types.ValidateProof(proof))
+			pubKey, err := privVal.GetPubKey()
+			require.NoError(t, err)
+			output, err := pubKey.VRFVerify(proof, msg)
+			require.Nil(t, err)
+			require.NotNil(t, output)
+			proofHash, err := types.ProofToHash(pubKey, proof)
+			require.NoError(t, err)
+			require.Equal(t, []byte(output), proofHash)
+		}
 	}
 }
 
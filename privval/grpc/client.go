@@ -0,0 +1,152 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	grpc "google.golang.org/grpc"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+
+	privvalproto "github.com/tendermint/tendermint/proto/tendermint/privval"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/Finschia/ostracon/crypto"
+	cryptoenc "github.com/Finschia/ostracon/crypto/encoding"
+	"github.com/Finschia/ostracon/libs/log"
+	"github.com/Finschia/ostracon/privval"
+	ocprivvalproto "github.com/Finschia/ostracon/proto/ostracon/privval"
+	"github.com/Finschia/ostracon/types"
+)
+
+// SignerClient implements PrivValidator.
+// Handles remote validator connections over the gRPC privval protocol
+type SignerClient struct {
+	logger log.Logger
+
+	conn    *grpc.ClientConn
+	client  ocprivvalproto.PrivValidatorAPIClient
+	health  healthpb.HealthClient
+	chainID string
+	timeout time.Duration
+}
+
+var _ types.PrivValidator = (*SignerClient)(nil)
+
+// NewSignerClient returns an instance of SignerClient over the given
+// connection. Each request times out after the given timeout.
+func NewSignerClient(conn *grpc.ClientConn, chainID string, timeout time.Duration, logger log.Logger) *SignerClient {
+	return &SignerClient{
+		logger:  logger,
+		conn:    conn,
+		client:  ocprivvalproto.NewPrivValidatorAPIClient(conn),
+		health:  healthpb.NewHealthClient(conn),
+		chainID: chainID,
+		timeout: timeout,
+	}
+}
+
+// Close closes the underlying connection
+func (sc *SignerClient) Close() error {
+	return sc.conn.Close()
+}
+
+// Health returns an error if the remote signer is not serving
+func (sc *SignerClient) Health() error {
+	ctx, cancel := context.WithTimeout(context.Background(), sc.timeout)
+	defer cancel()
+
+	resp, err := sc.health.Check(ctx, &healthpb.HealthCheckRequest{Service: serviceName})
+	if err != nil {
+		return sc.rpcError("Health", err)
+	}
+	if resp.Status != healthpb.HealthCheckResponse_SERVING {
+		return fmt.Errorf("remote signer is %s", resp.Status)
+	}
+	return nil
+}
+
+//--------------------------------------------------------
+// Implement PrivValidator
+
+// GetPubKey retrieves a public key from a remote signer
+// returns an error if client is not able to provide the key
+func (sc *SignerClient) GetPubKey() (crypto.PubKey, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), sc.timeout)
+	defer cancel()
+
+	resp, err := sc.client.GetPubKey(ctx, &privvalproto.PubKeyRequest{ChainId: sc.chainID})
+	if err != nil {
+		return nil, sc.rpcError("GetPubKey", err)
+	}
+	if resp.Error != nil {
+		return nil, &privval.RemoteSignerError{Code: int(resp.Error.Code), Description: resp.Error.Description}
+	}
+
+	pk, err := cryptoenc.PubKeyFromProto(&resp.PubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return pk, nil
+}
+
+// SignVote requests a remote signer to sign a vote
+func (sc *SignerClient) SignVote(chainID string, vote *tmproto.Vote) error {
+	ctx, cancel := context.WithTimeout(context.Background(), sc.timeout)
+	defer cancel()
+
+	resp, err := sc.client.SignVote(ctx, &privvalproto.SignVoteRequest{Vote: vote, ChainId: chainID})
+	if err != nil {
+		return sc.rpcError("SignVote", err)
+	}
+	if resp.Error != nil {
+		return &privval.RemoteSignerError{Code: int(resp.Error.Code), Description: resp.Error.Description}
+	}
+
+	*vote = resp.Vote
+
+	return nil
+}
+
+// SignProposal requests a remote signer to sign a proposal
+func (sc *SignerClient) SignProposal(chainID string, proposal *tmproto.Proposal) error {
+	ctx, cancel := context.WithTimeout(context.Background(), sc.timeout)
+	defer cancel()
+
+	resp, err := sc.client.SignProposal(ctx, &privvalproto.SignProposalRequest{Proposal: proposal, ChainId: chainID})
+	if err != nil {
+		return sc.rpcError("SignProposal", err)
+	}
+	if resp.Error != nil {
+		return &privval.RemoteSignerError{Code: int(resp.Error.Code), Description: resp.Error.Description}
+	}
+
+	*proposal = resp.Proposal
+
+	return nil
+}
+
+// GenerateVRFProof requests a remote signer to generate a VRF proof
+func (sc *SignerClient) GenerateVRFProof(message []byte) (crypto.Proof, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), sc.timeout)
+	defer cancel()
+
+	resp, err := sc.client.VRFProof(ctx, &ocprivvalproto.VRFProofRequest{Message: message})
+	if err != nil {
+		return nil, sc.rpcError("GenerateVRFProof", err)
+	}
+	if resp.Error != nil {
+		return nil, &privval.RemoteSignerError{Code: int(resp.Error.Code), Description: resp.Error.Description}
+	}
+
+	return resp.Proof, nil
+}
+
+// rpcError logs the status of a failed call and returns it as an error.
+func (sc *SignerClient) rpcError(method string, err error) error {
+	errStatus, _ := status.FromError(err)
+	sc.logger.Error("SignerClient::"+method, "code", errStatus.Code(), "err", errStatus.Message())
+	return errStatus.Err()
+}
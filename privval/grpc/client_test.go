@@ -0,0 +1,173 @@
+package grpc_test
+
+import (
+	"context"
+	"net"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	grpc "google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/Finschia/ostracon/crypto/ed25519"
+	"github.com/Finschia/ostracon/crypto/tmhash"
+	"github.com/Finschia/ostracon/libs/log"
+	tmrand "github.com/Finschia/ostracon/libs/rand"
+	"github.com/Finschia/ostracon/privval"
+	grpcprivval "github.com/Finschia/ostracon/privval/grpc"
+	"github.com/Finschia/ostracon/types"
+)
+
+const bufSize = 1024 * 1024
+
+func newTestSignerClient(t *testing.T, chainID string, pv types.PrivValidator) *grpcprivval.SignerClient {
+	t.Helper()
+	logger := log.TestingLogger()
+
+	lastSignState, err := privval.LoadOrGenFilePVLastSignState(filepath.Join(t.TempDir(), "signer_state.json"))
+	require.NoError(t, err)
+
+	lis := bufconn.Listen(bufSize)
+	s := grpc.NewServer()
+	grpcprivval.NewSignerServer(chainID, pv, lastSignState, logger).Register(s)
+	go func() {
+		_ = s.Serve(lis)
+	}()
+	t.Cleanup(s.Stop)
+
+	conn, err := grpc.Dial("bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	sc := grpcprivval.NewSignerClient(conn, chainID, grpcprivval.DefaultTimeout, logger)
+	t.Cleanup(func() { _ = sc.Close() })
+	return sc
+}
+
+func newTestVote(height int64, round int32, typ tmproto.SignedMsgType, blockHash []byte) *tmproto.Vote {
+	return &tmproto.Vote{
+		Type:             typ,
+		Height:           height,
+		Round:            round,
+		BlockID:          tmproto.BlockID{Hash: blockHash, PartSetHeader: tmproto.PartSetHeader{Total: 1, Hash: blockHash}},
+		Timestamp:        time.Now().UTC(),
+		ValidatorAddress: tmrand.Bytes(20),
+		ValidatorIndex:   1,
+	}
+}
+
+func TestSignerClientHealth(t *testing.T) {
+	sc := newTestSignerClient(t, tmrand.Str(12), types.NewMockPV())
+	assert.NoError(t, sc.Health())
+}
+
+func TestSignerClientGetPubKey(t *testing.T) {
+	chainID := tmrand.Str(12)
+	mockPV := types.NewMockPV()
+	sc := newTestSignerClient(t, chainID, mockPV)
+
+	pubKey, err := sc.GetPubKey()
+	require.NoError(t, err)
+	expected, err := mockPV.GetPubKey()
+	require.NoError(t, err)
+	assert.Equal(t, expected, pubKey)
+}
+
+func TestSignerClientChainIDMismatch(t *testing.T) {
+	sc := newTestSignerClient(t, tmrand.Str(12), types.NewMockPV())
+
+	vote := newTestVote(1, 0, tmproto.PrevoteType, tmhash.Sum([]byte("hash")))
+	err := sc.SignVote("other chain", vote)
+	require.Error(t, err)
+	assert.IsType(t, &privval.RemoteSignerError{}, err)
+}
+
+func TestSignerClientSignVote(t *testing.T) {
+	chainID := tmrand.Str(12)
+	mockPV := types.NewMockPV()
+	sc := newTestSignerClient(t, chainID, mockPV)
+
+	vote := newTestVote(1, 0, tmproto.PrevoteType, tmhash.Sum([]byte("hash")))
+	expected := *vote
+	require.NoError(t, mockPV.SignVote(chainID, &expected))
+
+	require.NoError(t, sc.SignVote(chainID, vote))
+	assert.Equal(t, expected.Signature, vote.Signature)
+
+	// signing the same vote again returns the same signature
+	again := *vote
+	again.Signature = nil
+	require.NoError(t, sc.SignVote(chainID, &again))
+	assert.Equal(t, vote.Signature, again.Signature)
+
+	// a conflicting vote at the same height, round and step is refused
+	conflicting := newTestVote(1, 0, tmproto.PrevoteType, tmhash.Sum([]byte("other hash")))
+	err := sc.SignVote(chainID, conflicting)
+	require.Error(t, err)
+	assert.IsType(t, &privval.RemoteSignerError{}, err)
+	assert.Nil(t, conflicting.Signature)
+
+	// so is a height regression
+	err = sc.SignVote(chainID, newTestVote(0, 0, tmproto.PrecommitType, tmhash.Sum([]byte("hash"))))
+	require.Error(t, err)
+
+	// a vote of an unknown type is refused
+	err = sc.SignVote(chainID, newTestVote(2, 0, tmproto.ProposalType, tmhash.Sum([]byte("hash"))))
+	require.Error(t, err)
+
+	// the next step is signed
+	require.NoError(t, sc.SignVote(chainID, newTestVote(1, 0, tmproto.PrecommitType, tmhash.Sum([]byte("hash")))))
+}
+
+func TestSignerClientSignProposal(t *testing.T) {
+	chainID := tmrand.Str(12)
+	mockPV := types.NewMockPV()
+	sc := newTestSignerClient(t, chainID, mockPV)
+
+	hash := tmhash.Sum([]byte("hash"))
+	proposal := &tmproto.Proposal{
+		Type:      tmproto.ProposalType,
+		Height:    1,
+		Round:     0,
+		PolRound:  -1,
+		BlockID:   tmproto.BlockID{Hash: hash, PartSetHeader: tmproto.PartSetHeader{Total: 1, Hash: hash}},
+		Timestamp: time.Now().UTC(),
+	}
+	expected := *proposal
+	require.NoError(t, mockPV.SignProposal(chainID, &expected))
+
+	require.NoError(t, sc.SignProposal(chainID, proposal))
+	assert.Equal(t, expected.Signature, proposal.Signature)
+
+	// a conflicting proposal at the same height and round is refused
+	conflicting := expected
+	conflicting.Signature = nil
+	conflicting.PolRound = 0
+	err := sc.SignProposal(chainID, &conflicting)
+	require.Error(t, err)
+	assert.IsType(t, &privval.RemoteSignerError{}, err)
+}
+
+func TestSignerClientGenerateVRFProof(t *testing.T) {
+	privKey := ed25519.GenPrivKey()
+	sc := newTestSignerClient(t, tmrand.Str(12), types.NewMockPVWithParams(privKey, false, false))
+
+	message := []byte("message")
+	proof, err := sc.GenerateVRFProof(message)
+	require.NoError(t, err)
+
+	expected, err := privKey.PubKey().VRFVerify(proof, message)
+	require.NoError(t, err)
+	output, err := types.ProofToHash(privKey.PubKey(), proof)
+	require.NoError(t, err)
+	assert.Equal(t, []byte(expected), output)
+}
@@ -0,0 +1,11 @@
+/*
+Package grpc implements the gRPC privval protocol.
+
+SignerServer serves the PrivValidatorAPI service and a health service on top
+of any PrivValidator, with the double signing protection of
+privval.FilePVLastSignState. SignerClient implements PrivValidator by calling
+the service; use DialRemoteSigner to connect to the signer configured in
+priv_validator_laddr, over mutual TLS if the client certificate, key and root
+CA files are configured.
+*/
+package grpc
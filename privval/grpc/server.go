@@ -0,0 +1,174 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+
+	grpc "google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+
+	cryptoproto "github.com/tendermint/tendermint/proto/tendermint/crypto"
+	privvalproto "github.com/tendermint/tendermint/proto/tendermint/privval"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	cryptoenc "github.com/Finschia/ostracon/crypto/encoding"
+	"github.com/Finschia/ostracon/libs/log"
+	tmsync "github.com/Finschia/ostracon/libs/sync"
+	"github.com/Finschia/ostracon/privval"
+	ocprivvalproto "github.com/Finschia/ostracon/proto/ostracon/privval"
+	"github.com/Finschia/ostracon/types"
+)
+
+// serviceName is the name the health of the signer is reported under.
+const serviceName = "ostracon.privval.PrivValidatorAPI"
+
+// SignerServer implements PrivValidatorAPIServer on top of any PrivValidator.
+// It refuses to sign anything conflicting with its last sign state, like
+// FilePV does.
+type SignerServer struct {
+	logger  log.Logger
+	chainID string
+	privVal types.PrivValidator
+
+	mtx           tmsync.Mutex
+	lastSignState *privval.FilePVLastSignState
+}
+
+var _ ocprivvalproto.PrivValidatorAPIServer = (*SignerServer)(nil)
+
+// NewSignerServer returns an instance of SignerServer. The lastSignState is
+// persisted after every signature, see privval.LoadOrGenFilePVLastSignState.
+func NewSignerServer(
+	chainID string,
+	privVal types.PrivValidator,
+	lastSignState *privval.FilePVLastSignState,
+	logger log.Logger,
+) *SignerServer {
+	return &SignerServer{
+		logger:        logger,
+		chainID:       chainID,
+		privVal:       privVal,
+		lastSignState: lastSignState,
+	}
+}
+
+// Register registers the signer and a health service reporting it as serving
+// on the gRPC server.
+func (ss *SignerServer) Register(s *grpc.Server) {
+	ocprivvalproto.RegisterPrivValidatorAPIServer(s, ss)
+
+	healthServer := health.NewServer()
+	healthServer.SetServingStatus(serviceName, healthpb.HealthCheckResponse_SERVING)
+	healthpb.RegisterHealthServer(s, healthServer)
+}
+
+// GetPubKey returns the public key of the PrivValidator.
+func (ss *SignerServer) GetPubKey(
+	ctx context.Context,
+	req *privvalproto.PubKeyRequest,
+) (*privvalproto.PubKeyResponse, error) {
+	if req.ChainId != ss.chainID {
+		ss.logger.Error("SignerServer::GetPubKey", "err", chainIDError(req.ChainId, ss.chainID))
+		return &privvalproto.PubKeyResponse{
+			PubKey: cryptoproto.PublicKey{}, Error: &privvalproto.RemoteSignerError{
+				Code: 0, Description: "unable to provide pubkey"}}, nil
+	}
+
+	pubKey, err := ss.privVal.GetPubKey()
+	if err != nil {
+		return &privvalproto.PubKeyResponse{
+			PubKey: cryptoproto.PublicKey{}, Error: &privvalproto.RemoteSignerError{Code: 0, Description: err.Error()}}, nil
+	}
+	pk, err := cryptoenc.PubKeyToProto(pubKey)
+	if err != nil {
+		return &privvalproto.PubKeyResponse{
+			PubKey: cryptoproto.PublicKey{}, Error: &privvalproto.RemoteSignerError{Code: 0, Description: err.Error()}}, nil
+	}
+
+	return &privvalproto.PubKeyResponse{PubKey: pk, Error: nil}, nil
+}
+
+// SignVote signs the vote with the PrivValidator unless it conflicts with the
+// last sign state.
+func (ss *SignerServer) SignVote(
+	ctx context.Context,
+	req *privvalproto.SignVoteRequest,
+) (*privvalproto.SignedVoteResponse, error) {
+	if req.ChainId != ss.chainID {
+		ss.logger.Error("SignerServer::SignVote", "err", chainIDError(req.ChainId, ss.chainID))
+		return &privvalproto.SignedVoteResponse{
+			Vote: tmproto.Vote{}, Error: &privvalproto.RemoteSignerError{
+				Code: 0, Description: "unable to sign vote"}}, nil
+	}
+
+	vote := req.Vote
+	if vote == nil || !types.IsVoteTypeValid(vote.Type) {
+		return &privvalproto.SignedVoteResponse{
+			Vote: tmproto.Vote{}, Error: &privvalproto.RemoteSignerError{
+				Code: 0, Description: "invalid vote"}}, nil
+	}
+
+	ss.mtx.Lock()
+	err := ss.lastSignState.SignVote(ss.chainID, vote, func(vote *tmproto.Vote) error {
+		return ss.privVal.SignVote(ss.chainID, vote)
+	})
+	ss.mtx.Unlock()
+	if err != nil {
+		return &privvalproto.SignedVoteResponse{
+			Vote: tmproto.Vote{}, Error: &privvalproto.RemoteSignerError{Code: 0, Description: err.Error()}}, nil
+	}
+
+	return &privvalproto.SignedVoteResponse{Vote: *vote, Error: nil}, nil
+}
+
+// SignProposal signs the proposal with the PrivValidator unless it conflicts
+// with the last sign state.
+func (ss *SignerServer) SignProposal(
+	ctx context.Context,
+	req *privvalproto.SignProposalRequest,
+) (*privvalproto.SignedProposalResponse, error) {
+	if req.ChainId != ss.chainID {
+		ss.logger.Error("SignerServer::SignProposal", "err", chainIDError(req.ChainId, ss.chainID))
+		return &privvalproto.SignedProposalResponse{
+			Proposal: tmproto.Proposal{}, Error: &privvalproto.RemoteSignerError{
+				Code: 0, Description: "unable to sign proposal"}}, nil
+	}
+
+	proposal := req.Proposal
+	if proposal == nil {
+		return &privvalproto.SignedProposalResponse{
+			Proposal: tmproto.Proposal{}, Error: &privvalproto.RemoteSignerError{
+				Code: 0, Description: "invalid proposal"}}, nil
+	}
+
+	ss.mtx.Lock()
+	err := ss.lastSignState.SignProposal(ss.chainID, proposal, func(proposal *tmproto.Proposal) error {
+		return ss.privVal.SignProposal(ss.chainID, proposal)
+	})
+	ss.mtx.Unlock()
+	if err != nil {
+		return &privvalproto.SignedProposalResponse{
+			Proposal: tmproto.Proposal{}, Error: &privvalproto.RemoteSignerError{Code: 0, Description: err.Error()}}, nil
+	}
+
+	return &privvalproto.SignedProposalResponse{Proposal: *proposal, Error: nil}, nil
+}
+
+// VRFProof generates a VRF proof of the message with the PrivValidator.
+func (ss *SignerServer) VRFProof(
+	ctx context.Context,
+	req *ocprivvalproto.VRFProofRequest,
+) (*ocprivvalproto.VRFProofResponse, error) {
+	proof, err := ss.privVal.GenerateVRFProof(req.Message)
+	if err != nil {
+		return &ocprivvalproto.VRFProofResponse{
+			Proof: nil, Error: &privvalproto.RemoteSignerError{Code: 0, Description: err.Error()}}, nil
+	}
+
+	return &ocprivvalproto.VRFProofResponse{Proof: proof, Error: nil}, nil
+}
+
+func chainIDError(got, want string) error {
+	return fmt.Errorf("want chainID: %s, got chainID: %s", want, got)
+}
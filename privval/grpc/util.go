@@ -0,0 +1,106 @@
+package grpc
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	grpc "google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+
+	cfg "github.com/Finschia/ostracon/config"
+	"github.com/Finschia/ostracon/libs/log"
+	tmnet "github.com/Finschia/ostracon/libs/net"
+)
+
+// DefaultTimeout is the timeout of each request to a remote signer.
+const DefaultTimeout = 5 * time.Second
+
+// DialRemoteSigner dials the gRPC remote signer configured by
+// priv_validator_laddr, with mutual TLS if the client security options are
+// configured, and checks it is healthy.
+func DialRemoteSigner(config *cfg.Config, chainID string, logger log.Logger) (*SignerClient, error) {
+	var transportSecurity grpc.DialOption
+	if config.ArePrivValidatorClientSecurityOptionsPresent() {
+		creds, err := ClientTLSCredentials(
+			config.PrivValidatorClientCertificateFile(),
+			config.PrivValidatorClientKeyFile(),
+			config.PrivValidatorRootCAFile(),
+		)
+		if err != nil {
+			return nil, err
+		}
+		transportSecurity = grpc.WithTransportCredentials(creds)
+	} else {
+		logger.Info("Using an insecure gRPC connection to the remote signer")
+		transportSecurity = grpc.WithTransportCredentials(insecure.NewCredentials())
+	}
+
+	_, address := tmnet.ProtocolAndAddress(config.PrivValidatorListenAddr)
+	conn, err := grpc.Dial(address, transportSecurity)
+	if err != nil {
+		return nil, fmt.Errorf("failed to dial remote signer %s: %w", address, err)
+	}
+
+	sc := NewSignerClient(conn, chainID, DefaultTimeout, logger)
+	if err := sc.Health(); err != nil {
+		_ = sc.Close()
+		return nil, fmt.Errorf("remote signer %s is not healthy: %w", address, err)
+	}
+
+	return sc, nil
+}
+
+// ClientTLSCredentials returns the credentials authenticating a client with the
+// certificate and key, and the server with the root CA certificate.
+func ClientTLSCredentials(certPath, keyPath, rootCAPath string) (credentials.TransportCredentials, error) {
+	certificate, certPool, err := loadKeyPairAndCA(certPath, keyPath, rootCAPath)
+	if err != nil {
+		return nil, err
+	}
+
+	return credentials.NewTLS(&tls.Config{
+		Certificates: []tls.Certificate{certificate},
+		RootCAs:      certPool,
+		MinVersion:   tls.VersionTLS13,
+	}), nil
+}
+
+// ServerTLSCredentials returns the credentials authenticating a server with the
+// certificate and key, and requiring clients to present a certificate signed by
+// the root CA.
+func ServerTLSCredentials(certPath, keyPath, rootCAPath string) (credentials.TransportCredentials, error) {
+	certificate, certPool, err := loadKeyPairAndCA(certPath, keyPath, rootCAPath)
+	if err != nil {
+		return nil, err
+	}
+
+	return credentials.NewTLS(&tls.Config{
+		Certificates: []tls.Certificate{certificate},
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		ClientCAs:    certPool,
+		MinVersion:   tls.VersionTLS13,
+	}), nil
+}
+
+func loadKeyPairAndCA(certPath, keyPath, rootCAPath string) (tls.Certificate, *x509.CertPool, error) {
+	certificate, err := tls.LoadX509KeyPair(certPath, keyPath)
+	if err != nil {
+		return tls.Certificate{}, nil, fmt.Errorf("failed to load the key pair: %w", err)
+	}
+
+	ca, err := os.ReadFile(rootCAPath)
+	if err != nil {
+		return tls.Certificate{}, nil, fmt.Errorf("failed to read the root CA certificate: %w", err)
+	}
+	certPool := x509.NewCertPool()
+	if !certPool.AppendCertsFromPEM(ca) {
+		return tls.Certificate{}, nil, errors.New("failed to append the root CA certificate to the pool")
+	}
+
+	return certificate, certPool, nil
+}
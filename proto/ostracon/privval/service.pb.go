@@ -0,0 +1,239 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: ostracon/privval/service.proto
+
+package privval
+
+import (
+	context "context"
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	privval "github.com/tendermint/tendermint/proto/tendermint/privval"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+func init() { proto.RegisterFile("ostracon/privval/service.proto", fileDescriptor_5cd6f915b031cfa7) }
+
+var fileDescriptor_5cd6f915b031cfa7 = []byte{
+	// 289 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0x41, 0x4b, 0xc3, 0x30,
+	0x18, 0x86, 0x37, 0x0f, 0x32, 0x83, 0x87, 0x91, 0x63, 0x91, 0xc0, 0x14, 0x14, 0x3c, 0xa4, 0xe0,
+	0x7e, 0x81, 0x1e, 0x26, 0x22, 0x42, 0xad, 0x50, 0xc1, 0x5b, 0xda, 0x7e, 0x6e, 0x81, 0x2e, 0x5f,
+	0x4d, 0xd2, 0xc2, 0xfe, 0x85, 0x3f, 0xcb, 0xe3, 0x8e, 0x1e, 0xa5, 0xfd, 0x11, 0x5e, 0x45, 0xdb,
+	0xd8, 0x31, 0xed, 0xae, 0x79, 0x9e, 0xef, 0x7d, 0x21, 0x2f, 0x61, 0x68, 0xac, 0x16, 0x09, 0x2a,
+	0x3f, 0xd7, 0xb2, 0x2c, 0x45, 0xe6, 0x1b, 0xd0, 0xa5, 0x4c, 0x80, 0xe7, 0x1a, 0x2d, 0xd2, 0xb1,
+	0xe3, 0xbc, 0xe5, 0x1e, 0xb3, 0xa0, 0x52, 0xd0, 0x4b, 0xa9, 0xec, 0xef, 0x8d, 0x5d, 0xe5, 0x60,
+	0x9a, 0x0b, 0xef, 0xe8, 0x4f, 0xe2, 0x06, 0xbd, 0xf8, 0xdc, 0x23, 0xe3, 0x40, 0xcb, 0x32, 0x12,
+	0x99, 0x4c, 0x85, 0x45, 0x7d, 0x19, 0xdc, 0xd0, 0x90, 0x1c, 0x5c, 0x83, 0x0d, 0x8a, 0xf8, 0x16,
+	0x56, 0x74, 0xc2, 0xbb, 0x02, 0x57, 0xca, 0x1b, 0x16, 0xc2, 0x4b, 0x01, 0xc6, 0x7a, 0xc7, 0xbb,
+	0x14, 0x93, 0xa3, 0x32, 0x40, 0x1f, 0xc9, 0xe8, 0x41, 0xce, 0x55, 0x84, 0x16, 0xe8, 0xc9, 0x7f,
+	0xbe, 0xa3, 0x2e, 0xf4, 0xb4, 0x4f, 0x82, 0xb4, 0xd1, 0xda, 0xe0, 0x84, 0x1c, 0x7e, 0xbf, 0x06,
+	0x1a, 0x73, 0x34, 0x22, 0xa3, 0x67, 0x7d, 0x77, 0xce, 0x70, 0x05, 0xe7, 0xfd, 0x05, 0x9d, 0xda,
+	0x96, 0xdc, 0x93, 0x51, 0x14, 0xce, 0x02, 0x8d, 0xf8, 0x4c, 0x27, 0x7c, 0x7b, 0x03, 0xee, 0x58,
+	0xf7, 0x21, 0x3b, 0x94, 0x26, 0xf2, 0xea, 0xee, 0xad, 0x62, 0xc3, 0x75, 0xc5, 0x86, 0x1f, 0x15,
+	0x1b, 0xbe, 0xd6, 0x6c, 0xb0, 0xae, 0xd9, 0xe0, 0xbd, 0x66, 0x83, 0xa7, 0xe9, 0x5c, 0xda, 0x45,
+	0x11, 0xf3, 0x04, 0x97, 0xfe, 0x4c, 0x2a, 0x93, 0x2c, 0xa4, 0xf0, 0x37, 0x56, 0x44, 0x8b, 0xfe,
+	0xf6, 0xa8, 0xf1, 0xfe, 0xcf, 0xfb, 0xf4, 0x6b, 0x00, 0x09, 0x7c, 0x97, 0xc8, 0x41, 0x02, 0x00,
+	0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// PrivValidatorAPIClient is the client API for PrivValidatorAPI service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type PrivValidatorAPIClient interface {
+	GetPubKey(ctx context.Context, in *privval.PubKeyRequest, opts ...grpc.CallOption) (*privval.PubKeyResponse, error)
+	SignVote(ctx context.Context, in *privval.SignVoteRequest, opts ...grpc.CallOption) (*privval.SignedVoteResponse, error)
+	SignProposal(ctx context.Context, in *privval.SignProposalRequest, opts ...grpc.CallOption) (*privval.SignedProposalResponse, error)
+	VRFProof(ctx context.Context, in *VRFProofRequest, opts ...grpc.CallOption) (*VRFProofResponse, error)
+}
+
+type privValidatorAPIClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewPrivValidatorAPIClient(cc *grpc.ClientConn) PrivValidatorAPIClient {
+	return &privValidatorAPIClient{cc}
+}
+
+func (c *privValidatorAPIClient) GetPubKey(ctx context.Context, in *privval.PubKeyRequest, opts ...grpc.CallOption) (*privval.PubKeyResponse, error) {
+	out := new(privval.PubKeyResponse)
+	err := c.cc.Invoke(ctx, "/ostracon.privval.PrivValidatorAPI/GetPubKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *privValidatorAPIClient) SignVote(ctx context.Context, in *privval.SignVoteRequest, opts ...grpc.CallOption) (*privval.SignedVoteResponse, error) {
+	out := new(privval.SignedVoteResponse)
+	err := c.cc.Invoke(ctx, "/ostracon.privval.PrivValidatorAPI/SignVote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *privValidatorAPIClient) SignProposal(ctx context.Context, in *privval.SignProposalRequest, opts ...grpc.CallOption) (*privval.SignedProposalResponse, error) {
+	out := new(privval.SignedProposalResponse)
+	err := c.cc.Invoke(ctx, "/ostracon.privval.PrivValidatorAPI/SignProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *privValidatorAPIClient) VRFProof(ctx context.Context, in *VRFProofRequest, opts ...grpc.CallOption) (*VRFProofResponse, error) {
+	out := new(VRFProofResponse)
+	err := c.cc.Invoke(ctx, "/ostracon.privval.PrivValidatorAPI/VRFProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PrivValidatorAPIServer is the server API for PrivValidatorAPI service.
+type PrivValidatorAPIServer interface {
+	GetPubKey(context.Context, *privval.PubKeyRequest) (*privval.PubKeyResponse, error)
+	SignVote(context.Context, *privval.SignVoteRequest) (*privval.SignedVoteResponse, error)
+	SignProposal(context.Context, *privval.SignProposalRequest) (*privval.SignedProposalResponse, error)
+	VRFProof(context.Context, *VRFProofRequest) (*VRFProofResponse, error)
+}
+
+// UnimplementedPrivValidatorAPIServer can be embedded to have forward compatible implementations.
+type UnimplementedPrivValidatorAPIServer struct {
+}
+
+func (*UnimplementedPrivValidatorAPIServer) GetPubKey(ctx context.Context, req *privval.PubKeyRequest) (*privval.PubKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPubKey not implemented")
+}
+func (*UnimplementedPrivValidatorAPIServer) SignVote(ctx context.Context, req *privval.SignVoteRequest) (*privval.SignedVoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignVote not implemented")
+}
+func (*UnimplementedPrivValidatorAPIServer) SignProposal(ctx context.Context, req *privval.SignProposalRequest) (*privval.SignedProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignProposal not implemented")
+}
+func (*UnimplementedPrivValidatorAPIServer) VRFProof(ctx context.Context, req *VRFProofRequest) (*VRFProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VRFProof not implemented")
+}
+
+func RegisterPrivValidatorAPIServer(s *grpc.Server, srv PrivValidatorAPIServer) {
+	s.RegisterService(&_PrivValidatorAPI_serviceDesc, srv)
+}
+
+func _PrivValidatorAPI_GetPubKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(privval.PubKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PrivValidatorAPIServer).GetPubKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ostracon.privval.PrivValidatorAPI/GetPubKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PrivValidatorAPIServer).GetPubKey(ctx, req.(*privval.PubKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PrivValidatorAPI_SignVote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(privval.SignVoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PrivValidatorAPIServer).SignVote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ostracon.privval.PrivValidatorAPI/SignVote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PrivValidatorAPIServer).SignVote(ctx, req.(*privval.SignVoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PrivValidatorAPI_SignProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(privval.SignProposalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PrivValidatorAPIServer).SignProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ostracon.privval.PrivValidatorAPI/SignProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PrivValidatorAPIServer).SignProposal(ctx, req.(*privval.SignProposalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PrivValidatorAPI_VRFProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VRFProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PrivValidatorAPIServer).VRFProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ostracon.privval.PrivValidatorAPI/VRFProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PrivValidatorAPIServer).VRFProof(ctx, req.(*VRFProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _PrivValidatorAPI_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ostracon.privval.PrivValidatorAPI",
+	HandlerType: (*PrivValidatorAPIServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetPubKey",
+			Handler:    _PrivValidatorAPI_GetPubKey_Handler,
+		},
+		{
+			MethodName: "SignVote",
+			Handler:    _PrivValidatorAPI_SignVote_Handler,
+		},
+		{
+			MethodName: "SignProposal",
+			Handler:    _PrivValidatorAPI_SignProposal_Handler,
+		},
+		{
+			MethodName: "VRFProof",
+			Handler:    _PrivValidatorAPI_VRFProof_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "ostracon/privval/service.proto",
+}
@@ -0,0 +1,60 @@
+package statesync
+
+import (
+	"fmt"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/tendermint/tendermint/p2p"
+)
+
+var (
+	_ p2p.Wrapper = &LightBlockRequest{}
+	_ p2p.Wrapper = &LightBlockResponse{}
+	_ p2p.Wrapper = &ParamsRequest{}
+	_ p2p.Wrapper = &ParamsResponse{}
+)
+
+func (m *LightBlockRequest) Wrap() proto.Message {
+	sm := &Message{}
+	sm.Sum = &Message_LightBlockRequest{LightBlockRequest: m}
+	return sm
+}
+
+func (m *LightBlockResponse) Wrap() proto.Message {
+	sm := &Message{}
+	sm.Sum = &Message_LightBlockResponse{LightBlockResponse: m}
+	return sm
+}
+
+func (m *ParamsRequest) Wrap() proto.Message {
+	sm := &Message{}
+	sm.Sum = &Message_ParamsRequest{ParamsRequest: m}
+	return sm
+}
+
+func (m *ParamsResponse) Wrap() proto.Message {
+	sm := &Message{}
+	sm.Sum = &Message_ParamsResponse{ParamsResponse: m}
+	return sm
+}
+
+// Unwrap implements the p2p Wrapper interface and unwraps a wrapped state sync
+// message.
+func (m *Message) Unwrap() (proto.Message, error) {
+	switch msg := m.Sum.(type) {
+	case *Message_LightBlockRequest:
+		return m.GetLightBlockRequest(), nil
+
+	case *Message_LightBlockResponse:
+		return m.GetLightBlockResponse(), nil
+
+	case *Message_ParamsRequest:
+		return m.GetParamsRequest(), nil
+
+	case *Message_ParamsResponse:
+		return m.GetParamsResponse(), nil
+
+	default:
+		return nil, fmt.Errorf("unknown message: %T", msg)
+	}
+}
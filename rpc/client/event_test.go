@@ -156,6 +156,71 @@ func testTxEventsSent(t *testing.T, broadcastMethod string) {
 	}
 }
 
+// replay the blocks and a tx from a past height and make sure the live events
+// follow without any gap
+func TestHTTPSubscribeFromHeight(t *testing.T) {
+	c := getHTTPClient()
+	err := c.Start()
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		if err := c.Stop(); err != nil {
+			t.Error(err)
+		}
+	})
+
+	_, _, tx := MakeTxKV()
+	txres, err := c.BroadcastTxCommit(context.Background(), tx)
+	require.NoError(t, err)
+	require.True(t, txres.DeliverTx.IsOK())
+	err = client.WaitForHeight(c, txres.Height+3, nil)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), waitForEventTimeout)
+	defer cancel()
+
+	query := types.QueryForEvent(types.EventTx).String()
+	txs, err := c.SubscribeFromHeight(ctx, "TestHTTPSubscribeFromHeight", query, txres.Height)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		if err := c.Unsubscribe(context.Background(), "TestHTTPSubscribeFromHeight", query); err != nil {
+			t.Error(err)
+		}
+	})
+
+	select {
+	case evt := <-txs:
+		txe, ok := evt.Data.(types.EventDataTx)
+		require.True(t, ok)
+		require.EqualValues(t, tx, txe.Tx)
+		require.Equal(t, txres.Height, txe.Height)
+		require.Equal(t, []string{fmt.Sprintf("%X", types.Tx(tx).Hash())}, evt.Events[types.TxHashKey])
+	case <-ctx.Done():
+		t.Fatal("timed out waiting for the replayed tx")
+	}
+
+	query = types.QueryForEvent(types.EventNewBlock).String()
+	blocks, err := c.SubscribeFromHeight(ctx, "TestHTTPSubscribeFromHeight", query, txres.Height, 100)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		if err := c.Unsubscribe(context.Background(), "TestHTTPSubscribeFromHeight", query); err != nil {
+			t.Error(err)
+		}
+	})
+
+	status, err := c.Status(ctx)
+	require.NoError(t, err)
+	for height := txres.Height; height <= status.SyncInfo.LatestBlockHeight+2; height++ {
+		select {
+		case evt := <-blocks:
+			blockEvent, ok := evt.Data.(types.EventDataNewBlock)
+			require.True(t, ok)
+			require.Equal(t, height, blockEvent.Block.Height)
+		case <-ctx.Done():
+			t.Fatalf("timed out waiting for the block at height %d", height)
+		}
+	}
+}
+
 // Test HTTPClient resubscribes upon disconnect && subscription error.
 // Test Local client resubscribes upon subscription error.
 func TestClientsResubscribe(t *testing.T) {
@@ -345,6 +345,19 @@ func (c *baseRPCClient) ConsensusState(ctx context.Context) (*ctypes.ResultConse
 	return result, nil
 }
 
+func (c *baseRPCClient) UpcomingProposers(ctx context.Context, rounds *int32) (*ctypes.ResultUpcomingProposers, error) {
+	result := new(ctypes.ResultUpcomingProposers)
+	params := make(map[string]interface{})
+	if rounds != nil {
+		params["rounds"] = rounds
+	}
+	_, err := c.caller.Call(ctx, "upcoming_proposers", params, result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
 func (c *baseRPCClient) ConsensusParams(
 	ctx context.Context,
 	height *int64,
@@ -529,6 +542,80 @@ func (c *baseRPCClient) BlockSearch(
 	return result, nil
 }
 
+func (c *baseRPCClient) TxSearchCursor(
+	ctx context.Context,
+	query string,
+	prove bool,
+	cursor string,
+	perPage *int,
+	orderBy string,
+) (*ctypes.ResultTxSearch, error) {
+
+	result := new(ctypes.ResultTxSearch)
+	params := map[string]interface{}{
+		"query":    query,
+		"prove":    prove,
+		"order_by": orderBy,
+		"cursor":   cursor,
+	}
+
+	if perPage != nil {
+		params["per_page"] = perPage
+	}
+
+	_, err := c.caller.Call(ctx, "tx_search", params, result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (c *baseRPCClient) BlockSearchCursor(
+	ctx context.Context,
+	query string,
+	cursor string,
+	perPage *int,
+	orderBy string,
+) (*ctypes.ResultBlockSearch, error) {
+
+	result := new(ctypes.ResultBlockSearch)
+	params := map[string]interface{}{
+		"query":    query,
+		"order_by": orderBy,
+		"cursor":   cursor,
+	}
+
+	if perPage != nil {
+		params["per_page"] = perPage
+	}
+
+	_, err := c.caller.Call(ctx, "block_search", params, result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (c *baseRPCClient) TxCount(ctx context.Context, query string) (*ctypes.ResultTxCount, error) {
+	result := new(ctypes.ResultTxCount)
+	_, err := c.caller.Call(ctx, "tx_count", map[string]interface{}{"query": query}, result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (c *baseRPCClient) BlockCount(ctx context.Context, query string) (*ctypes.ResultBlockCount, error) {
+	result := new(ctypes.ResultBlockCount)
+	_, err := c.caller.Call(ctx, "block_count", map[string]interface{}{"query": query}, result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
 func (c *baseRPCClient) Validators(
 	ctx context.Context,
 	height *int64,
@@ -553,6 +640,26 @@ func (c *baseRPCClient) Validators(
 	return result, nil
 }
 
+func (c *baseRPCClient) ProposerElection(
+	ctx context.Context,
+	height *int64,
+	round *int32,
+) (*ctypes.ResultProposerElection, error) {
+	result := new(ctypes.ResultProposerElection)
+	params := make(map[string]interface{})
+	if height != nil {
+		params["height"] = height
+	}
+	if round != nil {
+		params["round"] = round
+	}
+	_, err := c.caller.Call(ctx, "proposer_election", params, result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
 func (c *baseRPCClient) BroadcastEvidence(
 	ctx context.Context,
 	ev types.Evidence,
@@ -638,6 +745,29 @@ func (w *WSEvents) Subscribe(ctx context.Context, subscriber, query string,
 		return nil, err
 	}
 
+	return w.addSubscription(query, outCapacity...), nil
+}
+
+// SubscribeFromHeight is like Subscribe, but the events of the committed
+// blocks from fromHeight on matching query are replayed first. On reconnect,
+// the query is subscribed to again without any replay.
+//
+// It returns an error if WSEvents is not running.
+func (w *WSEvents) SubscribeFromHeight(ctx context.Context, subscriber, query string, fromHeight int64,
+	outCapacity ...int) (out <-chan ctypes.ResultEvent, err error) {
+
+	if !w.IsRunning() {
+		return nil, errNotRunning
+	}
+
+	if err := w.ws.SubscribeFromHeight(ctx, query, fromHeight); err != nil {
+		return nil, err
+	}
+
+	return w.addSubscription(query, outCapacity...), nil
+}
+
+func (w *WSEvents) addSubscription(query string, outCapacity ...int) chan ctypes.ResultEvent {
 	outCap := 1
 	if len(outCapacity) > 0 {
 		outCap = outCapacity[0]
@@ -650,7 +780,7 @@ func (w *WSEvents) Subscribe(ctx context.Context, subscriber, query string,
 	w.subscriptions[query] = outc
 	w.mtx.Unlock()
 
-	return outc, nil
+	return outc
 }
 
 // Unsubscribe implements EventsClient by using WSClient to unsubscribe given
@@ -71,6 +71,7 @@ type SignClient interface {
 	BlockResults(ctx context.Context, height *int64) (*ctypes.ResultBlockResults, error)
 	Commit(ctx context.Context, height *int64) (*ctypes.ResultCommit, error)
 	Validators(ctx context.Context, height *int64, page, perPage *int) (*ctypes.ResultValidators, error)
+	ProposerElection(ctx context.Context, height *int64, round *int32) (*ctypes.ResultProposerElection, error)
 	Tx(ctx context.Context, hash []byte, prove bool) (*ctypes.ResultTx, error)
 
 	// TxSearch defines a method to search for a paginated set of transactions by
@@ -91,6 +92,37 @@ type SignClient interface {
 		page, perPage *int,
 		orderBy string,
 	) (*ctypes.ResultBlockSearch, error)
+
+	// TxSearchCursor defines a method to search for up to perPage transactions
+	// after the position of a cursor by DeliverTx event search criteria. An
+	// empty cursor starts a new search.
+	TxSearchCursor(
+		ctx context.Context,
+		query string,
+		prove bool,
+		cursor string,
+		perPage *int,
+		orderBy string,
+	) (*ctypes.ResultTxSearch, error)
+
+	// BlockSearchCursor defines a method to search for up to perPage blocks
+	// after the height of a cursor by BeginBlock and EndBlock event search
+	// criteria. An empty cursor starts a new search.
+	BlockSearchCursor(
+		ctx context.Context,
+		query string,
+		cursor string,
+		perPage *int,
+		orderBy string,
+	) (*ctypes.ResultBlockSearch, error)
+
+	// TxCount returns the number of transactions matching DeliverTx event
+	// search criteria, without fetching them.
+	TxCount(ctx context.Context, query string) (*ctypes.ResultTxCount, error)
+
+	// BlockCount returns the number of blocks matching BeginBlock and EndBlock
+	// event search criteria, without fetching them.
+	BlockCount(ctx context.Context, query string) (*ctypes.ResultBlockCount, error)
 }
 
 // HistoryClient provides access to data from genesis to now in large chunks.
@@ -111,6 +143,7 @@ type NetworkClient interface {
 	NetInfo(context.Context) (*ctypes.ResultNetInfo, error)
 	DumpConsensusState(context.Context) (*ctypes.ResultDumpConsensusState, error)
 	ConsensusState(context.Context) (*ctypes.ResultConsensusState, error)
+	UpcomingProposers(ctx context.Context, rounds *int32) (*ctypes.ResultUpcomingProposers, error)
 	ConsensusParams(ctx context.Context, height *int64) (*ctypes.ResultConsensusParams, error)
 	Health(context.Context) (*ctypes.ResultHealth, error)
 }
@@ -123,6 +123,10 @@ func (c *Local) ConsensusState(ctx context.Context) (*ctypes.ResultConsensusStat
 	return core.ConsensusState(c.ctx)
 }
 
+func (c *Local) UpcomingProposers(ctx context.Context, rounds *int32) (*ctypes.ResultUpcomingProposers, error) {
+	return core.UpcomingProposers(c.ctx, rounds)
+}
+
 func (c *Local) ConsensusParams(ctx context.Context, height *int64) (*ctypes.ResultConsensusParams, error) {
 	return core.ConsensusParams(c.ctx, height)
 }
@@ -177,6 +181,10 @@ func (c *Local) Validators(ctx context.Context, height *int64, page, perPage *in
 	return core.Validators(c.ctx, height, page, perPage)
 }
 
+func (c *Local) ProposerElection(ctx context.Context, height *int64, round *int32) (*ctypes.ResultProposerElection, error) {
+	return core.ProposerElection(c.ctx, height, round)
+}
+
 func (c *Local) Tx(ctx context.Context, hash []byte, prove bool) (*ctypes.ResultTx, error) {
 	return core.Tx(c.ctx, hash, prove)
 }
@@ -189,7 +197,7 @@ func (c *Local) TxSearch(
 	perPage *int,
 	orderBy string,
 ) (*ctypes.ResultTxSearch, error) {
-	return core.TxSearch(c.ctx, query, prove, page, perPage, orderBy)
+	return core.TxSearch(c.ctx, query, prove, page, perPage, orderBy, nil)
 }
 
 func (c *Local) BlockSearch(
@@ -198,7 +206,36 @@ func (c *Local) BlockSearch(
 	page, perPage *int,
 	orderBy string,
 ) (*ctypes.ResultBlockSearch, error) {
-	return core.BlockSearch(c.ctx, query, page, perPage, orderBy)
+	return core.BlockSearch(c.ctx, query, page, perPage, orderBy, nil)
+}
+
+func (c *Local) TxSearchCursor(
+	_ context.Context,
+	query string,
+	prove bool,
+	cursor string,
+	perPage *int,
+	orderBy string,
+) (*ctypes.ResultTxSearch, error) {
+	return core.TxSearch(c.ctx, query, prove, nil, perPage, orderBy, &cursor)
+}
+
+func (c *Local) BlockSearchCursor(
+	_ context.Context,
+	query string,
+	cursor string,
+	perPage *int,
+	orderBy string,
+) (*ctypes.ResultBlockSearch, error) {
+	return core.BlockSearch(c.ctx, query, nil, perPage, orderBy, &cursor)
+}
+
+func (c *Local) TxCount(ctx context.Context, query string) (*ctypes.ResultTxCount, error) {
+	return core.TxCount(c.ctx, query)
+}
+
+func (c *Local) BlockCount(ctx context.Context, query string) (*ctypes.ResultBlockCount, error) {
+	return core.BlockCount(c.ctx, query)
 }
 
 func (c *Local) BroadcastEvidence(ctx context.Context, ev types.Evidence) (*ctypes.ResultBroadcastEvidence, error) {
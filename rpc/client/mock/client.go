@@ -123,6 +123,10 @@ func (c Client) ConsensusState(ctx context.Context) (*ctypes.ResultConsensusStat
 	return core.ConsensusState(&rpctypes.Context{})
 }
 
+func (c Client) UpcomingProposers(ctx context.Context, rounds *int32) (*ctypes.ResultUpcomingProposers, error) {
+	return core.UpcomingProposers(&rpctypes.Context{}, rounds)
+}
+
 func (c Client) DumpConsensusState(ctx context.Context) (*ctypes.ResultDumpConsensusState, error) {
 	return core.DumpConsensusState(&rpctypes.Context{})
 }
@@ -173,6 +177,10 @@ func (c Client) Validators(ctx context.Context, height *int64, page, perPage *in
 	return core.Validators(&rpctypes.Context{}, height, page, perPage)
 }
 
+func (c Client) ProposerElection(ctx context.Context, height *int64, round *int32) (*ctypes.ResultProposerElection, error) {
+	return core.ProposerElection(&rpctypes.Context{}, height, round)
+}
+
 func (c Client) BroadcastEvidence(ctx context.Context, ev types.Evidence) (*ctypes.ResultBroadcastEvidence, error) {
 	return core.BroadcastEvidence(&rpctypes.Context{}, ev)
 }
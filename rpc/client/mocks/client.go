@@ -152,6 +152,32 @@ func (_m *Client) BlockByHash(ctx context.Context, hash []byte) (*coretypes.Resu
 	return r0, r1
 }
 
+// BlockCount provides a mock function with given fields: ctx, query
+func (_m *Client) BlockCount(ctx context.Context, query string) (*coretypes.ResultBlockCount, error) {
+	ret := _m.Called(ctx, query)
+
+	var r0 *coretypes.ResultBlockCount
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*coretypes.ResultBlockCount, error)); ok {
+		return rf(ctx, query)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *coretypes.ResultBlockCount); ok {
+		r0 = rf(ctx, query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*coretypes.ResultBlockCount)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, query)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // BlockResults provides a mock function with given fields: ctx, height
 func (_m *Client) BlockResults(ctx context.Context, height *int64) (*coretypes.ResultBlockResults, error) {
 	ret := _m.Called(ctx, height)
@@ -204,6 +230,32 @@ func (_m *Client) BlockSearch(ctx context.Context, query string, page *int, perP
 	return r0, r1
 }
 
+// BlockSearchCursor provides a mock function with given fields: ctx, query, cursor, perPage, orderBy
+func (_m *Client) BlockSearchCursor(ctx context.Context, query string, cursor string, perPage *int, orderBy string) (*coretypes.ResultBlockSearch, error) {
+	ret := _m.Called(ctx, query, cursor, perPage, orderBy)
+
+	var r0 *coretypes.ResultBlockSearch
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, *int, string) (*coretypes.ResultBlockSearch, error)); ok {
+		return rf(ctx, query, cursor, perPage, orderBy)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, *int, string) *coretypes.ResultBlockSearch); ok {
+		r0 = rf(ctx, query, cursor, perPage, orderBy)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*coretypes.ResultBlockSearch)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, *int, string) error); ok {
+		r1 = rf(ctx, query, cursor, perPage, orderBy)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // BlockchainInfo provides a mock function with given fields: ctx, minHeight, maxHeight
 func (_m *Client) BlockchainInfo(ctx context.Context, minHeight int64, maxHeight int64) (*coretypes.ResultBlockchainInfo, error) {
 	ret := _m.Called(ctx, minHeight, maxHeight)
@@ -641,6 +693,32 @@ func (_m *Client) OnStop() {
 	_m.Called()
 }
 
+// ProposerElection provides a mock function with given fields: ctx, height, round
+func (_m *Client) ProposerElection(ctx context.Context, height *int64, round *int32) (*coretypes.ResultProposerElection, error) {
+	ret := _m.Called(ctx, height, round)
+
+	var r0 *coretypes.ResultProposerElection
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *int64, *int32) (*coretypes.ResultProposerElection, error)); ok {
+		return rf(ctx, height, round)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *int64, *int32) *coretypes.ResultProposerElection); ok {
+		r0 = rf(ctx, height, round)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*coretypes.ResultProposerElection)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *int64, *int32) error); ok {
+		r1 = rf(ctx, height, round)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Quit provides a mock function with given fields:
 func (_m *Client) Quit() <-chan struct{} {
 	ret := _m.Called()
@@ -803,6 +881,32 @@ func (_m *Client) Tx(ctx context.Context, hash []byte, prove bool) (*coretypes.R
 	return r0, r1
 }
 
+// TxCount provides a mock function with given fields: ctx, query
+func (_m *Client) TxCount(ctx context.Context, query string) (*coretypes.ResultTxCount, error) {
+	ret := _m.Called(ctx, query)
+
+	var r0 *coretypes.ResultTxCount
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*coretypes.ResultTxCount, error)); ok {
+		return rf(ctx, query)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *coretypes.ResultTxCount); ok {
+		r0 = rf(ctx, query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*coretypes.ResultTxCount)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, query)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // TxSearch provides a mock function with given fields: ctx, query, prove, page, perPage, orderBy
 func (_m *Client) TxSearch(ctx context.Context, query string, prove bool, page *int, perPage *int, orderBy string) (*coretypes.ResultTxSearch, error) {
 	ret := _m.Called(ctx, query, prove, page, perPage, orderBy)
@@ -829,6 +933,32 @@ func (_m *Client) TxSearch(ctx context.Context, query string, prove bool, page *
 	return r0, r1
 }
 
+// TxSearchCursor provides a mock function with given fields: ctx, query, prove, cursor, perPage, orderBy
+func (_m *Client) TxSearchCursor(ctx context.Context, query string, prove bool, cursor string, perPage *int, orderBy string) (*coretypes.ResultTxSearch, error) {
+	ret := _m.Called(ctx, query, prove, cursor, perPage, orderBy)
+
+	var r0 *coretypes.ResultTxSearch
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, bool, string, *int, string) (*coretypes.ResultTxSearch, error)); ok {
+		return rf(ctx, query, prove, cursor, perPage, orderBy)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, bool, string, *int, string) *coretypes.ResultTxSearch); ok {
+		r0 = rf(ctx, query, prove, cursor, perPage, orderBy)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*coretypes.ResultTxSearch)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, bool, string, *int, string) error); ok {
+		r1 = rf(ctx, query, prove, cursor, perPage, orderBy)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UnconfirmedTxs provides a mock function with given fields: ctx, limit
 func (_m *Client) UnconfirmedTxs(ctx context.Context, limit *int) (*coretypes.ResultUnconfirmedTxs, error) {
 	ret := _m.Called(ctx, limit)
@@ -883,6 +1013,32 @@ func (_m *Client) UnsubscribeAll(ctx context.Context, subscriber string) error {
 	return r0
 }
 
+// UpcomingProposers provides a mock function with given fields: ctx, rounds
+func (_m *Client) UpcomingProposers(ctx context.Context, rounds *int32) (*coretypes.ResultUpcomingProposers, error) {
+	ret := _m.Called(ctx, rounds)
+
+	var r0 *coretypes.ResultUpcomingProposers
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *int32) (*coretypes.ResultUpcomingProposers, error)); ok {
+		return rf(ctx, rounds)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *int32) *coretypes.ResultUpcomingProposers); ok {
+		r0 = rf(ctx, rounds)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*coretypes.ResultUpcomingProposers)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *int32) error); ok {
+		r1 = rf(ctx, rounds)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Validators provides a mock function with given fields: ctx, height, page, perPage
 func (_m *Client) Validators(ctx context.Context, height *int64, page *int, perPage *int) (*coretypes.ResultValidators, error) {
 	ret := _m.Called(ctx, height, page, perPage)
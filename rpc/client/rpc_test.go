@@ -188,6 +188,62 @@ func TestGenesisAndValidators(t *testing.T) {
 	}
 }
 
+func TestProposerElection(t *testing.T) {
+	for i, c := range GetClients() {
+		h := int64(1)
+		err := client.WaitForHeight(c, h, nil)
+		require.NoError(t, err)
+
+		res, err := c.ProposerElection(context.Background(), &h, nil)
+		require.Nil(t, err, "%d: %+v", i, err)
+
+		block, err := c.Block(context.Background(), &h)
+		require.Nil(t, err, "%d: %+v", i, err)
+		assert.Equal(t, h, res.Height)
+		assert.Equal(t, block.Block.Round, res.Round)
+		assert.Equal(t, block.Block.ProposerAddress, res.Proposer.Address)
+		assert.Equal(t, block.Block.Proof, res.Proof)
+		assert.Equal(t, types.MakeRoundHash(res.PreviousProofHash, h, res.Round), res.RoundHash.Bytes())
+
+		// the VRF output is the hash of the proof
+		output, err := types.ProofToHash(res.Proposer.PubKey, res.Proof)
+		require.NoError(t, err)
+		assert.Equal(t, output, res.VRFOutput.Bytes())
+
+		// the election is recomputed for any round
+		round := res.Round + 1
+		res, err = c.ProposerElection(context.Background(), &h, &round)
+		require.Nil(t, err, "%d: %+v", i, err)
+		assert.Equal(t, round, res.Round)
+		assert.Equal(t, types.MakeRoundHash(res.PreviousProofHash, h, round), res.RoundHash.Bytes())
+
+		round = -1
+		_, err = c.ProposerElection(context.Background(), &h, &round)
+		assert.Error(t, err)
+	}
+}
+
+func TestUpcomingProposers(t *testing.T) {
+	for i, c := range GetClients() {
+		rounds := int32(3)
+		res, err := c.UpcomingProposers(context.Background(), &rounds)
+		require.Nil(t, err, "%d: %+v", i, err)
+		assert.Positive(t, res.Height)
+		assert.Len(t, res.Proposers, int(rounds))
+
+		// the single validator proposes every round
+		vals, err := c.Validators(context.Background(), nil, nil, nil)
+		require.Nil(t, err, "%d: %+v", i, err)
+		for _, proposer := range res.Proposers {
+			assert.Equal(t, vals.Validators[0].Address, proposer.Address)
+		}
+
+		res, err = c.UpcomingProposers(context.Background(), nil)
+		require.Nil(t, err, "%d: %+v", i, err)
+		assert.Len(t, res.Proposers, 10)
+	}
+}
+
 func TestGenesisChunked(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
@@ -633,6 +689,54 @@ func TestTxSearch(t *testing.T) {
 			}
 		}
 		require.Len(t, seen, txCount)
+
+		// count without fetching
+		count, err := c.TxCount(context.Background(), "tx.height >= 1")
+		require.NoError(t, err)
+		require.Equal(t, txCount, count.TotalCount)
+
+		count, err = c.TxCount(context.Background(), fmt.Sprintf("tx.hash='%X'", anotherTxHash))
+		require.NoError(t, err)
+		require.Zero(t, count.TotalCount)
+
+		// check pagination by cursor
+		var (
+			cursor  string
+			txs     []*ctypes.ResultTx
+			hasNext = true
+		)
+		for hasNext {
+			result, err := c.TxSearchCursor(context.Background(), "tx.height >= 1", false, cursor, &perPage, "desc")
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(result.Txs), perPage)
+			txs = append(txs, result.Txs...)
+			cursor, hasNext = result.NextCursor, result.NextCursor != ""
+		}
+		require.Len(t, txs, txCount)
+		for k := 0; k < len(txs)-1; k++ {
+			require.Greater(t, txs[k].Height, txs[k+1].Height)
+		}
+	}
+}
+
+func TestBlockCount(t *testing.T) {
+	for i, c := range GetClients() {
+		t.Logf("client %d", i)
+
+		status, err := c.Status(context.Background())
+		require.NoError(t, err)
+		height := status.SyncInfo.LatestBlockHeight
+		q := fmt.Sprintf("block.height >= 1 AND block.height <= %d", height)
+
+		count, err := c.BlockCount(context.Background(), q)
+		require.NoError(t, err)
+		// the latest block may not be indexed yet
+		require.Greater(t, count.TotalCount, 0)
+		require.LessOrEqual(t, int64(count.TotalCount), height)
+
+		result, err := c.BlockSearch(context.Background(), q, nil, nil, "asc")
+		require.NoError(t, err)
+		require.Equal(t, result.TotalCount, count.TotalCount)
 	}
 }
 
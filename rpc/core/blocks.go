@@ -1,6 +1,7 @@
 package core
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"sort"
@@ -9,6 +10,7 @@ import (
 	tmquery "github.com/Finschia/ostracon/libs/pubsub/query"
 	ctypes "github.com/Finschia/ostracon/rpc/core/types"
 	rpctypes "github.com/Finschia/ostracon/rpc/jsonrpc/types"
+	"github.com/Finschia/ostracon/state/indexer"
 	blockidxnull "github.com/Finschia/ostracon/state/indexer/block/null"
 	"github.com/Finschia/ostracon/types"
 )
@@ -162,12 +164,21 @@ func BlockResults(ctx *rpctypes.Context, heightPtr *int64) (*ctypes.ResultBlockR
 
 // BlockSearch searches for a paginated set of blocks matching BeginBlock and
 // EndBlock event search criteria.
+//
+// If a cursor is given, even an empty one, the page is ignored and the search
+// continues after the position of the cursor instead, without counting all the
+// results: the total count is the number of returned blocks, and the next
+// cursor is returned if there are more results.
 func BlockSearch(
 	ctx *rpctypes.Context,
 	query string,
 	pagePtr, perPagePtr *int,
 	orderBy string,
+	cursorPtr *string,
 ) (*ctypes.ResultBlockSearch, error) {
+	if cursorPtr != nil {
+		return blockSearchCursor(ctx, query, *cursorPtr, validatePerPage(perPagePtr), orderBy)
+	}
 
 	// skip if block indexing is disabled
 	if _, ok := env.BlockIndexer.(*blockidxnull.BlockerIndexer); ok {
@@ -224,3 +235,102 @@ func BlockSearch(
 
 	return &ctypes.ResultBlockSearch{Blocks: apiResults, TotalCount: totalCount}, nil
 }
+
+// BlockCount returns the number of blocks matching BeginBlock and EndBlock
+// event search criteria, as in the total count of BlockSearch.
+func BlockCount(ctx *rpctypes.Context, query string) (*ctypes.ResultBlockCount, error) {
+	// skip if block indexing is disabled
+	if _, ok := env.BlockIndexer.(*blockidxnull.BlockerIndexer); ok {
+		return nil, errors.New("block indexing is disabled")
+	}
+
+	q, err := tmquery.New(query)
+	if err != nil {
+		return nil, err
+	}
+
+	count, err := env.BlockIndexer.Count(ctx.Context(), q)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ctypes.ResultBlockCount{TotalCount: count}, nil
+}
+
+// blockSearchCursor returns up to limit blocks after the position of the
+// cursor, along with the cursor of the last one if there are more.
+func blockSearchCursor(
+	ctx *rpctypes.Context,
+	query string,
+	cursor string,
+	limit int,
+	orderBy string,
+) (*ctypes.ResultBlockSearch, error) {
+	apiResults := make([]*ctypes.ResultBlock, 0, limit)
+	var lastCursor, nextCursor string
+	err := BlockSearchStream(ctx.Context(), query, cursor, orderBy, func(r *ctypes.ResultBlock, c string) bool {
+		if len(apiResults) == limit {
+			// there are more results
+			nextCursor = lastCursor
+			return false
+		}
+		apiResults = append(apiResults, r)
+		lastCursor = c
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &ctypes.ResultBlockSearch{Blocks: apiResults, TotalCount: len(apiResults), NextCursor: nextCursor}, nil
+}
+
+// BlockSearchStream calls fn with each block matching the query after the
+// height of the cursor, along with the cursor of its own height, until fn
+// returns false. The blocks are streamed in height order, descending unless
+// orderBy is "asc". An empty cursor starts from the first block.
+func BlockSearchStream(
+	ctx context.Context,
+	query string,
+	cursor string,
+	orderBy string,
+	fn func(*ctypes.ResultBlock, string) bool,
+) error {
+	// skip if block indexing is disabled
+	if _, ok := env.BlockIndexer.(*blockidxnull.BlockerIndexer); ok {
+		return errors.New("block indexing is disabled")
+	}
+
+	q, err := tmquery.New(query)
+	if err != nil {
+		return err
+	}
+
+	var opts indexer.StreamOptions
+	switch orderBy {
+	case "desc", "":
+		opts.Desc = true
+	case "asc":
+	default:
+		return errors.New("expected order_by to be either `asc` or `desc` or empty")
+	}
+
+	if cursor != "" {
+		opts.After, err = decodeBlockCursor(cursor)
+		if err != nil {
+			return err
+		}
+	}
+
+	return env.BlockIndexer.SearchStream(ctx, q, opts, func(height int64) bool {
+		block := env.BlockStore.LoadBlock(height)
+		if block == nil {
+			return true
+		}
+		blockMeta := env.BlockStore.LoadBlockMeta(height)
+		if blockMeta == nil {
+			return true
+		}
+		return fn(&ctypes.ResultBlock{Block: block, BlockID: blockMeta.BlockID}, encodeBlockCursor(height))
+	})
+}
@@ -142,7 +142,7 @@ func TestBlockSearchByBlockHeightQuery(t *testing.T) {
 
 	{
 		// Get by block.height (not search/range)
-		res, err := BlockSearch(ctx, q, &page, &perPage, orderBy)
+		res, err := BlockSearch(ctx, q, &page, &perPage, orderBy, nil)
 
 		require.NoError(t, err)
 		require.NotNil(t, res)
@@ -157,7 +157,7 @@ func TestBlockSearchByBlockHeightQuery(t *testing.T) {
 
 	{
 		// Get by block.height (not search/range)
-		res, err := BlockSearch(ctx, q, &page, &perPage, orderBy)
+		res, err := BlockSearch(ctx, q, &page, &perPage, orderBy, nil)
 
 		require.NoError(t, err)
 		require.NotNil(t, res)
@@ -185,7 +185,7 @@ func TestBlockSearchByRangeQuery(t *testing.T) {
 
 	{
 		// Search blocks by range query with desc (default)
-		res, err := BlockSearch(ctx, q, &page, &perPage, orderBy)
+		res, err := BlockSearch(ctx, q, &page, &perPage, orderBy, nil)
 
 		require.NoError(t, err)
 		require.NotNil(t, res)
@@ -197,7 +197,7 @@ func TestBlockSearchByRangeQuery(t *testing.T) {
 	{
 		orderBy = TestOrderByAsc
 		// Search blocks by range query with asc
-		res, err := BlockSearch(ctx, q, &page, &perPage, orderBy)
+		res, err := BlockSearch(ctx, q, &page, &perPage, orderBy, nil)
 
 		require.NoError(t, err)
 		require.NotNil(t, res)
@@ -206,6 +206,50 @@ func TestBlockSearchByRangeQuery(t *testing.T) {
 		require.Equal(t, height, res.Blocks[0].Block.Height)
 		require.Equal(t, int64(numToGet), res.Blocks[numToGet-1].Block.Height)
 	}
+	{
+		// Count the blocks of the range query
+		res, err := BlockCount(ctx, q)
+
+		require.NoError(t, err)
+		require.Equal(t, numToMakeBlocks, res.TotalCount)
+	}
+}
+
+func TestBlockSearchByCursor(t *testing.T) {
+	height := int64(1)
+	ctx := &rpctypes.Context{}
+
+	q := fmt.Sprintf("%s>=%d", types.BlockHeightKey, height)
+	perPage := 2
+
+	state, cleanup := makeTestState()
+	defer cleanup()
+
+	numToMakeBlocks := 5
+	// Save blocks
+	storeTestBlocks(height, int64(numToMakeBlocks), 0, state, time.Now())
+
+	cursor := ""
+	heights := make([]int64, 0)
+	for {
+		// desc (default)
+		res, err := BlockSearch(ctx, q, nil, &perPage, TestOrderByDefault, &cursor)
+		require.NoError(t, err)
+		require.Equal(t, len(res.Blocks), res.TotalCount)
+		for _, block := range res.Blocks {
+			heights = append(heights, block.Block.Height)
+		}
+		if res.NextCursor == "" {
+			break
+		}
+		cursor = res.NextCursor
+	}
+	require.Equal(t, []int64{5, 4, 3, 2, 1}, heights)
+
+	// error: invalid cursor
+	cursor = "invalid"
+	_, err := BlockSearch(ctx, q, nil, &perPage, TestOrderByAsc, &cursor)
+	require.Error(t, err)
 }
 
 func TestBlockSearch_errors(t *testing.T) {
@@ -221,7 +265,7 @@ func TestBlockSearch_errors(t *testing.T) {
 		env = &Environment{}
 		env.BlockIndexer = &blockidxnull.BlockerIndexer{}
 
-		res, err := BlockSearch(ctx, q, &page, &perPage, orderBy)
+		res, err := BlockSearch(ctx, q, &page, &perPage, orderBy, nil)
 
 		require.Error(t, err)
 		require.Equal(t, errors.New("block indexing is disabled"), err)
@@ -231,7 +275,7 @@ func TestBlockSearch_errors(t *testing.T) {
 		// error: tmquery.New(query)
 		env = &Environment{}
 
-		res, err := BlockSearch(ctx, q, &page, &perPage, orderBy)
+		res, err := BlockSearch(ctx, q, &page, &perPage, orderBy, nil)
 
 		require.Error(t, err)
 		require.Equal(t,
@@ -245,7 +289,7 @@ func TestBlockSearch_errors(t *testing.T) {
 		env.BlockIndexer = blockidxkv.New(dbm.NewMemDB())
 		q = fmt.Sprintf("%s>%d", types.BlockHeightKey, 1)
 
-		res, err := BlockSearch(ctx, q, &page, &perPage, orderBy)
+		res, err := BlockSearch(ctx, q, &page, &perPage, orderBy, nil)
 
 		require.Error(t, err)
 		require.Equal(t,
@@ -260,7 +304,7 @@ func TestBlockSearch_errors(t *testing.T) {
 		q = fmt.Sprintf("%s>%d", types.BlockHeightKey, 1)
 		orderBy = TestOrderByDesc
 
-		res, err := BlockSearch(ctx, q, &page, &perPage, orderBy)
+		res, err := BlockSearch(ctx, q, &page, &perPage, orderBy, nil)
 
 		require.Error(t, err)
 		require.Equal(t,
@@ -338,6 +382,7 @@ func (mockBlockStore) LoadBlockMeta(height int64) *types.BlockMeta       { retur
 func (mockBlockStore) LoadBlock(height int64) *types.Block               { return nil }
 func (mockBlockStore) LoadBlockByHash(hash []byte) *types.Block          { return nil }
 func (mockBlockStore) LoadBlockPart(height int64, index int) *types.Part { return nil }
+func (mockBlockStore) LoadBlockEntropy(height int64) *types.Entropy      { return nil }
 func (mockBlockStore) LoadBlockCommit(height int64) *types.Commit        { return nil }
 func (mockBlockStore) LoadSeenCommit(height int64) *types.Commit         { return nil }
 func (mockBlockStore) PruneBlocks(height int64) (uint64, error)          { return 0, nil }
@@ -1,7 +1,10 @@
 package core
 
 import (
+	"fmt"
+
 	cm "github.com/Finschia/ostracon/consensus"
+	tmbytes "github.com/Finschia/ostracon/libs/bytes"
 	tmmath "github.com/Finschia/ostracon/libs/math"
 	ctypes "github.com/Finschia/ostracon/rpc/core/types"
 	rpctypes "github.com/Finschia/ostracon/rpc/jsonrpc/types"
@@ -45,6 +48,80 @@ func Validators(ctx *rpctypes.Context, heightPtr *int64, pagePtr, perPagePtr *in
 		Total:       totalCount}, nil
 }
 
+// ProposerElection explains why the proposer of the block at the given height
+// was elected. It recomputes the election for the given round, which defaults
+// to the round the block was proposed in, and verifies the VRF proof of the
+// block against its proposer.
+//
+// If no height is provided, it will explain the latest block.
+func ProposerElection(ctx *rpctypes.Context, heightPtr *int64, roundPtr *int32) (*ctypes.ResultProposerElection, error) {
+	height, err := getHeight(env.BlockStore.Height(), heightPtr)
+	if err != nil {
+		return nil, err
+	}
+
+	block := env.BlockStore.LoadBlock(height)
+	if block == nil {
+		return nil, fmt.Errorf("block at height %d not found", height)
+	}
+
+	round := block.Round
+	if roundPtr != nil {
+		if *roundPtr < 0 {
+			return nil, fmt.Errorf("round must be non-negative, but got %d", *roundPtr)
+		}
+		round = *roundPtr
+	}
+
+	proofHash, err := env.StateStore.LoadProofHash(height)
+	if err != nil {
+		return nil, err
+	}
+	validators, err := env.StateStore.LoadValidators(height)
+	if err != nil {
+		return nil, err
+	}
+
+	roundHash := types.MakeRoundHash(proofHash, height, round)
+	seed := types.ProposerSeed(roundHash)
+	totalVotingPower := validators.TotalVotingPower()
+	proposer := validators.SelectProposer(proofHash, height, round)
+
+	// The proposer proves the hash message of the state it proposed on,
+	// see state.State.MakeHashMessage.
+	_, blockProposer := validators.GetByAddress(block.ProposerAddress)
+	if blockProposer == nil {
+		return nil, fmt.Errorf("proposer %X of block %d is not in the validator set", block.ProposerAddress, height)
+	}
+	output, err := blockProposer.PubKey.VRFVerify(block.Proof, types.MakeRoundHash(proofHash, height-1, block.Round))
+	if err != nil {
+		return nil, fmt.Errorf("failed to verify the proof of block %d: %w", height, err)
+	}
+
+	return &ctypes.ResultProposerElection{
+		Height:            height,
+		Round:             round,
+		PreviousProofHash: proofHash,
+		RoundHash:         roundHash,
+		Seed:              seed,
+		Threshold:         types.ProposerThreshold(seed, totalVotingPower),
+		TotalVotingPower:  totalVotingPower,
+		Proposer:          proposer,
+		Proof:             block.Proof,
+		VRFOutput:         tmbytes.HexBytes(output),
+	}, nil
+}
+
+// UpcomingProposers gets the proposers elected for the first rounds of the
+// current height. The number of rounds defaults to 10 and is at most 100.
+func UpcomingProposers(ctx *rpctypes.Context, roundsPtr *int32) (*ctypes.ResultUpcomingProposers, error) {
+	height, proposers := env.ConsensusState.GetUpcomingProposers(validateUpcomingRounds(roundsPtr))
+	return &ctypes.ResultUpcomingProposers{
+		Height:    height,
+		Proposers: proposers,
+	}, nil
+}
+
 // DumpConsensusState dumps consensus state.
 // UNSTABLE
 // More: https://docs.tendermint.com/v0.34/rpc/#/Info/dump_consensus_state
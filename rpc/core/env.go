@@ -2,6 +2,7 @@ package core
 
 import (
 	"encoding/base64"
+	"encoding/binary"
 	"fmt"
 	"time"
 
@@ -16,6 +17,7 @@ import (
 	sm "github.com/Finschia/ostracon/state"
 	"github.com/Finschia/ostracon/state/indexer"
 	"github.com/Finschia/ostracon/state/txindex"
+	"github.com/Finschia/ostracon/statesync"
 	"github.com/Finschia/ostracon/types"
 )
 
@@ -27,6 +29,10 @@ const (
 	// TODO It will be modified later to be configurable. (Also, add a option to get all tx of block)
 	maxPerPage = 10000
 
+	// see upcoming_proposers
+	defaultUpcomingRounds = 10
+	maxUpcomingRounds     = 100
+
 	// SubscribeTimeout is the maximum time we wait to subscribe for an event.
 	// must be less than the server's write timeout (see rpcserver.DefaultConfig)
 	SubscribeTimeout = 5 * time.Second
@@ -53,6 +59,7 @@ func SetEnvironment(e *Environment) {
 type Consensus interface {
 	GetState() sm.State
 	GetValidators() (int64, []*types.Validator)
+	GetUpcomingProposers(rounds int32) (int64, []*types.Validator)
 	GetLastHeight() int64
 	GetRoundStateJSON() ([]byte, error)
 	GetRoundStateSimpleJSON() ([]byte, error)
@@ -70,6 +77,7 @@ type peers interface {
 	AddPrivatePeerIDs([]string) error
 	DialPeersAsync([]string) error
 	Peers() p2p.IPeerSet
+	PeerTrustScore(p2p.ID) int
 }
 
 // ----------------------------------------------
@@ -94,6 +102,7 @@ type Environment struct {
 	TxIndexer        txindex.TxIndexer
 	BlockIndexer     indexer.BlockIndexer
 	ConsensusReactor *consensus.Reactor
+	StateSyncReactor *statesync.Reactor
 	EventBus         *types.EventBus // thread safe
 	Mempool          mempl.Mempool
 
@@ -142,6 +151,20 @@ func validatePerPage(perPagePtr *int) int {
 	return perPage
 }
 
+func validateUpcomingRounds(roundsPtr *int32) int32 {
+	if roundsPtr == nil { // no rounds parameter
+		return defaultUpcomingRounds
+	}
+
+	rounds := *roundsPtr
+	if rounds < 1 {
+		return defaultUpcomingRounds
+	} else if rounds > maxUpcomingRounds {
+		return maxUpcomingRounds
+	}
+	return rounds
+}
+
 // InitGenesisChunks configures the environment and should be called on service
 // startup.
 func InitGenesisChunks() error {
@@ -180,6 +203,42 @@ func validateSkipCount(page, perPage int) int {
 	return skipCount
 }
 
+// encodeTxCursor returns the opaque token of a tx search cursor: the base64
+// encoding of its big-endian height and index.
+func encodeTxCursor(cursor txindex.Cursor) string {
+	bz := make([]byte, 12)
+	binary.BigEndian.PutUint64(bz, uint64(cursor.Height))
+	binary.BigEndian.PutUint32(bz[8:], cursor.Index)
+	return base64.RawURLEncoding.EncodeToString(bz)
+}
+
+func decodeTxCursor(token string) (txindex.Cursor, error) {
+	bz, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil || len(bz) != 12 {
+		return txindex.Cursor{}, fmt.Errorf("invalid cursor %q", token)
+	}
+	return txindex.Cursor{
+		Height: int64(binary.BigEndian.Uint64(bz)),
+		Index:  binary.BigEndian.Uint32(bz[8:]),
+	}, nil
+}
+
+// encodeBlockCursor returns the opaque token of a block search cursor: the
+// base64 encoding of its big-endian height.
+func encodeBlockCursor(height int64) string {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, uint64(height))
+	return base64.RawURLEncoding.EncodeToString(bz)
+}
+
+func decodeBlockCursor(token string) (int64, error) {
+	bz, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil || len(bz) != 8 {
+		return 0, fmt.Errorf("invalid cursor %q", token)
+	}
+	return int64(binary.BigEndian.Uint64(bz)), nil
+}
+
 // latestHeight can be either latest committed or uncommitted (+1) height.
 func getHeight(latestHeight int64, heightPtr *int64) (int64, error) {
 	if heightPtr != nil {
@@ -6,10 +6,14 @@ import (
 	"fmt"
 	"time"
 
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmstate "github.com/tendermint/tendermint/proto/tendermint/state"
+
 	tmpubsub "github.com/Finschia/ostracon/libs/pubsub"
 	tmquery "github.com/Finschia/ostracon/libs/pubsub/query"
 	ctypes "github.com/Finschia/ostracon/rpc/core/types"
 	rpctypes "github.com/Finschia/ostracon/rpc/jsonrpc/types"
+	"github.com/Finschia/ostracon/types"
 )
 
 const (
@@ -18,87 +22,386 @@ const (
 	maxQueryLength = 512
 )
 
-// Subscribe for events via WebSocket.
+var (
+	// errSlowClient is returned by forwardEvents if writing an event failed,
+	// e.g. because of a slow client.
+	errSlowClient = errors.New("slow client")
+	// errOstraconExited is returned by forwardEvents if the event bus stopped.
+	errOstraconExited = errors.New("Ostracon exited")
+	// errReplayOverflow is returned by replayEvents if too many live events
+	// were published while replaying, i.e. the client is too slow to catch up.
+	errReplayOverflow = errors.New("slow client: too many events published during the replay")
+)
+
+// Subscribe for events via WebSocket. If fromHeight is given, the events of
+// the committed blocks from that height on matching the query are replayed
+// first, in the order they were published, before the live events. Only the
+// NewBlock, NewBlockHeader, NewEvidence and Tx events are replayed, from at
+// most max_replay_heights blocks back.
 // More: https://docs.tendermint.com/v0.34/rpc/#/Websocket/subscribe
-func Subscribe(ctx *rpctypes.Context, query string) (*ctypes.ResultSubscribe, error) {
+func Subscribe(ctx *rpctypes.Context, query string, fromHeight *int64) (*ctypes.ResultSubscribe, error) {
 	addr := ctx.RemoteAddr()
 
+	q, sub, err := subscribe(ctx.Context(), addr, query, fromHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	closeIfSlow := env.Config.CloseOnSlowClient
+
+	// Capture the current ID, since it can change in the future.
+	subscriptionID := ctx.JSONReq.ID
+
+	// writeEvent returns false if the subscription is to be closed.
+	writeEvent := func(resultEvent *ctypes.ResultEvent) bool {
+		resp := rpctypes.NewRPCSuccessResponse(subscriptionID, resultEvent)
+		writeCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		if err := ctx.WSConn.WriteRPCResponse(writeCtx, resp); err != nil {
+			env.Logger.Info("Can't write response (slow client)",
+				"to", addr, "subscriptionID", subscriptionID, "err", err)
+
+			if closeIfSlow {
+				var (
+					err  = errors.New("subscription was cancelled (reason: slow client)")
+					resp = rpctypes.RPCServerError(subscriptionID, err)
+				)
+				if !ctx.WSConn.TryWriteRPCResponse(resp) {
+					env.Logger.Info("Can't write response (slow client)",
+						"to", addr, "subscriptionID", subscriptionID, "err", err)
+				}
+				return false
+			}
+		}
+		return true
+	}
+
+	go func() {
+		err := forwardEvents(sub, q, query, fromHeight, nil, writeEvent)
+		if err == errSlowClient || err == tmpubsub.ErrUnsubscribed {
+			return
+		}
+
+		err = fmt.Errorf("subscription was cancelled (reason: %s)", err)
+		resp := rpctypes.RPCServerError(subscriptionID, err)
+		if !ctx.WSConn.TryWriteRPCResponse(resp) {
+			env.Logger.Info("Can't write response (slow client)",
+				"to", addr, "subscriptionID", subscriptionID, "err", err)
+		}
+		unsubscribe(addr, q)
+	}()
+
+	return &ctypes.ResultSubscribe{}, nil
+}
+
+// SubscribeStream subscribes subscriber to the events matching query, as
+// Subscribe does, and sends them with send until it returns false, the
+// subscription is cancelled or ctx is done. It blocks meanwhile, and
+// unsubscribes before returning.
+func SubscribeStream(
+	ctx context.Context,
+	subscriber, query string,
+	fromHeight *int64,
+	send func(*ctypes.ResultEvent) bool,
+) error {
+	q, sub, err := subscribe(ctx, subscriber, query, fromHeight)
+	if err != nil {
+		return err
+	}
+	defer unsubscribe(subscriber, q)
+
+	err = forwardEvents(sub, q, query, fromHeight, ctx.Done(), send)
+	switch err {
+	case nil:
+		return ctx.Err()
+	case errSlowClient, tmpubsub.ErrUnsubscribed:
+		return nil
+	default:
+		return fmt.Errorf("subscription was cancelled (reason: %s)", err)
+	}
+}
+
+// subscribe subscribes subscriber to the events matching query, within the
+// limits of the configuration.
+func subscribe(
+	ctx context.Context,
+	subscriber, query string,
+	fromHeight *int64,
+) (*tmquery.Query, types.Subscription, error) {
 	if env.EventBus.NumClients() >= env.Config.MaxSubscriptionClients {
-		return nil, fmt.Errorf("max_subscription_clients %d reached", env.Config.MaxSubscriptionClients)
-	} else if env.EventBus.NumClientSubscriptions(addr) >= env.Config.MaxSubscriptionsPerClient {
-		return nil, fmt.Errorf("max_subscriptions_per_client %d reached", env.Config.MaxSubscriptionsPerClient)
+		return nil, nil, fmt.Errorf("max_subscription_clients %d reached", env.Config.MaxSubscriptionClients)
+	} else if env.EventBus.NumClientSubscriptions(subscriber) >= env.Config.MaxSubscriptionsPerClient {
+		return nil, nil, fmt.Errorf("max_subscriptions_per_client %d reached", env.Config.MaxSubscriptionsPerClient)
 	} else if len(query) > maxQueryLength {
-		return nil, errors.New("maximum query length exceeded")
+		return nil, nil, errors.New("maximum query length exceeded")
 	}
 
-	env.Logger.Info("Subscribe to query", "remote", addr, "query", query)
+	env.Logger.Info("Subscribe to query", "remote", subscriber, "query", query)
 
 	q, err := tmquery.New(query)
 	if err != nil {
-		return nil, fmt.Errorf("failed to parse query: %w", err)
+		return nil, nil, fmt.Errorf("failed to parse query: %w", err)
+	}
+
+	if fromHeight != nil {
+		if err := validateReplayHeight(*fromHeight); err != nil {
+			return nil, nil, err
+		}
 	}
 
-	subCtx, cancel := context.WithTimeout(ctx.Context(), SubscribeTimeout)
+	subCtx, cancel := context.WithTimeout(ctx, SubscribeTimeout)
 	defer cancel()
 
-	sub, err := env.EventBus.Subscribe(subCtx, addr, q, env.Config.SubscriptionBufferSize)
+	sub, err := env.EventBus.Subscribe(subCtx, subscriber, q, env.Config.SubscriptionBufferSize)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
+	return q, sub, nil
+}
 
-	closeIfSlow := env.Config.CloseOnSlowClient
+func unsubscribe(subscriber string, q *tmquery.Query) {
+	err := env.EventBus.Unsubscribe(context.Background(), subscriber, q)
+	if err != nil && err != tmpubsub.ErrSubscriptionNotFound {
+		env.Logger.Error("Can't unsubscribe", "remote", subscriber, "query", q, "err", err)
+	}
+}
 
-	// Capture the current ID, since it can change in the future.
-	subscriptionID := ctx.JSONReq.ID
+// forwardEvents writes the events of sub, after replaying the ones from
+// fromHeight on if given, until write returns false, the subscription is
+// cancelled or done is closed. It returns errSlowClient in the first case,
+// the reason of the cancellation in the second one and nil in the last one.
+func forwardEvents(
+	sub types.Subscription,
+	q *tmquery.Query,
+	query string,
+	fromHeight *int64,
+	done <-chan struct{},
+	write func(*ctypes.ResultEvent) bool,
+) error {
+	// writeMessage writes a live event, unless it belongs to a block whose
+	// events were replayed already.
+	lastReplayedHeight := int64(0)
+	writeMessage := func(msg tmpubsub.Message) bool {
+		if height := eventHeight(msg.Data()); height > 0 && height <= lastReplayedHeight {
+			return true
+		}
+		return write(&ctypes.ResultEvent{Query: query, Data: msg.Data(), Events: msg.Events()})
+	}
+
+	if fromHeight != nil {
+		pending, lastHeight, err := replayEvents(sub, q, *fromHeight, func(data types.OCEventData,
+			events map[string][]string) bool {
+			return write(&ctypes.ResultEvent{Query: query, Data: data, Events: events})
+		})
+		if err != nil {
+			if err != errSlowClient {
+				env.Logger.Error("Can't replay events", "query", query, "err", err)
+			}
+			return err
+		}
+		lastReplayedHeight = lastHeight
+
+		for _, msg := range pending {
+			if !writeMessage(msg) {
+				return errSlowClient
+			}
+		}
+	}
+
+	for {
+		select {
+		case msg := <-sub.Out():
+			if !writeMessage(msg) {
+				return errSlowClient
+			}
+		case <-sub.Cancelled():
+			if sub.Err() == nil {
+				return errOstraconExited
+			}
+			return sub.Err()
+		case <-done:
+			return nil
+		}
+	}
+}
+
+// validateReplayHeight checks the events can be replayed from the given
+// height: its block must be stored, with its ABCI responses, or be the next
+// one to be committed.
+func validateReplayHeight(height int64) error {
+	if height <= 0 {
+		return fmt.Errorf("from_height must be greater than 0, but got %d", height)
+	}
+	latestHeight := env.BlockStore.Height()
+	if height > latestHeight+1 {
+		return fmt.Errorf("from_height %d must be less than or equal to the next blockchain height %d",
+			height, latestHeight+1)
+	}
+	if base := env.BlockStore.Base(); height < base {
+		return fmt.Errorf("from_height %d is not available, lowest height is %d", height, base)
+	}
+	if maxHeights := env.Config.MaxReplayHeights; maxHeights > 0 && height <= latestHeight-maxHeights {
+		return fmt.Errorf("from_height %d is too old, the events of at most %d blocks can be replayed",
+			height, maxHeights)
+	}
+	// the ABCI responses of the latest block may not be saved yet
+	if height < latestHeight {
+		if _, err := env.StateStore.LoadABCIResponses(height); err != nil {
+			return fmt.Errorf("can't replay the events of height %d: %w", height, err)
+		}
+	}
+	return nil
+}
+
+// replayEvents writes the events of the committed blocks from fromHeight on
+// matching q, until it reaches the latest block whose ABCI responses are
+// saved. The events of the following blocks are published after sub was
+// subscribed, so that there is no gap with the live events.
+//
+// The live events are collected meanwhile so as not to overflow sub, and
+// returned along with the last replayed height. The caller is to skip the
+// live events of the replayed blocks, which may have been published after sub
+// was subscribed too. At most experimental_subscription_buffer_size live
+// events are collected: errReplayOverflow is returned beyond.
+func replayEvents(
+	sub types.Subscription,
+	q *tmquery.Query,
+	fromHeight int64,
+	write func(data types.OCEventData, events map[string][]string) bool,
+) ([]tmpubsub.Message, int64, error) {
+	var (
+		pending    []tmpubsub.Message
+		maxPending = env.Config.SubscriptionBufferSize
+		overflow   = make(chan struct{})
+		done       = make(chan struct{})
+		stopped    = make(chan struct{})
+	)
 	go func() {
+		defer close(stopped)
 		for {
 			select {
 			case msg := <-sub.Out():
-				var (
-					resultEvent = &ctypes.ResultEvent{Query: query, Data: msg.Data(), Events: msg.Events()}
-					resp        = rpctypes.NewRPCSuccessResponse(subscriptionID, resultEvent)
-				)
-				writeCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
-				defer cancel()
-				if err := ctx.WSConn.WriteRPCResponse(writeCtx, resp); err != nil {
-					env.Logger.Info("Can't write response (slow client)",
-						"to", addr, "subscriptionID", subscriptionID, "err", err)
-
-					if closeIfSlow {
-						var (
-							err  = errors.New("subscription was cancelled (reason: slow client)")
-							resp = rpctypes.RPCServerError(subscriptionID, err)
-						)
-						if !ctx.WSConn.TryWriteRPCResponse(resp) {
-							env.Logger.Info("Can't write response (slow client)",
-								"to", addr, "subscriptionID", subscriptionID, "err", err)
-						}
-						return
-					}
-				}
-			case <-sub.Cancelled():
-				if sub.Err() != tmpubsub.ErrUnsubscribed {
-					var reason string
-					if sub.Err() == nil {
-						reason = "Ostracon exited"
-					} else {
-						reason = sub.Err().Error()
-					}
-					var (
-						err  = fmt.Errorf("subscription was cancelled (reason: %s)", reason)
-						resp = rpctypes.RPCServerError(subscriptionID, err)
-					)
-					if !ctx.WSConn.TryWriteRPCResponse(resp) {
-						env.Logger.Info("Can't write response (slow client)",
-							"to", addr, "subscriptionID", subscriptionID, "err", err)
-					}
+				if len(pending) >= maxPending {
+					close(overflow)
+					return
 				}
+				pending = append(pending, msg)
+			case <-done:
 				return
 			}
 		}
 	}()
+	stop := func(lastHeight int64, err error) ([]tmpubsub.Message, int64, error) {
+		close(done)
+		<-stopped
+		if err != nil {
+			return nil, 0, err
+		}
+		select {
+		case <-overflow:
+			return nil, 0, errReplayOverflow
+		default:
+			return pending, lastHeight, nil
+		}
+	}
 
-	return &ctypes.ResultSubscribe{}, nil
+	height := fromHeight
+	for ; height <= env.BlockStore.Height(); height++ {
+		select {
+		case <-sub.Cancelled():
+			// the cancellation is reported along with the live events
+			return stop(height-1, nil)
+		case <-overflow:
+			return stop(0, errReplayOverflow)
+		default:
+		}
+
+		block := env.BlockStore.LoadBlock(height)
+		if block == nil {
+			return stop(0, fmt.Errorf("block at height %d not found", height))
+		}
+		abciResponses, err := env.StateStore.LoadABCIResponses(height)
+		if err != nil {
+			if height == env.BlockStore.Height() {
+				// not applied yet, its events are to be published live
+				break
+			}
+			return stop(0, fmt.Errorf("can't load the ABCI responses of height %d: %w", height, err))
+		}
+
+		for _, event := range blockEvents(block, abciResponses) {
+			match, err := q.Matches(event.events)
+			if err != nil {
+				return stop(0, fmt.Errorf("failed to match the events of height %d: %w", height, err))
+			}
+			if match && !write(event.data, event.events) {
+				return stop(0, errSlowClient)
+			}
+		}
+	}
+
+	return stop(height-1, nil)
+}
+
+type blockEvent struct {
+	data   types.OCEventData
+	events map[string][]string
+}
+
+// blockEvents returns the events published when the block was committed, in
+// the same order, except for the ValidatorSetUpdates event.
+func blockEvents(block *types.Block, abciResponses *tmstate.ABCIResponses) []blockEvent {
+	newBlock := types.EventDataNewBlock{
+		Block:            block,
+		ResultBeginBlock: *abciResponses.BeginBlock,
+		ResultEndBlock:   *abciResponses.EndBlock,
+	}
+	newBlockHeader := types.EventDataNewBlockHeader{
+		Header:           block.Header,
+		NumTxs:           int64(len(block.Txs)),
+		ResultBeginBlock: *abciResponses.BeginBlock,
+		ResultEndBlock:   *abciResponses.EndBlock,
+	}
+	events := []blockEvent{
+		{newBlock, types.NewBlockEvents(newBlock, env.Logger)},
+		{newBlockHeader, types.NewBlockHeaderEvents(newBlockHeader, env.Logger)},
+	}
+
+	for _, ev := range block.Evidence.Evidence {
+		events = append(events, blockEvent{
+			types.EventDataNewEvidence{Evidence: ev, Height: block.Height},
+			map[string][]string{types.EventTypeKey: {types.EventNewEvidence}},
+		})
+	}
+
+	for i, tx := range block.Data.Txs {
+		txEvent := types.EventDataTx{TxResult: abci.TxResult{
+			Height: block.Height,
+			Index:  uint32(i),
+			Tx:     tx,
+			Result: *(abciResponses.DeliverTxs[i]),
+		}}
+		events = append(events, blockEvent{txEvent, types.TxEvents(txEvent, env.Logger)})
+	}
+
+	return events
+}
+
+// eventHeight returns the height of the block a replayable event belongs to,
+// or 0 for the other events.
+func eventHeight(data types.OCEventData) int64 {
+	switch data := data.(type) {
+	case types.EventDataNewBlock:
+		return data.Block.Height
+	case types.EventDataNewBlockHeader:
+		return data.Header.Height
+	case types.EventDataNewEvidence:
+		return data.Height
+	case types.EventDataTx:
+		return data.Height
+	default:
+		return 0
+	}
 }
 
 // Unsubscribe from events via WebSocket.
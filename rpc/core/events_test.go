@@ -0,0 +1,170 @@
+package core
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmstate "github.com/tendermint/tendermint/proto/tendermint/state"
+	tmversion "github.com/tendermint/tendermint/proto/tendermint/version"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/Finschia/ostracon/libs/log"
+	tmpubsub "github.com/Finschia/ostracon/libs/pubsub"
+	tmquery "github.com/Finschia/ostracon/libs/pubsub/query"
+	sm "github.com/Finschia/ostracon/state"
+	"github.com/Finschia/ostracon/types"
+)
+
+func TestValidateReplayHeight(t *testing.T) {
+	env = &Environment{}
+	env.StateStore = sm.NewStore(dbm.NewMemDB(), sm.StoreOptions{
+		DiscardABCIResponses: false,
+	})
+	err := env.StateStore.SaveABCIResponses(50, &tmstate.ABCIResponses{
+		EndBlock:   &abci.ResponseEndBlock{},
+		BeginBlock: &abci.ResponseBeginBlock{},
+	})
+	require.NoError(t, err)
+	env.BlockStore = mockBlockStore{height: 100}
+
+	testCases := []struct {
+		height  int64
+		wantErr bool
+	}{
+		{-1, true},
+		{0, true},
+		{10, true}, // no ABCI responses
+		{50, false},
+		{100, false},
+		{101, false},
+		{102, true},
+	}
+
+	for _, tc := range testCases {
+		err := validateReplayHeight(tc.height)
+		if tc.wantErr {
+			assert.Error(t, err, "height %d", tc.height)
+		} else {
+			assert.NoError(t, err, "height %d", tc.height)
+		}
+	}
+
+	env.Config.MaxReplayHeights = 50
+	assert.Error(t, validateReplayHeight(50))
+	assert.NoError(t, validateReplayHeight(100))
+
+	env.StateStore = sm.NewStore(dbm.NewMemDB(), sm.StoreOptions{
+		DiscardABCIResponses: true,
+	})
+	assert.Error(t, validateReplayHeight(50))
+}
+
+// replayBlockStore serves empty blocks up to its height.
+type replayBlockStore struct {
+	mockBlockStore
+}
+
+func (store replayBlockStore) LoadBlock(height int64) *types.Block {
+	if height > store.height {
+		return nil
+	}
+	return types.MakeBlock(height, nil, nil, nil, tmversion.Consensus{})
+}
+
+type mockSubscription struct {
+	out       chan tmpubsub.Message
+	cancelled chan struct{}
+}
+
+func (sub mockSubscription) Out() <-chan tmpubsub.Message { return sub.out }
+func (sub mockSubscription) Cancelled() <-chan struct{}   { return sub.cancelled }
+func (sub mockSubscription) Err() error                   { return nil }
+
+func TestReplayEventsPending(t *testing.T) {
+	const maxPending = 3
+
+	for _, numLive := range []int{maxPending, maxPending + 1} {
+		env = &Environment{Logger: log.TestingLogger()}
+		env.Config.SubscriptionBufferSize = maxPending
+		env.BlockStore = replayBlockStore{mockBlockStore{height: 2}}
+		env.StateStore = sm.NewStore(dbm.NewMemDB(), sm.StoreOptions{})
+		for height := int64(1); height <= 2; height++ {
+			err := env.StateStore.SaveABCIResponses(height, &tmstate.ABCIResponses{
+				EndBlock:   &abci.ResponseEndBlock{},
+				BeginBlock: &abci.ResponseBeginBlock{},
+			})
+			require.NoError(t, err)
+		}
+
+		// the live events are published before the replay starts writing
+		sub := mockSubscription{
+			out:       make(chan tmpubsub.Message, numLive),
+			cancelled: make(chan struct{}),
+		}
+		for i := 0; i < numLive; i++ {
+			sub.out <- tmpubsub.NewMessage(types.EventDataNewBlockHeader{}, nil)
+		}
+
+		q := tmquery.MustParse("tm.event = 'NewBlock'")
+		var replayed []int64
+		pending, lastHeight, err := replayEvents(sub, q, 1, func(data types.OCEventData,
+			events map[string][]string) bool {
+			for len(sub.out) > 0 {
+				time.Sleep(time.Millisecond)
+			}
+			replayed = append(replayed, eventHeight(data))
+			return true
+		})
+
+		if numLive > maxPending {
+			assert.Equal(t, errReplayOverflow, err)
+			continue
+		}
+		require.NoError(t, err)
+		assert.Len(t, pending, numLive)
+		assert.Equal(t, int64(2), lastHeight)
+		assert.Equal(t, []int64{1, 2}, replayed)
+	}
+}
+
+func TestBlockEvents(t *testing.T) {
+	env = &Environment{Logger: log.TestingLogger()}
+
+	block := types.MakeBlock(10, []types.Tx{types.Tx("a=1"), types.Tx("b=2")}, nil, nil, tmversion.Consensus{})
+	abciResponses := &tmstate.ABCIResponses{
+		DeliverTxs: []*abci.ResponseDeliverTx{
+			{Code: 0, Events: []abci.Event{{Type: "transfer", Attributes: []abci.EventAttribute{
+				{Key: []byte("sender"), Value: []byte("foo")},
+			}}}},
+			{Code: 1},
+		},
+		EndBlock: &abci.ResponseEndBlock{Events: []abci.Event{{Type: "end", Attributes: []abci.EventAttribute{
+			{Key: []byte("key"), Value: []byte("value")},
+		}}}},
+		BeginBlock: &abci.ResponseBeginBlock{},
+	}
+
+	events := blockEvents(block, abciResponses)
+	require.Len(t, events, 4)
+
+	assert.IsType(t, types.EventDataNewBlock{}, events[0].data)
+	assert.Equal(t, []string{types.EventNewBlock}, events[0].events[types.EventTypeKey])
+	assert.Equal(t, []string{"value"}, events[0].events["end.key"])
+	assert.IsType(t, types.EventDataNewBlockHeader{}, events[1].data)
+	assert.Equal(t, []string{types.EventNewBlockHeader}, events[1].events[types.EventTypeKey])
+
+	for i, event := range events[2:] {
+		txEvent, ok := event.data.(types.EventDataTx)
+		require.True(t, ok)
+		assert.EqualValues(t, i, txEvent.Index)
+		assert.Equal(t, block.Txs[i], types.Tx(txEvent.Tx))
+		assert.Equal(t, []string{types.EventTx}, event.events[types.EventTypeKey])
+		assert.Equal(t, []string{"10"}, event.events[types.TxHeightKey])
+		assert.Equal(t, int64(10), eventHeight(event.data))
+	}
+	assert.Equal(t, []string{"foo"}, events[2].events["transfer.sender"])
+}
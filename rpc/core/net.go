@@ -5,9 +5,11 @@ import (
 	"fmt"
 	"strings"
 
+	cm "github.com/Finschia/ostracon/consensus"
 	"github.com/Finschia/ostracon/p2p"
 	ctypes "github.com/Finschia/ostracon/rpc/core/types"
 	rpctypes "github.com/Finschia/ostracon/rpc/jsonrpc/types"
+	"github.com/Finschia/ostracon/types"
 )
 
 // NetInfo returns network info.
@@ -25,6 +27,8 @@ func NetInfo(ctx *rpctypes.Context) (*ctypes.ResultNetInfo, error) {
 			IsOutbound:       peer.IsOutbound(),
 			ConnectionStatus: peer.Status(),
 			RemoteIP:         peer.RemoteIP().String(),
+			TrustScore:       env.P2PPeers.PeerTrustScore(peer.ID()),
+			ConsensusGossip:  peerGossipInfo(peer),
 		})
 	}
 	// TODO: Should we include PersistentPeers and Seeds in here?
@@ -38,6 +42,30 @@ func NetInfo(ctx *rpctypes.Context) (*ctypes.ResultNetInfo, error) {
 	}, nil
 }
 
+// peerGossipInfo returns what the peer delivered to us of the consensus messages, or nil if it
+// doesn't have a consensus state yet.
+func peerGossipInfo(peer p2p.Peer) *ctypes.PeerGossipInfo {
+	ps, ok := peer.Get(types.PeerStateKey).(*cm.PeerState)
+	if !ok {
+		return nil
+	}
+	stats := ps.GetGossipStats()
+	return &ctypes.PeerGossipInfo{
+		Proposals:  toGossipInfo(stats.Proposals),
+		BlockParts: toGossipInfo(stats.BlockParts),
+		Votes:      toGossipInfo(stats.Votes),
+	}
+}
+
+func toGossipInfo(stats cm.GossipStats) ctypes.GossipInfo {
+	return ctypes.GossipInfo{
+		Received:        stats.Received,
+		FirstDeliveries: stats.FirstDeliveries,
+		DuplicateRatio:  stats.DuplicateRatio,
+		Latency:         stats.Latency,
+	}
+}
+
 // UnsafeDialSeeds dials the given seeds (comma-separated id@IP:PORT).
 func UnsafeDialSeeds(ctx *rpctypes.Context, seeds []string) (*ctypes.ResultDialSeeds, error) {
 	if len(seeds) == 0 {
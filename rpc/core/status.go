@@ -63,6 +63,7 @@ func Status(ctx *rpctypes.Context) (*ctypes.ResultStatus, error) {
 			EarliestBlockHeight: earliestBlockHeight,
 			EarliestBlockTime:   time.Unix(0, earliestBlockTimeNano),
 			CatchingUp:          env.ConsensusReactor.WaitSync(),
+			StateSync:           stateSyncInfo(),
 		},
 		ValidatorInfo: ctypes.ValidatorInfo{
 			Address:     env.PubKey.Address(),
@@ -74,6 +75,41 @@ func Status(ctx *rpctypes.Context) (*ctypes.ResultStatus, error) {
 	return result, nil
 }
 
+// stateSyncInfo returns the progress of the state sync, or nil if the node isn't restoring a
+// snapshot.
+func stateSyncInfo() *ctypes.StateSyncInfo {
+	if env.StateSyncReactor == nil {
+		return nil
+	}
+	progress := env.StateSyncReactor.Progress()
+	if progress == nil {
+		return nil
+	}
+
+	peers := make([]ctypes.StateSyncPeerInfo, 0, len(progress.Peers))
+	for _, peer := range progress.Peers {
+		peers = append(peers, ctypes.StateSyncPeerInfo{
+			ID:         peer.ID,
+			Latency:    peer.Latency,
+			Throughput: int64(peer.Throughput),
+			InFlight:   peer.InFlight,
+			Received:   peer.Received,
+			Timeouts:   peer.Timeouts,
+		})
+	}
+	return &ctypes.StateSyncInfo{
+		SnapshotHeight: progress.SnapshotHeight,
+		SnapshotFormat: progress.SnapshotFormat,
+		ChunksTotal:    progress.ChunksTotal,
+		ChunksFetched:  progress.ChunksFetched,
+		ChunksApplied:  progress.ChunksApplied,
+		BytesFetched:   progress.BytesFetched,
+		Elapsed:        progress.Elapsed,
+		ETA:            progress.ETA,
+		Peers:          peers,
+	}
+}
+
 func validatorAtHeight(h int64) *types.Validator {
 	vals, err := env.StateStore.LoadValidators(h)
 	if err != nil {
@@ -1,14 +1,18 @@
 package core
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"sort"
 
+	abci "github.com/tendermint/tendermint/abci/types"
+
 	tmmath "github.com/Finschia/ostracon/libs/math"
 	tmquery "github.com/Finschia/ostracon/libs/pubsub/query"
 	ctypes "github.com/Finschia/ostracon/rpc/core/types"
 	rpctypes "github.com/Finschia/ostracon/rpc/jsonrpc/types"
+	"github.com/Finschia/ostracon/state/txindex"
 	"github.com/Finschia/ostracon/state/txindex/null"
 	"github.com/Finschia/ostracon/types"
 )
@@ -53,6 +57,11 @@ func Tx(ctx *rpctypes.Context, hash []byte, prove bool) (*ctypes.ResultTx, error
 
 // TxSearch allows you to query for multiple transactions results. It returns a
 // list of transactions (maximum ?per_page entries) and the total count.
+//
+// If a cursor is given, even an empty one, the page is ignored and the search
+// continues after the position of the cursor instead, without counting all the
+// results: the total count is the number of returned transactions, and the
+// next cursor is returned if there are more results.
 // More: https://docs.tendermint.com/v0.34/rpc/#/Info/tx_search
 func TxSearch(
 	ctx *rpctypes.Context,
@@ -60,7 +69,11 @@ func TxSearch(
 	prove bool,
 	pagePtr, perPagePtr *int,
 	orderBy string,
+	cursorPtr *string,
 ) (*ctypes.ResultTxSearch, error) {
+	if cursorPtr != nil {
+		return txSearchCursor(ctx, query, prove, *cursorPtr, validatePerPage(perPagePtr), orderBy)
+	}
 
 	// if index is disabled, return error
 	if _, ok := env.TxIndexer.(*null.TxIndex); ok {
@@ -113,23 +126,124 @@ func TxSearch(
 
 	apiResults := make([]*ctypes.ResultTx, 0, pageSize)
 	for i := skipCount; i < skipCount+pageSize; i++ {
-		r := results[i]
+		apiResults = append(apiResults, resultTx(results[i], prove))
+	}
 
-		var proof types.TxProof
-		if prove {
-			block := env.BlockStore.LoadBlock(r.Height)
-			proof = block.Data.Txs.Proof(int(r.Index)) // XXX: overflow on 32-bit machines
+	return &ctypes.ResultTxSearch{Txs: apiResults, TotalCount: totalCount}, nil
+}
+
+// TxCount returns the number of transactions matching the query, as in the
+// total count of TxSearch, without loading them.
+func TxCount(ctx *rpctypes.Context, query string) (*ctypes.ResultTxCount, error) {
+	// if index is disabled, return error
+	if _, ok := env.TxIndexer.(*null.TxIndex); ok {
+		return nil, errors.New("transaction indexing is disabled")
+	} else if len(query) > maxQueryLength {
+		return nil, errors.New("maximum query length exceeded")
+	}
+
+	q, err := tmquery.New(query)
+	if err != nil {
+		return nil, err
+	}
+
+	count, err := env.TxIndexer.Count(ctx.Context(), q)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ctypes.ResultTxCount{TotalCount: count}, nil
+}
+
+// txSearchCursor returns up to limit transactions after the position of the
+// cursor, along with the cursor of the last one if there are more.
+func txSearchCursor(
+	ctx *rpctypes.Context,
+	query string,
+	prove bool,
+	cursor string,
+	limit int,
+	orderBy string,
+) (*ctypes.ResultTxSearch, error) {
+	apiResults := make([]*ctypes.ResultTx, 0, limit)
+	var lastCursor, nextCursor string
+	err := TxSearchStream(ctx.Context(), query, prove, cursor, orderBy, func(r *ctypes.ResultTx, c string) bool {
+		if len(apiResults) == limit {
+			// there are more results
+			nextCursor = lastCursor
+			return false
 		}
+		apiResults = append(apiResults, r)
+		lastCursor = c
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
 
-		apiResults = append(apiResults, &ctypes.ResultTx{
-			Hash:     types.Tx(r.Tx).Hash(),
-			Height:   r.Height,
-			Index:    r.Index,
-			TxResult: r.Result,
-			Tx:       r.Tx,
-			Proof:    proof,
-		})
+	return &ctypes.ResultTxSearch{Txs: apiResults, TotalCount: len(apiResults), NextCursor: nextCursor}, nil
+}
+
+// TxSearchStream calls fn with each transaction matching the query after the
+// position of the cursor, along with the cursor of its own position, until fn
+// returns false. The transactions are streamed in height and index order,
+// ascending unless orderBy is "desc". An empty cursor starts from the first
+// transaction.
+func TxSearchStream(
+	ctx context.Context,
+	query string,
+	prove bool,
+	cursor string,
+	orderBy string,
+	fn func(*ctypes.ResultTx, string) bool,
+) error {
+	// if index is disabled, return error
+	if _, ok := env.TxIndexer.(*null.TxIndex); ok {
+		return errors.New("transaction indexing is disabled")
+	} else if len(query) > maxQueryLength {
+		return errors.New("maximum query length exceeded")
 	}
 
-	return &ctypes.ResultTxSearch{Txs: apiResults, TotalCount: totalCount}, nil
+	q, err := tmquery.New(query)
+	if err != nil {
+		return err
+	}
+
+	opts := txindex.StreamOptions{MaxHeight: env.BlockStore.Height()}
+	switch orderBy {
+	case "desc":
+		opts.Desc = true
+	case "asc", "":
+	default:
+		return errors.New("expected order_by to be either `asc` or `desc` or empty")
+	}
+
+	if cursor != "" {
+		after, err := decodeTxCursor(cursor)
+		if err != nil {
+			return err
+		}
+		opts.After = &after
+	}
+
+	return env.TxIndexer.SearchStream(ctx, q, opts, func(r *abci.TxResult) bool {
+		return fn(resultTx(r, prove), encodeTxCursor(txindex.Cursor{Height: r.Height, Index: r.Index}))
+	})
+}
+
+func resultTx(r *abci.TxResult, prove bool) *ctypes.ResultTx {
+	var proof types.TxProof
+	if prove {
+		block := env.BlockStore.LoadBlock(r.Height)
+		proof = block.Data.Txs.Proof(int(r.Index)) // XXX: overflow on 32-bit machines
+	}
+
+	return &ctypes.ResultTx{
+		Hash:     types.Tx(r.Tx).Hash(),
+		Height:   r.Height,
+		Index:    r.Index,
+		TxResult: r.Result,
+		Tx:       r.Tx,
+		Proof:    proof,
+	}
 }
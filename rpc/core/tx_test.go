@@ -14,6 +14,7 @@ import (
 	txidxnull "github.com/Finschia/ostracon/state/txindex/null"
 	"github.com/stretchr/testify/require"
 
+	ctypes "github.com/Finschia/ostracon/rpc/core/types"
 	rpctypes "github.com/Finschia/ostracon/rpc/jsonrpc/types"
 	"github.com/Finschia/ostracon/types"
 )
@@ -36,7 +37,7 @@ func TestTxSearchByTxHashQuery(t *testing.T) {
 
 	{
 		// Get by tx.hash (not search/range)
-		res, err := TxSearch(ctx, q, prove, &page, &perPage, orderBy)
+		res, err := TxSearch(ctx, q, prove, &page, &perPage, orderBy, nil)
 
 		require.NoError(t, err)
 		require.NotNil(t, res)
@@ -52,7 +53,7 @@ func TestTxSearchByTxHashQuery(t *testing.T) {
 
 	{
 		// Get by block.height (not search/range)
-		res, err := TxSearch(ctx, q, prove, &page, &perPage, orderBy)
+		res, err := TxSearch(ctx, q, prove, &page, &perPage, orderBy, nil)
 
 		require.NoError(t, err)
 		require.NotNil(t, res)
@@ -82,7 +83,7 @@ func TestTxSearchByTxHeightQuery(t *testing.T) {
 
 	{
 		// Search blocks by range query with asc (default)
-		res, err := TxSearch(ctx, q, prove, &page, &perPage, orderBy)
+		res, err := TxSearch(ctx, q, prove, &page, &perPage, orderBy, nil)
 
 		require.NoError(t, err)
 		require.NotNil(t, res)
@@ -100,7 +101,7 @@ func TestTxSearchByTxHeightQuery(t *testing.T) {
 	{
 		orderBy = TestOrderByDesc
 		// Search blocks by range query with desc
-		res, err := TxSearch(ctx, q, prove, &page, &perPage, orderBy)
+		res, err := TxSearch(ctx, q, prove, &page, &perPage, orderBy, nil)
 
 		require.NoError(t, err)
 		require.NotNil(t, res)
@@ -120,7 +121,7 @@ func TestTxSearchByTxHeightQuery(t *testing.T) {
 		q = fmt.Sprintf("%s>=%d AND %s<=%d", types.TxHeightKey, height, types.TxHeightKey, height+1)
 		orderBy = TestOrderByAsc
 		// Search blocks by range query with asc
-		res, err := TxSearch(ctx, q, prove, &page, &perPage, orderBy)
+		res, err := TxSearch(ctx, q, prove, &page, &perPage, orderBy, nil)
 
 		require.NoError(t, err)
 		require.NotNil(t, res)
@@ -135,13 +136,20 @@ func TestTxSearchByTxHeightQuery(t *testing.T) {
 		require.Equal(t, height+1, last.Height)
 		require.Equal(t, uint32(numToMakeTxs-1), last.Index)
 	}
+	{
+		// Count the txs of the range query
+		res, err := TxCount(ctx, q)
+
+		require.NoError(t, err)
+		require.Equal(t, numToMakeTxs*2, res.TotalCount)
+	}
 	{
 		// Range queries with illegal key
 		q = fmt.Sprintf("%s>=%d AND %s<=%d AND test.key>=1",
 			types.TxHeightKey, height, types.TxHeightKey, height+1)
 		orderBy = TestOrderByAsc
 		// Search blocks by range query with asc
-		res, err := TxSearch(ctx, q, prove, &page, &perPage, orderBy)
+		res, err := TxSearch(ctx, q, prove, &page, &perPage, orderBy, nil)
 
 		require.NoError(t, err)
 		require.NotNil(t, res)
@@ -150,6 +158,55 @@ func TestTxSearchByTxHeightQuery(t *testing.T) {
 	}
 }
 
+func TestTxSearchByCursor(t *testing.T) {
+	height := int64(1)
+	ctx := &rpctypes.Context{}
+
+	q := fmt.Sprintf("%s>=%d", types.TxHeightKey, height)
+	prove := false
+	perPage := 4
+
+	state, cleanup := makeTestState()
+	defer cleanup()
+
+	numToMakeBlocks := 3
+	numToMakeTxs := 3
+	// SaveBlock
+	storeTestBlocks(height, int64(numToMakeBlocks), int64(numToMakeTxs), state, time.Now())
+
+	for _, orderBy := range []string{TestOrderByAsc, TestOrderByDesc} {
+		cursor := ""
+		txs := make([]*ctypes.ResultTx, 0)
+		for pages := 1; ; pages++ {
+			res, err := TxSearch(ctx, q, prove, nil, &perPage, orderBy, &cursor)
+			require.NoError(t, err)
+			require.Equal(t, len(res.Txs), res.TotalCount)
+			txs = append(txs, res.Txs...)
+			if res.NextCursor == "" {
+				require.Equal(t, 3, pages)
+				break
+			}
+			require.Equal(t, perPage, len(res.Txs))
+			cursor = res.NextCursor
+		}
+
+		require.Equal(t, numToMakeBlocks*numToMakeTxs, len(txs))
+		for i, tx := range txs {
+			position := i
+			if orderBy == TestOrderByDesc {
+				position = len(txs) - 1 - i
+			}
+			require.Equal(t, height+int64(position/numToMakeTxs), tx.Height)
+			require.Equal(t, uint32(position%numToMakeTxs), tx.Index)
+		}
+	}
+
+	// error: invalid cursor
+	cursor := "invalid"
+	_, err := TxSearch(ctx, q, prove, nil, &perPage, TestOrderByAsc, &cursor)
+	require.Error(t, err)
+}
+
 func TestTxSearch_errors(t *testing.T) {
 	ctx := &rpctypes.Context{}
 
@@ -164,7 +221,7 @@ func TestTxSearch_errors(t *testing.T) {
 		env = &Environment{}
 		env.TxIndexer = &txidxnull.TxIndex{}
 
-		res, err := TxSearch(ctx, q, prove, &page, &perPage, orderBy)
+		res, err := TxSearch(ctx, q, prove, &page, &perPage, orderBy, nil)
 
 		require.Error(t, err)
 		require.Equal(t, errors.New("transaction indexing is disabled"), err)
@@ -174,7 +231,7 @@ func TestTxSearch_errors(t *testing.T) {
 		// error: tmquery.New(query)
 		env = &Environment{}
 
-		res, err := TxSearch(ctx, q, prove, &page, &perPage, orderBy)
+		res, err := TxSearch(ctx, q, prove, &page, &perPage, orderBy, nil)
 
 		require.Error(t, err)
 		require.Equal(t,
@@ -188,7 +245,7 @@ func TestTxSearch_errors(t *testing.T) {
 		env.TxIndexer = txidxkv.NewTxIndex(dbm.NewMemDB())
 		q = fmt.Sprintf("%s=%s", types.TxHashKey, "'1'")
 
-		res, err := TxSearch(ctx, q, prove, &page, &perPage, orderBy)
+		res, err := TxSearch(ctx, q, prove, &page, &perPage, orderBy, nil)
 
 		require.Error(t, err)
 		require.Equal(t,
@@ -202,7 +259,7 @@ func TestTxSearch_errors(t *testing.T) {
 		env.TxIndexer = txidxkv.NewTxIndex(dbm.NewMemDB())
 		q = fmt.Sprintf("%s=%s", types.TxHashKey, "'1234567890abcdef'")
 
-		res, err := TxSearch(ctx, q, prove, &page, &perPage, orderBy)
+		res, err := TxSearch(ctx, q, prove, &page, &perPage, orderBy, nil)
 
 		require.Error(t, err)
 		require.Equal(t,
@@ -217,7 +274,7 @@ func TestTxSearch_errors(t *testing.T) {
 		q = fmt.Sprintf("%s=%s", types.TxHashKey, "'1234567890abcdef'")
 		orderBy = TestOrderByAsc
 
-		res, err := TxSearch(ctx, q, prove, &page, &perPage, orderBy)
+		res, err := TxSearch(ctx, q, prove, &page, &perPage, orderBy, nil)
 
 		require.Error(t, err)
 		require.Equal(t,
@@ -84,6 +84,35 @@ type SyncInfo struct {
 	EarliestBlockTime   time.Time      `json:"earliest_block_time"`
 
 	CatchingUp bool `json:"catching_up"`
+
+	// Set while the node is restoring a state sync snapshot
+	StateSync *StateSyncInfo `json:"state_sync,omitempty"`
+}
+
+// Info about the restoration of a state sync snapshot
+type StateSyncInfo struct {
+	SnapshotHeight uint64 `json:"snapshot_height"`
+	SnapshotFormat uint32 `json:"snapshot_format"`
+	ChunksTotal    uint32 `json:"chunks_total"`
+	ChunksFetched  uint32 `json:"chunks_fetched"`
+	ChunksApplied  uint32 `json:"chunks_applied"`
+	BytesFetched   int64  `json:"bytes_fetched"`
+
+	Elapsed time.Duration `json:"elapsed"`
+	// Estimated time to fetch the remaining chunks, 0 if unknown
+	ETA time.Duration `json:"eta"`
+
+	Peers []StateSyncPeerInfo `json:"peers"`
+}
+
+// Info about a peer serving state sync chunks
+type StateSyncPeerInfo struct {
+	ID         p2p.ID        `json:"id"`
+	Latency    time.Duration `json:"latency"`
+	Throughput int64         `json:"throughput"` // bytes per second
+	InFlight   int           `json:"in_flight"`
+	Received   int           `json:"received"`
+	Timeouts   int           `json:"timeouts"`
 }
 
 // Info about the node's validator
@@ -132,6 +161,26 @@ type Peer struct {
 	IsOutbound       bool                 `json:"is_outbound"`
 	ConnectionStatus p2p.ConnectionStatus `json:"connection_status"`
 	RemoteIP         string               `json:"remote_ip"`
+	TrustScore       int                  `json:"trust_score"`
+	// What the peer delivered to us of the consensus messages, nil until it has a consensus state
+	ConsensusGossip *PeerGossipInfo `json:"consensus_gossip,omitempty"`
+}
+
+// Info about the proposals, block parts and votes a peer delivered to us
+type PeerGossipInfo struct {
+	Proposals  GossipInfo `json:"proposals"`
+	BlockParts GossipInfo `json:"block_parts"`
+	Votes      GossipInfo `json:"votes"`
+}
+
+// Info about a kind of consensus message a peer delivered to us
+type GossipInfo struct {
+	Received        int `json:"received"`
+	FirstDeliveries int `json:"first_deliveries"` // delivered before any other peer
+	// Share of the messages already delivered by another peer
+	DuplicateRatio float64 `json:"duplicate_ratio"`
+	// Moving average of the time since the start of our round step, at first delivery
+	Latency time.Duration `json:"latency"`
 }
 
 // ResultValidators for a height
@@ -144,6 +193,29 @@ type ResultValidators struct {
 	Total int `json:"total"`
 }
 
+// ResultProposerElection explains the proposer election of a block
+type ResultProposerElection struct {
+	Height int64 `json:"height"`
+	Round  int32 `json:"round"`
+	// Proof hash of the previous block, or the genesis hash at the initial height
+	PreviousProofHash bytes.HexBytes   `json:"previous_proof_hash"`
+	RoundHash         bytes.HexBytes   `json:"round_hash"`
+	Seed              uint64           `json:"seed"`
+	Threshold         uint64           `json:"threshold"`
+	TotalVotingPower  int64            `json:"total_voting_power"`
+	Proposer          *types.Validator `json:"proposer"`
+	// VRF proof of the block and its verified output
+	Proof     bytes.HexBytes `json:"proof"`
+	VRFOutput bytes.HexBytes `json:"vrf_output"`
+}
+
+// ResultUpcomingProposers lists the proposers of the current height
+type ResultUpcomingProposers struct {
+	Height int64 `json:"height"`
+	// Proposers indexed by round
+	Proposers []*types.Validator `json:"proposers"`
+}
+
 // ConsensusParams for given height
 type ResultConsensusParams struct {
 	BlockHeight     int64                   `json:"block_height"`
@@ -206,12 +278,26 @@ type ResultTx struct {
 type ResultTxSearch struct {
 	Txs        []*ResultTx `json:"txs"`
 	TotalCount int         `json:"total_count"`
+	// NextCursor continues a search by cursor if there are more results.
+	NextCursor string `json:"next_cursor,omitempty"`
 }
 
 // ResultBlockSearch defines the RPC response type for a block search by events.
 type ResultBlockSearch struct {
 	Blocks     []*ResultBlock `json:"blocks"`
 	TotalCount int            `json:"total_count"`
+	// NextCursor continues a search by cursor if there are more results.
+	NextCursor string `json:"next_cursor,omitempty"`
+}
+
+// ResultTxCount is the number of transactions matching a search by events.
+type ResultTxCount struct {
+	TotalCount int `json:"total_count"`
+}
+
+// ResultBlockCount is the number of blocks matching a search by events.
+type ResultBlockCount struct {
+	TotalCount int `json:"total_count"`
 }
 
 // List of mempool txs
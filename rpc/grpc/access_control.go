@@ -0,0 +1,108 @@
+package coregrpc
+
+import (
+	"context"
+	"net/http"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	core "github.com/Finschia/ostracon/rpc/core"
+	rpcserver "github.com/Finschia/ostracon/rpc/jsonrpc/server"
+	rpctypes "github.com/Finschia/ostracon/rpc/jsonrpc/types"
+)
+
+// routes maps the gRPC methods to the JSON-RPC routes of the same rpc/core
+// functions, whose role and rate limits apply to them.
+var routes = map[string]string{
+	"/ostracon.rpc.grpc.BroadcastAPI/Ping":         "health",
+	"/ostracon.rpc.grpc.BroadcastAPI/BroadcastTx":  "broadcast_tx_commit",
+	"/ostracon.rpc.grpc.SearchAPI/TxSearch":        "tx_search",
+	"/ostracon.rpc.grpc.SearchAPI/BlockSearch":     "block_search",
+	"/ostracon.rpc.grpc.QueryAPI/Status":           "status",
+	"/ostracon.rpc.grpc.QueryAPI/Block":            "block",
+	"/ostracon.rpc.grpc.QueryAPI/BlockResults":     "block_results",
+	"/ostracon.rpc.grpc.QueryAPI/Commit":           "commit",
+	"/ostracon.rpc.grpc.QueryAPI/Validators":       "validators",
+	"/ostracon.rpc.grpc.QueryAPI/Tx":               "tx",
+	"/ostracon.rpc.grpc.QueryAPI/TxSearch":         "tx_search",
+	"/ostracon.rpc.grpc.QueryAPI/ABCIQuery":        "abci_query",
+	"/ostracon.rpc.grpc.QueryAPI/BroadcastTxSync":  "broadcast_tx_sync",
+	"/ostracon.rpc.grpc.QueryAPI/BroadcastTxAsync": "broadcast_tx_async",
+	"/ostracon.rpc.grpc.QueryAPI/Subscribe":        "subscribe",
+}
+
+// accessControlInterceptors returns the interceptors authorizing each gRPC
+// call with ac, as the call of its JSON-RPC route over HTTP would be. The
+// callers authenticate with the "authorization" metadata.
+func accessControlInterceptors(ac *rpcserver.AccessControl) []grpc.ServerOption {
+	authorize := func(ctx context.Context, method string) error {
+		rpcFunc, ok := core.Routes[routes[method]]
+		if !ok {
+			return status.Errorf(codes.Unimplemented, "unknown method %s", method)
+		}
+		httpStatus, err := ac.Authorize(httpRequest(ctx), rpcFunc)
+		if err != nil {
+			return status.Error(grpcCode(httpStatus), err.Error())
+		}
+		return nil
+	}
+
+	return []grpc.ServerOption{
+		grpc.UnaryInterceptor(func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
+			handler grpc.UnaryHandler) (interface{}, error) {
+			if err := authorize(ctx, info.FullMethod); err != nil {
+				return nil, err
+			}
+			return handler(ctx, req)
+		}),
+		grpc.StreamInterceptor(func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo,
+			handler grpc.StreamHandler) error {
+			if err := authorize(stream.Context(), info.FullMethod); err != nil {
+				return err
+			}
+			return handler(srv, stream)
+		}),
+	}
+}
+
+// grpcCode returns the gRPC status code of an HTTP status.
+func grpcCode(httpStatus int) codes.Code {
+	switch httpStatus {
+	case http.StatusUnauthorized:
+		return codes.Unauthenticated
+	case http.StatusForbidden:
+		return codes.PermissionDenied
+	case http.StatusTooManyRequests:
+		return codes.ResourceExhausted
+	default:
+		return codes.Unknown
+	}
+}
+
+// httpRequest describes the gRPC request of ctx as an HTTP one, with its
+// context, the address of its caller and its metadata as headers.
+func httpRequest(ctx context.Context) *http.Request {
+	r := (&http.Request{Header: http.Header{}}).WithContext(ctx)
+	if p, ok := peer.FromContext(ctx); ok {
+		r.RemoteAddr = p.Addr.String()
+	}
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		for key, values := range md {
+			for _, value := range values {
+				r.Header.Add(key, value)
+			}
+		}
+	}
+	return r
+}
+
+// rpcContext returns the context of the rpc/core functions serving the gRPC
+// request of ctx, so that they are cancelled along with it and see the
+// address of its caller.
+func rpcContext(ctx context.Context) *rpctypes.Context {
+	return &rpctypes.Context{HTTPReq: httpRequest(ctx)}
+}
@@ -0,0 +1,65 @@
+package coregrpc
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"github.com/Finschia/ostracon/libs/log"
+	rpcserver "github.com/Finschia/ostracon/rpc/jsonrpc/server"
+)
+
+func TestRPCContext(t *testing.T) {
+	addr := &net.TCPAddr{IP: net.IPv4(1, 2, 3, 4), Port: 5}
+	ctx, cancel := context.WithCancel(peer.NewContext(context.Background(), &peer.Peer{Addr: addr}))
+
+	rpcCtx := rpcContext(ctx)
+	assert.Equal(t, "1.2.3.4:5", rpcCtx.RemoteAddr())
+	cancel()
+	assert.Error(t, rpcCtx.Context().Err())
+}
+
+func TestAccessControlInterceptors(t *testing.T) {
+	ac := rpcserver.NewAccessControl(log.TestingLogger(),
+		rpcserver.WithAuthenticator(rpcserver.NewBearerTokenAuthenticator(map[string]rpcserver.Role{
+			"user-token": rpcserver.RoleUser,
+		})),
+		rpcserver.WithIPRateLimit(0.001, 1),
+	)
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	go StartGRPCServer(ln, WithAccessControl(ac)) //nolint:errcheck
+	defer ln.Close()
+
+	//nolint:staticcheck // SA1019 Existing use of deprecated but supported dial option.
+	conn, err := grpc.Dial(ln.Addr().String(), grpc.WithInsecure())
+	require.NoError(t, err)
+	defer conn.Close()
+	client := NewBroadcastAPIClient(conn)
+
+	ping := func(token string) codes.Code {
+		ctx := context.Background()
+		if token != "" {
+			ctx = metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+token)
+		}
+		_, err := client.Ping(ctx, &RequestPing{})
+		return status.Code(err)
+	}
+
+	assert.Equal(t, codes.Unauthenticated, ping("unknown-token"))
+	// the anonymous callers are rate limited per IP, the authenticated ones
+	// are not limited
+	assert.Equal(t, codes.OK, ping(""))
+	assert.Equal(t, codes.ResourceExhausted, ping(""))
+	assert.Equal(t, codes.OK, ping("user-token"))
+	assert.Equal(t, codes.OK, ping("user-token"))
+}
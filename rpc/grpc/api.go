@@ -7,7 +7,7 @@ import (
 
 	ocabci "github.com/Finschia/ostracon/abci/types"
 	core "github.com/Finschia/ostracon/rpc/core"
-	rpctypes "github.com/Finschia/ostracon/rpc/jsonrpc/types"
+	ctypes "github.com/Finschia/ostracon/rpc/core/types"
 )
 
 type broadcastAPI struct {
@@ -19,9 +19,7 @@ func (bapi *broadcastAPI) Ping(ctx context.Context, req *RequestPing) (*Response
 }
 
 func (bapi *broadcastAPI) BroadcastTx(ctx context.Context, req *RequestBroadcastTx) (*ResponseBroadcastTx, error) {
-	// NOTE: there's no way to get client's remote address
-	// see https://stackoverflow.com/questions/33684570/session-and-remote-ip-address-in-grpc-go
-	res, err := core.BroadcastTxCommit(&rpctypes.Context{}, req.Tx)
+	res, err := core.BroadcastTxCommit(rpcContext(ctx), req.Tx)
 	if err != nil {
 		return nil, err
 	}
@@ -39,3 +37,58 @@ func (bapi *broadcastAPI) BroadcastTx(ctx context.Context, req *RequestBroadcast
 		},
 	}, nil
 }
+
+type searchAPI struct {
+}
+
+func (sapi *searchAPI) TxSearch(req *RequestTxSearch, stream SearchAPI_TxSearchServer) error {
+	var sendErr error
+	err := core.TxSearchStream(stream.Context(), req.Query, req.Prove, req.Cursor, req.OrderBy,
+		func(r *ctypes.ResultTx, cursor string) bool {
+			res := &ResponseTxSearch{
+				Hash:     r.Hash,
+				Height:   r.Height,
+				Index:    r.Index,
+				TxResult: &r.TxResult,
+				Tx:       r.Tx,
+				Cursor:   cursor,
+			}
+			if req.Prove {
+				proof := r.Proof.ToProto()
+				res.Proof = &proof
+			}
+
+			sendErr = stream.Send(res)
+			return sendErr == nil
+		})
+	if err != nil {
+		return err
+	}
+
+	return sendErr
+}
+
+func (sapi *searchAPI) BlockSearch(req *RequestBlockSearch, stream SearchAPI_BlockSearchServer) error {
+	var sendErr error
+	err := core.BlockSearchStream(stream.Context(), req.Query, req.Cursor, req.OrderBy,
+		func(r *ctypes.ResultBlock, cursor string) bool {
+			block, err := r.Block.ToProto()
+			if err != nil {
+				sendErr = err
+				return false
+			}
+			blockID := r.BlockID.ToProto()
+
+			sendErr = stream.Send(&ResponseBlockSearch{
+				BlockId: &blockID,
+				Block:   block,
+				Cursor:  cursor,
+			})
+			return sendErr == nil
+		})
+	if err != nil {
+		return err
+	}
+
+	return sendErr
+}
@@ -7,6 +7,7 @@ import (
 	"google.golang.org/grpc"
 
 	tmnet "github.com/Finschia/ostracon/libs/net"
+	rpcserver "github.com/Finschia/ostracon/rpc/jsonrpc/server"
 )
 
 // Config is an gRPC server configuration.
@@ -14,12 +15,39 @@ type Config struct {
 	MaxOpenConnections int
 }
 
-// StartGRPCServer starts a new gRPC BroadcastAPIServer using the given
-// net.Listener.
+// Option sets an optional parameter on the gRPC server.
+type Option func(*serverOptions)
+
+type serverOptions struct {
+	accessControl *rpcserver.AccessControl
+}
+
+// WithAccessControl authorizes each call with ac, as the call of the
+// JSON-RPC route of the same function over HTTP. The callers authenticate
+// with the bearer token of their "authorization" metadata.
+func WithAccessControl(ac *rpcserver.AccessControl) Option {
+	return func(opts *serverOptions) {
+		opts.accessControl = ac
+	}
+}
+
+// StartGRPCServer starts a new gRPC BroadcastAPIServer, SearchAPIServer and
+// QueryAPIServer using the given net.Listener.
 // NOTE: This function blocks - you may want to call it in a go-routine.
-func StartGRPCServer(ln net.Listener) error {
-	grpcServer := grpc.NewServer()
+func StartGRPCServer(ln net.Listener, options ...Option) error {
+	opts := &serverOptions{}
+	for _, option := range options {
+		option(opts)
+	}
+
+	var grpcOptions []grpc.ServerOption
+	if opts.accessControl != nil {
+		grpcOptions = accessControlInterceptors(opts.accessControl)
+	}
+	grpcServer := grpc.NewServer(grpcOptions...)
 	RegisterBroadcastAPIServer(grpcServer, &broadcastAPI{})
+	RegisterSearchAPIServer(grpcServer, &searchAPI{})
+	RegisterQueryAPIServer(grpcServer, &queryAPI{})
 	return grpcServer.Serve(ln)
 }
 
@@ -34,6 +62,28 @@ func StartGRPCClient(protoAddr string) BroadcastAPIClient {
 	return NewBroadcastAPIClient(conn)
 }
 
+// StartGRPCSearchClient dials the gRPC server using protoAddr and returns a
+// new SearchAPIClient.
+func StartGRPCSearchClient(protoAddr string) SearchAPIClient {
+	//nolint:staticcheck // SA1019 Existing use of deprecated but supported dial option.
+	conn, err := grpc.Dial(protoAddr, grpc.WithInsecure(), grpc.WithContextDialer(dialerFunc))
+	if err != nil {
+		panic(err)
+	}
+	return NewSearchAPIClient(conn)
+}
+
+// StartGRPCQueryClient dials the gRPC server using protoAddr and returns a
+// new QueryAPIClient.
+func StartGRPCQueryClient(protoAddr string) QueryAPIClient {
+	//nolint:staticcheck // SA1019 Existing use of deprecated but supported dial option.
+	conn, err := grpc.Dial(protoAddr, grpc.WithInsecure(), grpc.WithContextDialer(dialerFunc))
+	if err != nil {
+		panic(err)
+	}
+	return NewQueryAPIClient(conn)
+}
+
 func dialerFunc(ctx context.Context, addr string) (net.Conn, error) {
 	return tmnet.Connect(addr)
 }
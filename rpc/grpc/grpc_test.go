@@ -2,8 +2,11 @@ package coregrpc_test
 
 import (
 	"context"
+	"fmt"
+	"io"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -33,3 +36,129 @@ func TestBroadcastTx(t *testing.T) {
 	require.EqualValues(t, 0, res.CheckTx.Code)
 	require.EqualValues(t, 0, res.DeliverTx.Code)
 }
+
+func TestSearch(t *testing.T) {
+	for i := 0; i < 3; i++ {
+		_, err := rpctest.GetGRPCClient().BroadcastTx(
+			context.Background(),
+			&core_grpc.RequestBroadcastTx{Tx: []byte(fmt.Sprintf("search tx %d", i))},
+		)
+		require.NoError(t, err)
+	}
+
+	client := rpctest.GetGRPCSearchClient()
+
+	// resume the search after the first tx
+	stream, err := client.TxSearch(context.Background(), &core_grpc.RequestTxSearch{
+		Query:   "app.creator = 'Cosmoshi Netowoko'",
+		Prove:   true,
+		OrderBy: "asc",
+	})
+	require.NoError(t, err)
+	first, err := stream.Recv()
+	require.NoError(t, err)
+	require.NotNil(t, first.Proof)
+
+	stream, err = client.TxSearch(context.Background(), &core_grpc.RequestTxSearch{
+		Query:   "app.creator = 'Cosmoshi Netowoko'",
+		OrderBy: "asc",
+		Cursor:  first.Cursor,
+	})
+	require.NoError(t, err)
+	last := first
+	count := 1
+	for {
+		res, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		require.True(t, res.Height > last.Height || (res.Height == last.Height && res.Index > last.Index))
+		last = res
+		count++
+	}
+	require.GreaterOrEqual(t, count, 3)
+
+	blockStream, err := client.BlockSearch(context.Background(), &core_grpc.RequestBlockSearch{
+		Query: fmt.Sprintf("block.height <= %d", last.Height),
+	})
+	require.NoError(t, err)
+	res, err := blockStream.Recv()
+	require.NoError(t, err)
+	require.Equal(t, last.Height, res.Block.Header.Height)
+	require.NotNil(t, res.BlockId)
+}
+
+func TestQueryAPI(t *testing.T) {
+	client := rpctest.GetGRPCQueryClient()
+	ctx := context.Background()
+
+	status, err := client.Status(ctx, &core_grpc.RequestStatus{})
+	require.NoError(t, err)
+	require.NotEmpty(t, status.NodeInfo.Network)
+	require.NotNil(t, status.ValidatorInfo.PubKey)
+
+	broadcast, err := client.BroadcastTxSync(ctx, &core_grpc.RequestBroadcastTxSync{Tx: []byte("query=api")})
+	require.NoError(t, err)
+	require.EqualValues(t, 0, broadcast.Code)
+
+	// wait for the tx to be committed
+	var tx *core_grpc.ResponseTx
+	require.Eventually(t, func() bool {
+		tx, err = client.Tx(ctx, &core_grpc.RequestTx{Hash: broadcast.Hash, Prove: true})
+		return err == nil
+	}, 10*time.Second, 100*time.Millisecond)
+	require.EqualValues(t, "query=api", tx.Tx)
+	require.NotNil(t, tx.Proof)
+
+	search, err := client.TxSearch(ctx, &core_grpc.RequestTxSearchPage{
+		Query: fmt.Sprintf("tx.height = %d", tx.Height),
+	})
+	require.NoError(t, err)
+	require.EqualValues(t, 1, search.TotalCount)
+	require.Equal(t, tx.Hash, search.Txs[0].Hash)
+
+	block, err := client.Block(ctx, &core_grpc.RequestBlock{Height: tx.Height})
+	require.NoError(t, err)
+	require.Equal(t, tx.Height, block.Block.Header.Height)
+
+	results, err := client.BlockResults(ctx, &core_grpc.RequestBlockResults{Height: tx.Height})
+	require.NoError(t, err)
+	require.Len(t, results.TxsResults, 1)
+
+	commit, err := client.Commit(ctx, &core_grpc.RequestCommit{Height: tx.Height})
+	require.NoError(t, err)
+	require.Equal(t, tx.Height, commit.SignedHeader.Header.Height)
+
+	validators, err := client.Validators(ctx, &core_grpc.RequestValidators{Height: tx.Height})
+	require.NoError(t, err)
+	require.Len(t, validators.Validators, 1)
+
+	query, err := client.ABCIQuery(ctx, &core_grpc.RequestABCIQuery{Path: "/key", Data: []byte("query")})
+	require.NoError(t, err)
+	require.EqualValues(t, "api", query.Response.Value)
+
+	async, err := client.BroadcastTxAsync(ctx, &core_grpc.RequestBroadcastTxAsync{Tx: []byte("query=async")})
+	require.NoError(t, err)
+	require.NotEmpty(t, async.Hash)
+}
+
+func TestQueryAPISubscribe(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// replay the block events from the first block on
+	stream, err := rpctest.GetGRPCQueryClient().Subscribe(ctx, &core_grpc.RequestSubscribe{
+		Query:      "tm.event = 'NewBlock'",
+		FromHeight: 1,
+	})
+	require.NoError(t, err)
+	for height := int64(1); height <= 2; height++ {
+		res, err := stream.Recv()
+		require.NoError(t, err)
+		newBlock := res.GetNewBlock()
+		require.NotNil(t, newBlock)
+		require.Equal(t, height, newBlock.Block.Header.Height)
+		require.NotEmpty(t, res.Events)
+	}
+}
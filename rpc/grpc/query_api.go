@@ -0,0 +1,322 @@
+package coregrpc
+
+import (
+	"context"
+	"sort"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"google.golang.org/grpc/peer"
+
+	cryptoenc "github.com/Finschia/ostracon/crypto/encoding"
+	tmjson "github.com/Finschia/ostracon/libs/json"
+	core "github.com/Finschia/ostracon/rpc/core"
+	ctypes "github.com/Finschia/ostracon/rpc/core/types"
+	"github.com/Finschia/ostracon/types"
+)
+
+// queryAPI serves the JSON-RPC routes over gRPC, with the same rpc/core
+// functions.
+type queryAPI struct {
+}
+
+var _ QueryAPIServer = (*queryAPI)(nil)
+
+func (qapi *queryAPI) Status(ctx context.Context, req *RequestStatus) (*ResponseStatus, error) {
+	res, err := core.Status(rpcContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	validatorInfo := &ValidatorInfo{
+		Address:     res.ValidatorInfo.Address,
+		VotingPower: res.ValidatorInfo.VotingPower,
+	}
+	if res.ValidatorInfo.PubKey != nil {
+		pubKey, err := cryptoenc.PubKeyToProto(res.ValidatorInfo.PubKey)
+		if err != nil {
+			return nil, err
+		}
+		validatorInfo.PubKey = &pubKey
+	}
+
+	return &ResponseStatus{
+		NodeInfo: res.NodeInfo.ToProto(),
+		SyncInfo: &SyncInfo{
+			LatestBlockHash:     res.SyncInfo.LatestBlockHash,
+			LatestAppHash:       res.SyncInfo.LatestAppHash,
+			LatestBlockHeight:   res.SyncInfo.LatestBlockHeight,
+			LatestBlockTime:     res.SyncInfo.LatestBlockTime,
+			EarliestBlockHash:   res.SyncInfo.EarliestBlockHash,
+			EarliestAppHash:     res.SyncInfo.EarliestAppHash,
+			EarliestBlockHeight: res.SyncInfo.EarliestBlockHeight,
+			EarliestBlockTime:   res.SyncInfo.EarliestBlockTime,
+			CatchingUp:          res.SyncInfo.CatchingUp,
+			StateSync:           toStateSyncInfo(res.SyncInfo.StateSync),
+		},
+		ValidatorInfo: validatorInfo,
+	}, nil
+}
+
+func (qapi *queryAPI) Block(ctx context.Context, req *RequestBlock) (*ResponseBlock, error) {
+	res, err := core.Block(rpcContext(ctx), heightPtr(req.Height))
+	if err != nil {
+		return nil, err
+	}
+
+	blockID := res.BlockID.ToProto()
+	resp := &ResponseBlock{BlockId: &blockID}
+	if res.Block != nil {
+		if resp.Block, err = res.Block.ToProto(); err != nil {
+			return nil, err
+		}
+	}
+	return resp, nil
+}
+
+func (qapi *queryAPI) BlockResults(ctx context.Context, req *RequestBlockResults) (*ResponseBlockResults, error) {
+	res, err := core.BlockResults(rpcContext(ctx), heightPtr(req.Height))
+	if err != nil {
+		return nil, err
+	}
+
+	validatorUpdates := make([]*abci.ValidatorUpdate, len(res.ValidatorUpdates))
+	for i := range res.ValidatorUpdates {
+		validatorUpdates[i] = &res.ValidatorUpdates[i]
+	}
+	return &ResponseBlockResults{
+		Height:                res.Height,
+		TxsResults:            res.TxsResults,
+		BeginBlockEvents:      eventPtrs(res.BeginBlockEvents),
+		EndBlockEvents:        eventPtrs(res.EndBlockEvents),
+		ValidatorUpdates:      validatorUpdates,
+		ConsensusParamUpdates: res.ConsensusParamUpdates,
+	}, nil
+}
+
+func (qapi *queryAPI) Commit(ctx context.Context, req *RequestCommit) (*ResponseCommit, error) {
+	res, err := core.Commit(rpcContext(ctx), heightPtr(req.Height))
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return &ResponseCommit{}, nil
+	}
+
+	return &ResponseCommit{
+		SignedHeader: res.SignedHeader.ToProto(),
+		Canonical:    res.CanonicalCommit,
+	}, nil
+}
+
+func (qapi *queryAPI) Validators(ctx context.Context, req *RequestValidators) (*ResponseValidators, error) {
+	res, err := core.Validators(rpcContext(ctx), heightPtr(req.Height), intPtr(req.Page), intPtr(req.PerPage))
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &ResponseValidators{
+		BlockHeight: res.BlockHeight,
+		Count:       int32(res.Count),
+		Total:       int32(res.Total),
+	}
+	for _, v := range res.Validators {
+		pv, err := v.ToProto()
+		if err != nil {
+			return nil, err
+		}
+		resp.Validators = append(resp.Validators, pv)
+	}
+	return resp, nil
+}
+
+func (qapi *queryAPI) Tx(ctx context.Context, req *RequestTx) (*ResponseTx, error) {
+	res, err := core.Tx(rpcContext(ctx), req.Hash, req.Prove)
+	if err != nil {
+		return nil, err
+	}
+	return toResponseTx(res, req.Prove), nil
+}
+
+func (qapi *queryAPI) TxSearch(ctx context.Context, req *RequestTxSearchPage) (*ResponseTxSearchPage, error) {
+	res, err := core.TxSearch(rpcContext(ctx), req.Query, req.Prove, intPtr(req.Page), intPtr(req.PerPage),
+		req.OrderBy, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &ResponseTxSearchPage{TotalCount: int32(res.TotalCount)}
+	for _, r := range res.Txs {
+		resp.Txs = append(resp.Txs, toResponseTx(r, req.Prove))
+	}
+	return resp, nil
+}
+
+func (qapi *queryAPI) ABCIQuery(ctx context.Context, req *RequestABCIQuery) (*ResponseABCIQuery, error) {
+	res, err := core.ABCIQuery(rpcContext(ctx), req.Path, req.Data, req.Height, req.Prove)
+	if err != nil {
+		return nil, err
+	}
+	return &ResponseABCIQuery{Response: &res.Response}, nil
+}
+
+func (qapi *queryAPI) BroadcastTxSync(ctx context.Context,
+	req *RequestBroadcastTxSync) (*ResponseBroadcastTxSync, error) {
+	res, err := core.BroadcastTxSync(rpcContext(ctx), req.Tx)
+	if err != nil {
+		return nil, err
+	}
+	return &ResponseBroadcastTxSync{
+		Code:         res.Code,
+		Data:         res.Data,
+		Log:          res.Log,
+		Codespace:    res.Codespace,
+		MempoolError: res.MempoolError,
+		Hash:         res.Hash,
+	}, nil
+}
+
+func (qapi *queryAPI) BroadcastTxAsync(ctx context.Context,
+	req *RequestBroadcastTxAsync) (*ResponseBroadcastTxAsync, error) {
+	res, err := core.BroadcastTxAsync(rpcContext(ctx), req.Tx)
+	if err != nil {
+		return nil, err
+	}
+	return &ResponseBroadcastTxAsync{Hash: res.Hash}, nil
+}
+
+func (qapi *queryAPI) Subscribe(req *RequestSubscribe, stream QueryAPI_SubscribeServer) error {
+	// the subscriptions are limited per remote address, as over websocket
+	subscriber := "grpc"
+	if p, ok := peer.FromContext(stream.Context()); ok {
+		subscriber = p.Addr.String()
+	}
+
+	var sendErr error
+	err := core.SubscribeStream(stream.Context(), subscriber, req.Query, heightPtr(req.FromHeight),
+		func(e *ctypes.ResultEvent) bool {
+			var res *ResponseEvent
+			res, sendErr = toResponseEvent(e)
+			if sendErr == nil {
+				sendErr = stream.Send(res)
+			}
+			return sendErr == nil
+		})
+	if err != nil {
+		return err
+	}
+
+	return sendErr
+}
+
+// heightPtr returns nil for the height 0, which stands for the latest one.
+func heightPtr(height int64) *int64 {
+	if height == 0 {
+		return nil
+	}
+	return &height
+}
+
+// intPtr returns nil for 0, which stands for the default value.
+func intPtr(i int32) *int {
+	if i == 0 {
+		return nil
+	}
+	v := int(i)
+	return &v
+}
+
+func eventPtrs(events []abci.Event) []*abci.Event {
+	ptrs := make([]*abci.Event, len(events))
+	for i := range events {
+		ptrs[i] = &events[i]
+	}
+	return ptrs
+}
+
+func toResponseTx(r *ctypes.ResultTx, prove bool) *ResponseTx {
+	res := &ResponseTx{
+		Hash:     r.Hash,
+		Height:   r.Height,
+		Index:    r.Index,
+		TxResult: &r.TxResult,
+		Tx:       r.Tx,
+	}
+	if prove {
+		proof := r.Proof.ToProto()
+		res.Proof = &proof
+	}
+	return res
+}
+
+// toResponseEvent converts the event data to its message, if it has one, or
+// to JSON otherwise.
+func toResponseEvent(e *ctypes.ResultEvent) (*ResponseEvent, error) {
+	res := &ResponseEvent{Query: e.Query}
+
+	switch data := e.Data.(type) {
+	case types.EventDataNewBlock:
+		block, err := data.Block.ToProto()
+		if err != nil {
+			return nil, err
+		}
+		res.Data = &ResponseEvent_NewBlock{NewBlock: &EventDataNewBlock{
+			Block:            block,
+			ResultBeginBlock: &data.ResultBeginBlock,
+			ResultEndBlock:   &data.ResultEndBlock,
+		}}
+	case types.EventDataNewBlockHeader:
+		res.Data = &ResponseEvent_NewBlockHeader{NewBlockHeader: &EventDataNewBlockHeader{
+			Header:           data.Header.ToProto(),
+			NumTxs:           data.NumTxs,
+			ResultBeginBlock: &data.ResultBeginBlock,
+			ResultEndBlock:   &data.ResultEndBlock,
+		}}
+	case types.EventDataTx:
+		res.Data = &ResponseEvent_Tx{Tx: &data.TxResult}
+	default:
+		bz, err := tmjson.Marshal(data)
+		if err != nil {
+			return nil, err
+		}
+		res.Data = &ResponseEvent_Json{Json: bz}
+	}
+
+	keys := make([]string, 0, len(e.Events))
+	for key := range e.Events {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		res.Events = append(res.Events, &EventValues{Key: key, Values: e.Events[key]})
+	}
+
+	return res, nil
+}
+
+func toStateSyncInfo(info *ctypes.StateSyncInfo) *StateSyncInfo {
+	if info == nil {
+		return nil
+	}
+	peers := make([]StateSyncPeerInfo, 0, len(info.Peers))
+	for _, peer := range info.Peers {
+		peers = append(peers, StateSyncPeerInfo{
+			Id:         string(peer.ID),
+			Latency:    peer.Latency,
+			Throughput: peer.Throughput,
+			InFlight:   int32(peer.InFlight),
+			Received:   int32(peer.Received),
+			Timeouts:   int32(peer.Timeouts),
+		})
+	}
+	return &StateSyncInfo{
+		SnapshotHeight: info.SnapshotHeight,
+		SnapshotFormat: info.SnapshotFormat,
+		ChunksTotal:    info.ChunksTotal,
+		ChunksFetched:  info.ChunksFetched,
+		ChunksApplied:  info.ChunksApplied,
+		BytesFetched:   info.BytesFetched,
+		Elapsed:        info.Elapsed,
+		Eta:            info.ETA,
+		Peers:          peers,
+	}
+}
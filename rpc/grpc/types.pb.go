@@ -7,8 +7,10 @@ import (
 	context "context"
 	fmt "fmt"
 	types "github.com/Finschia/ostracon/abci/types"
+	types3 "github.com/Finschia/ostracon/proto/ostracon/types"
 	proto "github.com/gogo/protobuf/proto"
 	types1 "github.com/tendermint/tendermint/abci/types"
+	types2 "github.com/tendermint/tendermint/proto/tendermint/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -108,6 +110,138 @@ func (m *RequestBroadcastTx) GetTx() []byte {
 	return nil
 }
 
+// RequestTxSearch searches for the transactions after the position of the
+// cursor, or from the first one if the cursor is empty.
+type RequestTxSearch struct {
+	Query   string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Prove   bool   `protobuf:"varint,2,opt,name=prove,proto3" json:"prove,omitempty"`
+	OrderBy string `protobuf:"bytes,3,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	Cursor  string `protobuf:"bytes,4,opt,name=cursor,proto3" json:"cursor,omitempty"`
+}
+
+func (m *RequestTxSearch) Reset()         { *m = RequestTxSearch{} }
+func (m *RequestTxSearch) String() string { return proto.CompactTextString(m) }
+func (*RequestTxSearch) ProtoMessage()    {}
+func (*RequestTxSearch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_907c7db099111068, []int{2}
+}
+func (m *RequestTxSearch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RequestTxSearch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RequestTxSearch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RequestTxSearch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RequestTxSearch.Merge(m, src)
+}
+func (m *RequestTxSearch) XXX_Size() int {
+	return m.Size()
+}
+func (m *RequestTxSearch) XXX_DiscardUnknown() {
+	xxx_messageInfo_RequestTxSearch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RequestTxSearch proto.InternalMessageInfo
+
+func (m *RequestTxSearch) GetQuery() string {
+	if m != nil {
+		return m.Query
+	}
+	return ""
+}
+
+func (m *RequestTxSearch) GetProve() bool {
+	if m != nil {
+		return m.Prove
+	}
+	return false
+}
+
+func (m *RequestTxSearch) GetOrderBy() string {
+	if m != nil {
+		return m.OrderBy
+	}
+	return ""
+}
+
+func (m *RequestTxSearch) GetCursor() string {
+	if m != nil {
+		return m.Cursor
+	}
+	return ""
+}
+
+// RequestBlockSearch searches for the blocks after the height of the cursor,
+// or from the first one if the cursor is empty.
+type RequestBlockSearch struct {
+	Query   string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	OrderBy string `protobuf:"bytes,2,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	Cursor  string `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
+}
+
+func (m *RequestBlockSearch) Reset()         { *m = RequestBlockSearch{} }
+func (m *RequestBlockSearch) String() string { return proto.CompactTextString(m) }
+func (*RequestBlockSearch) ProtoMessage()    {}
+func (*RequestBlockSearch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_907c7db099111068, []int{3}
+}
+func (m *RequestBlockSearch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RequestBlockSearch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RequestBlockSearch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RequestBlockSearch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RequestBlockSearch.Merge(m, src)
+}
+func (m *RequestBlockSearch) XXX_Size() int {
+	return m.Size()
+}
+func (m *RequestBlockSearch) XXX_DiscardUnknown() {
+	xxx_messageInfo_RequestBlockSearch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RequestBlockSearch proto.InternalMessageInfo
+
+func (m *RequestBlockSearch) GetQuery() string {
+	if m != nil {
+		return m.Query
+	}
+	return ""
+}
+
+func (m *RequestBlockSearch) GetOrderBy() string {
+	if m != nil {
+		return m.OrderBy
+	}
+	return ""
+}
+
+func (m *RequestBlockSearch) GetCursor() string {
+	if m != nil {
+		return m.Cursor
+	}
+	return ""
+}
+
 type ResponsePing struct {
 }
 
@@ -115,7 +249,7 @@ func (m *ResponsePing) Reset()         { *m = ResponsePing{} }
 func (m *ResponsePing) String() string { return proto.CompactTextString(m) }
 func (*ResponsePing) ProtoMessage()    {}
 func (*ResponsePing) Descriptor() ([]byte, []int) {
-	return fileDescriptor_907c7db099111068, []int{2}
+	return fileDescriptor_907c7db099111068, []int{4}
 }
 func (m *ResponsePing) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -153,7 +287,7 @@ func (m *ResponseBroadcastTx) Reset()         { *m = ResponseBroadcastTx{} }
 func (m *ResponseBroadcastTx) String() string { return proto.CompactTextString(m) }
 func (*ResponseBroadcastTx) ProtoMessage()    {}
 func (*ResponseBroadcastTx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_907c7db099111068, []int{3}
+	return fileDescriptor_907c7db099111068, []int{5}
 }
 func (m *ResponseBroadcastTx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -196,38 +330,216 @@ func (m *ResponseBroadcastTx) GetDeliverTx() *types1.ResponseDeliverTx {
 	return nil
 }
 
+// ResponseTxSearch is a transaction found by a search, along with the cursor
+// to resume the search after it.
+type ResponseTxSearch struct {
+	Hash     []byte                    `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	Height   int64                     `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	Index    uint32                    `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"`
+	TxResult *types1.ResponseDeliverTx `protobuf:"bytes,4,opt,name=tx_result,json=txResult,proto3" json:"tx_result,omitempty"`
+	Tx       []byte                    `protobuf:"bytes,5,opt,name=tx,proto3" json:"tx,omitempty"`
+	Proof    *types2.TxProof           `protobuf:"bytes,6,opt,name=proof,proto3" json:"proof,omitempty"`
+	Cursor   string                    `protobuf:"bytes,7,opt,name=cursor,proto3" json:"cursor,omitempty"`
+}
+
+func (m *ResponseTxSearch) Reset()         { *m = ResponseTxSearch{} }
+func (m *ResponseTxSearch) String() string { return proto.CompactTextString(m) }
+func (*ResponseTxSearch) ProtoMessage()    {}
+func (*ResponseTxSearch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_907c7db099111068, []int{6}
+}
+func (m *ResponseTxSearch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResponseTxSearch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResponseTxSearch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ResponseTxSearch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResponseTxSearch.Merge(m, src)
+}
+func (m *ResponseTxSearch) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResponseTxSearch) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResponseTxSearch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResponseTxSearch proto.InternalMessageInfo
+
+func (m *ResponseTxSearch) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
+func (m *ResponseTxSearch) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ResponseTxSearch) GetIndex() uint32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *ResponseTxSearch) GetTxResult() *types1.ResponseDeliverTx {
+	if m != nil {
+		return m.TxResult
+	}
+	return nil
+}
+
+func (m *ResponseTxSearch) GetTx() []byte {
+	if m != nil {
+		return m.Tx
+	}
+	return nil
+}
+
+func (m *ResponseTxSearch) GetProof() *types2.TxProof {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+func (m *ResponseTxSearch) GetCursor() string {
+	if m != nil {
+		return m.Cursor
+	}
+	return ""
+}
+
+// ResponseBlockSearch is a block found by a search, along with the cursor to
+// resume the search after it.
+type ResponseBlockSearch struct {
+	BlockId *types2.BlockID `protobuf:"bytes,1,opt,name=block_id,json=blockId,proto3" json:"block_id,omitempty"`
+	Block   *types3.Block   `protobuf:"bytes,2,opt,name=block,proto3" json:"block,omitempty"`
+	Cursor  string          `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
+}
+
+func (m *ResponseBlockSearch) Reset()         { *m = ResponseBlockSearch{} }
+func (m *ResponseBlockSearch) String() string { return proto.CompactTextString(m) }
+func (*ResponseBlockSearch) ProtoMessage()    {}
+func (*ResponseBlockSearch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_907c7db099111068, []int{7}
+}
+func (m *ResponseBlockSearch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResponseBlockSearch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResponseBlockSearch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ResponseBlockSearch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResponseBlockSearch.Merge(m, src)
+}
+func (m *ResponseBlockSearch) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResponseBlockSearch) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResponseBlockSearch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResponseBlockSearch proto.InternalMessageInfo
+
+func (m *ResponseBlockSearch) GetBlockId() *types2.BlockID {
+	if m != nil {
+		return m.BlockId
+	}
+	return nil
+}
+
+func (m *ResponseBlockSearch) GetBlock() *types3.Block {
+	if m != nil {
+		return m.Block
+	}
+	return nil
+}
+
+func (m *ResponseBlockSearch) GetCursor() string {
+	if m != nil {
+		return m.Cursor
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*RequestPing)(nil), "ostracon.rpc.grpc.RequestPing")
 	proto.RegisterType((*RequestBroadcastTx)(nil), "ostracon.rpc.grpc.RequestBroadcastTx")
+	proto.RegisterType((*RequestTxSearch)(nil), "ostracon.rpc.grpc.RequestTxSearch")
+	proto.RegisterType((*RequestBlockSearch)(nil), "ostracon.rpc.grpc.RequestBlockSearch")
 	proto.RegisterType((*ResponsePing)(nil), "ostracon.rpc.grpc.ResponsePing")
 	proto.RegisterType((*ResponseBroadcastTx)(nil), "ostracon.rpc.grpc.ResponseBroadcastTx")
+	proto.RegisterType((*ResponseTxSearch)(nil), "ostracon.rpc.grpc.ResponseTxSearch")
+	proto.RegisterType((*ResponseBlockSearch)(nil), "ostracon.rpc.grpc.ResponseBlockSearch")
 }
 
 func init() { proto.RegisterFile("ostracon/rpc/grpc/types.proto", fileDescriptor_907c7db099111068) }
 
 var fileDescriptor_907c7db099111068 = []byte{
-	// 335 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0xcd, 0x4a, 0x03, 0x31,
-	0x14, 0x85, 0x9b, 0x22, 0xfe, 0xdc, 0xd6, 0x82, 0x71, 0xa3, 0x23, 0x46, 0x19, 0x54, 0x5c, 0x65,
-	0xa0, 0xae, 0xc4, 0x55, 0xab, 0xf8, 0xb3, 0x2b, 0x43, 0x57, 0x22, 0xc8, 0x34, 0x13, 0xda, 0xa0,
-	0x9d, 0x8c, 0x49, 0x2a, 0xe3, 0x5b, 0x08, 0x3e, 0x8b, 0xef, 0xe0, 0xb2, 0x4b, 0x97, 0xd2, 0xbe,
-	0x88, 0xa4, 0xed, 0x4c, 0x23, 0x55, 0x37, 0xc3, 0x9d, 0x9c, 0xf3, 0x5d, 0xce, 0xbd, 0x09, 0xec,
-	0x4a, 0x6d, 0x54, 0xc4, 0x64, 0x12, 0xa8, 0x94, 0x05, 0x5d, 0xfb, 0x31, 0x2f, 0x29, 0xd7, 0x34,
-	0x55, 0xd2, 0x48, 0xbc, 0x91, 0xcb, 0x54, 0xa5, 0x8c, 0x5a, 0xd9, 0xdb, 0x2e, 0x88, 0xa8, 0xc3,
-	0x84, 0xeb, 0xf6, 0x76, 0x0c, 0x4f, 0x62, 0xae, 0xfa, 0x22, 0x31, 0x0b, 0xa2, 0xbf, 0x0e, 0x95,
-	0x90, 0x3f, 0x0d, 0xb8, 0x36, 0x2d, 0x91, 0x74, 0xfd, 0x03, 0xc0, 0xb3, 0xdf, 0xa6, 0x92, 0x51,
-	0xcc, 0x22, 0x6d, 0xda, 0x19, 0xae, 0x41, 0xd9, 0x64, 0x5b, 0x68, 0x1f, 0x1d, 0x57, 0xc3, 0xb2,
-	0xc9, 0xfc, 0x1a, 0x54, 0x43, 0xae, 0x53, 0x99, 0x68, 0x3e, 0xa1, 0xde, 0x10, 0x6c, 0xe6, 0x07,
-	0x2e, 0x77, 0x0a, 0xab, 0xac, 0xc7, 0xd9, 0xc3, 0xfd, 0x8c, 0xae, 0xd4, 0x09, 0x2d, 0xa2, 0xdb,
-	0x28, 0x34, 0xa7, 0xce, 0xad, 0xad, 0x9d, 0x85, 0x2b, 0x6c, 0x5a, 0xe0, 0x06, 0x40, 0xcc, 0x1f,
-	0xc5, 0x33, 0x57, 0x16, 0x2e, 0x4f, 0x60, 0x9f, 0xce, 0x27, 0xf9, 0x89, 0x5f, 0x4c, 0xad, 0xed,
-	0x2c, 0x5c, 0x8b, 0xf3, 0xb2, 0xfe, 0x8e, 0xa0, 0x5a, 0xa4, 0x69, 0xb4, 0x6e, 0xf0, 0x15, 0x2c,
-	0xd9, 0xb8, 0xd8, 0x09, 0x91, 0xef, 0x8f, 0x3a, 0x4b, 0xf0, 0xf6, 0x7e, 0xd5, 0xe7, 0xf3, 0xe2,
-	0x3b, 0xa8, 0xb8, 0x63, 0x1e, 0xfe, 0xdd, 0xcf, 0xb1, 0x79, 0x47, 0xff, 0xb4, 0x75, 0x7c, 0xcd,
-	0xeb, 0x8f, 0x11, 0x41, 0xc3, 0x11, 0x41, 0x5f, 0x23, 0x82, 0x5e, 0xc7, 0xa4, 0x34, 0x1c, 0x93,
-	0xd2, 0xe7, 0x98, 0x94, 0x6e, 0x69, 0x57, 0x98, 0xde, 0xa0, 0x43, 0x99, 0xec, 0x07, 0x97, 0x22,
-	0xd1, 0xac, 0x27, 0xa2, 0x60, 0xe1, 0xa9, 0x9c, 0x31, 0xa9, 0xb8, 0x2d, 0x3a, 0xcb, 0x93, 0x3b,
-	0x3e, 0xf9, 0x0e, 0x00, 0x00, 0xff, 0xff, 0xb8, 0x26, 0x3a, 0x9b, 0x4f, 0x02, 0x00, 0x00,
+	// 617 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0xee, 0xa6, 0x69, 0x93, 0x4c, 0xda, 0x02, 0xcb, 0x8f, 0xdc, 0x00, 0xa6, 0x32, 0x3f, 0xaa,
+	0x84, 0x64, 0x57, 0x85, 0x0b, 0xe2, 0x80, 0x5a, 0x2a, 0xa0, 0xb7, 0x6a, 0x09, 0x17, 0x04, 0x44,
+	0xce, 0x7a, 0x89, 0xad, 0xb6, 0x5e, 0x77, 0xbd, 0xa9, 0x9c, 0xb7, 0xa8, 0xc4, 0xb3, 0xf0, 0x02,
+	0x9c, 0x38, 0xf6, 0xc8, 0x11, 0xb5, 0x77, 0x9e, 0x01, 0xed, 0xfa, 0x6f, 0xab, 0x90, 0x88, 0x4b,
+	0xb4, 0xb3, 0xf3, 0x7d, 0x33, 0x3b, 0xf3, 0x7d, 0x31, 0xdc, 0xe7, 0xa9, 0x14, 0x3e, 0xe5, 0xb1,
+	0x27, 0x12, 0xea, 0x8d, 0xd4, 0x8f, 0x9c, 0x24, 0x2c, 0x75, 0x13, 0xc1, 0x25, 0xc7, 0x37, 0xca,
+	0xb4, 0x2b, 0x12, 0xea, 0xaa, 0x74, 0x6f, 0xbd, 0x62, 0xf8, 0x43, 0x1a, 0x99, 0xe8, 0x5e, 0xaf,
+	0x4a, 0xe9, 0x5b, 0x6f, 0x78, 0xc4, 0xe9, 0x61, 0x91, 0xbb, 0x2b, 0x59, 0x1c, 0x30, 0x71, 0x1c,
+	0xc5, 0x72, 0x9a, 0x78, 0xcf, 0x48, 0xe6, 0x54, 0x23, 0xeb, 0xac, 0x42, 0x97, 0xb0, 0x93, 0x31,
+	0x4b, 0xe5, 0x41, 0x14, 0x8f, 0x9c, 0x47, 0x80, 0x8b, 0x70, 0x57, 0x70, 0x3f, 0xa0, 0x7e, 0x2a,
+	0xfb, 0x19, 0x5e, 0x83, 0x86, 0xcc, 0x2c, 0xb4, 0x81, 0x36, 0x57, 0x48, 0x43, 0x66, 0x4e, 0x02,
+	0xd7, 0x0a, 0x54, 0x3f, 0x7b, 0xcf, 0x7c, 0x41, 0x43, 0x7c, 0x0b, 0x96, 0x4e, 0xc6, 0x4c, 0x4c,
+	0x34, 0xaa, 0x43, 0xf2, 0x40, 0xdd, 0x26, 0x82, 0x9f, 0x32, 0xab, 0xb1, 0x81, 0x36, 0xdb, 0x24,
+	0x0f, 0xf0, 0x3a, 0xb4, 0xb9, 0x08, 0x98, 0x18, 0x0c, 0x27, 0xd6, 0xa2, 0x86, 0xb7, 0x74, 0xbc,
+	0x3b, 0xc1, 0x77, 0x60, 0x99, 0x8e, 0x45, 0xca, 0x85, 0xd5, 0xd4, 0x89, 0x22, 0x72, 0x3e, 0xd7,
+	0xef, 0x52, 0x73, 0xcf, 0x6d, 0x6a, 0x96, 0x6f, 0xcc, 0x2a, 0xbf, 0x78, 0xa5, 0xfc, 0x1a, 0xac,
+	0x10, 0x96, 0x26, 0x3c, 0x4e, 0x99, 0x5e, 0xc3, 0x37, 0x04, 0x37, 0xcb, 0x0b, 0x73, 0x11, 0x2f,
+	0xa0, 0x4d, 0x43, 0x46, 0x0f, 0x07, 0xc5, 0x3a, 0xba, 0xdb, 0xb6, 0x5b, 0xa9, 0xa8, 0x36, 0xef,
+	0x96, 0xac, 0xd7, 0x0a, 0xd6, 0xcf, 0x48, 0x8b, 0xe6, 0x07, 0xbc, 0x03, 0x10, 0xb0, 0xa3, 0xe8,
+	0x94, 0x09, 0x45, 0x6e, 0x68, 0xb2, 0xe3, 0xd6, 0xda, 0x5c, 0xa5, 0xef, 0xe5, 0xd0, 0x7e, 0x46,
+	0x3a, 0x41, 0x79, 0x74, 0xfe, 0x20, 0xb8, 0x5e, 0x02, 0xaa, 0xc5, 0x63, 0x68, 0x86, 0x7e, 0x1a,
+	0x16, 0xea, 0xe8, 0xb3, 0x1a, 0x33, 0x64, 0xd1, 0x28, 0x94, 0xba, 0xcf, 0x22, 0x29, 0x22, 0xb5,
+	0xaf, 0x28, 0x0e, 0x58, 0xa6, 0xa7, 0x5f, 0x25, 0x79, 0x80, 0x5f, 0x41, 0x47, 0x66, 0x03, 0xc1,
+	0xd2, 0xf1, 0x91, 0xb4, 0x9a, 0xff, 0xfd, 0xb0, 0xb6, 0xcc, 0x88, 0xe6, 0x14, 0xf6, 0x58, 0x2a,
+	0xed, 0x81, 0x3d, 0xad, 0x3a, 0xff, 0x6a, 0x2d, 0xeb, 0x62, 0xeb, 0x66, 0xb1, 0xdc, 0x7b, 0xfd,
+	0xec, 0x40, 0x01, 0x48, 0x8e, 0x33, 0x64, 0x69, 0x5d, 0x91, 0xe5, 0xcc, 0x94, 0xc1, 0xd0, 0xfd,
+	0x39, 0xb4, 0xb5, 0xfd, 0x07, 0x51, 0x60, 0xa1, 0x59, 0x3d, 0x34, 0x61, 0x7f, 0x8f, 0xb4, 0x34,
+	0x74, 0x3f, 0xc0, 0x4f, 0x61, 0x49, 0x1f, 0x8b, 0xe5, 0xdf, 0xae, 0x95, 0x33, 0x08, 0x24, 0xc7,
+	0xcc, 0x72, 0xca, 0xf6, 0x77, 0x04, 0x2b, 0x95, 0x23, 0x76, 0x0e, 0xf6, 0xf1, 0x5b, 0x68, 0x2a,
+	0xcb, 0x60, 0xdb, 0x9d, 0xfa, 0x3b, 0xbb, 0xc6, 0x3f, 0xab, 0xf7, 0xe0, 0x9f, 0xf9, 0xda, 0x73,
+	0xf8, 0x13, 0x74, 0x4d, 0xab, 0x3d, 0x9e, 0x5d, 0xcf, 0x80, 0xf5, 0x9e, 0xcc, 0x29, 0x6b, 0xe0,
+	0xb6, 0x7f, 0x20, 0xe8, 0xe4, 0xdb, 0x53, 0x8f, 0xfe, 0x00, 0xed, 0xca, 0x40, 0xce, 0xec, 0x46,
+	0x25, 0xa6, 0xf7, 0x70, 0x4e, 0x97, 0x12, 0xb4, 0x85, 0xf0, 0x17, 0xe8, 0x9a, 0x32, 0xcd, 0x1b,
+	0xa1, 0x86, 0xcd, 0x1f, 0xa1, 0xc6, 0x6d, 0xa1, 0xdd, 0x77, 0x3f, 0x2f, 0x6c, 0x74, 0x7e, 0x61,
+	0xa3, 0xdf, 0x17, 0x36, 0x3a, 0xbb, 0xb4, 0x17, 0xce, 0x2f, 0xed, 0x85, 0x5f, 0x97, 0xf6, 0xc2,
+	0x47, 0x77, 0x14, 0xc9, 0x70, 0x3c, 0x74, 0x29, 0x3f, 0xf6, 0xde, 0x44, 0x71, 0x4a, 0xc3, 0xc8,
+	0xf7, 0xa6, 0x3e, 0xbf, 0x2f, 0x29, 0x17, 0x4c, 0x1d, 0x86, 0xcb, 0xfa, 0xeb, 0xf7, 0xec, 0xef,
+	0x00, 0x45, 0xb9, 0x48, 0xf5, 0xa3, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -346,6 +658,168 @@ var _BroadcastAPI_serviceDesc = grpc.ServiceDesc{
 	Metadata: "ostracon/rpc/grpc/types.proto",
 }
 
+// SearchAPIClient is the client API for SearchAPI service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type SearchAPIClient interface {
+	TxSearch(ctx context.Context, in *RequestTxSearch, opts ...grpc.CallOption) (SearchAPI_TxSearchClient, error)
+	BlockSearch(ctx context.Context, in *RequestBlockSearch, opts ...grpc.CallOption) (SearchAPI_BlockSearchClient, error)
+}
+
+type searchAPIClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewSearchAPIClient(cc *grpc.ClientConn) SearchAPIClient {
+	return &searchAPIClient{cc}
+}
+
+func (c *searchAPIClient) TxSearch(ctx context.Context, in *RequestTxSearch, opts ...grpc.CallOption) (SearchAPI_TxSearchClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_SearchAPI_serviceDesc.Streams[0], "/ostracon.rpc.grpc.SearchAPI/TxSearch", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &searchAPITxSearchClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type SearchAPI_TxSearchClient interface {
+	Recv() (*ResponseTxSearch, error)
+	grpc.ClientStream
+}
+
+type searchAPITxSearchClient struct {
+	grpc.ClientStream
+}
+
+func (x *searchAPITxSearchClient) Recv() (*ResponseTxSearch, error) {
+	m := new(ResponseTxSearch)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *searchAPIClient) BlockSearch(ctx context.Context, in *RequestBlockSearch, opts ...grpc.CallOption) (SearchAPI_BlockSearchClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_SearchAPI_serviceDesc.Streams[1], "/ostracon.rpc.grpc.SearchAPI/BlockSearch", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &searchAPIBlockSearchClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type SearchAPI_BlockSearchClient interface {
+	Recv() (*ResponseBlockSearch, error)
+	grpc.ClientStream
+}
+
+type searchAPIBlockSearchClient struct {
+	grpc.ClientStream
+}
+
+func (x *searchAPIBlockSearchClient) Recv() (*ResponseBlockSearch, error) {
+	m := new(ResponseBlockSearch)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// SearchAPIServer is the server API for SearchAPI service.
+type SearchAPIServer interface {
+	TxSearch(*RequestTxSearch, SearchAPI_TxSearchServer) error
+	BlockSearch(*RequestBlockSearch, SearchAPI_BlockSearchServer) error
+}
+
+// UnimplementedSearchAPIServer can be embedded to have forward compatible implementations.
+type UnimplementedSearchAPIServer struct {
+}
+
+func (*UnimplementedSearchAPIServer) TxSearch(req *RequestTxSearch, srv SearchAPI_TxSearchServer) error {
+	return status.Errorf(codes.Unimplemented, "method TxSearch not implemented")
+}
+func (*UnimplementedSearchAPIServer) BlockSearch(req *RequestBlockSearch, srv SearchAPI_BlockSearchServer) error {
+	return status.Errorf(codes.Unimplemented, "method BlockSearch not implemented")
+}
+
+func RegisterSearchAPIServer(s *grpc.Server, srv SearchAPIServer) {
+	s.RegisterService(&_SearchAPI_serviceDesc, srv)
+}
+
+func _SearchAPI_TxSearch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(RequestTxSearch)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SearchAPIServer).TxSearch(m, &searchAPITxSearchServer{stream})
+}
+
+type SearchAPI_TxSearchServer interface {
+	Send(*ResponseTxSearch) error
+	grpc.ServerStream
+}
+
+type searchAPITxSearchServer struct {
+	grpc.ServerStream
+}
+
+func (x *searchAPITxSearchServer) Send(m *ResponseTxSearch) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _SearchAPI_BlockSearch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(RequestBlockSearch)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SearchAPIServer).BlockSearch(m, &searchAPIBlockSearchServer{stream})
+}
+
+type SearchAPI_BlockSearchServer interface {
+	Send(*ResponseBlockSearch) error
+	grpc.ServerStream
+}
+
+type searchAPIBlockSearchServer struct {
+	grpc.ServerStream
+}
+
+func (x *searchAPIBlockSearchServer) Send(m *ResponseBlockSearch) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _SearchAPI_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ostracon.rpc.grpc.SearchAPI",
+	HandlerType: (*SearchAPIServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "TxSearch",
+			Handler:       _SearchAPI_TxSearch_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "BlockSearch",
+			Handler:       _SearchAPI_BlockSearch_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "ostracon/rpc/grpc/types.proto",
+}
+
 func (m *RequestPing) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -399,7 +873,7 @@ func (m *RequestBroadcastTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *ResponsePing) Marshal() (dAtA []byte, err error) {
+func (m *RequestTxSearch) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -409,20 +883,118 @@ func (m *ResponsePing) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ResponsePing) MarshalTo(dAtA []byte) (int, error) {
+func (m *RequestTxSearch) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ResponsePing) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RequestTxSearch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Cursor) > 0 {
+		i -= len(m.Cursor)
+		copy(dAtA[i:], m.Cursor)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Cursor)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.OrderBy) > 0 {
+		i -= len(m.OrderBy)
+		copy(dAtA[i:], m.OrderBy)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.OrderBy)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Prove {
+		i--
+		if m.Prove {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Query) > 0 {
+		i -= len(m.Query)
+		copy(dAtA[i:], m.Query)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Query)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ResponseBroadcastTx) Marshal() (dAtA []byte, err error) {
+func (m *RequestBlockSearch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RequestBlockSearch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RequestBlockSearch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Cursor) > 0 {
+		i -= len(m.Cursor)
+		copy(dAtA[i:], m.Cursor)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Cursor)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.OrderBy) > 0 {
+		i -= len(m.OrderBy)
+		copy(dAtA[i:], m.OrderBy)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.OrderBy)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Query) > 0 {
+		i -= len(m.Query)
+		copy(dAtA[i:], m.Query)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Query)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ResponsePing) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResponsePing) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResponsePing) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *ResponseBroadcastTx) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -469,6 +1041,138 @@ func (m *ResponseBroadcastTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ResponseTxSearch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResponseTxSearch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResponseTxSearch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Cursor) > 0 {
+		i -= len(m.Cursor)
+		copy(dAtA[i:], m.Cursor)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Cursor)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Proof != nil {
+		{
+			size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Tx) > 0 {
+		i -= len(m.Tx)
+		copy(dAtA[i:], m.Tx)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Tx)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.TxResult != nil {
+		{
+			size, err := m.TxResult.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Index != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Height != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ResponseBlockSearch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResponseBlockSearch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResponseBlockSearch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Cursor) > 0 {
+		i -= len(m.Cursor)
+		copy(dAtA[i:], m.Cursor)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Cursor)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Block != nil {
+		{
+			size, err := m.Block.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.BlockId != nil {
+		{
+			size, err := m.BlockId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTypes(v)
 	base := offset
@@ -502,6 +1206,51 @@ func (m *RequestBroadcastTx) Size() (n int) {
 	return n
 }
 
+func (m *RequestTxSearch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Query)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Prove {
+		n += 2
+	}
+	l = len(m.OrderBy)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Cursor)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *RequestBlockSearch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Query)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.OrderBy)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Cursor)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
 func (m *ResponsePing) Size() (n int) {
 	if m == nil {
 		return 0
@@ -528,6 +1277,62 @@ func (m *ResponseBroadcastTx) Size() (n int) {
 	return n
 }
 
+func (m *ResponseTxSearch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
+	if m.Index != 0 {
+		n += 1 + sovTypes(uint64(m.Index))
+	}
+	if m.TxResult != nil {
+		l = m.TxResult.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Tx)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Proof != nil {
+		l = m.Proof.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Cursor)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *ResponseBlockSearch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockId != nil {
+		l = m.BlockId.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Block != nil {
+		l = m.Block.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Cursor)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
 func sovTypes(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -668,7 +1473,7 @@ func (m *RequestBroadcastTx) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResponsePing) Unmarshal(dAtA []byte) error {
+func (m *RequestTxSearch) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -691,30 +1496,342 @@ func (m *ResponsePing) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResponsePing: wiretype end group for non-group")
+			return fmt.Errorf("proto: RequestTxSearch: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResponsePing: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RequestTxSearch: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTypes(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
+			m.Query = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prove", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Prove = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cursor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cursor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RequestBlockSearch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RequestBlockSearch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RequestBlockSearch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Query = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cursor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cursor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResponsePing) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResponsePing: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResponsePing: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
 	return nil
 }
@@ -840,6 +1957,420 @@ func (m *ResponseBroadcastTx) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ResponseTxSearch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResponseTxSearch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResponseTxSearch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Hash == nil {
+				m.Hash = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxResult", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TxResult == nil {
+				m.TxResult = &types1.ResponseDeliverTx{}
+			}
+			if err := m.TxResult.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tx", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tx = append(m.Tx[:0], dAtA[iNdEx:postIndex]...)
+			if m.Tx == nil {
+				m.Tx = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Proof == nil {
+				m.Proof = &types2.TxProof{}
+			}
+			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cursor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cursor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResponseBlockSearch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResponseBlockSearch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResponseBlockSearch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BlockId == nil {
+				m.BlockId = &types2.BlockID{}
+			}
+			if err := m.BlockId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Block == nil {
+				m.Block = &types3.Block{}
+			}
+			if err := m.Block.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cursor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cursor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTypes(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
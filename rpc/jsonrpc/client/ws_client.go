@@ -532,6 +532,14 @@ func (c *WSClient) Subscribe(ctx context.Context, query string) error {
 	return c.Call(ctx, "subscribe", params)
 }
 
+// SubscribeFromHeight to a query, replaying the events of the committed blocks
+// from fromHeight on first. Note the server must have a "subscribe" route
+// defined, with a "from_height" parameter.
+func (c *WSClient) SubscribeFromHeight(ctx context.Context, query string, fromHeight int64) error {
+	params := map[string]interface{}{"query": query, "from_height": fromHeight}
+	return c.Call(ctx, "subscribe", params)
+}
+
 // Unsubscribe from a query. Note the server must have a "unsubscribe" route
 // defined.
 func (c *WSClient) Unsubscribe(ctx context.Context, query string) error {
@@ -0,0 +1,306 @@
+package server
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/Finschia/ostracon/libs/log"
+	types "github.com/Finschia/ostracon/rpc/jsonrpc/types"
+)
+
+// Role is the role of an RPC caller. A caller may call the RPC functions
+// requiring its role or a lower one, see RequireRole.
+type Role int
+
+const (
+	// RoleAnonymous is the role of the callers without credentials.
+	RoleAnonymous Role = iota
+	// RoleUser is the role of the authenticated callers.
+	RoleUser
+	// RoleAdmin is the role of the callers allowed to call the unsafe RPC
+	// functions.
+	RoleAdmin
+)
+
+func (r Role) String() string {
+	switch r {
+	case RoleAnonymous:
+		return "anonymous"
+	case RoleUser:
+		return "user"
+	case RoleAdmin:
+		return "admin"
+	default:
+		return fmt.Sprintf("Role(%d)", int(r))
+	}
+}
+
+// Caller is the caller of an RPC request.
+type Caller struct {
+	// ID identifies the caller, e.g. by its token, for rate limiting.
+	ID   string
+	Role Role
+}
+
+// Authenticator authenticates the caller of an HTTP request, including the
+// websocket upgrade requests. It returns nil if the request carries none of
+// the credentials it knows about, and an error if they are invalid.
+type Authenticator interface {
+	Authenticate(r *http.Request) (*Caller, error)
+}
+
+// BearerTokenAuthenticator authenticates the callers by the bearer token of
+// their "Authorization" header.
+type BearerTokenAuthenticator struct {
+	tokens map[string]Role
+}
+
+var _ Authenticator = (*BearerTokenAuthenticator)(nil)
+
+// NewBearerTokenAuthenticator returns a BearerTokenAuthenticator granting each
+// token its role.
+func NewBearerTokenAuthenticator(tokens map[string]Role) *BearerTokenAuthenticator {
+	return &BearerTokenAuthenticator{tokens: tokens}
+}
+
+// Authenticate implements Authenticator.
+func (a *BearerTokenAuthenticator) Authenticate(r *http.Request) (*Caller, error) {
+	header := r.Header.Get("Authorization")
+	if header == "" {
+		return nil, nil
+	}
+	scheme, token, ok := strings.Cut(header, " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") {
+		return nil, errors.New("unsupported authorization scheme")
+	}
+
+	// compare all the tokens in constant time, so as not to leak them
+	var (
+		role  Role
+		found bool
+	)
+	for t, r := range a.tokens {
+		if subtle.ConstantTimeCompare([]byte(t), []byte(token)) == 1 {
+			role, found = r, true
+		}
+	}
+	if !found {
+		return nil, errors.New("invalid bearer token")
+	}
+
+	// the token itself is not to end up in the logs
+	hash := sha256.Sum256([]byte(token))
+	return &Caller{ID: "token:" + hex.EncodeToString(hash[:8]), Role: role}, nil
+}
+
+// TLSClientAuthenticator authenticates the callers by their TLS client
+// certificate (mTLS), once verified by the server, see Config.TLSClientCAFile.
+type TLSClientAuthenticator struct {
+	roles       map[string]Role
+	defaultRole Role
+}
+
+var _ Authenticator = (*TLSClientAuthenticator)(nil)
+
+// NewTLSClientAuthenticator returns a TLSClientAuthenticator granting the
+// certificates their role by their subject common name, or defaultRole if it
+// is not in roles.
+func NewTLSClientAuthenticator(roles map[string]Role, defaultRole Role) *TLSClientAuthenticator {
+	return &TLSClientAuthenticator{roles: roles, defaultRole: defaultRole}
+}
+
+// Authenticate implements Authenticator.
+func (a *TLSClientAuthenticator) Authenticate(r *http.Request) (*Caller, error) {
+	if r.TLS == nil || len(r.TLS.VerifiedChains) == 0 || len(r.TLS.VerifiedChains[0]) == 0 {
+		return nil, nil
+	}
+	name := r.TLS.VerifiedChains[0][0].Subject.CommonName
+	role, ok := a.roles[name]
+	if !ok {
+		role = a.defaultRole
+	}
+	return &Caller{ID: "cert:" + name, Role: role}, nil
+}
+
+// AccessControl is an HTTP middleware authenticating the callers of the RPC
+// server, restricting the RPC functions they may call by role and limiting
+// the rate of their calls. It applies to each call, whether over HTTP, in a
+// batch or not, or over a websocket.
+//
+// The anonymous callers are rate limited per IP, the authenticated ones per
+// caller ID.
+type AccessControl struct {
+	authenticators []Authenticator
+	anonymousRole  Role
+	ipLimiter      *rateLimiter
+	callerLimiter  *rateLimiter
+	logger         log.Logger
+}
+
+// AccessControlOption sets an optional parameter on the AccessControl.
+type AccessControlOption func(*AccessControl)
+
+// WithAuthenticator adds an authenticator. The caller of a request is the
+// one of the first authenticator recognizing its credentials.
+func WithAuthenticator(authenticator Authenticator) AccessControlOption {
+	return func(ac *AccessControl) {
+		ac.authenticators = append(ac.authenticators, authenticator)
+	}
+}
+
+// WithAnonymousRole sets the role of the callers without credentials,
+// RoleAnonymous by default.
+func WithAnonymousRole(role Role) AccessControlOption {
+	return func(ac *AccessControl) {
+		ac.anonymousRole = role
+	}
+}
+
+// WithIPRateLimit limits the anonymous callers to rate calls per second per
+// IP, with bursts of up to burst calls. 0 means unlimited.
+func WithIPRateLimit(rate float64, burst int) AccessControlOption {
+	return func(ac *AccessControl) {
+		ac.ipLimiter = newRateLimiter(rate, burst)
+	}
+}
+
+// WithCallerRateLimit limits the authenticated callers to rate calls per
+// second each, with bursts of up to burst calls. 0 means unlimited.
+func WithCallerRateLimit(rate float64, burst int) AccessControlOption {
+	return func(ac *AccessControl) {
+		ac.callerLimiter = newRateLimiter(rate, burst)
+	}
+}
+
+// NewAccessControl returns an AccessControl without any authenticator nor
+// rate limit, unless set by the options.
+func NewAccessControl(logger log.Logger, options ...AccessControlOption) *AccessControl {
+	ac := &AccessControl{logger: logger}
+	for _, option := range options {
+		option(ac)
+	}
+	return ac
+}
+
+// Handler wraps next, authenticating the caller of each request. The requests
+// with invalid credentials are rejected.
+func (ac *AccessControl) Handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		caller, anonymous, err := ac.authenticate(r)
+		if err != nil {
+			ac.logger.Info("Rejected RPC request", "remote", r.RemoteAddr, "err", err)
+			res := types.RPCInvalidRequestError(nil, err)
+			if wErr := WriteRPCResponseHTTPError(w, http.StatusUnauthorized, res); wErr != nil {
+				ac.logger.Error("failed to write response", "res", res, "err", wErr)
+			}
+			return
+		}
+
+		ctx := context.WithValue(r.Context(), accessKey{}, &access{
+			ac:        ac,
+			caller:    *caller,
+			anonymous: anonymous,
+			ip:        remoteIP(r),
+		})
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// Authorize authenticates the caller of r, as Handler does, and returns an
+// error if it may not call rpcFunc now, along with the HTTP status of the
+// rejection. It is meant for the servers of the RPC functions over other
+// protocols, e.g. gRPC, which are to describe their requests as HTTP ones.
+func (ac *AccessControl) Authorize(r *http.Request, rpcFunc *RPCFunc) (int, error) {
+	caller, anonymous, err := ac.authenticate(r)
+	if err != nil {
+		return http.StatusUnauthorized, err
+	}
+	a := &access{ac: ac, caller: *caller, anonymous: anonymous, ip: remoteIP(r)}
+	if aErr := a.check(rpcFunc); aErr != nil {
+		return aErr.status, aErr.err
+	}
+	return http.StatusOK, nil
+}
+
+// authenticate returns the caller of r, and whether it has no credentials.
+func (ac *AccessControl) authenticate(r *http.Request) (*Caller, bool, error) {
+	for _, authenticator := range ac.authenticators {
+		caller, err := authenticator.Authenticate(r)
+		if err != nil {
+			return nil, false, err
+		}
+		if caller != nil {
+			return caller, false, nil
+		}
+	}
+	return &Caller{ID: "ip:" + remoteIP(r), Role: ac.anonymousRole}, true, nil
+}
+
+func remoteIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
+type accessKey struct{}
+
+// access is the caller of a request, as authenticated by an AccessControl.
+type access struct {
+	ac        *AccessControl
+	caller    Caller
+	anonymous bool
+	ip        string
+}
+
+// accessFromContext returns the access of the request, or nil if the server
+// is not behind an AccessControl.
+func accessFromContext(ctx context.Context) *access {
+	a, _ := ctx.Value(accessKey{}).(*access)
+	return a
+}
+
+// accessError is an error rejecting a call, along with its HTTP status.
+type accessError struct {
+	status int
+	err    error
+}
+
+func (e *accessError) Error() string {
+	return e.err.Error()
+}
+
+// check returns an error if the caller may not call rpcFunc now, either
+// because of its role or of the rate limits. Each allowed call counts toward
+// the rate limits.
+func (a *access) check(rpcFunc *RPCFunc) *accessError {
+	if a == nil {
+		return nil
+	}
+	if a.caller.Role < rpcFunc.role {
+		return &accessError{
+			status: http.StatusForbidden,
+			err:    fmt.Errorf("access denied: the %s role is required", rpcFunc.role),
+		}
+	}
+
+	limiter, key := a.ac.ipLimiter, a.ip
+	if !a.anonymous {
+		limiter, key = a.ac.callerLimiter, a.caller.ID
+	}
+	if !limiter.allow(key) {
+		return &accessError{
+			status: http.StatusTooManyRequests,
+			err:    errors.New("rate limit exceeded"),
+		}
+	}
+	return nil
+}
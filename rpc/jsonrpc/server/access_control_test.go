@@ -0,0 +1,226 @@
+package server
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Finschia/ostracon/libs/log"
+	types "github.com/Finschia/ostracon/rpc/jsonrpc/types"
+)
+
+func newAccessControlServer(options ...AccessControlOption) *httptest.Server {
+	echo := func(ctx *types.Context) (string, error) { return "ok", nil }
+	funcMap := map[string]*RPCFunc{
+		"public": NewRPCFunc(echo, ""),
+		"admin":  NewRPCFunc(echo, "", RequireRole(RoleAdmin)),
+		"ws":     NewWSRPCFunc(echo, ""),
+	}
+	logger := log.TestingLogger()
+
+	mux := http.NewServeMux()
+	wm := NewWebsocketManager(funcMap)
+	wm.SetLogger(logger)
+	mux.HandleFunc("/websocket", wm.WebsocketHandler)
+	RegisterRPCFuncs(mux, funcMap, logger)
+
+	handler := maxBatchRequestHandler{
+		h:              NewAccessControl(logger, options...).Handler(mux),
+		NewHeaderName:  "Max-Batch-Request-Num",
+		NewHeaderValue: TestMaxBatchRequestNum,
+	}
+	return httptest.NewServer(handler)
+}
+
+var testTokens = map[string]Role{
+	"user-token":  RoleUser,
+	"admin-token": RoleAdmin,
+}
+
+func get(t *testing.T, url, token string) int {
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	require.NoError(t, err)
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	res, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	res.Body.Close()
+	return res.StatusCode
+}
+
+func TestAccessControlRoles(t *testing.T) {
+	s := newAccessControlServer(WithAuthenticator(NewBearerTokenAuthenticator(testTokens)))
+	defer s.Close()
+
+	testCases := []struct {
+		path   string
+		token  string
+		status int
+	}{
+		{"/public", "", http.StatusOK},
+		{"/public", "user-token", http.StatusOK},
+		{"/public", "admin-token", http.StatusOK},
+		{"/public", "unknown-token", http.StatusUnauthorized},
+		{"/admin", "", http.StatusForbidden},
+		{"/admin", "user-token", http.StatusForbidden},
+		{"/admin", "admin-token", http.StatusOK},
+	}
+	for _, tc := range testCases {
+		assert.Equal(t, tc.status, get(t, s.URL+tc.path, tc.token), "%s with %q", tc.path, tc.token)
+	}
+
+	// each request of a batch is checked
+	req, err := http.NewRequest(http.MethodPost, s.URL,
+		strings.NewReader(`[{"jsonrpc":"2.0","id":1,"method":"public"},{"jsonrpc":"2.0","id":2,"method":"admin"}]`))
+	require.NoError(t, err)
+	req.Header.Set("Authorization", "Bearer user-token")
+	res, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer res.Body.Close()
+	body, err := io.ReadAll(res.Body)
+	require.NoError(t, err)
+	var responses []types.RPCResponse
+	require.NoError(t, json.Unmarshal(body, &responses))
+	require.Len(t, responses, 2)
+	assert.Nil(t, responses[0].Error)
+	require.NotNil(t, responses[1].Error)
+	assert.Contains(t, responses[1].Error.Data, "admin role is required")
+}
+
+func TestAccessControlAnonymousRole(t *testing.T) {
+	s := newAccessControlServer(WithAnonymousRole(RoleAdmin))
+	defer s.Close()
+
+	assert.Equal(t, http.StatusOK, get(t, s.URL+"/admin", ""))
+}
+
+func TestAccessControlRateLimits(t *testing.T) {
+	s := newAccessControlServer(
+		WithAuthenticator(NewBearerTokenAuthenticator(testTokens)),
+		WithIPRateLimit(0.001, 2),
+		WithCallerRateLimit(0.001, 3),
+	)
+	defer s.Close()
+
+	// the anonymous callers share the limit of their IP
+	assert.Equal(t, http.StatusOK, get(t, s.URL+"/public", ""))
+	assert.Equal(t, http.StatusOK, get(t, s.URL+"/public", ""))
+	assert.Equal(t, http.StatusTooManyRequests, get(t, s.URL+"/public", ""))
+
+	// the authenticated callers have their own
+	for i := 0; i < 3; i++ {
+		assert.Equal(t, http.StatusOK, get(t, s.URL+"/public", "user-token"))
+	}
+	assert.Equal(t, http.StatusTooManyRequests, get(t, s.URL+"/public", "user-token"))
+	assert.Equal(t, http.StatusOK, get(t, s.URL+"/public", "admin-token"))
+}
+
+func TestAccessControlAuthorize(t *testing.T) {
+	ac := NewAccessControl(log.TestingLogger(),
+		WithAuthenticator(NewBearerTokenAuthenticator(testTokens)),
+		WithIPRateLimit(0.001, 1),
+	)
+	echo := func(ctx *types.Context) (string, error) { return "ok", nil }
+	public := NewRPCFunc(echo, "")
+	admin := NewRPCFunc(echo, "", RequireRole(RoleAdmin))
+
+	request := func(token string) *http.Request {
+		r := &http.Request{Header: http.Header{}, RemoteAddr: "1.2.3.4:5"}
+		if token != "" {
+			r.Header.Set("Authorization", "Bearer "+token)
+		}
+		return r
+	}
+
+	status, err := ac.Authorize(request("unknown-token"), public)
+	assert.Error(t, err)
+	assert.Equal(t, http.StatusUnauthorized, status)
+	status, err = ac.Authorize(request("user-token"), admin)
+	assert.Error(t, err)
+	assert.Equal(t, http.StatusForbidden, status)
+	status, err = ac.Authorize(request("admin-token"), admin)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, status)
+
+	_, err = ac.Authorize(request(""), public)
+	assert.NoError(t, err)
+	status, err = ac.Authorize(request(""), public)
+	assert.Error(t, err)
+	assert.Equal(t, http.StatusTooManyRequests, status)
+}
+
+func TestAccessControlWebsocket(t *testing.T) {
+	s := newAccessControlServer(
+		WithAuthenticator(NewBearerTokenAuthenticator(testTokens)),
+		WithCallerRateLimit(0.001, 2),
+	)
+	defer s.Close()
+
+	call := func(c *websocket.Conn, method string) *types.RPCError {
+		req, err := types.MapToRequest(types.JSONRPCStringID("TestAccessControlWebsocket"), method, nil)
+		require.NoError(t, err)
+		require.NoError(t, c.WriteJSON(req))
+		var resp types.RPCResponse
+		require.NoError(t, c.ReadJSON(&resp))
+		return resp.Error
+	}
+
+	url := "ws://" + s.Listener.Addr().String() + "/websocket"
+	c, res, err := websocket.DefaultDialer.Dial(url, nil)
+	require.NoError(t, err)
+	res.Body.Close()
+	assert.Nil(t, call(c, "ws"))
+	assert.NotNil(t, call(c, "admin"))
+	c.Close()
+
+	_, res, err = websocket.DefaultDialer.Dial(url, http.Header{"Authorization": {"Bearer unknown-token"}})
+	require.Error(t, err)
+	assert.Equal(t, http.StatusUnauthorized, res.StatusCode)
+	res.Body.Close()
+
+	c, res, err = websocket.DefaultDialer.Dial(url, http.Header{"Authorization": {"Bearer admin-token"}})
+	require.NoError(t, err)
+	res.Body.Close()
+	defer c.Close()
+	assert.Nil(t, call(c, "admin"))
+	assert.Nil(t, call(c, "ws"))
+	// each message counts
+	rpcErr := call(c, "ws")
+	require.NotNil(t, rpcErr)
+	assert.Contains(t, rpcErr.Data, "rate limit exceeded")
+}
+
+func TestTLSClientAuthenticator(t *testing.T) {
+	a := NewTLSClientAuthenticator(map[string]Role{"operator": RoleAdmin}, RoleUser)
+
+	withCert := func(name string) *http.Request {
+		r := httptest.NewRequest(http.MethodGet, "/", nil)
+		r.TLS = &tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{
+			{Subject: pkix.Name{CommonName: name}},
+		}}}
+		return r
+	}
+
+	caller, err := a.Authenticate(httptest.NewRequest(http.MethodGet, "/", nil))
+	require.NoError(t, err)
+	assert.Nil(t, caller)
+
+	caller, err = a.Authenticate(withCert("operator"))
+	require.NoError(t, err)
+	assert.Equal(t, &Caller{ID: "cert:operator", Role: RoleAdmin}, caller)
+
+	caller, err = a.Authenticate(withCert("client"))
+	require.NoError(t, err)
+	assert.Equal(t, &Caller{ID: "cert:client", Role: RoleUser}, caller)
+}
@@ -110,6 +110,11 @@ func makeJSONRPCHandler(funcMap map[string]*RPCFunc, logger log.Logger) http.Han
 				cache = false
 				continue
 			}
+			if aErr := accessFromContext(r.Context()).check(rpcFunc); aErr != nil {
+				responses = append(responses, types.RPCInvalidRequestError(request.ID, aErr))
+				cache = false
+				continue
+			}
 			ctx := &types.Context{JSONReq: &request, HTTPReq: r}
 			args := []reflect.Value{reflect.ValueOf(ctx)}
 			if len(request.Params) > 0 {
@@ -3,6 +3,8 @@ package server
 
 import (
 	"bufio"
+	"crypto/tls"
+	"crypto/x509"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -37,6 +39,9 @@ type Config struct {
 	MaxBatchRequestNum int
 	// mirrors http.Server#MaxHeaderBytes
 	MaxHeaderBytes int
+	// TLSClientCAFile is a PEM file of the CA certificates the client
+	// certificates are verified against, if given, by ServeTLS (mTLS).
+	TLSClientCAFile string
 }
 
 // DefaultConfig returns a default configuration.
@@ -116,12 +121,37 @@ func ServeTLS(
 		IdleTimeout:       config.IdleTimeout,
 		MaxHeaderBytes:    config.MaxHeaderBytes,
 	}
+	if config.TLSClientCAFile != "" {
+		tlsConfig, err := clientAuthTLSConfig(config.TLSClientCAFile)
+		if err != nil {
+			return err
+		}
+		s.TLSConfig = tlsConfig
+	}
 	err := s.ServeTLS(listener, certFile, keyFile)
 
 	logger.Error("RPC HTTPS server stopped", "err", err)
 	return err
 }
 
+// clientAuthTLSConfig returns a TLS configuration verifying the client
+// certificates, if any, against the CA certificates in caFile.
+func clientAuthTLSConfig(caFile string) (*tls.Config, error) {
+	pem, err := os.ReadFile(caFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the client CA file: %w", err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(pem) {
+		return nil, fmt.Errorf("no certificate found in the client CA file %s", caFile)
+	}
+	return &tls.Config{
+		ClientCAs:  pool,
+		ClientAuth: tls.VerifyClientCertIfGiven,
+		MinVersion: tls.VersionTLS12,
+	}, nil
+}
+
 // WriteRPCResponseHTTPError marshals res as JSON (with indent) and writes it
 // to w.
 //
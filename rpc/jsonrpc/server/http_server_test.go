@@ -1,13 +1,22 @@
 package server
 
 import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
 	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
 	"errors"
 	"fmt"
 	"io"
+	"math/big"
 	"net"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"strconv"
 	"strings"
 	"sync"
@@ -109,6 +118,97 @@ func TestServeTLS(t *testing.T) {
 	assert.Equal(t, []byte("some body"), body)
 }
 
+func TestServeTLSWithClientCA(t *testing.T) {
+	ln, err := net.Listen("tcp", "localhost:0")
+	require.NoError(t, err)
+	defer ln.Close()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, accessFromContext(r.Context()).caller.ID)
+	})
+	ac := NewAccessControl(log.TestingLogger(),
+		WithAuthenticator(NewTLSClientAuthenticator(nil, RoleUser)))
+
+	caFile, cert := newTestClientCert(t, "client")
+	config := DefaultConfig()
+	config.TLSClientCAFile = caFile
+	chErr := make(chan error, 1)
+	go func() {
+		// FIXME This goroutine leaks
+		chErr <- ServeTLS(ln, ac.Handler(mux), "test.crt", "test.key", log.TestingLogger(), config)
+	}()
+
+	select {
+	case err := <-chErr:
+		require.NoError(t, err)
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	for _, certs := range [][]tls.Certificate{nil, {cert}} {
+		tr := &http.Transport{
+			TLSClientConfig: &tls.Config{InsecureSkipVerify: true, Certificates: certs},
+		}
+		c := &http.Client{Transport: tr}
+		res, err := c.Get("https://" + ln.Addr().String())
+		require.NoError(t, err)
+		body, err := io.ReadAll(res.Body)
+		res.Body.Close()
+		require.NoError(t, err)
+		if certs == nil {
+			assert.True(t, strings.HasPrefix(string(body), "ip:"), string(body))
+		} else {
+			assert.Equal(t, "cert:client", string(body))
+		}
+	}
+
+	_, err = clientAuthTLSConfig("missing.crt")
+	assert.Error(t, err)
+	_, err = clientAuthTLSConfig("test.key")
+	assert.Error(t, err)
+}
+
+// newTestClientCert returns the PEM file of a new CA, and a client
+// certificate with the given common name it issued.
+func newTestClientCert(t *testing.T, name string) (string, tls.Certificate) {
+	newKey := func() *ecdsa.PrivateKey {
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		require.NoError(t, err)
+		return key
+	}
+
+	caKey := newKey()
+	caTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	caDER, err := x509.CreateCertificate(rand.Reader, caTemplate, caTemplate, &caKey.PublicKey, caKey)
+	require.NoError(t, err)
+
+	clientKey := newKey()
+	clientTemplate := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	clientDER, err := x509.CreateCertificate(rand.Reader, clientTemplate, caTemplate, &clientKey.PublicKey, caKey)
+	require.NoError(t, err)
+
+	caFile := filepath.Join(t.TempDir(), "ca.crt")
+	err = os.WriteFile(caFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: caDER}), 0o600)
+	require.NoError(t, err)
+
+	return caFile, tls.Certificate{Certificate: [][]byte{clientDER}, PrivateKey: clientKey}
+}
+
 func TestWriteRPCResponseHTTP(t *testing.T) {
 	id := types.JSONRPCIntID(-1)
 
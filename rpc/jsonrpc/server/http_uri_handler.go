@@ -36,6 +36,14 @@ func makeHTTPHandler(rpcFunc *RPCFunc, logger log.Logger) func(http.ResponseWrit
 	return func(w http.ResponseWriter, r *http.Request) {
 		logger.Debug("HTTP HANDLER", "req", r)
 
+		if aErr := accessFromContext(r.Context()).check(rpcFunc); aErr != nil {
+			res := types.RPCInvalidRequestError(dummyID, aErr)
+			if wErr := WriteRPCResponseHTTPError(w, aErr.status, res); wErr != nil {
+				logger.Error("failed to write response", "res", res, "err", wErr)
+			}
+			return
+		}
+
 		ctx := &types.Context{HTTPReq: r}
 		args := []reflect.Value{reflect.ValueOf(ctx)}
 
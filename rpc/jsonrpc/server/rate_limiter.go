@@ -0,0 +1,97 @@
+package server
+
+import (
+	"math"
+	"sync"
+	"time"
+)
+
+// rateLimiterSweepInterval is how often the idle buckets are dropped.
+const rateLimiterSweepInterval = time.Minute
+
+// rateLimiter is a set of token buckets, one per key, each refilled at rate
+// tokens per second up to burst tokens. A call takes a token from the bucket
+// of its key, and is rejected if it is empty.
+//
+// A nil rateLimiter allows every call.
+type rateLimiter struct {
+	mtx       sync.Mutex
+	rate      float64
+	burst     float64
+	buckets   map[string]*tokenBucket
+	lastSweep time.Time
+
+	now func() time.Time
+}
+
+type tokenBucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// newRateLimiter returns a rateLimiter allowing rate calls per second per key,
+// with bursts of up to burst calls, or nil if rate is not positive. burst
+// defaults to rate, and to 1 if rate is lower.
+func newRateLimiter(rate float64, burst int) *rateLimiter {
+	if rate <= 0 {
+		return nil
+	}
+	b := float64(burst)
+	if burst <= 0 {
+		b = math.Max(1, math.Floor(rate))
+	}
+	return &rateLimiter{
+		rate:      rate,
+		burst:     b,
+		buckets:   make(map[string]*tokenBucket),
+		lastSweep: time.Now(),
+		now:       time.Now,
+	}
+}
+
+// allow takes a token from the bucket of key, and returns false if there was
+// none left.
+func (l *rateLimiter) allow(key string) bool {
+	if l == nil {
+		return true
+	}
+
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+
+	now := l.now()
+	l.sweep(now)
+
+	b, ok := l.buckets[key]
+	if !ok {
+		b = &tokenBucket{tokens: l.burst, last: now}
+		l.buckets[key] = b
+	} else {
+		b.tokens = l.refill(b, now)
+		b.last = now
+	}
+
+	if b.tokens < 1 {
+		return false
+	}
+	b.tokens--
+	return true
+}
+
+func (l *rateLimiter) refill(b *tokenBucket, now time.Time) float64 {
+	return math.Min(l.burst, b.tokens+now.Sub(b.last).Seconds()*l.rate)
+}
+
+// sweep drops the buckets that are full again, which are the same as new
+// ones, so that the idle keys do not pile up.
+func (l *rateLimiter) sweep(now time.Time) {
+	if now.Sub(l.lastSweep) < rateLimiterSweepInterval {
+		return
+	}
+	l.lastSweep = now
+	for key, b := range l.buckets {
+		if l.refill(b, now) >= l.burst {
+			delete(l.buckets, key)
+		}
+	}
+}
@@ -0,0 +1,50 @@
+package server
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateLimiter(t *testing.T) {
+	var unlimited *rateLimiter
+	require.Nil(t, newRateLimiter(0, 10))
+	for i := 0; i < 100; i++ {
+		require.True(t, unlimited.allow("a"))
+	}
+
+	now := time.Now()
+	l := newRateLimiter(2, 3)
+	l.now = func() time.Time { return now }
+
+	// the burst, then nothing until refilled
+	for i := 0; i < 3; i++ {
+		assert.True(t, l.allow("a"), "call %d", i)
+	}
+	assert.False(t, l.allow("a"))
+	// the keys do not share their buckets
+	assert.True(t, l.allow("b"))
+
+	now = now.Add(500 * time.Millisecond)
+	assert.True(t, l.allow("a"))
+	assert.False(t, l.allow("a"))
+
+	// never more than the burst
+	now = now.Add(time.Hour)
+	for i := 0; i < 3; i++ {
+		assert.True(t, l.allow("a"), "call %d", i)
+	}
+	assert.False(t, l.allow("a"))
+
+	// the full buckets are dropped
+	now = now.Add(time.Hour)
+	l.allow("c")
+	assert.Len(t, l.buckets, 1)
+}
+
+func TestRateLimiterDefaultBurst(t *testing.T) {
+	assert.EqualValues(t, 5, newRateLimiter(5, 0).burst)
+	assert.EqualValues(t, 1, newRateLimiter(0.1, 0).burst)
+}
@@ -48,6 +48,14 @@ func Ws() Option {
 	}
 }
 
+// RequireRole restricts the RPC function to the callers having at least the
+// given role. It only applies if the server is behind an AccessControl.
+func RequireRole(role Role) Option {
+	return func(r *RPCFunc) {
+		r.role = role
+	}
+}
+
 // RPCFunc contains the introspected type information for a function
 type RPCFunc struct {
 	f              reflect.Value          // underlying rpc function
@@ -56,6 +64,7 @@ type RPCFunc struct {
 	argNames       []string               // name of each argument
 	cacheable      bool                   // enable cache control
 	ws             bool                   // enable websocket communication
+	role           Role                   // minimum role of the callers
 	noCacheDefArgs map[string]interface{} // a lookup table of args that, if not supplied or are set to default values, cause us to not cache
 }
 
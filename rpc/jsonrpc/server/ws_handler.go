@@ -86,6 +86,7 @@ func (wm *WebsocketManager) WebsocketHandler(w http.ResponseWriter, r *http.Requ
 
 	// register connection
 	con := newWSConnection(wsConn, wm.funcMap, wm.wsConnOptions...)
+	con.access = accessFromContext(r.Context())
 	con.SetLogger(wm.logger.With("remote", wsConn.RemoteAddr()))
 	wm.logger.Info("New websocket connection", "remote", con.remoteAddr)
 	err = con.Start() // BLOCKING
@@ -118,6 +119,9 @@ type wsConnection struct {
 
 	funcMap map[string]*RPCFunc
 
+	// caller of the upgrade request, if behind an AccessControl
+	access *access
+
 	// write channel capacity
 	writeChanCapacity int
 
@@ -362,6 +366,12 @@ func (wsc *wsConnection) readRoutine() {
 				}
 				continue
 			}
+			if aErr := wsc.access.check(rpcFunc); aErr != nil {
+				if err := wsc.WriteRPCResponse(writeCtx, types.RPCInvalidRequestError(request.ID, aErr)); err != nil {
+					wsc.Logger.Error("Error writing RPC response", "err", err)
+				}
+				continue
+			}
 
 			ctx := &types.Context{JSONReq: &request, WSConn: wsc}
 			args := []reflect.Value{reflect.ValueOf(ctx)}
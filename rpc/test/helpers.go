@@ -114,6 +114,16 @@ func GetGRPCClient() core_grpc.BroadcastAPIClient {
 	return core_grpc.StartGRPCClient(grpcAddr)
 }
 
+func GetGRPCSearchClient() core_grpc.SearchAPIClient {
+	grpcAddr := globalConfig.RPC.GRPCListenAddress
+	return core_grpc.StartGRPCSearchClient(grpcAddr)
+}
+
+func GetGRPCQueryClient() core_grpc.QueryAPIClient {
+	grpcAddr := globalConfig.RPC.GRPCListenAddress
+	return core_grpc.StartGRPCQueryClient(grpcAddr)
+}
+
 // StartOstracon starts a test ostracon server in a go routine and returns when it is initialized
 func StartOstracon(app abci.Application, opts ...func(*Options)) *nm.Node {
 	nodeOpts := defaultOptions
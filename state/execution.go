@@ -11,7 +11,6 @@ import (
 
 	ocabci "github.com/Finschia/ostracon/abci/types"
 	"github.com/Finschia/ostracon/crypto"
-	"github.com/Finschia/ostracon/crypto/ed25519"
 	cryptoenc "github.com/Finschia/ostracon/crypto/encoding"
 	"github.com/Finschia/ostracon/libs/fail"
 	"github.com/Finschia/ostracon/libs/log"
@@ -45,6 +44,9 @@ type BlockExecutor struct {
 	logger log.Logger
 
 	metrics *Metrics
+
+	// prunes the indexes below the retain height of the blocks
+	indexPruner IndexPruner
 }
 
 type CommitStepTimes struct {
@@ -79,6 +81,13 @@ func BlockExecutorWithMetrics(metrics *Metrics) BlockExecutorOption {
 	}
 }
 
+// BlockExecutorWithIndexPruner sets the pruner of the tx and block indexes.
+func BlockExecutorWithIndexPruner(pruner IndexPruner) BlockExecutorOption {
+	return func(blockExec *BlockExecutor) {
+		blockExec.indexPruner = pruner
+	}
+}
+
 // NewBlockExecutor returns a new BlockExecutor with a NopEventBus.
 // Call SetEventBus to provide one.
 func NewBlockExecutor(
@@ -110,6 +119,15 @@ func (blockExec *BlockExecutor) Store() Store {
 	return blockExec.store
 }
 
+// PruneIndexes prunes the tx and block indexes below retainHeight, as
+// returned by ApplyBlock, if an IndexPruner is set. It does not wait for the
+// indexes to be pruned.
+func (blockExec *BlockExecutor) PruneIndexes(retainHeight int64) {
+	if blockExec.indexPruner != nil {
+		blockExec.indexPruner.SetRetainHeight(retainHeight)
+	}
+}
+
 // SetEventBus - sets the event bus for publishing block related events.
 // If not called, it defaults to types.NopEventBus.
 func (blockExec *BlockExecutor) SetEventBus(eventBus types.BlockEventPublisher) {
@@ -135,7 +153,8 @@ func (blockExec *BlockExecutor) CreateProposalBlock(
 	evidence, evSize := blockExec.evpool.PendingEvidence(state.ConsensusParams.Evidence.MaxBytes)
 
 	// Fetch a limited amount of valid txs
-	maxDataBytes := types.MaxDataBytes(maxBytes, evSize, state.Validators.Size())
+	maxDataBytes := types.MaxDataBytes(maxBytes, evSize, state.Validators.Size(),
+		state.ConsensusParams.Validator.PubKeyTypes)
 
 	txs := blockExec.mempool.ReapMaxBytesMaxGasMaxTxs(maxDataBytes, maxGas, maxTxs)
 
@@ -542,7 +561,11 @@ func updateState(
 	nextVersion := state.Version
 
 	// get proof hash from vrf proof
-	proofHash, err := ed25519.ProofToHash(entropy.Proof.Bytes())
+	_, proposer := state.Validators.GetByAddress(header.ProposerAddress)
+	if proposer == nil {
+		return state, fmt.Errorf("proposer %X is not in the validator set", header.ProposerAddress)
+	}
+	proofHash, err := types.ProofToHash(proposer.PubKey, entropy.Proof.Bytes())
 	if err != nil {
 		return state, fmt.Errorf("error get proof of hash: %v", err)
 	}
@@ -143,8 +143,15 @@ func makeState(nVals, height int) (sm.State, dbm.DB, map[string]types.PrivValida
 	return s, stateDB, privVals
 }
 
+// makeBlock makes a block proposed by the proposer of the state. Note that
+// the VRF proof isn't generated by the proposer, so the block is invalid.
 func makeBlock(state sm.State, height int64) *types.Block {
-	return makeBlockWithPrivVal(state, makePrivVal(), height)
+	block := makeBlockWithPrivVal(state, makePrivVal(), height)
+	if !state.Validators.IsNilOrEmpty() {
+		proposer := state.Validators.SelectProposer(state.LastProofHash, height, 0)
+		block.ProposerAddress = proposer.Address
+	}
+	return block
 }
 
 func makeBlockWithPrivVal(state sm.State, privVal types.PrivValidator, height int64) *types.Block {
@@ -21,4 +21,28 @@ type BlockIndexer interface {
 	// Search performs a query for block heights that match a given BeginBlock
 	// and Endblock event search criteria.
 	Search(ctx context.Context, q *query.Query) ([]int64, error)
+
+	// Count returns the number of block heights Search would return for the
+	// query.
+	Count(ctx context.Context, q *query.Query) (int, error)
+
+	// SearchStream calls fn, in height order, for each block height matching
+	// the query until fn returns false or the results are exhausted.
+	SearchStream(ctx context.Context, q *query.Query, opts StreamOptions, fn func(int64) bool) error
+
+	// Prune removes the blocks indexed below retainHeight and returns the
+	// number of removed blocks.
+	Prune(retainHeight int64) (int64, error)
+}
+
+// StreamOptions bounds and orders the results of a BlockIndexer.SearchStream.
+type StreamOptions struct {
+	// MinHeight and MaxHeight bound the searched heights, inclusive. Zero
+	// means no bound.
+	MinHeight int64
+	MaxHeight int64
+	// Desc streams the results from the highest height down.
+	Desc bool
+	// After, if non-zero, skips the heights up to and including it.
+	After int64
 }
@@ -4,9 +4,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math"
 	"sort"
 	"strconv"
 	"strings"
+	"sync"
 
 	"github.com/google/orderedcode"
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -19,17 +21,48 @@ import (
 
 var _ indexer.BlockIndexer = (*BlockerIndexer)(nil)
 
+// deleteBatchSize is the number of keys Prune and DeleteHeights remove at
+// once.
+const deleteBatchSize = 1000
+
+var (
+	// retainHeightKey records the height below which the index is pruned.
+	retainHeightKey = []byte("retain_height")
+	// heightEventsSinceKey records the first height whose events are indexed
+	// by height. The heights below it are indexed by height once, by scanning
+	// the store, when they are first pruned, deleted or searched.
+	heightEventsSinceKey = []byte("height_events_since")
+)
+
 // BlockerIndexer implements a block indexer, indexing BeginBlock and EndBlock
 // events with an underlying KV store. Block events are indexed by their height,
 // such that matching search criteria returns the respective block height(s).
 type BlockerIndexer struct {
 	store dbm.DB
+	// filter selects the event attributes to index.
+	filter *indexer.KeyFilter
+	// migrateMtx serializes the indexing by height of the heights indexed
+	// before.
+	migrateMtx sync.Mutex
+}
+
+// BlockerIndexerOption sets an optional parameter on the BlockerIndexer.
+type BlockerIndexerOption func(*BlockerIndexer)
+
+// WithKeyFilter sets the filter selecting the event attributes to index. The
+// block height is indexed regardless of it.
+func WithKeyFilter(filter *indexer.KeyFilter) BlockerIndexerOption {
+	return func(idx *BlockerIndexer) { idx.filter = filter }
 }
 
-func New(store dbm.DB) *BlockerIndexer {
-	return &BlockerIndexer{
+func New(store dbm.DB, options ...BlockerIndexerOption) *BlockerIndexer {
+	idx := &BlockerIndexer{
 		store: store,
 	}
+	for _, option := range options {
+		option(idx)
+	}
+	return idx
 }
 
 // Has returns true if the given height has been indexed. An error is returned
@@ -49,12 +82,22 @@ func (idx *BlockerIndexer) Has(height int64) (bool, error) {
 // primary key: encode(block.height | height) => encode(height)
 // BeginBlock events: encode(eventType.eventAttr|eventValue|height|begin_block) => encode(height)
 // EndBlock events: encode(eventType.eventAttr|eventValue|height|end_block) => encode(height)
+//
+// Each of them is also indexed by the height first, for SearchStream:
+//
+// encode(height_events|height|block.height|height|) => encode(height)
+// encode(height_events|height|eventType.eventAttr|eventValue|begin_block) => encode(height)
+// encode(height_events|height|eventType.eventAttr|eventValue|end_block) => encode(height)
 func (idx *BlockerIndexer) Index(bh types.EventDataNewBlockHeader) error {
 	batch := idx.store.NewBatch()
 	defer batch.Close()
 
 	height := bh.Header.Height
 
+	if err := idx.markHeightEvents(batch, height); err != nil {
+		return err
+	}
+
 	// 1. index by height
 	key, err := heightKey(height)
 	if err != nil {
@@ -63,6 +106,13 @@ func (idx *BlockerIndexer) Index(bh types.EventDataNewBlockHeader) error {
 	if err := batch.Set(key, int64ToBytes(height)); err != nil {
 		return err
 	}
+	key, err = heightEventKey(height, types.BlockHeightKey, "", strconv.FormatInt(height, 10))
+	if err != nil {
+		return fmt.Errorf("failed to create block height index key: %w", err)
+	}
+	if err := batch.Set(key, int64ToBytes(height)); err != nil {
+		return err
+	}
 
 	// 2. index BeginBlock events
 	if err := idx.indexEvents(batch, bh.ResultBeginBlock.Events, "begin_block", height); err != nil {
@@ -77,12 +127,263 @@ func (idx *BlockerIndexer) Index(bh types.EventDataNewBlockHeader) error {
 	return batch.WriteSync()
 }
 
+// markHeightEvents records the given height as the first one whose events
+// are indexed by height, unless one is recorded already. If nothing is
+// indexed yet, all the heights are.
+func (idx *BlockerIndexer) markHeightEvents(batch dbm.Batch, height int64) error {
+	ok, err := idx.store.Has(heightEventsSinceKey)
+	if err != nil || ok {
+		return err
+	}
+
+	prefix, err := orderedcode.Append(nil, types.BlockHeightKey)
+	if err != nil {
+		return err
+	}
+	it, err := dbm.IteratePrefix(idx.store, prefix)
+	if err != nil {
+		return fmt.Errorf("failed to create prefix iterator: %w", err)
+	}
+	if !it.Valid() {
+		height = 1
+	}
+	err = it.Close()
+	if err != nil {
+		return err
+	}
+
+	return batch.Set(heightEventsSinceKey, int64ToBytes(height))
+}
+
+// Prune removes the blocks indexed below retainHeight, along with their
+// events, and returns the number of removed blocks. Only the heights from the
+// retain height of the previous call on are visited.
+//
+// The first call after an upgrade indexes the events of the heights indexed
+// before by height, scanning the whole store once, see migrateHeightEvents.
+func (idx *BlockerIndexer) Prune(retainHeight int64) (int64, error) {
+	bz, err := idx.store.Get(retainHeightKey)
+	if err != nil {
+		return 0, err
+	}
+	base := int64FromBytes(bz)
+	if base >= retainHeight {
+		return 0, nil
+	}
+
+	pruned, err := idx.deleteHeightRange(base, retainHeight-1)
+	if err != nil {
+		return pruned, err
+	}
+
+	return pruned, idx.store.SetSync(retainHeightKey, int64ToBytes(retainHeight))
+}
+
+// DeleteHeights removes the blocks indexed from startHeight to endHeight
+// (inclusive), along with their events, so that they can be indexed again,
+// e.g. after the key filter changed.
+func (idx *BlockerIndexer) DeleteHeights(startHeight, endHeight int64) error {
+	_, err := idx.deleteHeightRange(startHeight, endHeight)
+	return err
+}
+
+// deleteHeightRange removes the keys of the heights from startHeight to
+// endHeight (inclusive) through their events indexed by height, and returns
+// the number of removed blocks.
+func (idx *BlockerIndexer) deleteHeightRange(startHeight, endHeight int64) (int64, error) {
+	if err := idx.migrateHeightEvents(startHeight); err != nil {
+		return 0, err
+	}
+	return idx.deleteHeightEvents(startHeight, endHeight)
+}
+
+// migrateHeightEvents indexes the events of the heights indexed before their
+// events were indexed by height, if any of them is from fromHeight on. They
+// are found by scanning the whole store once, deleteBatchSize keys at a time,
+// after which all the heights are recorded as indexed by height.
+func (idx *BlockerIndexer) migrateHeightEvents(fromHeight int64) error {
+	idx.migrateMtx.Lock()
+	defer idx.migrateMtx.Unlock()
+
+	bz, err := idx.store.Get(heightEventsSinceKey)
+	if err != nil || bz == nil {
+		return err
+	}
+	since := int64FromBytes(bz)
+	if since <= 1 || fromHeight >= since {
+		return nil
+	}
+
+	var start []byte
+	for {
+		keys, next, err := idx.legacyHeightEventKeys(start, since)
+		if err != nil {
+			return err
+		}
+
+		batch := idx.store.NewBatch()
+		for key, height := range keys {
+			if err := batch.Set([]byte(key), int64ToBytes(height)); err != nil {
+				batch.Close()
+				return err
+			}
+		}
+		err = batch.Write()
+		batch.Close()
+		if err != nil {
+			return err
+		}
+
+		if next == nil {
+			return idx.store.SetSync(heightEventsSinceKey, int64ToBytes(1))
+		}
+		start = next
+	}
+}
+
+// legacyHeightEventKeys returns the keys indexing by height up to
+// deleteBatchSize primary and event keys of the heights below since, from
+// start on, along with their heights and the key to resume the scan from,
+// which is nil if the end of the store was reached.
+func (idx *BlockerIndexer) legacyHeightEventKeys(start []byte, since int64) (map[string]int64, []byte, error) {
+	it, err := idx.store.Iterator(start, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer it.Close()
+
+	keys := make(map[string]int64)
+	for ; it.Valid(); it.Next() {
+		height, compositeKey, typ, eventValue, ok := parseLegacyKey(it.Key())
+		if !ok || height >= since {
+			continue
+		}
+
+		key, err := heightEventKey(height, compositeKey, typ, eventValue)
+		if err != nil {
+			return nil, nil, err
+		}
+		keys[string(key)] = height
+		if len(keys) == deleteBatchSize {
+			next := append(append([]byte{}, it.Key()...), 0)
+			return keys, next, it.Error()
+		}
+	}
+
+	return keys, nil, it.Error()
+}
+
+// deleteHeightEvents removes the keys of the heights from startHeight to
+// endHeight (inclusive) through their events indexed by height, and returns
+// the number of removed blocks. The keys are removed deleteBatchSize events at
+// a time.
+func (idx *BlockerIndexer) deleteHeightEvents(startHeight, endHeight int64) (int64, error) {
+	if endHeight == math.MaxInt64 {
+		endHeight--
+	}
+	start, err := orderedcode.Append(nil, heightEventsKey, startHeight)
+	if err != nil {
+		return 0, err
+	}
+	end, err := orderedcode.Append(nil, heightEventsKey, endHeight+1)
+	if err != nil {
+		return 0, err
+	}
+
+	var deleted int64
+	for {
+		keys, blocks, err := idx.heightEventKeys(start, end)
+		if err != nil || len(keys) == 0 {
+			return deleted, err
+		}
+
+		batch := idx.store.NewBatch()
+		for _, key := range keys {
+			if err := batch.Delete(key); err != nil {
+				batch.Close()
+				return deleted, err
+			}
+		}
+		err = batch.Write()
+		batch.Close()
+		if err != nil {
+			return deleted, err
+		}
+		deleted += blocks
+	}
+}
+
+// heightEventKeys returns the keys of up to deleteBatchSize events indexed by
+// height between start and end, along with the primary and the event keys
+// they index, and the number of blocks among them.
+func (idx *BlockerIndexer) heightEventKeys(start, end []byte) ([][]byte, int64, error) {
+	it, err := idx.store.Iterator(start, end)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer it.Close()
+
+	var (
+		keys   [][]byte
+		blocks int64
+	)
+	for n := 0; it.Valid() && n < deleteBatchSize; it.Next() {
+		height, compositeKey, typ, eventValue, err := parseHeightEventKey(it.Key())
+		if err != nil {
+			continue
+		}
+
+		var key []byte
+		if compositeKey == types.BlockHeightKey {
+			key, err = heightKey(height)
+			blocks++
+		} else {
+			key, err = eventKey(compositeKey, typ, eventValue, height)
+		}
+		if err != nil {
+			return nil, 0, err
+		}
+		keys = append(keys, it.Key(), key)
+		n++
+	}
+
+	return keys, blocks, it.Error()
+}
+
 // Search performs a query for block heights that match a given BeginBlock
 // and Endblock event search criteria. The given query can match against zero,
 // one or more block heights. In the case of height queries, i.e. block.height=H,
 // if the height is indexed, that height alone will be returned. An error and
 // nil slice is returned. Otherwise, a non-nil slice and nil error is returned.
+//
+// The conditions of an OR are matched separately and their results are
+// merged. NOT is the complement of its matches among all the indexed heights,
+// or among the matches of the rest of the AND it belongs to if there is any.
 func (idx *BlockerIndexer) Search(ctx context.Context, q *query.Query) ([]int64, error) {
+	results, err := idx.searchHeights(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(results, func(i, j int) bool { return results[i] < results[j] })
+
+	return results, nil
+}
+
+// Count returns the number of block heights Search would return for the given
+// query.
+func (idx *BlockerIndexer) Count(ctx context.Context, q *query.Query) (int, error) {
+	results, err := idx.searchHeights(ctx, q)
+	if err != nil {
+		return 0, err
+	}
+
+	return len(results), nil
+}
+
+// searchHeights returns the indexed block heights matching the given query, in
+// no particular order.
+func (idx *BlockerIndexer) searchHeights(ctx context.Context, q *query.Query) ([]int64, error) {
 	results := make([]int64, 0)
 	select {
 	case <-ctx.Done():
@@ -91,33 +392,312 @@ func (idx *BlockerIndexer) Search(ctx context.Context, q *query.Query) ([]int64,
 	default:
 	}
 
-	conditions, err := q.Conditions()
+	// If the query is a conjunction with an exact height condition, return the
+	// result immediately (if it exists).
+	if conditions, err := q.Conditions(); err == nil {
+		height, ok := lookForHeight(conditions)
+		if ok {
+			ok, err := idx.Has(height)
+			if err != nil {
+				return nil, err
+			}
+
+			if ok {
+				return []int64{height}, nil
+			}
+
+			return results, nil
+		}
+	}
+
+	filteredHeights, err := idx.matchExpression(ctx, q.Expression())
 	if err != nil {
-		return nil, fmt.Errorf("failed to parse query conditions: %w", err)
+		return nil, err
 	}
 
-	// If there is an exact height query, return the result immediately
-	// (if it exists).
-	height, ok := lookForHeight(conditions)
-	if ok {
-		ok, err := idx.Has(height)
+	// fetch matching heights
+	results = make([]int64, 0, len(filteredHeights))
+	for _, hBz := range filteredHeights {
+		h := int64FromBytes(hBz)
+
+		ok, err := idx.Has(h)
 		if err != nil {
 			return nil, err
 		}
-
 		if ok {
-			return []int64{height}, nil
+			results = append(results, h)
 		}
 
-		return results, nil
+		select {
+		case <-ctx.Done():
+			break
+
+		default:
+		}
+	}
+
+	return results, nil
+}
+
+// SearchStream performs a query for block heights like Search and calls fn for
+// each matching height, in height order, until fn returns false.
+//
+// The events indexed by height are iterated from the first height in bounds
+// on, and the query is matched against the events of one height at a time, so
+// that the iteration stops as soon as fn returns false. The blocks indexed
+// before the events were indexed by height are indexed by height first if the
+// search reaches them, see migrateHeightEvents.
+func (idx *BlockerIndexer) SearchStream(
+	ctx context.Context,
+	q *query.Query,
+	opts indexer.StreamOptions,
+	fn func(int64) bool,
+) error {
+	minHeight, maxHeight := opts.MinHeight, opts.MaxHeight
+	if minHeight < 1 {
+		minHeight = 1
+	}
+	if maxHeight <= 0 || maxHeight == math.MaxInt64 {
+		maxHeight = math.MaxInt64 - 1
+	}
+	if conditions, err := q.Conditions(); err == nil {
+		minHeight, maxHeight = lookForHeightRange(conditions, minHeight, maxHeight)
+	}
+	if opts.After > 0 {
+		if opts.Desc && opts.After <= maxHeight {
+			maxHeight = opts.After - 1
+		} else if !opts.Desc && opts.After >= minHeight {
+			minHeight = opts.After + 1
+		}
+	}
+	if minHeight > maxHeight {
+		return nil
+	}
+	if err := idx.migrateHeightEvents(minHeight); err != nil {
+		return err
 	}
 
+	start, err := orderedcode.Append(nil, heightEventsKey, minHeight)
+	if err != nil {
+		return err
+	}
+	end, err := orderedcode.Append(nil, heightEventsKey, maxHeight+1)
+	if err != nil {
+		return err
+	}
+	var it dbm.Iterator
+	if opts.Desc {
+		it, err = idx.store.ReverseIterator(start, end)
+	} else {
+		it, err = idx.store.Iterator(start, end)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to create height events iterator: %w", err)
+	}
+	defer it.Close()
+
+	var (
+		height int64
+		events map[string][]string
+	)
+	// next matches the events of the current height and returns whether to
+	// go on
+	next := func() (bool, error) {
+		if events == nil {
+			return true, nil
+		}
+		match, err := q.Matches(events)
+		if err != nil {
+			return false, fmt.Errorf("failed to match block %d: %w", height, err)
+		}
+		return !match || fn(height), nil
+	}
+
+	for ; it.Valid(); it.Next() {
+		h, compositeKey, _, eventValue, err := parseHeightEventKey(it.Key())
+		if err != nil {
+			continue
+		}
+
+		if h != height {
+			if ok, err := next(); err != nil || !ok {
+				return err
+			}
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			height, events = h, make(map[string][]string)
+		}
+		events[compositeKey] = append(events[compositeKey], eventValue)
+	}
+	if err := it.Error(); err != nil {
+		return err
+	}
+
+	_, err = next()
+	return err
+}
+
+// matchExpression returns all matching heights that meet the given
+// expression. The conditions of an AND are matched together by
+// matchConditions, and the rest of its sub-expressions only narrow down their
+// matches.
+func (idx *BlockerIndexer) matchExpression(ctx context.Context, expr *query.Expression) (map[string][]byte, error) {
+	switch expr.Op {
+	case query.ExprCondition:
+		return idx.matchConditions(ctx, []query.Condition{expr.Condition})
+
+	case query.ExprOr:
+		filteredHeights := make(map[string][]byte)
+		for _, sub := range expr.Expressions {
+			tmpHeights, err := idx.matchExpression(ctx, sub)
+			if err != nil {
+				return nil, err
+			}
+			for k, v := range tmpHeights {
+				filteredHeights[k] = v
+			}
+		}
+		return filteredHeights, nil
+
+	case query.ExprNot:
+		filteredHeights, err := idx.matchAll(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return idx.exclude(ctx, filteredHeights, expr.Expressions[0])
+
+	case query.ExprAnd:
+		var (
+			conditions        []query.Condition
+			others, negations []*query.Expression
+		)
+		for _, sub := range expr.Expressions {
+			switch sub.Op {
+			case query.ExprCondition:
+				conditions = append(conditions, sub.Condition)
+			case query.ExprNot:
+				negations = append(negations, sub.Expressions[0])
+			default:
+				others = append(others, sub)
+			}
+		}
+
+		var (
+			filteredHeights    map[string][]byte
+			heightsInitialized bool
+			err                error
+		)
+		if len(conditions) > 0 {
+			filteredHeights, err = idx.matchConditions(ctx, conditions)
+			if err != nil {
+				return nil, err
+			}
+			heightsInitialized = true
+		}
+
+		for _, sub := range others {
+			// Ignore any remaining sub-expressions if there is no match already.
+			if heightsInitialized && len(filteredHeights) == 0 {
+				return filteredHeights, nil
+			}
+
+			tmpHeights, err := idx.matchExpression(ctx, sub)
+			if err != nil {
+				return nil, err
+			}
+			if !heightsInitialized {
+				filteredHeights = tmpHeights
+				heightsInitialized = true
+				continue
+			}
+			for k := range filteredHeights {
+				if tmpHeights[k] == nil {
+					delete(filteredHeights, k)
+				}
+			}
+		}
+
+		if !heightsInitialized {
+			filteredHeights, err = idx.matchAll(ctx)
+			if err != nil {
+				return nil, err
+			}
+		}
+		for _, sub := range negations {
+			filteredHeights, err = idx.exclude(ctx, filteredHeights, sub)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return filteredHeights, nil
+
+	default:
+		return nil, fmt.Errorf("unknown expression operator %v", expr.Op)
+	}
+}
+
+// exclude removes the heights matching the expression from filteredHeights.
+func (idx *BlockerIndexer) exclude(
+	ctx context.Context,
+	filteredHeights map[string][]byte,
+	expr *query.Expression,
+) (map[string][]byte, error) {
+	if len(filteredHeights) == 0 {
+		return filteredHeights, nil
+	}
+
+	tmpHeights, err := idx.matchExpression(ctx, expr)
+	if err != nil {
+		return nil, err
+	}
+	for k := range tmpHeights {
+		delete(filteredHeights, k)
+	}
+	return filteredHeights, nil
+}
+
+// matchAll returns all the indexed heights.
+func (idx *BlockerIndexer) matchAll(ctx context.Context) (map[string][]byte, error) {
+	return idx.match(ctx, query.Condition{CompositeKey: types.BlockHeightKey, Op: query.OpExists}, nil,
+		make(map[string][]byte), true)
+}
+
+// matchConditions returns all matching heights that meet all the given
+// conditions.
+func (idx *BlockerIndexer) matchConditions(ctx context.Context, conditions []query.Condition) (map[string][]byte, error) {
 	var heightsInitialized bool
 	filteredHeights := make(map[string][]byte)
 
 	// conditions to skip because they're handled before "everything else"
 	skipIndexes := make([]int, 0)
 
+	// exact height conditions are looked up by the primary key
+	for i, c := range conditions {
+		if c.CompositeKey != types.BlockHeightKey || (c.Op != query.OpEqual && c.Op != query.OpIn) {
+			continue
+		}
+		skipIndexes = append(skipIndexes, i)
+
+		tmpHeights, err := idx.matchHeights(c)
+		if err != nil {
+			return nil, err
+		}
+		if !heightsInitialized {
+			filteredHeights = tmpHeights
+			heightsInitialized = true
+			continue
+		}
+		for k := range filteredHeights {
+			if tmpHeights[k] == nil {
+				delete(filteredHeights, k)
+			}
+		}
+	}
+	if heightsInitialized && len(filteredHeights) == 0 {
+		return filteredHeights, nil
+	}
+
 	// Extract ranges. If both upper and lower bounds exist, it's better to get
 	// them in order as to not iterate over kvs that are not within range.
 	ranges, rangeIndexes := indexer.LookForRanges(conditions)
@@ -184,30 +764,34 @@ func (idx *BlockerIndexer) Search(ctx context.Context, q *query.Query) ([]int64,
 		}
 	}
 
-	// fetch matching heights
-	results = make([]int64, 0, len(filteredHeights))
-	for _, hBz := range filteredHeights {
-		h := int64FromBytes(hBz)
+	return filteredHeights, nil
+}
 
-		ok, err := idx.Has(h)
+// matchHeights returns the indexed heights among the ones of a "block.height"
+// condition.
+func (idx *BlockerIndexer) matchHeights(c query.Condition) (map[string][]byte, error) {
+	operands := []interface{}{c.Operand}
+	if c.Op == query.OpIn {
+		operands = c.Operand.([]interface{})
+	}
+
+	heights := make(map[string][]byte)
+	for _, operand := range operands {
+		height, ok := operand.(int64)
+		if !ok {
+			continue
+		}
+
+		ok, err := idx.Has(height)
 		if err != nil {
 			return nil, err
 		}
 		if ok {
-			results = append(results, h)
-		}
-
-		select {
-		case <-ctx.Done():
-			break
-
-		default:
+			heightBz := int64ToBytes(height)
+			heights[string(heightBz)] = heightBz
 		}
 	}
-
-	sort.Slice(results, func(i, j int) bool { return results[i] < results[j] })
-
-	return results, nil
+	return heights, nil
 }
 
 // matchRange returns all matching block heights that match a given QueryRange
@@ -387,6 +971,33 @@ func (idx *BlockerIndexer) match(
 			return nil, err
 		}
 
+	case c.Op == query.OpIn:
+		for _, operand := range c.Operand.([]interface{}) {
+			prefix, err := orderedcode.Append(nil, c.CompositeKey, fmt.Sprintf("%v", operand))
+			if err != nil {
+				return nil, err
+			}
+
+			it, err := dbm.IteratePrefix(idx.store, prefix)
+			if err != nil {
+				return nil, fmt.Errorf("failed to create prefix iterator: %w", err)
+			}
+
+			for ; it.Valid(); it.Next() {
+				tmpHeights[string(it.Value())] = it.Value()
+
+				if err := ctx.Err(); err != nil {
+					break
+				}
+			}
+
+			err = it.Error()
+			it.Close()
+			if err != nil {
+				return nil, err
+			}
+		}
+
 	case c.Op == query.OpContains:
 		prefix, err := orderedcode.Append(nil, c.CompositeKey)
 		if err != nil {
@@ -467,13 +1078,14 @@ func (idx *BlockerIndexer) indexEvents(batch dbm.Batch, events []abci.Event, typ
 				continue
 			}
 
-			// index iff the event specified index:true and it's not a reserved event
+			// index iff the event specified index:true, the filter allows it and
+			// it's not a reserved event
 			compositeKey := fmt.Sprintf("%s.%s", event.Type, string(attr.Key))
 			if compositeKey == types.BlockHeightKey {
 				return fmt.Errorf("event type and attribute key \"%s\" is reserved; please use a different key", compositeKey)
 			}
 
-			if attr.GetIndex() {
+			if attr.GetIndex() && idx.filter.Allow(compositeKey) {
 				key, err := eventKey(compositeKey, typ, string(attr.Value), height)
 				if err != nil {
 					return fmt.Errorf("failed to create block index key: %w", err)
@@ -482,6 +1094,14 @@ func (idx *BlockerIndexer) indexEvents(batch dbm.Batch, events []abci.Event, typ
 				if err := batch.Set(key, heightBz); err != nil {
 					return err
 				}
+
+				key, err = heightEventKey(height, compositeKey, typ, string(attr.Value))
+				if err != nil {
+					return fmt.Errorf("failed to create block index key: %w", err)
+				}
+				if err := batch.Set(key, heightBz); err != nil {
+					return err
+				}
 			}
 		}
 	}
@@ -5,19 +5,21 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/google/orderedcode"
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
 	db "github.com/tendermint/tm-db"
 
 	"github.com/Finschia/ostracon/libs/pubsub/query"
+	"github.com/Finschia/ostracon/state/indexer"
 	blockidxkv "github.com/Finschia/ostracon/state/indexer/block/kv"
 	"github.com/Finschia/ostracon/types"
 )
 
 func TestBlockIndexer(t *testing.T) {
 	store := db.NewPrefixDB(db.NewMemDB(), []byte("block_events"))
-	indexer := blockidxkv.New(store)
-	require.NoError(t, indexer.Index(types.EventDataNewBlockHeader{
+	blockIndexer := blockidxkv.New(store)
+	require.NoError(t, blockIndexer.Index(types.EventDataNewBlockHeader{
 		Header: types.Header{Height: 1},
 		ResultBeginBlock: abci.ResponseBeginBlock{
 			Events: []abci.Event{
@@ -55,7 +57,7 @@ func TestBlockIndexer(t *testing.T) {
 			index = true
 		}
 
-		require.NoError(t, indexer.Index(types.EventDataNewBlockHeader{
+		require.NoError(t, blockIndexer.Index(types.EventDataNewBlockHeader{
 			Header: types.Header{Height: int64(i)},
 			ResultBeginBlock: abci.ResponseBeginBlock{
 				Events: []abci.Event{
@@ -128,14 +130,335 @@ func TestBlockIndexer(t *testing.T) {
 			q:       query.MustParse("begin_event.proposer CONTAINS 'FCAA001'"),
 			results: []int64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11},
 		},
+		"end_event.foo = 2 OR end_event.foo >= 10": {
+			q:       query.MustParse("end_event.foo = 2 OR end_event.foo >= 10"),
+			results: []int64{1, 2, 10},
+		},
+		"end_event.foo IN (2, 3, 4)": {
+			q:       query.MustParse("end_event.foo IN (2, 3, 4)"),
+			results: []int64{2, 4},
+		},
+		"block.height IN (3, 5, 100)": {
+			q:       query.MustParse("block.height IN (3, 5, 100)"),
+			results: []int64{3, 5},
+		},
+		"NOT end_event.foo EXISTS": {
+			q:       query.MustParse("NOT end_event.foo EXISTS"),
+			results: []int64{3, 5, 7, 9, 11},
+		},
+		"block.height < 6 AND NOT (end_event.foo = 2 OR block.height = 3)": {
+			q:       query.MustParse("block.height < 6 AND NOT (end_event.foo = 2 OR block.height = 3)"),
+			results: []int64{1, 4, 5},
+		},
+		"(block.height = 3 OR block.height = 4) AND (end_event.foo <= 5 OR end_event.foo >= 100)": {
+			q:       query.MustParse("(block.height = 3 OR block.height = 4) AND (end_event.foo <= 5 OR end_event.foo >= 100)"),
+			results: []int64{4},
+		},
 	}
 
 	for name, tc := range testCases {
 		tc := tc
 		t.Run(name, func(t *testing.T) {
-			results, err := indexer.Search(context.Background(), tc.q)
+			results, err := blockIndexer.Search(context.Background(), tc.q)
 			require.NoError(t, err)
 			require.Equal(t, tc.results, results)
+
+			count, err := blockIndexer.Count(context.Background(), tc.q)
+			require.NoError(t, err)
+			require.Equal(t, len(tc.results), count)
+
+			streamed := make([]int64, 0)
+			err = blockIndexer.SearchStream(context.Background(), tc.q, indexer.StreamOptions{}, func(height int64) bool {
+				streamed = append(streamed, height)
+				return true
+			})
+			require.NoError(t, err)
+			require.Equal(t, tc.results, streamed)
 		})
 	}
+
+	streamCases := map[string]struct {
+		q       *query.Query
+		opts    indexer.StreamOptions
+		limit   int
+		results []int64
+	}{
+		"ascending after a height": {
+			q:       query.MustParse("begin_event.proposer = 'FCAA001'"),
+			opts:    indexer.StreamOptions{After: 8},
+			results: []int64{9, 10, 11},
+		},
+		"descending with a limit": {
+			q:       query.MustParse("end_event.foo EXISTS"),
+			opts:    indexer.StreamOptions{Desc: true},
+			limit:   2,
+			results: []int64{10, 8},
+		},
+		"descending after a height within bounds": {
+			q:       query.MustParse("end_event.foo EXISTS"),
+			opts:    indexer.StreamOptions{MinHeight: 2, MaxHeight: 9, Desc: true, After: 6},
+			results: []int64{4, 2},
+		},
+	}
+
+	for name, tc := range streamCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			results := make([]int64, 0)
+			err := blockIndexer.SearchStream(context.Background(), tc.q, tc.opts, func(height int64) bool {
+				results = append(results, height)
+				return len(results) != tc.limit
+			})
+			require.NoError(t, err)
+			require.Equal(t, tc.results, results)
+		})
+	}
+}
+
+func TestBlockIndexerPrune(t *testing.T) {
+	store := db.NewPrefixDB(db.NewMemDB(), []byte("block_events"))
+	blockIndexer := blockidxkv.New(store)
+
+	for height := int64(1); height <= 10; height++ {
+		require.NoError(t, blockIndexer.Index(types.EventDataNewBlockHeader{
+			Header: types.Header{Height: height},
+			ResultEndBlock: abci.ResponseEndBlock{
+				Events: []abci.Event{
+					{
+						Type: "end_event",
+						Attributes: []abci.EventAttribute{
+							{
+								Key:   []byte("foo"),
+								Value: []byte(fmt.Sprintf("%d", height%3)),
+								Index: true,
+							},
+						},
+					},
+				},
+			},
+		}))
+	}
+
+	pruned, err := blockIndexer.Prune(6)
+	require.NoError(t, err)
+	require.EqualValues(t, 5, pruned)
+
+	// pruning again up to the same height is a noop
+	pruned, err = blockIndexer.Prune(6)
+	require.NoError(t, err)
+	require.EqualValues(t, 0, pruned)
+
+	for height := int64(1); height <= 10; height++ {
+		ok, err := blockIndexer.Has(height)
+		require.NoError(t, err)
+		require.Equal(t, height >= 6, ok)
+	}
+
+	results, err := blockIndexer.Search(context.Background(), query.MustParse("end_event.foo EXISTS"))
+	require.NoError(t, err)
+	require.Equal(t, []int64{6, 7, 8, 9, 10}, results)
+
+	// a primary and an event key per retained block, both indexed by height
+	// too, the retain height and the first height indexed by height
+	it, err := store.Iterator(nil, nil)
+	require.NoError(t, err)
+	keys := 0
+	for ; it.Valid(); it.Next() {
+		keys++
+	}
+	require.NoError(t, it.Close())
+	require.Equal(t, 22, keys)
+
+	pruned, err = blockIndexer.Prune(8)
+	require.NoError(t, err)
+	require.EqualValues(t, 2, pruned)
+}
+
+func TestBlockIndexerPruneUnindexedHeights(t *testing.T) {
+	store := db.NewPrefixDB(db.NewMemDB(), []byte("block_events"))
+	blockIndexer := blockidxkv.New(store)
+	index := func(height int64) {
+		require.NoError(t, blockIndexer.Index(types.EventDataNewBlockHeader{
+			Header: types.Header{Height: height},
+			ResultEndBlock: abci.ResponseEndBlock{
+				Events: []abci.Event{
+					{
+						Type:       "end_event",
+						Attributes: []abci.EventAttribute{{Key: []byte("foo"), Value: []byte("1"), Index: true}},
+					},
+				},
+			},
+		}))
+	}
+
+	// the heights indexed before their events were indexed by height
+	for height := int64(1); height <= 4; height++ {
+		index(height)
+	}
+	prefix, err := orderedcode.Append(nil, "height_events")
+	require.NoError(t, err)
+	it, err := db.IteratePrefix(store, prefix)
+	require.NoError(t, err)
+	var keys [][]byte
+	for ; it.Valid(); it.Next() {
+		keys = append(keys, it.Key())
+	}
+	require.NoError(t, it.Close())
+	for _, key := range append(keys, []byte("height_events_since")) {
+		require.NoError(t, store.Delete(key))
+	}
+
+	for height := int64(5); height <= 10; height++ {
+		index(height)
+	}
+
+	// the first prune indexes them by height, so that the next ones don't scan the store
+	pruned, err := blockIndexer.Prune(3)
+	require.NoError(t, err)
+	require.EqualValues(t, 2, pruned)
+	since, err := store.Get([]byte("height_events_since"))
+	require.NoError(t, err)
+	require.Equal(t, []byte{2}, since) // varint of 1
+	pruned, err = blockIndexer.Prune(7)
+	require.NoError(t, err)
+	require.EqualValues(t, 4, pruned)
+	pruned, err = blockIndexer.Prune(9)
+	require.NoError(t, err)
+	require.EqualValues(t, 2, pruned)
+
+	results, err := blockIndexer.Search(context.Background(), query.MustParse("end_event.foo EXISTS"))
+	require.NoError(t, err)
+	require.Equal(t, []int64{9, 10}, results)
+	require.NoError(t, blockIndexer.DeleteHeights(1, 9))
+	results, err = blockIndexer.Search(context.Background(), query.MustParse("end_event.foo EXISTS"))
+	require.NoError(t, err)
+	require.Equal(t, []int64{10}, results)
+}
+
+func TestBlockIndexerSearchStreamUnindexedHeights(t *testing.T) {
+	store := db.NewPrefixDB(db.NewMemDB(), []byte("block_events"))
+	blockIndexer := blockidxkv.New(store)
+	index := func(height int64) {
+		require.NoError(t, blockIndexer.Index(types.EventDataNewBlockHeader{
+			Header: types.Header{Height: height},
+			ResultEndBlock: abci.ResponseEndBlock{
+				Events: []abci.Event{
+					{
+						Type:       "end_event",
+						Attributes: []abci.EventAttribute{{Key: []byte("foo"), Value: []byte("1"), Index: true}},
+					},
+				},
+			},
+		}))
+	}
+
+	// the heights indexed before their events were indexed by height
+	for height := int64(1); height <= 3; height++ {
+		index(height)
+	}
+	prefix, err := orderedcode.Append(nil, "height_events")
+	require.NoError(t, err)
+	it, err := db.IteratePrefix(store, prefix)
+	require.NoError(t, err)
+	var keys [][]byte
+	for ; it.Valid(); it.Next() {
+		keys = append(keys, it.Key())
+	}
+	require.NoError(t, it.Close())
+	for _, key := range append(keys, []byte("height_events_since")) {
+		require.NoError(t, store.Delete(key))
+	}
+
+	for height := int64(4); height <= 5; height++ {
+		index(height)
+	}
+
+	search := func(opts indexer.StreamOptions) []int64 {
+		results := []int64{}
+		err := blockIndexer.SearchStream(context.Background(), query.MustParse("end_event.foo = 1"), opts,
+			func(height int64) bool {
+				results = append(results, height)
+				return true
+			})
+		require.NoError(t, err)
+		return results
+	}
+
+	// the search above them doesn't index them, the one reaching them does
+	require.Equal(t, []int64{5}, search(indexer.StreamOptions{After: 4}))
+	since, err := store.Get([]byte("height_events_since"))
+	require.NoError(t, err)
+	require.Equal(t, []byte{8}, since) // varint of 4
+	require.Equal(t, []int64{1, 2, 3, 4, 5}, search(indexer.StreamOptions{}))
+	require.Equal(t, []int64{5, 4, 3, 2, 1}, search(indexer.StreamOptions{Desc: true}))
+}
+
+func TestBlockIndexerKeyFilter(t *testing.T) {
+	store := db.NewPrefixDB(db.NewMemDB(), []byte("block_events"))
+	blockIndexer := blockidxkv.New(store, blockidxkv.WithKeyFilter(indexer.NewKeyFilter([]string{"end_event.*"}, []string{"*.memo"})))
+
+	require.NoError(t, blockIndexer.Index(types.EventDataNewBlockHeader{
+		Header: types.Header{Height: 1},
+		ResultBeginBlock: abci.ResponseBeginBlock{
+			Events: []abci.Event{
+				{
+					Type:       "begin_event",
+					Attributes: []abci.EventAttribute{{Key: []byte("foo"), Value: []byte("1"), Index: true}},
+				},
+			},
+		},
+		ResultEndBlock: abci.ResponseEndBlock{
+			Events: []abci.Event{
+				{
+					Type: "end_event",
+					Attributes: []abci.EventAttribute{
+						{Key: []byte("foo"), Value: []byte("1"), Index: true},
+						{Key: []byte("memo"), Value: []byte("hello"), Index: true},
+					},
+				},
+			},
+		},
+	}))
+
+	for q, results := range map[string][]int64{
+		"block.height = 1":       {1},
+		"end_event.foo = 1":      {1},
+		"end_event.memo EXISTS":  {},
+		"begin_event.foo EXISTS": {},
+	} {
+		heights, err := blockIndexer.Search(context.Background(), query.MustParse(q))
+		require.NoError(t, err)
+		require.Equal(t, results, heights, q)
+	}
+}
+
+func TestBlockIndexerDeleteHeights(t *testing.T) {
+	store := db.NewPrefixDB(db.NewMemDB(), []byte("block_events"))
+	blockIndexer := blockidxkv.New(store)
+
+	for height := int64(1); height <= 5; height++ {
+		require.NoError(t, blockIndexer.Index(types.EventDataNewBlockHeader{
+			Header: types.Header{Height: height},
+			ResultEndBlock: abci.ResponseEndBlock{
+				Events: []abci.Event{
+					{
+						Type:       "end_event",
+						Attributes: []abci.EventAttribute{{Key: []byte("foo"), Value: []byte("bar"), Index: true}},
+					},
+				},
+			},
+		}))
+	}
+
+	require.NoError(t, blockIndexer.DeleteHeights(2, 4))
+
+	for height := int64(1); height <= 5; height++ {
+		ok, err := blockIndexer.Has(height)
+		require.NoError(t, err)
+		require.Equal(t, height == 1 || height == 5, ok)
+	}
+
+	results, err := blockIndexer.Search(context.Background(), query.MustParse("end_event.foo = 'bar'"))
+	require.NoError(t, err)
+	require.Equal(t, []int64{1, 5}, results)
 }
@@ -32,6 +32,10 @@ func int64ToBytes(i int64) []byte {
 	return buf[:n]
 }
 
+// heightEventsKey prefixes the keys indexing the events of a block by its
+// height. It has no dot, so it can't be the composite key of an event.
+const heightEventsKey = "height_events"
+
 func heightKey(height int64) ([]byte, error) {
 	return orderedcode.Append(
 		nil,
@@ -50,6 +54,35 @@ func eventKey(compositeKey, typ, eventValue string, height int64) ([]byte, error
 	)
 }
 
+// heightEventKey indexes an event of a block, or its height with an empty
+// typ, by the height first, so that the events of a block are found without
+// a scan.
+func heightEventKey(height int64, compositeKey, typ, eventValue string) ([]byte, error) {
+	return orderedcode.Append(
+		nil,
+		heightEventsKey,
+		height,
+		compositeKey,
+		eventValue,
+		typ,
+	)
+}
+
+func parseHeightEventKey(key []byte) (height int64, compositeKey, typ, eventValue string, err error) {
+	var prefix string
+
+	remaining, err := orderedcode.Parse(string(key), &prefix, &height, &compositeKey, &eventValue, &typ)
+	if err != nil {
+		return 0, "", "", "", fmt.Errorf("failed to parse height event key: %w", err)
+	}
+
+	if len(remaining) != 0 || prefix != heightEventsKey {
+		return 0, "", "", "", fmt.Errorf("unexpected height event key: %X", key)
+	}
+
+	return height, compositeKey, typ, eventValue, nil
+}
+
 func parseValueFromPrimaryKey(key []byte) (string, error) {
 	var (
 		compositeKey string
@@ -86,6 +119,58 @@ func parseValueFromEventKey(key []byte) (string, error) {
 	return eventValue, nil
 }
 
+// parseLegacyKey returns the height and the event of a primary or an event
+// key, as they are indexed by height. ok is false for any other key.
+func parseLegacyKey(key []byte) (height int64, compositeKey, typ, eventValue string, ok bool) {
+	if _, _, _, _, err := parseHeightEventKey(key); err == nil {
+		return 0, "", "", "", false
+	}
+
+	remaining, err := orderedcode.Parse(string(key), &compositeKey, &height)
+	if err == nil && len(remaining) == 0 && compositeKey == types.BlockHeightKey {
+		return height, compositeKey, "", strconv.FormatInt(height, 10), true
+	}
+
+	remaining, err = orderedcode.Parse(string(key), &compositeKey, &eventValue, &height, &typ)
+	if err == nil && len(remaining) == 0 {
+		return height, compositeKey, typ, eventValue, true
+	}
+
+	return 0, "", "", "", false
+}
+
+// lookForHeightRange narrows down the given inclusive height bounds with the
+// "block.height" conditions.
+func lookForHeightRange(conditions []query.Condition, minHeight, maxHeight int64) (int64, int64) {
+	for _, c := range conditions {
+		height, ok := c.Operand.(int64)
+		if c.CompositeKey != types.BlockHeightKey || !ok {
+			continue
+		}
+
+		lower, upper := minHeight, maxHeight
+		switch c.Op {
+		case query.OpEqual:
+			lower, upper = height, height
+		case query.OpGreater:
+			lower = height + 1
+		case query.OpGreaterEqual:
+			lower = height
+		case query.OpLess:
+			upper = height - 1
+		case query.OpLessEqual:
+			upper = height
+		}
+		if lower > minHeight {
+			minHeight = lower
+		}
+		if upper < maxHeight {
+			maxHeight = upper
+		}
+	}
+	return minHeight, maxHeight
+}
+
 func lookForHeight(conditions []query.Condition) (int64, bool) {
 	for _, c := range conditions {
 		if c.CompositeKey == types.BlockHeightKey && c.Op == query.OpEqual {
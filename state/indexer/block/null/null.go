@@ -25,3 +25,20 @@ func (idx *BlockerIndexer) Index(types.EventDataNewBlockHeader) error {
 func (idx *BlockerIndexer) Search(ctx context.Context, q *query.Query) ([]int64, error) {
 	return []int64{}, nil
 }
+
+func (idx *BlockerIndexer) Count(ctx context.Context, q *query.Query) (int, error) {
+	return 0, nil
+}
+
+func (idx *BlockerIndexer) SearchStream(
+	ctx context.Context,
+	q *query.Query,
+	opts indexer.StreamOptions,
+	fn func(int64) bool,
+) error {
+	return nil
+}
+
+func (idx *BlockerIndexer) Prune(retainHeight int64) (int64, error) {
+	return 0, nil
+}
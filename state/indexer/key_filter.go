@@ -0,0 +1,76 @@
+package indexer
+
+import (
+	"strings"
+)
+
+// KeyFilter selects the event attributes to index by their composite keys,
+// "type.key". A pattern matches a composite key either exactly or, if it
+// contains "*", with each "*" standing for any sequence of characters, e.g.
+// "transfer.*" matches all the attributes of the transfer events.
+//
+// A nil KeyFilter selects every attribute.
+type KeyFilter struct {
+	include []string
+	exclude []string
+}
+
+// NewKeyFilter returns a KeyFilter selecting the composite keys matching one
+// of the include patterns, or any key if there is none, and none of the
+// exclude patterns. It returns nil if both lists are empty.
+func NewKeyFilter(include, exclude []string) *KeyFilter {
+	if len(include) == 0 && len(exclude) == 0 {
+		return nil
+	}
+	return &KeyFilter{
+		include: include,
+		exclude: exclude,
+	}
+}
+
+// Allow returns true if the attribute with the given composite key is to be
+// indexed.
+func (f *KeyFilter) Allow(compositeKey string) bool {
+	if f == nil {
+		return true
+	}
+	if len(f.include) > 0 && !matchAny(f.include, compositeKey) {
+		return false
+	}
+	return !matchAny(f.exclude, compositeKey)
+}
+
+func matchAny(patterns []string, compositeKey string) bool {
+	for _, pattern := range patterns {
+		if MatchKey(pattern, compositeKey) {
+			return true
+		}
+	}
+	return false
+}
+
+// MatchKey returns true if the composite key matches the pattern, where each
+// "*" stands for any sequence of characters.
+func MatchKey(pattern, compositeKey string) bool {
+	parts := strings.Split(pattern, "*")
+	if len(parts) == 1 {
+		return pattern == compositeKey
+	}
+
+	// the first part is a prefix and the last one a suffix, and the ones in
+	// between are found in order, as early as possible, in what remains
+	first, last := parts[0], parts[len(parts)-1]
+	if len(compositeKey) < len(first)+len(last) ||
+		!strings.HasPrefix(compositeKey, first) || !strings.HasSuffix(compositeKey, last) {
+		return false
+	}
+	rest := compositeKey[len(first) : len(compositeKey)-len(last)]
+	for _, part := range parts[1 : len(parts)-1] {
+		i := strings.Index(rest, part)
+		if i < 0 {
+			return false
+		}
+		rest = rest[i+len(part):]
+	}
+	return true
+}
@@ -0,0 +1,51 @@
+package indexer_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Finschia/ostracon/state/indexer"
+)
+
+func TestMatchKey(t *testing.T) {
+	testCases := []struct {
+		pattern string
+		key     string
+		match   bool
+	}{
+		{"transfer.sender", "transfer.sender", true},
+		{"transfer.sender", "transfer.recipient", false},
+		{"transfer.*", "transfer.sender", true},
+		{"transfer.*", "transfer.", true},
+		{"transfer.*", "transferx.sender", false},
+		{"*.sender", "transfer.sender", true},
+		{"*.sender", "transfer.senders", false},
+		{"*", "transfer.sender", true},
+		{"t*.*r", "transfer.sender", true},
+		{"t*.*r", "transfer.amount", false},
+		{"a*b*a", "aba", true},
+		{"a*a", "a", false},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, tc.match, indexer.MatchKey(tc.pattern, tc.key), "%q %q", tc.pattern, tc.key)
+	}
+}
+
+func TestKeyFilter(t *testing.T) {
+	require.Nil(t, indexer.NewKeyFilter(nil, nil))
+
+	var filter *indexer.KeyFilter
+	require.True(t, filter.Allow("transfer.sender"))
+
+	filter = indexer.NewKeyFilter([]string{"transfer.*", "message.action"}, []string{"transfer.amount"})
+	require.True(t, filter.Allow("transfer.sender"))
+	require.True(t, filter.Allow("message.action"))
+	require.False(t, filter.Allow("transfer.amount"))
+	require.False(t, filter.Allow("message.sender"))
+
+	filter = indexer.NewKeyFilter(nil, []string{"*.memo"})
+	require.True(t, filter.Allow("transfer.sender"))
+	require.False(t, filter.Allow("tx.memo"))
+}
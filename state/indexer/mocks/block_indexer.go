@@ -5,6 +5,7 @@ package mocks
 import (
 	context "context"
 
+	indexer "github.com/Finschia/ostracon/state/indexer"
 	mock "github.com/stretchr/testify/mock"
 
 	query "github.com/Finschia/ostracon/libs/pubsub/query"
@@ -17,6 +18,30 @@ type BlockIndexer struct {
 	mock.Mock
 }
 
+// Count provides a mock function with given fields: ctx, q
+func (_m *BlockIndexer) Count(ctx context.Context, q *query.Query) (int, error) {
+	ret := _m.Called(ctx, q)
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *query.Query) (int, error)); ok {
+		return rf(ctx, q)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *query.Query) int); ok {
+		r0 = rf(ctx, q)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *query.Query) error); ok {
+		r1 = rf(ctx, q)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Has provides a mock function with given fields: height
 func (_m *BlockIndexer) Has(height int64) (bool, error) {
 	ret := _m.Called(height)
@@ -55,6 +80,30 @@ func (_m *BlockIndexer) Index(_a0 types.EventDataNewBlockHeader) error {
 	return r0
 }
 
+// Prune provides a mock function with given fields: retainHeight
+func (_m *BlockIndexer) Prune(retainHeight int64) (int64, error) {
+	ret := _m.Called(retainHeight)
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int64) (int64, error)); ok {
+		return rf(retainHeight)
+	}
+	if rf, ok := ret.Get(0).(func(int64) int64); ok {
+		r0 = rf(retainHeight)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(int64) error); ok {
+		r1 = rf(retainHeight)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Search provides a mock function with given fields: ctx, q
 func (_m *BlockIndexer) Search(ctx context.Context, q *query.Query) ([]int64, error) {
 	ret := _m.Called(ctx, q)
@@ -81,6 +130,20 @@ func (_m *BlockIndexer) Search(ctx context.Context, q *query.Query) ([]int64, er
 	return r0, r1
 }
 
+// SearchStream provides a mock function with given fields: ctx, q, opts, fn
+func (_m *BlockIndexer) SearchStream(ctx context.Context, q *query.Query, opts indexer.StreamOptions, fn func(int64) bool) error {
+	ret := _m.Called(ctx, q, opts, fn)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *query.Query, indexer.StreamOptions, func(int64) bool) error); ok {
+		r0 = rf(ctx, q, opts, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // NewBlockIndexer creates a new instance of BlockIndexer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewBlockIndexer(t interface {
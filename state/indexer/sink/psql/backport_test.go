@@ -19,14 +19,14 @@ var (
 )
 
 func TestBackportTxIndexer_AddBatch(t *testing.T) {
-	indexer := &EventSink{store: testDB(), chainID: chainID}
+	indexer := newTestSink(t, chainID)
 	txIndexer := indexer.TxIndexer()
 	err := txIndexer.AddBatch(&txindex.Batch{})
 	require.NoError(t, err)
 }
 
 func TestBackportTxIndexer_Index(t *testing.T) {
-	indexer := &EventSink{store: testDB(), chainID: chainID}
+	indexer := newTestSink(t, chainID)
 	txIndexer := indexer.TxIndexer()
 	err := txIndexer.Index(&abci.TxResult{})
 	require.Error(t, err)
@@ -40,44 +40,66 @@ func TestBackportTxIndexer_Index(t *testing.T) {
 }
 
 func TestBackportTxIndexer_Get(t *testing.T) {
-	indexer := &EventSink{store: testDB(), chainID: chainID}
+	indexer := newTestSink(t, chainID)
 	txIndexer := indexer.TxIndexer()
 	result, err := txIndexer.Get([]byte{1})
-	require.Error(t, err)
-	require.Equal(t, "the TxIndexer.Get method is not supported", err.Error())
+	require.NoError(t, err)
+	require.Nil(t, result)
+
+	result, err = txIndexer.Get(nil)
+	require.Equal(t, txindex.ErrorEmptyHash, err)
 	require.Nil(t, result)
 }
 
 func TestBackportTxIndexer_Search(t *testing.T) {
-	indexer := &EventSink{store: testDB(), chainID: chainID}
+	indexer := newTestSink(t, chainID)
 	txIndexer := indexer.TxIndexer()
-	result, err := txIndexer.Get([]byte{1})
-	require.Error(t, err)
-	require.Equal(t, "the TxIndexer.Get method is not supported", err.Error())
-	require.Nil(t, result)
+	result, err := txIndexer.Search(context.Background(), query.MustParse("tx.height = 1000"))
+	require.NoError(t, err)
+	require.Empty(t, result)
 }
 
 func TestBackportBlockIndexer_Has(t *testing.T) {
-	indexer := &EventSink{store: testDB(), chainID: chainID}
+	indexer := newTestSink(t, chainID)
 	blockIndexer := indexer.BlockIndexer()
-	result, err := blockIndexer.Has(0)
-	require.Error(t, err)
-	require.Equal(t, "the BlockIndexer.Has method is not supported", err.Error())
+	result, err := blockIndexer.Has(1000)
+	require.NoError(t, err)
 	require.False(t, result)
 }
 
 func TestBackportBlockIndexer_Index(t *testing.T) {
-	indexer := &EventSink{store: testDB(), chainID: chainID}
+	indexer := newTestSink(t, chainID)
 	blockIndexer := indexer.BlockIndexer()
 	err := blockIndexer.Index(types.EventDataNewBlockHeader{})
 	require.NoError(t, err)
 }
 
 func TestBackportBlockIndexer_Search(t *testing.T) {
-	indexer := &EventSink{store: testDB(), chainID: chainID}
+	indexer := newTestSink(t, chainID)
 	blockIndexer := indexer.BlockIndexer()
-	result, err := blockIndexer.Search(context.Background(), &query.Query{})
-	require.Error(t, err)
-	require.Equal(t, "the BlockIndexer.Search method is not supported", err.Error())
-	require.Nil(t, result)
+	result, err := blockIndexer.Search(context.Background(), query.MustParse("block.height = 1000"))
+	require.NoError(t, err)
+	require.Empty(t, result)
+}
+
+func TestBackportTxIndexer_SearchStream(t *testing.T) {
+	indexer := newTestSink(t, chainID)
+	txIndexer := indexer.TxIndexer()
+	err := txIndexer.SearchStream(context.Background(), query.MustParse("tx.height = 1000"), txindex.StreamOptions{},
+		func(*abci.TxResult) bool {
+			t.Fatal("unexpected result")
+			return false
+		})
+	require.NoError(t, err)
+}
+
+func TestBackportBlockIndexer_SearchStream(t *testing.T) {
+	sink := newTestSink(t, chainID)
+	blockIndexer := sink.BlockIndexer()
+	err := blockIndexer.SearchStream(context.Background(), query.MustParse("block.height = 1000"), indexer.StreamOptions{},
+		func(int64) bool {
+			t.Fatal("unexpected result")
+			return false
+		})
+	require.NoError(t, err)
 }
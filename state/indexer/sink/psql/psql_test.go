@@ -8,6 +8,7 @@ import (
 	"log"
 	"os"
 	"os/signal"
+	"strings"
 	"testing"
 	"time"
 
@@ -21,6 +22,9 @@ import (
 	abci "github.com/tendermint/tendermint/abci/types"
 
 	ocabci "github.com/Finschia/ostracon/abci/types"
+	"github.com/Finschia/ostracon/libs/pubsub/query"
+	"github.com/Finschia/ostracon/state/indexer"
+	"github.com/Finschia/ostracon/state/indexer/sink/sqlsink"
 	"github.com/Finschia/ostracon/state/txindex"
 	"github.com/Finschia/ostracon/types"
 
@@ -45,6 +49,8 @@ const (
 	dbName   = "postgres"
 	chainID  = "test-chainID"
 
+	tableBlocks     = "blocks"
+	tableTxResults  = "tx_results"
 	viewBlockEvents = "block_events"
 	viewTxEvents    = "tx_events"
 )
@@ -139,19 +145,23 @@ func TestMain(m *testing.M) {
 
 func TestIndexing(t *testing.T) {
 	t.Run("IndexBlockEvents", func(t *testing.T) {
-		indexer := &EventSink{store: testDB(), chainID: chainID}
+		indexer := newTestSink(t, chainID)
 		require.NoError(t, indexer.IndexBlockEvents(newTestBlockHeader()))
 
 		verifyBlock(t, 1)
 		verifyBlock(t, 2)
 
-		verifyNotImplemented(t, "hasBlock", func() (bool, error) { return indexer.HasBlock(1) })
-		verifyNotImplemented(t, "hasBlock", func() (bool, error) { return indexer.HasBlock(2) })
+		ok, err := indexer.HasBlock(1)
+		require.NoError(t, err)
+		assert.True(t, ok)
+		ok, err = indexer.HasBlock(2)
+		require.NoError(t, err)
+		assert.False(t, ok)
 
-		verifyNotImplemented(t, "block search", func() (bool, error) {
-			v, err := indexer.SearchBlockEvents(context.Background(), nil)
-			return v != nil, err
-		})
+		heights, err := indexer.SearchBlockEvents(context.Background(),
+			query.MustParse("begin_event.proposer = 'FCAA001' AND end_event.foo >= 100"))
+		require.NoError(t, err)
+		assert.Equal(t, []int64{1}, heights)
 
 		require.NoError(t, verifyTimeStamp(tableBlocks))
 
@@ -160,7 +170,7 @@ func TestIndexing(t *testing.T) {
 	})
 
 	t.Run("IndexTxEvents", func(t *testing.T) {
-		indexer := &EventSink{store: testDB(), chainID: chainID}
+		indexer := newTestSink(t, chainID)
 
 		txResult := txResultWithEvents([]abci.Event{
 			makeIndexedEvent("account.number", "1"),
@@ -184,14 +194,14 @@ func TestIndexing(t *testing.T) {
 		require.NoError(t, verifyTimeStamp(tableTxResults))
 		require.NoError(t, verifyTimeStamp(viewTxEvents))
 
-		verifyNotImplemented(t, "getTxByHash", func() (bool, error) {
-			txr, err := indexer.GetTxByHash(types.Tx(txResult.Tx).Hash())
-			return txr != nil, err
-		})
-		verifyNotImplemented(t, "tx search", func() (bool, error) {
-			txr, err := indexer.SearchTxEvents(context.Background(), nil)
-			return txr != nil, err
-		})
+		txr, err = indexer.GetTxByHash(types.Tx(txResult.Tx).Hash())
+		require.NoError(t, err)
+		assert.Equal(t, txResult, txr)
+
+		txrs, err := indexer.SearchTxEvents(context.Background(),
+			query.MustParse("account.owner = 'Yulieta' AND tx.height = 1"))
+		require.NoError(t, err)
+		assert.Equal(t, []*abci.TxResult{txResult}, txrs)
 
 		// try to insert the duplicate tx events.
 		err = indexer.IndexTxEvents([]*abci.TxResult{txResult})
@@ -199,7 +209,7 @@ func TestIndexing(t *testing.T) {
 	})
 
 	t.Run("IndexerService", func(t *testing.T) {
-		indexer := &EventSink{store: testDB(), chainID: chainID}
+		indexer := newTestSink(t, chainID)
 
 		// event bus
 		eventBus := types.NewEventBus()
@@ -248,11 +258,273 @@ func TestIndexing(t *testing.T) {
 	})
 }
 
+func TestSearch(t *testing.T) {
+	// a chain of its own keeps the other tests' events out of the results
+	sink := newTestSink(t, "search-chainID")
+	ctx := context.Background()
+
+	owners := []string{"Ivan", "Igor", "Vlad", "Pavel"}
+	for i, owner := range owners {
+		height := int64(i/2 + 1)
+		if i%2 == 0 {
+			require.NoError(t, sink.IndexBlockEvents(types.EventDataNewBlockHeader{
+				Header: types.Header{Height: height},
+				ResultEndBlock: abci.ResponseEndBlock{
+					Events: []abci.Event{makeIndexedEvent("end_event.foo", fmt.Sprint(height*10))},
+				},
+			}))
+		}
+
+		txResult := txResultWithEvents([]abci.Event{
+			{Type: "account", Attributes: []abci.EventAttribute{
+				{Key: []byte("number"), Value: []byte(fmt.Sprint(i + 1)), Index: true},
+				{Key: []byte("owner"), Value: []byte(owner), Index: true},
+			}},
+		})
+		txResult.Tx = types.Tx(owner + "'s account")
+		txResult.Height = height
+		txResult.Index = uint32(i % 2)
+		require.NoError(t, sink.IndexTxEvents([]*abci.TxResult{txResult}))
+	}
+
+	t.Run("SearchTxEvents", func(t *testing.T) {
+		testCases := []struct {
+			q      string
+			owners []string
+		}{
+			{"account.owner = 'Ivan'", []string{"Ivan"}},
+			{"account.number >= 2 AND account.number < 4", []string{"Igor", "Vlad"}},
+			{"account.owner CONTAINS 'av'", []string{"Pavel"}},
+			{"account.owner = 'Ivan' OR account.owner = 'Vlad'", []string{"Ivan", "Vlad"}},
+			{"account.owner IN ('Ivan', 'Vlad', 'John')", []string{"Ivan", "Vlad"}},
+			{"NOT account.owner IN ('Ivan', 'Vlad')", []string{"Igor", "Pavel"}},
+			{"account.number >= 2 AND NOT tx.height = 2", []string{"Igor"}},
+			{"tx.height = 2 AND (account.owner = 'Ivan' OR account.number < 4)", []string{"Vlad"}},
+			{"account EXISTS AND tx.height > 1", []string{"Vlad", "Pavel"}},
+			{"account.balance EXISTS", nil},
+			{fmt.Sprintf("tx.hash = '%x'", types.Tx("Igor's account").Hash()), []string{"Igor"}},
+		}
+
+		for _, tc := range testCases {
+			tc := tc
+			t.Run(tc.q, func(t *testing.T) {
+				results, err := sink.SearchTxEvents(ctx, query.MustParse(tc.q))
+				require.NoError(t, err)
+
+				txs := make([]string, 0, len(results))
+				for _, txr := range results {
+					txs = append(txs, string(txr.Tx))
+				}
+				expected := make([]string, 0, len(tc.owners))
+				for _, owner := range tc.owners {
+					expected = append(expected, owner+"'s account")
+				}
+				assert.Equal(t, expected, txs)
+
+				count, err := sink.CountTxEvents(ctx, query.MustParse(tc.q))
+				require.NoError(t, err)
+				assert.Equal(t, len(tc.owners), count)
+			})
+		}
+	})
+
+	t.Run("StreamTxEvents", func(t *testing.T) {
+		txs := make([]string, 0)
+		err := sink.StreamTxEvents(ctx, query.MustParse("account.number > 0"), txindex.StreamOptions{
+			Desc:  true,
+			After: &txindex.Cursor{Height: 2, Index: 0},
+		}, func(txr *abci.TxResult) bool {
+			txs = append(txs, string(txr.Tx))
+			return len(txs) < 2
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Igor's account", "Ivan's account"}, txs)
+	})
+
+	t.Run("SearchBlockEvents", func(t *testing.T) {
+		heights, err := sink.SearchBlockEvents(ctx, query.MustParse("end_event.foo > 10"))
+		require.NoError(t, err)
+		assert.Equal(t, []int64{2}, heights)
+
+		heights, err = sink.SearchBlockEvents(ctx, query.MustParse("block.height IN (1, 2, 3)"))
+		require.NoError(t, err)
+		assert.Equal(t, []int64{1, 2}, heights)
+
+		count, err := sink.CountBlockEvents(ctx, query.MustParse("block.height IN (1, 2, 3)"))
+		require.NoError(t, err)
+		assert.Equal(t, 2, count)
+
+		heights = make([]int64, 0)
+		err = sink.StreamBlockEvents(ctx, query.MustParse("NOT end_event.foo = 30"),
+			indexer.StreamOptions{Desc: true}, func(height int64) bool {
+				heights = append(heights, height)
+				return true
+			})
+		require.NoError(t, err)
+		assert.Equal(t, []int64{2, 1}, heights)
+	})
+
+	t.Run("GetTxByHash", func(t *testing.T) {
+		txr, err := sink.GetTxByHash(types.Tx("Pavel's account").Hash())
+		require.NoError(t, err)
+		require.NotNil(t, txr)
+		assert.EqualValues(t, 2, txr.Height)
+
+		txr, err = sink.GetTxByHash(types.Tx("John's account").Hash())
+		require.NoError(t, err)
+		assert.Nil(t, txr)
+	})
+}
+
+func TestPrune(t *testing.T) {
+	sink := newTestSink(t, "prune-chainID")
+
+	for height := int64(1); height <= 3; height++ {
+		require.NoError(t, sink.IndexBlockEvents(types.EventDataNewBlockHeader{
+			Header: types.Header{Height: height},
+			ResultEndBlock: abci.ResponseEndBlock{
+				Events: []abci.Event{makeIndexedEvent("end_event.foo", fmt.Sprint(height))},
+			},
+		}))
+
+		txResult := txResultWithEvents([]abci.Event{makeIndexedEvent("account.number", fmt.Sprint(height))})
+		txResult.Tx = types.Tx(fmt.Sprintf("tx at %d", height))
+		txResult.Height = height
+		require.NoError(t, sink.IndexTxEvents([]*abci.TxResult{txResult}))
+	}
+
+	pruned, err := sink.PruneTxs(3)
+	require.NoError(t, err)
+	assert.EqualValues(t, 2, pruned)
+
+	pruned, err = sink.PruneBlocks(3)
+	require.NoError(t, err)
+	assert.EqualValues(t, 2, pruned)
+
+	for height := int64(1); height <= 3; height++ {
+		ok, err := sink.HasBlock(height)
+		require.NoError(t, err)
+		assert.Equal(t, height == 3, ok)
+
+		txr, err := sink.GetTxByHash(types.Tx(fmt.Sprintf("tx at %d", height)).Hash())
+		require.NoError(t, err)
+		assert.Equal(t, height == 3, txr != nil)
+	}
+
+	results, err := sink.SearchTxEvents(context.Background(), query.MustParse("account.number > 0"))
+	require.NoError(t, err)
+	assert.Len(t, results, 1)
+
+	pruned, err = sink.PruneBlocks(3)
+	require.NoError(t, err)
+	assert.EqualValues(t, 0, pruned)
+}
+
+func TestKeyFilter(t *testing.T) {
+	filter := indexer.NewKeyFilter([]string{"transfer.*", "end_event.*"}, []string{"*.memo"})
+	sink := newTestSink(t, "filter-chainID", WithKeyFilter(filter))
+
+	events := []abci.Event{
+		makeIndexedEvent("transfer.sender", "alice"),
+		makeIndexedEvent("transfer.memo", "hello"),
+		makeIndexedEvent("account.owner", "Ivan"),
+	}
+	require.NoError(t, sink.IndexBlockEvents(types.EventDataNewBlockHeader{
+		Header:           types.Header{Height: 1},
+		ResultBeginBlock: abci.ResponseBeginBlock{Events: events},
+	}))
+	txResult := txResultWithEvents(events)
+	require.NoError(t, sink.IndexTxEvents([]*abci.TxResult{txResult}))
+
+	for q, found := range map[string]bool{
+		"transfer.sender = 'alice'": true,
+		"transfer.memo = 'hello'":   false,
+		"account.owner = 'Ivan'":    false,
+	} {
+		txrs, err := sink.SearchTxEvents(context.Background(), query.MustParse(q))
+		require.NoError(t, err)
+		assert.Equal(t, found, len(txrs) == 1, q)
+
+		heights, err := sink.SearchBlockEvents(context.Background(), query.MustParse(q))
+		require.NoError(t, err)
+		assert.Equal(t, found, len(heights) == 1, q)
+	}
+
+	// the meta-events are indexed regardless of the filter
+	txrs, err := sink.SearchTxEvents(context.Background(), query.MustParse("tx.height = 1"))
+	require.NoError(t, err)
+	assert.Len(t, txrs, 1)
+	heights, err := sink.SearchBlockEvents(context.Background(), query.MustParse("block.height = 1"))
+	require.NoError(t, err)
+	assert.Len(t, heights, 1)
+}
+
+func TestDeleteHeights(t *testing.T) {
+	sink := newTestSink(t, "delete-chainID")
+
+	index := func(height int64) {
+		require.NoError(t, sink.IndexBlockEvents(types.EventDataNewBlockHeader{
+			Header: types.Header{Height: height},
+			ResultEndBlock: abci.ResponseEndBlock{
+				Events: []abci.Event{makeIndexedEvent("end_event.foo", fmt.Sprint(height))},
+			},
+		}))
+
+		txResult := txResultWithEvents([]abci.Event{makeIndexedEvent("account.number", fmt.Sprint(height))})
+		txResult.Tx = types.Tx(fmt.Sprintf("tx at %d", height))
+		txResult.Height = height
+		require.NoError(t, sink.IndexTxEvents([]*abci.TxResult{txResult}))
+	}
+	for height := int64(1); height <= 3; height++ {
+		index(height)
+	}
+
+	require.NoError(t, sink.TxIndexer().DeleteHeights(2, 3))
+	require.NoError(t, sink.BlockIndexer().DeleteHeights(2, 2))
+
+	for height := int64(1); height <= 3; height++ {
+		ok, err := sink.HasBlock(height)
+		require.NoError(t, err)
+		assert.Equal(t, height != 2, ok)
+
+		txr, err := sink.GetTxByHash(types.Tx(fmt.Sprintf("tx at %d", height)).Hash())
+		require.NoError(t, err)
+		assert.Equal(t, height == 1, txr != nil)
+	}
+
+	// the deleted heights can be indexed again
+	index(2)
+	heights, err := sink.SearchBlockEvents(context.Background(), query.MustParse("end_event.foo = 2"))
+	require.NoError(t, err)
+	assert.Equal(t, []int64{2}, heights)
+	txrs, err := sink.SearchTxEvents(context.Background(), query.MustParse("account.number = 2"))
+	require.NoError(t, err)
+	assert.Len(t, txrs, 1)
+}
+
 func TestStop(t *testing.T) {
-	indexer := &EventSink{store: testDB()}
+	indexer := newTestSink(t, "")
 	require.NoError(t, indexer.Stop())
 }
 
+// newTestSink returns an event sink of the test database attributing the
+// events to chainID.
+func newTestSink(t *testing.T, chainID string, options ...EventSinkOption) *EventSink {
+	t.Helper()
+	sink, err := sqlsink.NewEventSink(testDB(), chainID, dialect, options...)
+	require.NoError(t, err)
+	return sink
+}
+
+// makeIndexedEvent constructs an event with a single indexed attribute from
+// the specified composite key and value.
+func makeIndexedEvent(compositeKey, value string) abci.Event {
+	i := strings.Index(compositeKey, ".")
+	return abci.Event{Type: compositeKey[:i], Attributes: []abci.EventAttribute{
+		{Key: []byte(compositeKey[i+1:]), Value: []byte(value), Index: true},
+	}}
+}
+
 // newTestBlockHeader constructs a fresh copy of a block header containing
 // known test values to exercise the indexer.
 func newTestBlockHeader() types.EventDataNewBlockHeader {
@@ -371,20 +643,6 @@ SELECT type, height, chain_id FROM `+viewBlockEvents+`
 	}
 }
 
-// verifyNotImplemented calls f and verifies that it returns both a
-// false-valued flag and a non-nil error whose string matching the expected
-// "not supported" message with label prefixed.
-func verifyNotImplemented(t *testing.T, label string, f func() (bool, error)) {
-	t.Helper()
-	t.Logf("Verifying that %q reports it is not implemented", label)
-
-	want := label + " is not supported via the postgres event sink"
-	ok, err := f()
-	assert.False(t, ok)
-	require.NotNil(t, err)
-	assert.Equal(t, want, err.Error())
-}
-
 // waitForInterrupt blocks until a SIGINT is received by the process.
 func waitForInterrupt() {
 	ch := make(chan os.Signal, 1)
@@ -0,0 +1,14 @@
+package psql
+
+import (
+	"github.com/Finschia/ostracon/state/indexer/sink/sqlsink"
+)
+
+// dialect tells the numbers and the times from the other values with regular
+// expressions. The schema is installed by the operator.
+var dialect = sqlsink.Dialect{
+	NumericValue: `CASE WHEN value ~ '^-?[0-9]+(\.[0-9]+)?$' THEN CAST(value AS NUMERIC) END`,
+	TimeValue:    `CASE WHEN value ~ '^[0-9]{4}-[0-9]{2}-[0-9]{2}' THEN CAST(value AS TIMESTAMPTZ) END`,
+	TimeOperand:  `CAST(%s AS TIMESTAMPTZ)`,
+	Strpos:       `strpos`,
+}
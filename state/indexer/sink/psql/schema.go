@@ -0,0 +1,11 @@
+package psql
+
+import (
+	_ "embed" // for the schema
+)
+
+// Schema is the database schema the operator installs before using the sink,
+// as defined in schema.sql. The SQLite event sink installs it as well.
+//
+//go:embed schema.sql
+var Schema string
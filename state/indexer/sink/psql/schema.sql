@@ -1,7 +1,8 @@
 /*
   This file defines the database schema for the PostgresQL ("psql") event sink
   implementation in Tendermint. The operator must create a database and install
-  this schema before using the database to index events.
+  this schema before using the database to index events. The SQLite ("sqlite")
+  event sink installs it in its database file by itself.
  */
 
 -- The blocks table records metadata about each block.
@@ -29,8 +30,9 @@ CREATE TABLE tx_results (
 
   -- The block to which this transaction belongs.
   block_id BIGINT NOT NULL REFERENCES blocks(rowid),
-  -- The sequential index of the transaction within the block.
-  index INTEGER NOT NULL,
+  -- The sequential index of the transaction within the block. The name is
+  -- quoted, as it is a keyword in SQLite.
+  "index" INTEGER NOT NULL,
   -- When this result record was logged into the sink, in UTC.
   created_at TIMESTAMPTZ NOT NULL,
   -- The hex-encoded hash of the transaction.
@@ -38,7 +40,7 @@ CREATE TABLE tx_results (
   -- The protobuf wire encoding of the TxResult message.
   tx_result BYTEA NOT NULL,
 
-  UNIQUE (block_id, index)
+  UNIQUE (block_id, "index")
 );
 
 -- The events table records events. All events (both block and transaction) are
@@ -79,7 +81,7 @@ CREATE VIEW block_events AS
 
 -- A joined view of all transaction events.
 CREATE VIEW tx_events AS
-  SELECT height, index, chain_id, type, key, composite_key, value, tx_results.created_at
+  SELECT height, "index", chain_id, type, key, composite_key, value, tx_results.created_at
   FROM blocks JOIN tx_results ON (blocks.rowid = tx_results.block_id)
   JOIN event_attributes ON (tx_results.rowid = event_attributes.tx_id)
   WHERE event_attributes.tx_id IS NOT NULL;
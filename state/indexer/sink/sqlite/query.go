@@ -0,0 +1,43 @@
+package sqlite
+
+import (
+	"database/sql/driver"
+	"regexp"
+	"strconv"
+
+	"modernc.org/sqlite"
+
+	"github.com/Finschia/ostracon/state/indexer/sink/sqlsink"
+)
+
+// dialect compares the numbers with the event_numeric_value function, and the
+// times as Julian days.
+var dialect = sqlsink.Dialect{
+	NumericValue:  `event_numeric_value(value)`,
+	TimeValue:     `CASE WHEN value GLOB '[0-9][0-9][0-9][0-9]-[0-9][0-9]-[0-9][0-9]*' THEN julianday(value) END`,
+	TimeOperand:   `julianday(%s)`,
+	Strpos:        `instr`,
+	InstallSchema: installSchema,
+}
+
+// numberPattern matches the values compared as numbers, as in the psql sink.
+var numberPattern = regexp.MustCompile(`^-?[0-9]+(\.[0-9]+)?$`)
+
+func init() {
+	// SQLite has no regular expressions to tell the numbers from the other
+	// values, so the event_numeric_value function does.
+	err := sqlite.RegisterDeterministicScalarFunction("event_numeric_value", 1,
+		func(ctx *sqlite.FunctionContext, args []driver.Value) (driver.Value, error) {
+			value, ok := args[0].(string)
+			if !ok || !numberPattern.MatchString(value) {
+				return nil, nil
+			}
+			if i, err := strconv.ParseInt(value, 10, 64); err == nil {
+				return i, nil
+			}
+			return strconv.ParseFloat(value, 64)
+		})
+	if err != nil {
+		panic(err)
+	}
+}
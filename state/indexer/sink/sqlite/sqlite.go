@@ -0,0 +1,93 @@
+// Package sqlite implements an event sink backed by an SQLite database file.
+//
+// It stores the events in the schema of the psql event sink, which it
+// installs by itself, so that the events can be queried with SQL without
+// running a database server.
+package sqlite
+
+import (
+	"database/sql"
+	"net/url"
+	"path/filepath"
+	"strings"
+
+	tmos "github.com/Finschia/ostracon/libs/os"
+	"github.com/Finschia/ostracon/state/indexer"
+	"github.com/Finschia/ostracon/state/indexer/sink/psql"
+	"github.com/Finschia/ostracon/state/indexer/sink/sqlsink"
+)
+
+const (
+	driverName = "sqlite"
+
+	// DBFileName is the name of the database file in the db directory of the
+	// node.
+	DBFileName = "tx_index.sqlite"
+)
+
+type (
+	// EventSink is an indexer backend providing the tx/block index services.
+	// This implementation stores records in an SQLite database file using
+	// the schema defined in state/indexer/sink/psql/schema.sql.
+	EventSink = sqlsink.EventSink
+	// EventSinkOption sets an optional parameter on the EventSink.
+	EventSinkOption = sqlsink.EventSinkOption
+	// TxIndex implements the txindex.TxIndexer interface by delegating
+	// indexing operations to an underlying SQLite event sink.
+	TxIndex = sqlsink.TxIndex
+	// BlockIndex implements the indexer.BlockIndexer interface by
+	// delegating indexing operations to an underlying SQLite event sink.
+	BlockIndex = sqlsink.BlockIndex
+)
+
+// WithKeyFilter sets the filter selecting the event attributes to index. The
+// block height and the tx hash and height are indexed regardless of it.
+func WithKeyFilter(filter *indexer.KeyFilter) EventSinkOption {
+	return sqlsink.WithKeyFilter(filter)
+}
+
+// NewEventSink constructs an event sink associated with the SQLite database
+// file at path, installing the schema in it if it is not there yet. Events
+// written to the sink are attributed to the specified chainID.
+func NewEventSink(path, chainID string, options ...EventSinkOption) (*EventSink, error) {
+	if err := tmos.EnsureDir(filepath.Dir(path), 0700); err != nil {
+		return nil, err
+	}
+
+	// The write-ahead log lets the RPC read the events while they are written,
+	// and the busy timeout makes a write wait for another one to finish.
+	dsn := "file:" + path + "?" + url.Values{
+		"_pragma":      {"busy_timeout(5000)", "journal_mode(WAL)"},
+		"_time_format": {"sqlite"},
+	}.Encode()
+	db, err := sql.Open(driverName, dsn)
+	if err != nil {
+		return nil, err
+	}
+
+	es, err := sqlsink.NewEventSink(db, chainID, dialect, options...)
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+	return es, nil
+}
+
+// installSchema installs the psql schema unless it is already installed. The
+// serial row IDs become the row IDs of SQLite.
+func installSchema(db *sql.DB) error {
+	var installed bool
+	if err := db.QueryRow(`
+SELECT EXISTS (SELECT 1 FROM sqlite_master WHERE type = 'table' AND name = 'blocks');
+`).Scan(&installed); err != nil {
+		return err
+	}
+	if installed {
+		return nil
+	}
+
+	return sqlsink.RunInTransaction(db, func(dbtx *sql.Tx) error {
+		_, err := dbtx.Exec(strings.ReplaceAll(psql.Schema, "BIGSERIAL PRIMARY KEY", "INTEGER PRIMARY KEY"))
+		return err
+	})
+}
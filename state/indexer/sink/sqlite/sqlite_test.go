@@ -0,0 +1,467 @@
+package sqlite
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	ocabci "github.com/Finschia/ostracon/abci/types"
+	"github.com/Finschia/ostracon/libs/pubsub/query"
+	"github.com/Finschia/ostracon/state/indexer"
+	"github.com/Finschia/ostracon/state/txindex"
+	"github.com/Finschia/ostracon/types"
+)
+
+const chainID = "test-chainID"
+
+func newTestSink(t *testing.T, options ...EventSinkOption) *EventSink {
+	t.Helper()
+	sink, err := NewEventSink(filepath.Join(t.TempDir(), DBFileName), chainID, options...)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, sink.Stop())
+	})
+	return sink
+}
+
+func TestNewEventSink(t *testing.T) {
+	path := filepath.Join(t.TempDir(), DBFileName)
+
+	sink, err := NewEventSink(path, chainID)
+	require.NoError(t, err)
+	require.NoError(t, sink.IndexBlockEvents(newTestBlockHeader()))
+	require.NoError(t, sink.Stop())
+
+	// the schema is installed once and the events are kept
+	sink, err = NewEventSink(path, chainID)
+	require.NoError(t, err)
+	ok, err := sink.HasBlock(1)
+	require.NoError(t, err)
+	assert.True(t, ok)
+	require.NoError(t, sink.Stop())
+}
+
+func TestIndexing(t *testing.T) {
+	t.Run("IndexBlockEvents", func(t *testing.T) {
+		sink := newTestSink(t)
+		require.NoError(t, sink.IndexBlockEvents(newTestBlockHeader()))
+
+		ok, err := sink.HasBlock(1)
+		require.NoError(t, err)
+		assert.True(t, ok)
+		ok, err = sink.HasBlock(2)
+		require.NoError(t, err)
+		assert.False(t, ok)
+
+		heights, err := sink.SearchBlockEvents(context.Background(),
+			query.MustParse("begin_event.proposer = 'FCAA001' AND end_event.foo >= 100"))
+		require.NoError(t, err)
+		assert.Equal(t, []int64{1}, heights)
+
+		var createdAt string
+		require.NoError(t, sink.DB().QueryRow(`SELECT created_at FROM blocks`).Scan(&createdAt))
+		ts, err := time.Parse("2006-01-02 15:04:05.999999999-07:00", createdAt)
+		require.NoError(t, err)
+		assert.WithinDuration(t, time.Now(), ts, time.Minute)
+
+		// Attempting to reindex the same events should gracefully succeed.
+		require.NoError(t, sink.IndexBlockEvents(newTestBlockHeader()))
+	})
+
+	t.Run("IndexTxEvents", func(t *testing.T) {
+		sink := newTestSink(t)
+		require.NoError(t, sink.IndexBlockEvents(newTestBlockHeader()))
+
+		txResult := txResultWithEvents([]abci.Event{
+			makeIndexedEvent("account.number", "1"),
+			makeIndexedEvent("account.owner", "Ivan"),
+			makeIndexedEvent("account.owner", "Yulieta"),
+
+			{Type: "", Attributes: []abci.EventAttribute{
+				{
+					Key:   []byte("not_allowed"),
+					Value: []byte("Vlad"),
+					Index: true,
+				},
+			}},
+		})
+		require.NoError(t, sink.IndexTxEvents([]*abci.TxResult{txResult}))
+
+		txr, err := sink.GetTxByHash(types.Tx(txResult.Tx).Hash())
+		require.NoError(t, err)
+		assert.Equal(t, txResult, txr)
+
+		txrs, err := sink.SearchTxEvents(context.Background(),
+			query.MustParse("account.owner = 'Yulieta' AND tx.height = 1"))
+		require.NoError(t, err)
+		assert.Equal(t, []*abci.TxResult{txResult}, txrs)
+
+		txrs, err = sink.SearchTxEvents(context.Background(), query.MustParse("not_allowed EXISTS"))
+		require.NoError(t, err)
+		assert.Empty(t, txrs)
+
+		// try to insert the duplicate tx events.
+		require.NoError(t, sink.IndexTxEvents([]*abci.TxResult{txResult}))
+	})
+
+	t.Run("IndexerService", func(t *testing.T) {
+		sink := newTestSink(t)
+
+		// event bus
+		eventBus := types.NewEventBus()
+		err := eventBus.Start()
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			if err := eventBus.Stop(); err != nil {
+				t.Error(err)
+			}
+		})
+
+		service := txindex.NewIndexerService(sink.TxIndexer(), sink.BlockIndexer(), eventBus, true)
+		err = service.Start()
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			if err := service.Stop(); err != nil {
+				t.Error(err)
+			}
+		})
+
+		// publish block with txs
+		err = eventBus.PublishEventNewBlockHeader(types.EventDataNewBlockHeader{
+			Header: types.Header{Height: 1},
+			NumTxs: int64(2),
+		})
+		require.NoError(t, err)
+		txResult1 := &abci.TxResult{
+			Height: 1,
+			Index:  uint32(0),
+			Tx:     types.Tx("foo"),
+			Result: abci.ResponseDeliverTx{Code: 0},
+		}
+		err = eventBus.PublishEventTx(types.EventDataTx{TxResult: *txResult1})
+		require.NoError(t, err)
+		txResult2 := &abci.TxResult{
+			Height: 1,
+			Index:  uint32(1),
+			Tx:     types.Tx("bar"),
+			Result: abci.ResponseDeliverTx{Code: 1},
+		}
+		err = eventBus.PublishEventTx(types.EventDataTx{TxResult: *txResult2})
+		require.NoError(t, err)
+
+		require.Eventually(t, func() bool {
+			txr, err := sink.TxIndexer().Get(types.Tx("bar").Hash())
+			return err == nil && txr != nil
+		}, time.Second, 10*time.Millisecond)
+		require.True(t, service.IsRunning())
+
+		ok, err := sink.BlockIndexer().Has(1)
+		require.NoError(t, err)
+		assert.True(t, ok)
+	})
+}
+
+func TestSearch(t *testing.T) {
+	sink := newTestSink(t)
+	ctx := context.Background()
+
+	owners := []string{"Ivan", "Igor", "Vlad", "Pavel"}
+	for i, owner := range owners {
+		height := int64(i/2 + 1)
+		if i%2 == 0 {
+			require.NoError(t, sink.IndexBlockEvents(types.EventDataNewBlockHeader{
+				Header: types.Header{Height: height},
+				ResultEndBlock: abci.ResponseEndBlock{
+					Events: []abci.Event{makeIndexedEvent("end_event.foo", fmt.Sprint(height*10))},
+				},
+			}))
+		}
+
+		txResult := txResultWithEvents([]abci.Event{
+			{Type: "account", Attributes: []abci.EventAttribute{
+				{Key: []byte("number"), Value: []byte(fmt.Sprint(i + 1)), Index: true},
+				{Key: []byte("owner"), Value: []byte(owner), Index: true},
+				{Key: []byte("created"), Value: []byte(fmt.Sprintf("2020-0%d-01T00:00:00Z", i+1)), Index: true},
+			}},
+		})
+		txResult.Tx = types.Tx(owner + "'s account")
+		txResult.Height = height
+		txResult.Index = uint32(i % 2)
+		require.NoError(t, sink.IndexTxEvents([]*abci.TxResult{txResult}))
+	}
+
+	t.Run("SearchTxEvents", func(t *testing.T) {
+		testCases := []struct {
+			q      string
+			owners []string
+		}{
+			{"account.owner = 'Ivan'", []string{"Ivan"}},
+			{"account.number >= 2 AND account.number < 4", []string{"Igor", "Vlad"}},
+			{"account.number = 2.0", []string{"Igor"}},
+			{"account.owner CONTAINS 'av'", []string{"Pavel"}},
+			{"account.owner = 'Ivan' OR account.owner = 'Vlad'", []string{"Ivan", "Vlad"}},
+			{"account.owner IN ('Ivan', 'Vlad', 'John')", []string{"Ivan", "Vlad"}},
+			{"NOT account.owner IN ('Ivan', 'Vlad')", []string{"Igor", "Pavel"}},
+			{"account.number >= 2 AND NOT tx.height = 2", []string{"Igor"}},
+			{"tx.height = 2 AND (account.owner = 'Ivan' OR account.number < 4)", []string{"Vlad"}},
+			{"account EXISTS AND tx.height > 1", []string{"Vlad", "Pavel"}},
+			{"account.created >= DATE 2020-02-01 AND account.created < TIME 2020-04-01T00:00:00Z",
+				[]string{"Igor", "Vlad"}},
+			{"account.owner > 1", nil},
+			{"account.balance EXISTS", nil},
+			{fmt.Sprintf("tx.hash = '%x'", types.Tx("Igor's account").Hash()), []string{"Igor"}},
+		}
+
+		for _, tc := range testCases {
+			tc := tc
+			t.Run(tc.q, func(t *testing.T) {
+				results, err := sink.SearchTxEvents(ctx, query.MustParse(tc.q))
+				require.NoError(t, err)
+
+				txs := make([]string, 0, len(results))
+				for _, txr := range results {
+					txs = append(txs, string(txr.Tx))
+				}
+				expected := make([]string, 0, len(tc.owners))
+				for _, owner := range tc.owners {
+					expected = append(expected, owner+"'s account")
+				}
+				assert.Equal(t, expected, txs)
+
+				count, err := sink.CountTxEvents(ctx, query.MustParse(tc.q))
+				require.NoError(t, err)
+				assert.Equal(t, len(tc.owners), count)
+			})
+		}
+	})
+
+	t.Run("StreamTxEvents", func(t *testing.T) {
+		txs := make([]string, 0)
+		err := sink.StreamTxEvents(ctx, query.MustParse("account.number > 0"), txindex.StreamOptions{
+			Desc:  true,
+			After: &txindex.Cursor{Height: 2, Index: 0},
+		}, func(txr *abci.TxResult) bool {
+			txs = append(txs, string(txr.Tx))
+			return len(txs) < 2
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Igor's account", "Ivan's account"}, txs)
+	})
+
+	t.Run("SearchBlockEvents", func(t *testing.T) {
+		heights, err := sink.SearchBlockEvents(ctx, query.MustParse("end_event.foo > 10"))
+		require.NoError(t, err)
+		assert.Equal(t, []int64{2}, heights)
+
+		heights, err = sink.SearchBlockEvents(ctx, query.MustParse("block.height IN (1, 2, 3)"))
+		require.NoError(t, err)
+		assert.Equal(t, []int64{1, 2}, heights)
+
+		count, err := sink.CountBlockEvents(ctx, query.MustParse("block.height IN (1, 2, 3)"))
+		require.NoError(t, err)
+		assert.Equal(t, 2, count)
+
+		heights = make([]int64, 0)
+		err = sink.StreamBlockEvents(ctx, query.MustParse("NOT end_event.foo = 30"),
+			indexer.StreamOptions{Desc: true}, func(height int64) bool {
+				heights = append(heights, height)
+				return true
+			})
+		require.NoError(t, err)
+		assert.Equal(t, []int64{2, 1}, heights)
+	})
+
+	t.Run("GetTxByHash", func(t *testing.T) {
+		txr, err := sink.GetTxByHash(types.Tx("Pavel's account").Hash())
+		require.NoError(t, err)
+		require.NotNil(t, txr)
+		assert.EqualValues(t, 2, txr.Height)
+
+		txr, err = sink.TxIndexer().Get(types.Tx("John's account").Hash())
+		require.NoError(t, err)
+		assert.Nil(t, txr)
+
+		_, err = sink.TxIndexer().Get(nil)
+		assert.ErrorIs(t, err, txindex.ErrorEmptyHash)
+	})
+}
+
+func TestPrune(t *testing.T) {
+	sink := newTestSink(t)
+
+	for height := int64(1); height <= 3; height++ {
+		require.NoError(t, sink.IndexBlockEvents(types.EventDataNewBlockHeader{
+			Header: types.Header{Height: height},
+			ResultEndBlock: abci.ResponseEndBlock{
+				Events: []abci.Event{makeIndexedEvent("end_event.foo", fmt.Sprint(height))},
+			},
+		}))
+
+		txResult := txResultWithEvents([]abci.Event{makeIndexedEvent("account.number", fmt.Sprint(height))})
+		txResult.Tx = types.Tx(fmt.Sprintf("tx at %d", height))
+		txResult.Height = height
+		require.NoError(t, sink.IndexTxEvents([]*abci.TxResult{txResult}))
+	}
+
+	pruned, err := sink.TxIndexer().Prune(3)
+	require.NoError(t, err)
+	assert.EqualValues(t, 2, pruned)
+
+	pruned, err = sink.BlockIndexer().Prune(3)
+	require.NoError(t, err)
+	assert.EqualValues(t, 2, pruned)
+
+	for height := int64(1); height <= 3; height++ {
+		ok, err := sink.HasBlock(height)
+		require.NoError(t, err)
+		assert.Equal(t, height == 3, ok)
+
+		txr, err := sink.GetTxByHash(types.Tx(fmt.Sprintf("tx at %d", height)).Hash())
+		require.NoError(t, err)
+		assert.Equal(t, height == 3, txr != nil)
+	}
+
+	var attributes int
+	require.NoError(t, sink.DB().QueryRow(`SELECT count(*) FROM attributes`).Scan(&attributes))
+	// the block height, tx hash, tx height and the two events of height 3
+	assert.Equal(t, 5, attributes)
+
+	pruned, err = sink.PruneBlocks(3)
+	require.NoError(t, err)
+	assert.EqualValues(t, 0, pruned)
+}
+
+func TestKeyFilter(t *testing.T) {
+	filter := indexer.NewKeyFilter([]string{"transfer.*", "end_event.*"}, []string{"*.memo"})
+	sink := newTestSink(t, WithKeyFilter(filter))
+
+	events := []abci.Event{
+		makeIndexedEvent("transfer.sender", "alice"),
+		makeIndexedEvent("transfer.memo", "hello"),
+		makeIndexedEvent("account.owner", "Ivan"),
+	}
+	require.NoError(t, sink.IndexBlockEvents(types.EventDataNewBlockHeader{
+		Header:           types.Header{Height: 1},
+		ResultBeginBlock: abci.ResponseBeginBlock{Events: events},
+	}))
+	txResult := txResultWithEvents(events)
+	require.NoError(t, sink.IndexTxEvents([]*abci.TxResult{txResult}))
+
+	for q, found := range map[string]bool{
+		"transfer.sender = 'alice'": true,
+		"transfer.memo = 'hello'":   false,
+		"account.owner = 'Ivan'":    false,
+	} {
+		txrs, err := sink.SearchTxEvents(context.Background(), query.MustParse(q))
+		require.NoError(t, err)
+		assert.Equal(t, found, len(txrs) == 1, q)
+
+		heights, err := sink.SearchBlockEvents(context.Background(), query.MustParse(q))
+		require.NoError(t, err)
+		assert.Equal(t, found, len(heights) == 1, q)
+	}
+
+	// the meta-events are indexed regardless of the filter
+	txrs, err := sink.SearchTxEvents(context.Background(), query.MustParse("tx.height = 1"))
+	require.NoError(t, err)
+	assert.Len(t, txrs, 1)
+	heights, err := sink.SearchBlockEvents(context.Background(), query.MustParse("block.height = 1"))
+	require.NoError(t, err)
+	assert.Len(t, heights, 1)
+}
+
+func TestDeleteHeights(t *testing.T) {
+	sink := newTestSink(t)
+
+	index := func(height int64) {
+		require.NoError(t, sink.IndexBlockEvents(types.EventDataNewBlockHeader{
+			Header: types.Header{Height: height},
+			ResultEndBlock: abci.ResponseEndBlock{
+				Events: []abci.Event{makeIndexedEvent("end_event.foo", fmt.Sprint(height))},
+			},
+		}))
+
+		txResult := txResultWithEvents([]abci.Event{makeIndexedEvent("account.number", fmt.Sprint(height))})
+		txResult.Tx = types.Tx(fmt.Sprintf("tx at %d", height))
+		txResult.Height = height
+		require.NoError(t, sink.IndexTxEvents([]*abci.TxResult{txResult}))
+	}
+	for height := int64(1); height <= 3; height++ {
+		index(height)
+	}
+
+	require.NoError(t, sink.TxIndexer().DeleteHeights(2, 3))
+	require.NoError(t, sink.BlockIndexer().DeleteHeights(2, 2))
+
+	for height := int64(1); height <= 3; height++ {
+		ok, err := sink.HasBlock(height)
+		require.NoError(t, err)
+		assert.Equal(t, height != 2, ok)
+
+		txr, err := sink.GetTxByHash(types.Tx(fmt.Sprintf("tx at %d", height)).Hash())
+		require.NoError(t, err)
+		assert.Equal(t, height == 1, txr != nil)
+	}
+
+	// the deleted heights can be indexed again
+	index(2)
+	heights, err := sink.SearchBlockEvents(context.Background(), query.MustParse("end_event.foo = 2"))
+	require.NoError(t, err)
+	assert.Equal(t, []int64{2}, heights)
+	txrs, err := sink.SearchTxEvents(context.Background(), query.MustParse("account.number = 2"))
+	require.NoError(t, err)
+	assert.Len(t, txrs, 1)
+}
+
+// newTestBlockHeader constructs a fresh copy of a block header containing
+// known test values to exercise the indexer.
+func newTestBlockHeader() types.EventDataNewBlockHeader {
+	return types.EventDataNewBlockHeader{
+		Header: types.Header{Height: 1},
+		ResultBeginBlock: abci.ResponseBeginBlock{
+			Events: []abci.Event{
+				makeIndexedEvent("begin_event.proposer", "FCAA001"),
+				makeIndexedEvent("thingy.whatzit", "O.O"),
+			},
+		},
+		ResultEndBlock: abci.ResponseEndBlock{
+			Events: []abci.Event{
+				makeIndexedEvent("end_event.foo", "100"),
+				makeIndexedEvent("thingy.whatzit", "-.O"),
+			},
+		},
+	}
+}
+
+// txResultWithEvents constructs a fresh transaction result with fixed values
+// for testing, that includes the specified events.
+func txResultWithEvents(events []abci.Event) *abci.TxResult {
+	return &abci.TxResult{
+		Height: 1,
+		Index:  0,
+		Tx:     types.Tx("HELLO WORLD"),
+		Result: abci.ResponseDeliverTx{
+			Data:   []byte{0},
+			Code:   ocabci.CodeTypeOK,
+			Log:    "",
+			Events: events,
+		},
+	}
+}
+
+// makeIndexedEvent constructs an event with a single indexed attribute from
+// the specified composite key and value.
+func makeIndexedEvent(compositeKey, value string) abci.Event {
+	i := strings.Index(compositeKey, ".")
+	return abci.Event{Type: compositeKey[:i], Attributes: []abci.EventAttribute{
+		{Key: []byte(compositeKey[i+1:]), Value: []byte(value), Index: true},
+	}}
+}
@@ -0,0 +1,128 @@
+package sqlsink
+
+import (
+	"context"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/Finschia/ostracon/libs/pubsub/query"
+	"github.com/Finschia/ostracon/state/indexer"
+	"github.com/Finschia/ostracon/state/txindex"
+	"github.com/Finschia/ostracon/types"
+)
+
+var (
+	_ txindex.TxIndexer    = TxIndex{}
+	_ indexer.BlockIndexer = BlockIndex{}
+)
+
+// TxIndexer returns the transaction indexer backed by es.
+func (es *EventSink) TxIndexer() TxIndex {
+	return TxIndex{sink: es}
+}
+
+// TxIndex implements the txindex.TxIndexer interface by delegating
+// indexing operations to an underlying event sink.
+type TxIndex struct{ sink *EventSink }
+
+// AddBatch indexes a batch of transactions, as part of TxIndexer.
+func (b TxIndex) AddBatch(batch *txindex.Batch) error {
+	return b.sink.IndexTxEvents(batch.Ops)
+}
+
+// Index indexes a single transaction result, as part of TxIndexer.
+func (b TxIndex) Index(txr *abci.TxResult) error {
+	return b.sink.IndexTxEvents([]*abci.TxResult{txr})
+}
+
+// Get looks up the transaction result of the given hash, as part of
+// TxIndexer.
+func (b TxIndex) Get(hash []byte) (*abci.TxResult, error) {
+	if len(hash) == 0 {
+		return nil, txindex.ErrorEmptyHash
+	}
+	return b.sink.GetTxByHash(hash)
+}
+
+// Search queries the transaction results matching q, as part of TxIndexer.
+func (b TxIndex) Search(ctx context.Context, q *query.Query) ([]*abci.TxResult, error) {
+	return b.sink.SearchTxEvents(ctx, q)
+}
+
+// Count counts the transaction results matching q, as part of TxIndexer.
+func (b TxIndex) Count(ctx context.Context, q *query.Query) (int, error) {
+	return b.sink.CountTxEvents(ctx, q)
+}
+
+// SearchStream streams the transaction results matching q, as part of
+// TxIndexer.
+func (b TxIndex) SearchStream(
+	ctx context.Context, q *query.Query, opts txindex.StreamOptions, fn func(*abci.TxResult) bool,
+) error {
+	return b.sink.StreamTxEvents(ctx, q, opts, fn)
+}
+
+// Prune removes the transactions indexed below retainHeight, as part of
+// TxIndexer.
+func (b TxIndex) Prune(retainHeight int64) (int64, error) {
+	return b.sink.PruneTxs(retainHeight)
+}
+
+// DeleteHeights removes the transactions indexed from startHeight to
+// endHeight (inclusive), so that they can be indexed again.
+func (b TxIndex) DeleteHeights(startHeight, endHeight int64) error {
+	return b.sink.DeleteTxs(startHeight, endHeight)
+}
+
+// BlockIndexer returns the block indexer backed by es.
+func (es *EventSink) BlockIndexer() BlockIndex {
+	return BlockIndex{sink: es}
+}
+
+// BlockIndex implements the indexer.BlockIndexer interface by
+// delegating indexing operations to an underlying event sink.
+type BlockIndex struct{ sink *EventSink }
+
+// Has reports whether the block at the given height is indexed, as part of
+// BlockIndexer.
+func (b BlockIndex) Has(height int64) (bool, error) {
+	return b.sink.HasBlock(height)
+}
+
+// Index indexes block begin and end events for the specified block, as part
+// of BlockIndexer.
+func (b BlockIndex) Index(block types.EventDataNewBlockHeader) error {
+	return b.sink.IndexBlockEvents(block)
+}
+
+// Search queries the heights of the blocks matching q, as part of
+// BlockIndexer.
+func (b BlockIndex) Search(ctx context.Context, q *query.Query) ([]int64, error) {
+	return b.sink.SearchBlockEvents(ctx, q)
+}
+
+// Count counts the blocks matching q, as part of BlockIndexer.
+func (b BlockIndex) Count(ctx context.Context, q *query.Query) (int, error) {
+	return b.sink.CountBlockEvents(ctx, q)
+}
+
+// SearchStream streams the heights of the blocks matching q, as part of
+// BlockIndexer.
+func (b BlockIndex) SearchStream(
+	ctx context.Context, q *query.Query, opts indexer.StreamOptions, fn func(int64) bool,
+) error {
+	return b.sink.StreamBlockEvents(ctx, q, opts, fn)
+}
+
+// Prune removes the blocks indexed below retainHeight, as part of
+// BlockIndexer.
+func (b BlockIndex) Prune(retainHeight int64) (int64, error) {
+	return b.sink.PruneBlocks(retainHeight)
+}
+
+// DeleteHeights removes the blocks indexed from startHeight to endHeight
+// (inclusive), along with their transactions, so that they can be indexed
+// again.
+func (b BlockIndex) DeleteHeights(startHeight, endHeight int64) error {
+	return b.sink.DeleteBlocks(startHeight, endHeight)
+}
@@ -0,0 +1,151 @@
+package sqlsink
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Finschia/ostracon/libs/pubsub/query"
+	"github.com/Finschia/ostracon/types"
+)
+
+// queryBuilder translates a query into an SQL condition on the rows of the
+// tx_results table or of the blocks table, and collects the arguments of the
+// condition along the way.
+//
+// Each condition of the query selects the rows having an event attribute that
+// meets it, so that AND, OR and NOT keep their meaning, as in the kv indexer:
+// NOT is the complement among all the indexed rows.
+type queryBuilder struct {
+	dialect Dialect
+	// table is either tableTxResults or tableBlocks.
+	table string
+	args  []interface{}
+}
+
+func newQueryBuilder(dialect Dialect, table string, args ...interface{}) *queryBuilder {
+	return &queryBuilder{dialect: dialect, table: table, args: args}
+}
+
+// arg adds an argument and returns its placeholder.
+func (b *queryBuilder) arg(v interface{}) string {
+	b.args = append(b.args, v)
+	return fmt.Sprintf("$%d", len(b.args))
+}
+
+func (b *queryBuilder) expression(expr *query.Expression) (string, error) {
+	switch expr.Op {
+	case query.ExprAnd, query.ExprOr:
+		sep := " AND "
+		if expr.Op == query.ExprOr {
+			sep = " OR "
+		}
+
+		parts := make([]string, 0, len(expr.Expressions))
+		for _, sub := range expr.Expressions {
+			part, err := b.expression(sub)
+			if err != nil {
+				return "", err
+			}
+			parts = append(parts, part)
+		}
+		return "(" + strings.Join(parts, sep) + ")", nil
+
+	case query.ExprNot:
+		part, err := b.expression(expr.Expressions[0])
+		if err != nil {
+			return "", err
+		}
+		return "NOT " + part, nil
+
+	default:
+		return b.condition(expr.Condition)
+	}
+}
+
+// condition selects the rows having an event attribute that meets c.
+func (b *queryBuilder) condition(c query.Condition) (string, error) {
+	column, owner := "block_id", "tx_id IS NULL"
+	if b.table == tableTxResults {
+		column, owner = "tx_id", "tx_id IS NOT NULL"
+	}
+
+	// EXISTS on a bare event type matches the events of that type.
+	if c.Op == query.OpExists && !strings.Contains(c.CompositeKey, ".") {
+		return fmt.Sprintf("%s.rowid IN (SELECT %s FROM %s WHERE %s AND type = %s)",
+			b.table, column, tableEvents, owner, b.arg(c.CompositeKey)), nil
+	}
+
+	where := fmt.Sprintf("%s AND composite_key = %s", owner, b.arg(c.CompositeKey))
+	switch c.Op {
+	case query.OpExists:
+
+	case query.OpIn:
+		operands, ok := c.Operand.([]interface{})
+		if !ok {
+			return "", fmt.Errorf("invalid operand %v of %s", c.Operand, c.CompositeKey)
+		}
+		parts := make([]string, 0, len(operands))
+		for _, operand := range operands {
+			part, err := b.compare(c.CompositeKey, query.OpEqual, operand)
+			if err != nil {
+				return "", err
+			}
+			parts = append(parts, part)
+		}
+		where += " AND (" + strings.Join(parts, " OR ") + ")"
+
+	default:
+		part, err := b.compare(c.CompositeKey, c.Op, c.Operand)
+		if err != nil {
+			return "", err
+		}
+		where += " AND " + part
+	}
+
+	return fmt.Sprintf("%s.rowid IN (SELECT %s FROM event_attributes WHERE %s)", b.table, column, where), nil
+}
+
+// compare compares the value of an attribute with the operand. Numbers and
+// times are compared as such, other values as strings.
+func (b *queryBuilder) compare(key string, op query.Operator, operand interface{}) (string, error) {
+	var value, placeholder string
+	switch operand := operand.(type) {
+	case string:
+		switch op {
+		case query.OpEqual:
+			if key == types.TxHashKey {
+				// the hashes are indexed in upper case
+				operand = strings.ToUpper(operand)
+			}
+			return "value = " + b.arg(operand), nil
+		case query.OpContains:
+			return b.dialect.Strpos + "(value, " + b.arg(operand) + ") > 0", nil
+		default:
+			return "", fmt.Errorf("operator %v is not supported for %s", op, key)
+		}
+	case int64, float64:
+		value, placeholder = b.dialect.NumericValue, b.arg(operand)
+	case time.Time:
+		value, placeholder = b.dialect.TimeValue, fmt.Sprintf(b.dialect.TimeOperand, b.arg(operand.Format(time.RFC3339Nano)))
+	default:
+		return "", fmt.Errorf("unsupported operand %v of %s", operand, key)
+	}
+
+	var sqlOp string
+	switch op {
+	case query.OpEqual:
+		sqlOp = "="
+	case query.OpLess:
+		sqlOp = "<"
+	case query.OpLessEqual:
+		sqlOp = "<="
+	case query.OpGreater:
+		sqlOp = ">"
+	case query.OpGreaterEqual:
+		sqlOp = ">="
+	default:
+		return "", fmt.Errorf("operator %v is not supported for %s", op, key)
+	}
+	return fmt.Sprintf("%s %s %s", value, sqlOp, placeholder), nil
+}
@@ -0,0 +1,51 @@
+package sqlsink
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Finschia/ostracon/libs/pubsub/query"
+)
+
+const chainID = "test-chainID"
+
+var testDialect = Dialect{
+	NumericValue: "number(value)",
+	TimeValue:    "time(value)",
+	TimeOperand:  "time(%s)",
+	Strpos:       "position",
+}
+
+func TestQueryBuilder(t *testing.T) {
+	b := newQueryBuilder(testDialect, tableTxResults, chainID)
+	cond, err := b.expression(query.MustParse(
+		"account.number > 1 AND NOT (account.owner = 'Ivan' OR tx.hash IN ('ab', 'cd'))").Expression())
+	require.NoError(t, err)
+	assert.Equal(t, "(tx_results.rowid IN (SELECT tx_id FROM event_attributes "+
+		"WHERE tx_id IS NOT NULL AND composite_key = $2 AND number(value) > $3) AND "+
+		"NOT (tx_results.rowid IN (SELECT tx_id FROM event_attributes "+
+		"WHERE tx_id IS NOT NULL AND composite_key = $4 AND value = $5) OR "+
+		"tx_results.rowid IN (SELECT tx_id FROM event_attributes "+
+		"WHERE tx_id IS NOT NULL AND composite_key = $6 AND (value = $7 OR value = $8))))", cond)
+	assert.Equal(t, []interface{}{
+		chainID, "account.number", int64(1), "account.owner", "Ivan", "tx.hash", "AB", "CD",
+	}, b.args)
+
+	b = newQueryBuilder(testDialect, tableBlocks, chainID)
+	cond, err = b.expression(query.MustParse("end_event EXISTS").Expression())
+	require.NoError(t, err)
+	assert.Equal(t, "blocks.rowid IN (SELECT block_id FROM events WHERE tx_id IS NULL AND type = $2)", cond)
+	assert.Equal(t, []interface{}{chainID, "end_event"}, b.args)
+
+	b = newQueryBuilder(testDialect, tableBlocks, chainID)
+	cond, err = b.expression(query.MustParse(
+		"end_event.memo CONTAINS 'abc' AND end_event.at <= TIME 2013-05-03T14:45:00Z").Expression())
+	require.NoError(t, err)
+	assert.Equal(t, "(blocks.rowid IN (SELECT block_id FROM event_attributes "+
+		"WHERE tx_id IS NULL AND composite_key = $2 AND position(value, $3) > 0) AND "+
+		"blocks.rowid IN (SELECT block_id FROM event_attributes "+
+		"WHERE tx_id IS NULL AND composite_key = $4 AND time(value) <= time($5)))", cond)
+	assert.Equal(t, []interface{}{chainID, "end_event.memo", "abc", "end_event.at", "2013-05-03T14:45:00Z"}, b.args)
+}
@@ -0,0 +1,598 @@
+// Package sqlsink implements the event sinks storing the events in the
+// schema of state/indexer/sink/psql/schema.sql. The SQL which differs between
+// the databases is given by a Dialect.
+package sqlsink
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/Finschia/ostracon/libs/pubsub/query"
+	"github.com/Finschia/ostracon/state/indexer"
+	"github.com/Finschia/ostracon/state/txindex"
+	"github.com/Finschia/ostracon/types"
+)
+
+const (
+	tableBlocks     = "blocks"
+	tableTxResults  = "tx_results"
+	tableEvents     = "events"
+	tableAttributes = "attributes"
+)
+
+// Dialect holds the SQL which differs between the databases.
+type Dialect struct {
+	// NumericValue is the value of an attribute as a number, or NULL if it
+	// does not look like one.
+	NumericValue string
+	// TimeValue is the value of an attribute as a time, or NULL if it does
+	// not look like one.
+	TimeValue string
+	// TimeOperand formats the placeholder of a time operand, given as an RFC
+	// 3339 string, into a value comparable with TimeValue.
+	TimeOperand string
+	// Strpos is the function returning the position of its second argument
+	// in its first one, or 0 if it is not found.
+	Strpos string
+	// InstallSchema, if set, installs the schema unless it is already
+	// installed.
+	InstallSchema func(*sql.DB) error
+}
+
+// EventSink is an indexer backend providing the tx/block index services. It
+// stores records in a database using the schema defined in
+// state/indexer/sink/psql/schema.sql.
+type EventSink struct {
+	store   *sql.DB
+	chainID string
+	dialect Dialect
+	// filter selects the event attributes to index.
+	filter *indexer.KeyFilter
+}
+
+// EventSinkOption sets an optional parameter on the EventSink.
+type EventSinkOption func(*EventSink)
+
+// WithKeyFilter sets the filter selecting the event attributes to index. The
+// block height and the tx hash and height are indexed regardless of it.
+func WithKeyFilter(filter *indexer.KeyFilter) EventSinkOption {
+	return func(es *EventSink) { es.filter = filter }
+}
+
+// NewEventSink constructs an event sink storing the events in db, in the given
+// dialect, installing the schema first if the dialect does. Events written to
+// the sink are attributed to the specified chainID.
+func NewEventSink(db *sql.DB, chainID string, dialect Dialect, options ...EventSinkOption) (*EventSink, error) {
+	if dialect.InstallSchema != nil {
+		if err := dialect.InstallSchema(db); err != nil {
+			return nil, fmt.Errorf("installing schema: %w", err)
+		}
+	}
+
+	es := &EventSink{
+		store:   db,
+		chainID: chainID,
+		dialect: dialect,
+	}
+	for _, option := range options {
+		option(es)
+	}
+	return es, nil
+}
+
+// DB returns the underlying connection used by the sink.
+// This is exported to support testing.
+func (es *EventSink) DB() *sql.DB { return es.store }
+
+// RunInTransaction executes query in a fresh database transaction.
+// If query reports an error, the transaction is rolled back and the
+// error from query is reported to the caller.
+// Otherwise, the result of committing the transaction is returned.
+func RunInTransaction(db *sql.DB, query func(*sql.Tx) error) error {
+	dbtx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	if err := query(dbtx); err != nil {
+		_ = dbtx.Rollback() // report the initial error, not the rollback
+		return err
+	}
+	return dbtx.Commit()
+}
+
+// queryWithID executes the specified SQL query with the given arguments,
+// expecting a single-row, single-column result containing an ID. If the query
+// succeeds, the ID from the result is returned.
+func queryWithID(tx *sql.Tx, query string, args ...interface{}) (uint32, error) {
+	var id uint32
+	if err := tx.QueryRow(query, args...).Scan(&id); err != nil {
+		return 0, err
+	}
+	return id, nil
+}
+
+// insertEvents inserts a slice of events and any indexed attributes of those
+// events into the database associated with dbtx.
+//
+// The attributes are indexed if the filter allows them.
+//
+// If txID > 0, the event is attributed to the transaction with that ID;
+// otherwise it is recorded as a block event.
+func insertEvents(dbtx *sql.Tx, filter *indexer.KeyFilter, blockID, txID uint32, evts []abci.Event) error {
+	// Populate the transaction ID field iff one is defined (> 0).
+	var txIDArg interface{}
+	if txID > 0 {
+		txIDArg = txID
+	}
+
+	// Add each event to the events table, and retrieve its row ID to use when
+	// adding any attributes the event provides.
+	for _, evt := range evts {
+		// Skip events with an empty type.
+		if evt.Type == "" {
+			continue
+		}
+
+		eid, err := queryWithID(dbtx, `
+INSERT INTO `+tableEvents+` (block_id, tx_id, type) VALUES ($1, $2, $3)
+  RETURNING rowid;
+`, blockID, txIDArg, evt.Type)
+		if err != nil {
+			return err
+		}
+
+		// Add any attributes flagged for indexing.
+		for _, attr := range evt.Attributes {
+			if !attr.Index {
+				continue
+			}
+			compositeKey := evt.Type + "." + string(attr.Key)
+			if !filter.Allow(compositeKey) {
+				continue
+			}
+			if _, err := dbtx.Exec(`
+INSERT INTO `+tableAttributes+` (event_id, key, composite_key, value)
+  VALUES ($1, $2, $3, $4);
+`, eid, string(attr.Key), compositeKey, string(attr.Value)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// makeIndexedEvent constructs an event from the specified composite key and
+// value. If the key has the form "type.name", the event will have a single
+// attribute with that name and the value; otherwise the event will have only
+// a type and no attributes.
+func makeIndexedEvent(compositeKey, value string) abci.Event {
+	i := strings.Index(compositeKey, ".")
+	if i < 0 {
+		return abci.Event{Type: compositeKey}
+	}
+	return abci.Event{Type: compositeKey[:i], Attributes: []abci.EventAttribute{
+		{Key: []byte(compositeKey[i+1:]), Value: []byte(value), Index: true},
+	}}
+}
+
+// IndexBlockEvents indexes the specified block header.
+func (es *EventSink) IndexBlockEvents(h types.EventDataNewBlockHeader) error {
+	ts := time.Now().UTC()
+
+	return RunInTransaction(es.store, func(dbtx *sql.Tx) error {
+		// Add the block to the blocks table and report back its row ID for use
+		// in indexing the events for the block.
+		blockID, err := queryWithID(dbtx, `
+INSERT INTO `+tableBlocks+` (height, chain_id, created_at)
+  VALUES ($1, $2, $3)
+  ON CONFLICT DO NOTHING
+  RETURNING rowid;
+`, h.Header.Height, es.chainID, ts)
+		if err == sql.ErrNoRows {
+			return nil // we already saw this block; quietly succeed
+		} else if err != nil {
+			return fmt.Errorf("indexing block header: %w", err)
+		}
+
+		// Insert the special block meta-event for height.
+		if err := insertEvents(dbtx, nil, blockID, 0, []abci.Event{
+			makeIndexedEvent(types.BlockHeightKey, fmt.Sprint(h.Header.Height)),
+		}); err != nil {
+			return fmt.Errorf("block meta-events: %w", err)
+		}
+		// Insert all the block events. Order is important here,
+		if err := insertEvents(dbtx, es.filter, blockID, 0, h.ResultBeginBlock.Events); err != nil {
+			return fmt.Errorf("begin-block events: %w", err)
+		}
+		if err := insertEvents(dbtx, es.filter, blockID, 0, h.ResultEndBlock.Events); err != nil {
+			return fmt.Errorf("end-block events: %w", err)
+		}
+		return nil
+	})
+}
+
+// IndexTxEvents indexes the specified transaction results. The block of each
+// of them must have been indexed first.
+func (es *EventSink) IndexTxEvents(txrs []*abci.TxResult) error {
+	ts := time.Now().UTC()
+
+	return RunInTransaction(es.store, func(dbtx *sql.Tx) error {
+		for _, txr := range txrs {
+			// Encode the result message in protobuf wire format for indexing.
+			resultData, err := proto.Marshal(txr)
+			if err != nil {
+				return fmt.Errorf("marshaling tx_result: %w", err)
+			}
+
+			// Index the hash of the underlying transaction as a hex string.
+			txHash := fmt.Sprintf("%X", types.Tx(txr.Tx).Hash())
+
+			// Find the block associated with this transaction.
+			blockID, err := queryWithID(dbtx, `
+SELECT rowid FROM `+tableBlocks+` WHERE height = $1 AND chain_id = $2;
+`, txr.Height, es.chainID)
+			if err != nil {
+				return fmt.Errorf("finding block ID: %w", err)
+			}
+
+			// Insert a record for this tx_result and capture its ID for indexing events.
+			txID, err := queryWithID(dbtx, `
+INSERT INTO `+tableTxResults+` (block_id, "index", created_at, tx_hash, tx_result)
+  VALUES ($1, $2, $3, $4, $5)
+  ON CONFLICT DO NOTHING
+  RETURNING rowid;
+`, blockID, txr.Index, ts, txHash, resultData)
+			if err == sql.ErrNoRows {
+				continue // we already saw this transaction; quietly succeed
+			} else if err != nil {
+				return fmt.Errorf("indexing tx_result: %w", err)
+			}
+
+			// Insert the special transaction meta-events for hash and height.
+			if err := insertEvents(dbtx, nil, blockID, txID, []abci.Event{
+				makeIndexedEvent(types.TxHashKey, txHash),
+				makeIndexedEvent(types.TxHeightKey, fmt.Sprint(txr.Height)),
+			}); err != nil {
+				return fmt.Errorf("indexing transaction meta-events: %w", err)
+			}
+			// Index any events packaged with the transaction.
+			if err := insertEvents(dbtx, es.filter, blockID, txID, txr.Result.Events); err != nil {
+				return fmt.Errorf("indexing transaction events: %w", err)
+			}
+		}
+		return nil
+	})
+}
+
+// SearchBlockEvents returns the heights of the blocks matching the given
+// query, in ascending order.
+func (es *EventSink) SearchBlockEvents(ctx context.Context, q *query.Query) ([]int64, error) {
+	heights := make([]int64, 0)
+	err := es.StreamBlockEvents(ctx, q, indexer.StreamOptions{}, func(height int64) bool {
+		heights = append(heights, height)
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	return heights, nil
+}
+
+// CountBlockEvents returns the number of blocks matching the given query.
+func (es *EventSink) CountBlockEvents(ctx context.Context, q *query.Query) (int, error) {
+	b := newQueryBuilder(es.dialect, tableBlocks, es.chainID)
+	cond, err := b.expression(q.Expression())
+	if err != nil {
+		return 0, err
+	}
+
+	var count int
+	if err := es.store.QueryRowContext(ctx, `
+SELECT count(*) FROM `+tableBlocks+`
+  WHERE chain_id = $1 AND `+cond+`;
+`, b.args...).Scan(&count); err != nil {
+		return 0, fmt.Errorf("counting blocks: %w", err)
+	}
+	return count, nil
+}
+
+// StreamBlockEvents calls fn with the height of each block matching the given
+// query, in height order, until fn returns false.
+func (es *EventSink) StreamBlockEvents(
+	ctx context.Context,
+	q *query.Query,
+	opts indexer.StreamOptions,
+	fn func(int64) bool,
+) error {
+	b := newQueryBuilder(es.dialect, tableBlocks, es.chainID)
+	cond, err := b.expression(q.Expression())
+	if err != nil {
+		return err
+	}
+
+	where := []string{"chain_id = $1", cond}
+	if opts.MinHeight > 0 {
+		where = append(where, "height >= "+b.arg(opts.MinHeight))
+	}
+	if opts.MaxHeight > 0 {
+		where = append(where, "height <= "+b.arg(opts.MaxHeight))
+	}
+	order, after := "ASC", ">"
+	if opts.Desc {
+		order, after = "DESC", "<"
+	}
+	if opts.After > 0 {
+		where = append(where, fmt.Sprintf("height %s %s", after, b.arg(opts.After)))
+	}
+
+	rows, err := es.store.QueryContext(ctx, `
+SELECT height FROM `+tableBlocks+`
+  WHERE `+strings.Join(where, " AND ")+`
+  ORDER BY height `+order+`;
+`, b.args...)
+	if err != nil {
+		return fmt.Errorf("searching blocks: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var height int64
+		if err := rows.Scan(&height); err != nil {
+			return fmt.Errorf("searching blocks: %w", err)
+		}
+		if !fn(height) {
+			return nil
+		}
+	}
+	return rows.Err()
+}
+
+// SearchTxEvents returns the transaction results matching the given query,
+// in height and index order.
+func (es *EventSink) SearchTxEvents(ctx context.Context, q *query.Query) ([]*abci.TxResult, error) {
+	results := make([]*abci.TxResult, 0)
+	err := es.StreamTxEvents(ctx, q, txindex.StreamOptions{}, func(txr *abci.TxResult) bool {
+		results = append(results, txr)
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// CountTxEvents returns the number of transaction results matching the given
+// query, without loading them.
+func (es *EventSink) CountTxEvents(ctx context.Context, q *query.Query) (int, error) {
+	b := newQueryBuilder(es.dialect, tableTxResults, es.chainID)
+	cond, err := b.expression(q.Expression())
+	if err != nil {
+		return 0, err
+	}
+
+	var count int
+	if err := es.store.QueryRowContext(ctx, `
+SELECT count(*) FROM `+tableTxResults+`
+  JOIN `+tableBlocks+` ON (blocks.rowid = tx_results.block_id)
+  WHERE blocks.chain_id = $1 AND `+cond+`;
+`, b.args...).Scan(&count); err != nil {
+		return 0, fmt.Errorf("counting tx_results: %w", err)
+	}
+	return count, nil
+}
+
+// StreamTxEvents calls fn with each transaction result matching the given
+// query, in height and index order, until fn returns false.
+func (es *EventSink) StreamTxEvents(
+	ctx context.Context,
+	q *query.Query,
+	opts txindex.StreamOptions,
+	fn func(*abci.TxResult) bool,
+) error {
+	b := newQueryBuilder(es.dialect, tableTxResults, es.chainID)
+	cond, err := b.expression(q.Expression())
+	if err != nil {
+		return err
+	}
+
+	where := []string{"blocks.chain_id = $1", cond}
+	if opts.MinHeight > 0 {
+		where = append(where, "blocks.height >= "+b.arg(opts.MinHeight))
+	}
+	if opts.MaxHeight > 0 {
+		where = append(where, "blocks.height <= "+b.arg(opts.MaxHeight))
+	}
+	order, after := "ASC", ">"
+	if opts.Desc {
+		order, after = "DESC", "<"
+	}
+	if opts.After != nil {
+		where = append(where, fmt.Sprintf(`(blocks.height, tx_results."index") %s (%s, %s)`,
+			after, b.arg(opts.After.Height), b.arg(opts.After.Index)))
+	}
+
+	rows, err := es.store.QueryContext(ctx, `
+SELECT tx_results.tx_result FROM `+tableTxResults+`
+  JOIN `+tableBlocks+` ON (blocks.rowid = tx_results.block_id)
+  WHERE `+strings.Join(where, " AND ")+`
+  ORDER BY blocks.height `+order+`, tx_results."index" `+order+`;
+`, b.args...)
+	if err != nil {
+		return fmt.Errorf("searching tx_results: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		txr, err := scanTxResult(rows)
+		if err != nil {
+			return err
+		}
+		if !fn(txr) {
+			return nil
+		}
+	}
+	return rows.Err()
+}
+
+// GetTxByHash returns the transaction result of the given hash or nil if the
+// transaction is not indexed. As in the kv indexer, the result of a
+// transaction indexed more than once is its latest successful one if any.
+func (es *EventSink) GetTxByHash(hash []byte) (*abci.TxResult, error) {
+	rows, err := es.store.Query(`
+SELECT tx_results.tx_result FROM `+tableTxResults+`
+  JOIN `+tableBlocks+` ON (blocks.rowid = tx_results.block_id)
+  WHERE tx_results.tx_hash = $1 AND blocks.chain_id = $2
+  ORDER BY blocks.height DESC;
+`, fmt.Sprintf("%X", hash), es.chainID)
+	if err != nil {
+		return nil, fmt.Errorf("looking up tx_result: %w", err)
+	}
+	defer rows.Close()
+
+	var found *abci.TxResult
+	for rows.Next() {
+		txr, err := scanTxResult(rows)
+		if err != nil {
+			return nil, err
+		}
+		if txr.Result.IsOK() {
+			return txr, nil
+		}
+		if found == nil {
+			found = txr
+		}
+	}
+	return found, rows.Err()
+}
+
+// HasBlock reports whether the block at the given height is indexed.
+func (es *EventSink) HasBlock(h int64) (bool, error) {
+	var found bool
+	if err := es.store.QueryRow(`
+SELECT EXISTS (SELECT 1 FROM `+tableBlocks+` WHERE height = $1 AND chain_id = $2);
+`, h, es.chainID).Scan(&found); err != nil {
+		return false, fmt.Errorf("looking up block: %w", err)
+	}
+	return found, nil
+}
+
+// PruneTxs removes the transactions of the blocks below retainHeight, along
+// with their events, and returns the number of removed transactions.
+func (es *EventSink) PruneTxs(retainHeight int64) (int64, error) {
+	return es.deleteTxs(0, retainHeight-1)
+}
+
+// PruneBlocks removes the blocks below retainHeight, along with their
+// transactions and events, and returns the number of removed blocks.
+func (es *EventSink) PruneBlocks(retainHeight int64) (int64, error) {
+	return es.deleteBlocks(0, retainHeight-1)
+}
+
+// DeleteTxs removes the transactions of the blocks from startHeight to
+// endHeight (inclusive), along with their events, so that they can be indexed
+// again, e.g. after the key filter changed.
+func (es *EventSink) DeleteTxs(startHeight, endHeight int64) error {
+	_, err := es.deleteTxs(startHeight, endHeight)
+	return err
+}
+
+// DeleteBlocks removes the blocks from startHeight to endHeight (inclusive),
+// along with their transactions and events, so that they can be indexed
+// again, e.g. after the key filter changed.
+func (es *EventSink) DeleteBlocks(startHeight, endHeight int64) error {
+	_, err := es.deleteBlocks(startHeight, endHeight)
+	return err
+}
+
+func (es *EventSink) deleteTxs(startHeight, endHeight int64) (int64, error) {
+	var deleted int64
+	err := RunInTransaction(es.store, func(dbtx *sql.Tx) error {
+		var err error
+		deleted, err = deleteTxs(dbtx, startHeight, endHeight, es.chainID)
+		return err
+	})
+	return deleted, err
+}
+
+func (es *EventSink) deleteBlocks(startHeight, endHeight int64) (int64, error) {
+	var deleted int64
+	err := RunInTransaction(es.store, func(dbtx *sql.Tx) error {
+		if _, err := deleteTxs(dbtx, startHeight, endHeight, es.chainID); err != nil {
+			return err
+		}
+
+		if _, err := dbtx.Exec(`
+DELETE FROM `+tableAttributes+` WHERE event_id IN (
+  SELECT events.rowid FROM `+tableEvents+` JOIN `+tableBlocks+` ON (blocks.rowid = events.block_id)
+  WHERE blocks.height BETWEEN $1 AND $2 AND blocks.chain_id = $3);
+`, startHeight, endHeight, es.chainID); err != nil {
+			return fmt.Errorf("deleting block attributes: %w", err)
+		}
+		if _, err := dbtx.Exec(`
+DELETE FROM `+tableEvents+` WHERE block_id IN (
+  SELECT rowid FROM `+tableBlocks+` WHERE height BETWEEN $1 AND $2 AND chain_id = $3);
+`, startHeight, endHeight, es.chainID); err != nil {
+			return fmt.Errorf("deleting block events: %w", err)
+		}
+		res, err := dbtx.Exec(`
+DELETE FROM `+tableBlocks+` WHERE height BETWEEN $1 AND $2 AND chain_id = $3;
+`, startHeight, endHeight, es.chainID)
+		if err != nil {
+			return fmt.Errorf("deleting blocks: %w", err)
+		}
+		deleted, err = res.RowsAffected()
+		return err
+	})
+	return deleted, err
+}
+
+// deleteTxs removes the transactions of the blocks from startHeight to
+// endHeight (inclusive) and their events in dbtx.
+func deleteTxs(dbtx *sql.Tx, startHeight, endHeight int64, chainID string) (int64, error) {
+	const deletedTxs = `
+  SELECT tx_results.rowid FROM ` + tableTxResults + ` JOIN ` + tableBlocks + ` ON (blocks.rowid = tx_results.block_id)
+  WHERE blocks.height BETWEEN $1 AND $2 AND blocks.chain_id = $3`
+
+	if _, err := dbtx.Exec(`
+DELETE FROM `+tableAttributes+` WHERE event_id IN (
+  SELECT rowid FROM `+tableEvents+` WHERE tx_id IN (`+deletedTxs+`));
+`, startHeight, endHeight, chainID); err != nil {
+		return 0, fmt.Errorf("deleting tx attributes: %w", err)
+	}
+	if _, err := dbtx.Exec(`
+DELETE FROM `+tableEvents+` WHERE tx_id IN (`+deletedTxs+`);
+`, startHeight, endHeight, chainID); err != nil {
+		return 0, fmt.Errorf("deleting tx events: %w", err)
+	}
+	res, err := dbtx.Exec(`
+DELETE FROM `+tableTxResults+` WHERE rowid IN (`+deletedTxs+`);
+`, startHeight, endHeight, chainID)
+	if err != nil {
+		return 0, fmt.Errorf("deleting tx_results: %w", err)
+	}
+	return res.RowsAffected()
+}
+
+// scanTxResult decodes the tx_result column of the current row.
+func scanTxResult(rows *sql.Rows) (*abci.TxResult, error) {
+	var resultData []byte
+	if err := rows.Scan(&resultData); err != nil {
+		return nil, fmt.Errorf("reading tx_result: %w", err)
+	}
+
+	txr := new(abci.TxResult)
+	if err := proto.Unmarshal(resultData, txr); err != nil {
+		return nil, fmt.Errorf("unmarshaling tx_result: %w", err)
+	}
+	return txr, nil
+}
+
+// Stop closes the underlying database.
+func (es *EventSink) Stop() error { return es.store.Close() }
@@ -105,6 +105,22 @@ func (_m *BlockStore) LoadBlockCommit(height int64) *types.Commit {
 	return r0
 }
 
+// LoadBlockEntropy provides a mock function with given fields: height
+func (_m *BlockStore) LoadBlockEntropy(height int64) *types.Entropy {
+	ret := _m.Called(height)
+
+	var r0 *types.Entropy
+	if rf, ok := ret.Get(0).(func(int64) *types.Entropy); ok {
+		r0 = rf(height)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.Entropy)
+		}
+	}
+
+	return r0
+}
+
 // LoadBlockMeta provides a mock function with given fields: height
 func (_m *BlockStore) LoadBlockMeta(height int64) *types.BlockMeta {
 	ret := _m.Called(height)
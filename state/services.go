@@ -30,6 +30,7 @@ type BlockStore interface {
 
 	LoadBlockByHash(hash []byte) *types.Block
 	LoadBlockPart(height int64, index int) *types.Part
+	LoadBlockEntropy(height int64) *types.Entropy
 
 	LoadBlockCommit(height int64) *types.Commit
 	LoadSeenCommit(height int64) *types.Commit
@@ -59,3 +60,13 @@ func (EmptyEvidencePool) AddEvidence(types.Evidence) error                { retu
 func (EmptyEvidencePool) Update(State, types.EvidenceList)                {}
 func (EmptyEvidencePool) CheckEvidence(evList types.EvidenceList) error   { return nil }
 func (EmptyEvidencePool) ReportConflictingVotes(voteA, voteB *types.Vote) {}
+
+//-----------------------------------------------------------------------------
+// indexes
+
+// IndexPruner defines the interface used by the BlockExecutor to prune the tx
+// and block indexes along with the blocks.
+type IndexPruner interface {
+	// SetRetainHeight sets the height below which the indexes are pruned.
+	SetRetainHeight(retainHeight int64)
+}
@@ -89,6 +89,21 @@ func (state State) MakeHashMessage(round int32) []byte {
 	return types.MakeRoundHash(state.LastProofHash, state.LastBlockHeight, round)
 }
 
+// UpcomingProposers returns the next height and the proposers elected for its
+// rounds [0, rounds). The election only depends on LastProofHash and the
+// validator set, so it is known as soon as the previous block is committed.
+func (state State) UpcomingProposers(rounds int32) (int64, []*types.Validator) {
+	height := state.LastBlockHeight + 1
+	if height == 1 {
+		height = state.InitialHeight
+	}
+	proposers := make([]*types.Validator, 0, rounds)
+	for round := int32(0); round < rounds; round++ {
+		proposers = append(proposers, state.Validators.SelectProposer(state.LastProofHash, height, round).Copy())
+	}
+	return height, proposers
+}
+
 // Copy makes a copy of the State for mutating.
 func (state State) Copy() State {
 	return State{
@@ -1169,6 +1169,24 @@ func TestState_MakeHashMessage(t *testing.T) {
 	require.False(t, bytes.Equal(message2, message3))
 }
 
+func TestState_UpcomingProposers(t *testing.T) {
+	_, _, state := setupTestCase(t)
+	state.Validators, _ = types.RandValidatorSet(4, 10)
+
+	height, proposers := state.UpcomingProposers(5)
+	assert.Equal(t, state.InitialHeight, height)
+	require.Len(t, proposers, 5)
+	for round, proposer := range proposers {
+		expected := state.Validators.SelectProposer(state.LastProofHash, height, int32(round))
+		assert.Equal(t, expected.Address, proposer.Address)
+	}
+
+	state.LastBlockHeight = 10
+	height, proposers = state.UpcomingProposers(1)
+	assert.EqualValues(t, 11, height)
+	assert.Equal(t, state.Validators.SelectProposer(state.LastProofHash, 11, 0).Address, proposers[0].Address)
+}
+
 func TestMedianTime(t *testing.T) {
 	now := tmtime.Now()
 	cases := []struct {
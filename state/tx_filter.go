@@ -11,6 +11,7 @@ func TxPreCheck(state State) mempl.PreCheckFunc {
 	maxDataBytes := types.MaxDataBytesNoEvidence(
 		state.ConsensusParams.Block.MaxBytes,
 		state.Validators.Size(),
+		state.ConsensusParams.Validator.PubKeyTypes,
 	)
 	return mempl.PreCheckMaxBytes(maxDataBytes)
 }
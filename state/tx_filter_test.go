@@ -9,10 +9,10 @@ import (
 
 	dbm "github.com/tendermint/tm-db"
 
+	"github.com/Finschia/ostracon/crypto/secp256k1"
 	tmrand "github.com/Finschia/ostracon/libs/rand"
 	sm "github.com/Finschia/ostracon/state"
 	"github.com/Finschia/ostracon/types"
-	vrf "github.com/oasisprotocol/curve25519-voi/primitives/ed25519/extra/ecvrf"
 )
 
 func TestTxFilter(t *testing.T) {
@@ -26,8 +26,8 @@ func TestTxFilter(t *testing.T) {
 		tx    types.Tx
 		isErr bool
 	}{
-		{types.Tx(tmrand.Bytes(2178 - vrf.ProofSize)), false},
-		{types.Tx(tmrand.Bytes(2189 - vrf.ProofSize)), true},
+		{types.Tx(tmrand.Bytes(2178 - secp256k1.ProofSize)), false},
+		{types.Tx(tmrand.Bytes(2189 - secp256k1.ProofSize)), true},
 		{types.Tx(tmrand.Bytes(3000)), true},
 	}
 
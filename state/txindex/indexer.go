@@ -27,6 +27,36 @@ type TxIndexer interface {
 
 	// Search allows you to query for transactions.
 	Search(ctx context.Context, q *query.Query) ([]*abci.TxResult, error)
+
+	// Count returns the number of transactions Search would return for the
+	// query, without loading them.
+	Count(ctx context.Context, q *query.Query) (int, error)
+
+	// SearchStream calls fn, in height and index order, for each transaction
+	// matching the query until fn returns false or the results are exhausted.
+	SearchStream(ctx context.Context, q *query.Query, opts StreamOptions, fn func(*abci.TxResult) bool) error
+
+	// Prune removes the transactions indexed below retainHeight and returns
+	// the number of removed transactions.
+	Prune(retainHeight int64) (int64, error)
+}
+
+// Cursor is the position of a transaction in the height and index order.
+type Cursor struct {
+	Height int64
+	Index  uint32
+}
+
+// StreamOptions bounds and orders the results of a SearchStream.
+type StreamOptions struct {
+	// MinHeight and MaxHeight bound the searched heights, inclusive. Zero
+	// means no bound.
+	MinHeight int64
+	MaxHeight int64
+	// Desc streams the results from the highest height down.
+	Desc bool
+	// After, if set, skips the results up to and including its position.
+	After *Cursor
 }
 
 // Batch groups together multiple Index operations to be performed at the same time.
@@ -2,8 +2,11 @@ package txindex
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"github.com/Finschia/ostracon/libs/service"
+	tmsync "github.com/Finschia/ostracon/libs/sync"
 	"github.com/Finschia/ostracon/state/indexer"
 	"github.com/Finschia/ostracon/types"
 )
@@ -12,6 +15,10 @@ import (
 
 const (
 	subscriber = "IndexerService"
+
+	// defaultPruneInterval is how often the indexes are pruned up to the
+	// latest retain height.
+	defaultPruneInterval = 10 * time.Second
 )
 
 // IndexerService connects event bus, transaction and block indexers together in
@@ -23,6 +30,26 @@ type IndexerService struct {
 	blockIdxr        indexer.BlockIndexer
 	eventBus         *types.EventBus
 	terminateOnError bool
+
+	metrics       *Metrics
+	pruneInterval time.Duration
+
+	mtx          tmsync.Mutex
+	retainHeight int64
+}
+
+// IndexerServiceOption sets an optional parameter on the IndexerService.
+type IndexerServiceOption func(*IndexerService)
+
+// WithMetrics sets the metrics.
+func WithMetrics(metrics *Metrics) IndexerServiceOption {
+	return func(is *IndexerService) { is.metrics = metrics }
+}
+
+// WithPruneInterval sets how often the indexes are pruned up to the latest
+// retain height.
+func WithPruneInterval(interval time.Duration) IndexerServiceOption {
+	return func(is *IndexerService) { is.pruneInterval = interval }
 }
 
 // NewIndexerService returns a new service instance.
@@ -31,13 +58,37 @@ func NewIndexerService(
 	blockIdxr indexer.BlockIndexer,
 	eventBus *types.EventBus,
 	terminateOnError bool,
+	options ...IndexerServiceOption,
 ) *IndexerService {
 
-	is := &IndexerService{txIdxr: txIdxr, blockIdxr: blockIdxr, eventBus: eventBus, terminateOnError: terminateOnError}
+	is := &IndexerService{
+		txIdxr:           txIdxr,
+		blockIdxr:        blockIdxr,
+		eventBus:         eventBus,
+		terminateOnError: terminateOnError,
+		metrics:          NopMetrics(),
+		pruneInterval:    defaultPruneInterval,
+	}
 	is.BaseService = *service.NewBaseService(nil, "IndexerService", is)
+	for _, option := range options {
+		option(is)
+	}
 	return is
 }
 
+// SetRetainHeight sets the height below which the indexed txs and blocks are
+// pruned. They are pruned in the background; a height lower than the current
+// one is ignored.
+func (is *IndexerService) SetRetainHeight(retainHeight int64) {
+	is.mtx.Lock()
+	defer is.mtx.Unlock()
+
+	if retainHeight > is.retainHeight {
+		is.retainHeight = retainHeight
+		is.metrics.RetainHeight.Set(float64(retainHeight))
+	}
+}
+
 // OnStart implements service.Service by subscribing for all transactions
 // and indexing them by events.
 func (is *IndexerService) OnStart() error {
@@ -110,6 +161,61 @@ func (is *IndexerService) OnStart() error {
 			}
 		}
 	}()
+
+	go is.pruneRoutine()
+
+	return nil
+}
+
+// pruneRoutine prunes the indexes up to the latest retain height every
+// pruneInterval rather than whenever it is raised, as the retain height may
+// be raised at every block.
+func (is *IndexerService) pruneRoutine() {
+	ticker := time.NewTicker(is.pruneInterval)
+	defer ticker.Stop()
+
+	var prunedHeight int64
+	for {
+		select {
+		case <-ticker.C:
+			is.mtx.Lock()
+			retainHeight := is.retainHeight
+			is.mtx.Unlock()
+
+			if retainHeight <= prunedHeight {
+				continue
+			}
+			if err := is.prune(retainHeight); err != nil {
+				is.Logger.Error("failed to prune indexes", "retain_height", retainHeight, "err", err)
+				continue
+			}
+			prunedHeight = retainHeight
+
+		case <-is.Quit():
+			return
+		}
+	}
+}
+
+func (is *IndexerService) prune(retainHeight int64) error {
+	start := time.Now()
+
+	txs, err := is.txIdxr.Prune(retainHeight)
+	is.metrics.PrunedTxs.Add(float64(txs))
+	if err != nil {
+		return fmt.Errorf("failed to prune tx index: %w", err)
+	}
+
+	blocks, err := is.blockIdxr.Prune(retainHeight)
+	is.metrics.PrunedBlocks.Add(float64(blocks))
+	if err != nil {
+		return fmt.Errorf("failed to prune block index: %w", err)
+	}
+
+	is.metrics.PrunedHeight.Set(float64(retainHeight))
+	is.metrics.PruningTime.Set(float64(time.Since(start).Milliseconds()))
+	is.Logger.Info("pruned indexes", "retain_height", retainHeight, "txs", txs, "blocks", blocks)
+
 	return nil
 }
 
@@ -4,14 +4,17 @@ import (
 	"testing"
 	"time"
 
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
 	db "github.com/tendermint/tm-db"
 
 	"github.com/Finschia/ostracon/libs/log"
 	blockidxkv "github.com/Finschia/ostracon/state/indexer/block/kv"
+	indexermocks "github.com/Finschia/ostracon/state/indexer/mocks"
 	"github.com/Finschia/ostracon/state/txindex"
 	"github.com/Finschia/ostracon/state/txindex/kv"
+	txindexmocks "github.com/Finschia/ostracon/state/txindex/mocks"
 	"github.com/Finschia/ostracon/types"
 )
 
@@ -79,3 +82,46 @@ func TestIndexerServiceIndexesBlocks(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, txResult2, res)
 }
+
+func TestIndexerServicePrunesIndexes(t *testing.T) {
+	eventBus := types.NewEventBus()
+	eventBus.SetLogger(log.TestingLogger())
+	require.NoError(t, eventBus.Start())
+	t.Cleanup(func() {
+		if err := eventBus.Stop(); err != nil {
+			t.Error(err)
+		}
+	})
+
+	pruned := make(chan struct{})
+	txIndexer := txindexmocks.NewTxIndexer(t)
+	txIndexer.On("Prune", int64(5)).Return(int64(4), nil).Once()
+	blockIndexer := indexermocks.NewBlockIndexer(t)
+	blockIndexer.On("Prune", int64(5)).Return(int64(2), nil).Once().
+		Run(func(mock.Arguments) { close(pruned) })
+
+	service := txindex.NewIndexerService(txIndexer, blockIndexer, eventBus, false,
+		txindex.WithPruneInterval(10*time.Millisecond))
+	service.SetLogger(log.TestingLogger())
+	require.NoError(t, service.Start())
+	t.Cleanup(func() {
+		if err := service.Stop(); err != nil {
+			t.Error(err)
+		}
+	})
+
+	service.SetRetainHeight(5)
+	// a lower retain height is ignored
+	service.SetRetainHeight(3)
+
+	select {
+	case <-pruned:
+	case <-time.After(time.Second):
+		t.Fatal("the indexes were not pruned")
+	}
+
+	// the indexes are not pruned again until the retain height is raised
+	time.Sleep(50 * time.Millisecond)
+	txIndexer.AssertNumberOfCalls(t, "Prune", 1)
+	blockIndexer.AssertNumberOfCalls(t, "Prune", 1)
+}
@@ -5,10 +5,13 @@ import (
 	"context"
 	"encoding/hex"
 	"fmt"
+	"math"
 	"strconv"
 	"strings"
+	"sync"
 
 	"github.com/gogo/protobuf/proto"
+	"github.com/google/orderedcode"
 	abci "github.com/tendermint/tendermint/abci/types"
 	dbm "github.com/tendermint/tm-db"
 
@@ -20,6 +23,24 @@ import (
 
 const (
 	tagKeySeparator = "/"
+
+	// heightEventsKey prefixes the keys indexing the events of a tx by its
+	// height and index. It has no dot, so it can't be the composite key of an
+	// event.
+	heightEventsKey = "height_events"
+
+	// migrateBatchSize is the number of keys migrateHeightEvents indexes by
+	// height at once.
+	migrateBatchSize = 1000
+)
+
+var (
+	// retainHeightKey records the height below which the index is pruned.
+	retainHeightKey = []byte("retain_height")
+	// heightEventsSinceKey records the first height whose events are indexed
+	// by height. The heights below it are indexed by height once, by scanning
+	// the store, when they are first searched by SearchStream.
+	heightEventsSinceKey = []byte("height_events_since")
 )
 
 var _ txindex.TxIndexer = (*TxIndex)(nil)
@@ -27,13 +48,31 @@ var _ txindex.TxIndexer = (*TxIndex)(nil)
 // TxIndex is the simplest possible indexer, backed by key-value storage (levelDB).
 type TxIndex struct {
 	store dbm.DB
+	// filter selects the event attributes to index.
+	filter *indexer.KeyFilter
+	// migrateMtx serializes the indexing by height of the heights indexed
+	// before.
+	migrateMtx sync.Mutex
+}
+
+// TxIndexOption sets an optional parameter on the TxIndex.
+type TxIndexOption func(*TxIndex)
+
+// WithKeyFilter sets the filter selecting the event attributes to index. The
+// tx hash and height are indexed regardless of it.
+func WithKeyFilter(filter *indexer.KeyFilter) TxIndexOption {
+	return func(txi *TxIndex) { txi.filter = filter }
 }
 
 // NewTxIndex creates new KV indexer.
-func NewTxIndex(store dbm.DB) *TxIndex {
-	return &TxIndex{
+func NewTxIndex(store dbm.DB, options ...TxIndexOption) *TxIndex {
+	txi := &TxIndex{
 		store: store,
 	}
+	for _, option := range options {
+		option(txi)
+	}
+	return txi
 }
 
 // Get gets transaction from the TxIndex storage and returns it or nil if the
@@ -68,6 +107,12 @@ func (txi *TxIndex) AddBatch(b *txindex.Batch) error {
 	storeBatch := txi.store.NewBatch()
 	defer storeBatch.Close()
 
+	if len(b.Ops) > 0 {
+		if err := txi.markHeightEvents(storeBatch, b.Ops[0].Height); err != nil {
+			return err
+		}
+	}
+
 	for _, result := range b.Ops {
 		hash := types.Tx(result.Tx).Hash()
 
@@ -78,7 +123,7 @@ func (txi *TxIndex) AddBatch(b *txindex.Batch) error {
 		}
 
 		// index by height (always)
-		err = storeBatch.Set(keyForHeight(result), hash)
+		err = txi.indexHeight(result, hash, storeBatch)
 		if err != nil {
 			return err
 		}
@@ -125,6 +170,10 @@ func (txi *TxIndex) Index(result *abci.TxResult) error {
 		}
 	}
 
+	if err := txi.markHeightEvents(b, result.Height); err != nil {
+		return err
+	}
+
 	// index tx by events
 	err := txi.indexEvents(result, hash, b)
 	if err != nil {
@@ -132,7 +181,7 @@ func (txi *TxIndex) Index(result *abci.TxResult) error {
 	}
 
 	// index by height (always)
-	err = b.Set(keyForHeight(result), hash)
+	err = txi.indexHeight(result, hash, b)
 	if err != nil {
 		return err
 	}
@@ -162,13 +211,20 @@ func (txi *TxIndex) indexEvents(result *abci.TxResult, hash []byte, store dbm.Ba
 				continue
 			}
 
-			// index if `index: true` is set
+			// index if `index: true` is set and the filter allows it
 			compositeTag := fmt.Sprintf("%s.%s", event.Type, string(attr.Key))
-			if attr.GetIndex() {
+			if attr.GetIndex() && txi.filter.Allow(compositeTag) {
 				err := store.Set(keyForEvent(compositeTag, attr.Value, result), hash)
 				if err != nil {
 					return err
 				}
+				key, err := heightEventKey(result.Height, result.Index, compositeTag, string(attr.Value))
+				if err != nil {
+					return err
+				}
+				if err := store.Set(key, hash); err != nil {
+					return err
+				}
 			}
 		}
 	}
@@ -176,6 +232,224 @@ func (txi *TxIndex) indexEvents(result *abci.TxResult, hash []byte, store dbm.Ba
 	return nil
 }
 
+// indexHeight indexes the tx by its height, also as an event indexed by
+// height, for SearchStream:
+//
+// tx.height/height/height/index => hash
+// encode(height_events|height|index|tx.height|height) => hash
+//
+// Its events are indexed by height by indexEvents:
+//
+// encode(height_events|height|index|eventType.eventAttr|eventValue) => hash
+func (txi *TxIndex) indexHeight(result *abci.TxResult, hash []byte, store dbm.Batch) error {
+	if err := store.Set(keyForHeight(result), hash); err != nil {
+		return err
+	}
+	key, err := heightEventKey(result.Height, result.Index, types.TxHeightKey, strconv.FormatInt(result.Height, 10))
+	if err != nil {
+		return err
+	}
+	return store.Set(key, hash)
+}
+
+// markHeightEvents records the given height as the first one whose events
+// are indexed by height, unless one is recorded already. If nothing is
+// indexed yet, all the heights are.
+func (txi *TxIndex) markHeightEvents(batch dbm.Batch, height int64) error {
+	ok, err := txi.store.Has(heightEventsSinceKey)
+	if err != nil || ok {
+		return err
+	}
+
+	it, err := dbm.IteratePrefix(txi.store, startKey(types.TxHeightKey))
+	if err != nil {
+		return fmt.Errorf("failed to create prefix iterator: %w", err)
+	}
+	if !it.Valid() {
+		height = 1
+	}
+	err = it.Close()
+	if err != nil {
+		return err
+	}
+
+	return batch.Set(heightEventsSinceKey, int64ToBytes(height))
+}
+
+// Prune removes the txs indexed below retainHeight, along with their height
+// and event entries, and returns the number of removed txs.
+//
+// The heights are pruned one at a time, from the height the previous call
+// stopped at, and the progress is written along with each of them, so that
+// an interrupted pruning resumes where it stopped. The events of a tx indexed
+// again at a later height are left, as they can't be told from its result any
+// more.
+func (txi *TxIndex) Prune(retainHeight int64) (int64, error) {
+	base, err := txi.retainHeight()
+	if err != nil {
+		return 0, err
+	}
+	if base >= retainHeight {
+		return 0, nil
+	}
+	if base == 0 {
+		base, _ = txi.heightBounds()
+		if base == 0 {
+			// nothing is indexed yet
+			return 0, txi.store.SetSync(retainHeightKey, int64ToBytes(retainHeight))
+		}
+	}
+
+	var pruned int64
+	for height := base; height < retainHeight; height++ {
+		n, err := txi.pruneHeight(height)
+		if err != nil {
+			return pruned, fmt.Errorf("failed to prune height %d: %w", height, err)
+		}
+		pruned += n
+	}
+
+	return pruned, txi.store.SetSync(retainHeightKey, int64ToBytes(retainHeight))
+}
+
+// pruneHeight removes the txs indexed at the given height and returns their
+// number.
+func (txi *TxIndex) pruneHeight(height int64) (int64, error) {
+	b := txi.store.NewBatch()
+	defer b.Close()
+
+	pruned, err := txi.deleteHeight(height, b)
+	if err != nil {
+		return 0, err
+	}
+	if err := b.Set(retainHeightKey, int64ToBytes(height+1)); err != nil {
+		return 0, err
+	}
+
+	return pruned, b.Write()
+}
+
+// DeleteHeights removes the txs indexed from startHeight to endHeight
+// (inclusive), along with their height and event entries, so that they can be
+// indexed again, e.g. after the key filter changed.
+func (txi *TxIndex) DeleteHeights(startHeight, endHeight int64) error {
+	for height := startHeight; height <= endHeight; height++ {
+		b := txi.store.NewBatch()
+		_, err := txi.deleteHeight(height, b)
+		if err == nil {
+			err = b.Write()
+		}
+		b.Close()
+		if err != nil {
+			return fmt.Errorf("failed to delete height %d: %w", height, err)
+		}
+	}
+
+	return nil
+}
+
+// deleteHeight adds the removal of the txs indexed at the given height to the
+// batch and returns their number.
+func (txi *TxIndex) deleteHeight(height int64, b dbm.Batch) (int64, error) {
+	it, err := dbm.IteratePrefix(txi.store, startKey(types.TxHeightKey, height))
+	if err != nil {
+		panic(err)
+	}
+	var keys, hashes [][]byte
+	for ; it.Valid(); it.Next() {
+		keys = append(keys, it.Key())
+		hashes = append(hashes, it.Value())
+	}
+	err = it.Error()
+	it.Close()
+	if err != nil {
+		return 0, err
+	}
+
+	// the events indexed by height are removed whichever tx they belong to
+	prefix, err := orderedcode.Append(nil, heightEventsKey, height)
+	if err != nil {
+		return 0, err
+	}
+	it, err = dbm.IteratePrefix(txi.store, prefix)
+	if err != nil {
+		panic(err)
+	}
+	var heightEventKeys [][]byte
+	for ; it.Valid(); it.Next() {
+		heightEventKeys = append(heightEventKeys, it.Key())
+	}
+	err = it.Error()
+	it.Close()
+	if err != nil {
+		return 0, err
+	}
+	for _, key := range heightEventKeys {
+		if err := b.Delete(key); err != nil {
+			return 0, err
+		}
+	}
+
+	var deleted int64
+	for i, hash := range hashes {
+		if err := b.Delete(keys[i]); err != nil {
+			return 0, err
+		}
+
+		res, err := txi.Get(hash)
+		if err != nil {
+			return 0, fmt.Errorf("failed to get Tx{%X}: %w", hash, err)
+		}
+		// a tx indexed again at a later height is deleted at that height
+		if res == nil || res.Height != height {
+			continue
+		}
+
+		if err := txi.deleteEvents(res, b); err != nil {
+			return 0, err
+		}
+		if err := b.Delete(hash); err != nil {
+			return 0, err
+		}
+		deleted++
+	}
+
+	return deleted, nil
+}
+
+// deleteEvents removes the event entries written by indexEvents, whatever
+// filter they were written with.
+func (txi *TxIndex) deleteEvents(result *abci.TxResult, store dbm.Batch) error {
+	for _, event := range result.Result.Events {
+		if len(event.Type) == 0 {
+			continue
+		}
+
+		for _, attr := range event.Attributes {
+			if len(attr.Key) == 0 || !attr.GetIndex() {
+				continue
+			}
+
+			compositeTag := fmt.Sprintf("%s.%s", event.Type, string(attr.Key))
+			if err := store.Delete(keyForEvent(compositeTag, attr.Value, result)); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// retainHeight returns the height below which the index is pruned or 0 if it
+// has never been pruned.
+func (txi *TxIndex) retainHeight() (int64, error) {
+	bz, err := txi.store.Get(retainHeightKey)
+	if err != nil {
+		return 0, err
+	}
+	return int64FromBytes(bz), nil
+}
+
 // Search performs a search using the given query.
 //
 // It breaks the query into conditions (like "tx.height > 5"). For each
@@ -185,6 +459,10 @@ func (txi *TxIndex) indexEvents(result *abci.TxResult, hash []byte, store dbm.Ba
 // performing a full scan. Results from querying indexes are then intersected
 // and returned to the caller, in no particular order.
 //
+// The conditions of an OR are matched separately and their results are
+// merged. NOT is the complement of its matches among all the indexed txs, or
+// among the matches of the rest of the AND it belongs to if there is any.
+//
 // Search will exit early and return any result fetched so far,
 // when a message is received on the context chan.
 func (txi *TxIndex) Search(ctx context.Context, q *query.Query) ([]*abci.TxResult, error) {
@@ -195,34 +473,491 @@ func (txi *TxIndex) Search(ctx context.Context, q *query.Query) ([]*abci.TxResul
 	default:
 	}
 
-	var hashesInitialized bool
-	filteredHashes := make(map[string][]byte)
+	// if the query is a conjunction with a hash condition, return the result
+	// immediately
+	if conditions, err := q.Conditions(); err == nil {
+		hash, ok, err := lookForHash(conditions)
+		if err != nil {
+			return nil, fmt.Errorf("error during searching for a hash in the query: %w", err)
+		} else if ok {
+			res, err := txi.Get(hash)
+			switch {
+			case err != nil:
+				return []*abci.TxResult{}, fmt.Errorf("error while retrieving the result: %w", err)
+			case res == nil:
+				return []*abci.TxResult{}, nil
+			default:
+				return []*abci.TxResult{res}, nil
+			}
+		}
+	}
 
-	// get a list of conditions (like "tx.height > 5")
-	conditions, err := q.Conditions()
+	filteredHashes, err := txi.matchExpression(ctx, q.Expression())
 	if err != nil {
-		return nil, fmt.Errorf("error during parsing conditions from query: %w", err)
+		return nil, err
 	}
 
-	// if there is a hash condition, return the result immediately
-	hash, ok, err := lookForHash(conditions)
+	results := make([]*abci.TxResult, 0, len(filteredHashes))
+	for _, h := range filteredHashes {
+		res, err := txi.Get(h)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get Tx{%X}: %w", h, err)
+		}
+		results = append(results, res)
+
+		// Potentially exit early.
+		select {
+		case <-ctx.Done():
+			break
+		default:
+		}
+	}
+
+	return results, nil
+}
+
+// Count returns the number of txs Search would return for the given query.
+// The matching hashes are counted without loading the results they point to.
+func (txi *TxIndex) Count(ctx context.Context, q *query.Query) (int, error) {
+	select {
+	case <-ctx.Done():
+		return 0, nil
+
+	default:
+	}
+
+	// if the query is a conjunction with a hash condition, count the tx it
+	// points to if it is indexed
+	if conditions, err := q.Conditions(); err == nil {
+		hash, ok, err := lookForHash(conditions)
+		if err != nil {
+			return 0, fmt.Errorf("error during searching for a hash in the query: %w", err)
+		} else if ok {
+			has, err := txi.store.Has(hash)
+			if err != nil || !has {
+				return 0, err
+			}
+			return 1, nil
+		}
+	}
+
+	filteredHashes, err := txi.matchExpression(ctx, q.Expression())
+	if err != nil {
+		return 0, err
+	}
+
+	return len(filteredHashes), nil
+}
+
+// SearchStream performs a search using the given query and calls fn for each
+// matching transaction, in height and index order, until fn returns false.
+//
+// The events indexed by height are iterated from the cursor or the first
+// height in bounds on, and the query is matched against the events of one
+// transaction at a time, so that the iteration stops as soon as fn returns
+// false and only the matching transactions are loaded. The txs indexed
+// before the events were indexed by height are indexed by height first if the
+// search reaches them, see migrateHeightEvents.
+func (txi *TxIndex) SearchStream(
+	ctx context.Context,
+	q *query.Query,
+	opts txindex.StreamOptions,
+	fn func(*abci.TxResult) bool,
+) error {
+	minHeight, maxHeight := opts.MinHeight, opts.MaxHeight
+	if minHeight < 1 {
+		minHeight = 1
+	}
+	if maxHeight <= 0 || maxHeight == math.MaxInt64 {
+		maxHeight = math.MaxInt64 - 1
+	}
+	if conditions, err := q.Conditions(); err == nil {
+		minHeight, maxHeight = lookForHeightRange(conditions, minHeight, maxHeight)
+
+		// a tx looked up by its hash is only searched at its height
+		hash, ok, err := lookForHash(conditions)
+		if err != nil {
+			return fmt.Errorf("error during searching for a hash in the query: %w", err)
+		} else if ok {
+			res, err := txi.Get(hash)
+			if err != nil || res == nil {
+				return err
+			}
+			if res.Height < minHeight || res.Height > maxHeight {
+				return nil
+			}
+			minHeight, maxHeight = res.Height, res.Height
+		}
+	}
+	// the cursor bounds the heights too
+	if after := opts.After; after != nil {
+		if opts.Desc && after.Height < maxHeight {
+			maxHeight = after.Height
+		} else if !opts.Desc && after.Height > minHeight {
+			minHeight = after.Height
+		}
+	}
+	if minHeight > maxHeight {
+		return nil
+	}
+	if err := txi.migrateHeightEvents(minHeight); err != nil {
+		return err
+	}
+
+	start, err := orderedcode.Append(nil, heightEventsKey, minHeight)
+	if err != nil {
+		return err
+	}
+	end, err := orderedcode.Append(nil, heightEventsKey, maxHeight+1)
 	if err != nil {
-		return nil, fmt.Errorf("error during searching for a hash in the query: %w", err)
-	} else if ok {
+		return err
+	}
+	if after := opts.After; after != nil {
+		if opts.Desc {
+			key, err := orderedcode.Append(nil, heightEventsKey, after.Height, int64(after.Index))
+			if err != nil {
+				return err
+			}
+			if bytes.Compare(key, end) < 0 {
+				end = key
+			}
+		} else {
+			key, err := orderedcode.Append(nil, heightEventsKey, after.Height, int64(after.Index)+1)
+			if err != nil {
+				return err
+			}
+			if bytes.Compare(key, start) > 0 {
+				start = key
+			}
+		}
+	}
+	if bytes.Compare(start, end) >= 0 {
+		return nil
+	}
+
+	var it dbm.Iterator
+	if opts.Desc {
+		it, err = txi.store.ReverseIterator(start, end)
+	} else {
+		it, err = txi.store.Iterator(start, end)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to create height events iterator: %w", err)
+	}
+	defer it.Close()
+
+	var (
+		pos    txindex.Cursor
+		hash   []byte
+		events map[string][]string
+	)
+	// next matches the events of the current tx, calls fn if they match and
+	// returns whether to go on
+	next := func() (bool, error) {
+		if events == nil {
+			return true, nil
+		}
+		events[types.TxHashKey] = []string{fmt.Sprintf("%X", hash)}
+		match, err := q.Matches(events)
+		if err != nil {
+			return false, fmt.Errorf("failed to match Tx{%X}: %w", hash, err)
+		}
+		if !match {
+			return true, nil
+		}
+
 		res, err := txi.Get(hash)
-		switch {
-		case err != nil:
-			return []*abci.TxResult{}, fmt.Errorf("error while retrieving the result: %w", err)
-		case res == nil:
-			return []*abci.TxResult{}, nil
-		default:
-			return []*abci.TxResult{res}, nil
+		if err != nil {
+			return false, fmt.Errorf("failed to get Tx{%X}: %w", hash, err)
+		}
+		// the entries of a tx indexed again at another position are left at
+		// the previous one
+		if res == nil || res.Height != pos.Height || res.Index != pos.Index {
+			return true, nil
+		}
+		return fn(res), nil
+	}
+
+	for ; it.Valid(); it.Next() {
+		height, index, compositeKey, eventValue, err := parseHeightEventKey(it.Key())
+		if err != nil {
+			continue
+		}
+
+		if events == nil || height != pos.Height || index != pos.Index {
+			if ok, err := next(); err != nil || !ok {
+				return err
+			}
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			pos, hash, events = txindex.Cursor{Height: height, Index: index}, it.Value(), make(map[string][]string)
+		}
+		events[compositeKey] = append(events[compositeKey], eventValue)
+	}
+	if err := it.Error(); err != nil {
+		return err
+	}
+
+	_, err = next()
+	return err
+}
+
+// migrateHeightEvents indexes the events of the txs indexed before their
+// events were indexed by height, if any of them is from fromHeight on. They
+// are found by scanning the whole store once, migrateBatchSize keys at a
+// time, after which all the heights are recorded as indexed by height.
+func (txi *TxIndex) migrateHeightEvents(fromHeight int64) error {
+	txi.migrateMtx.Lock()
+	defer txi.migrateMtx.Unlock()
+
+	bz, err := txi.store.Get(heightEventsSinceKey)
+	if err != nil || bz == nil {
+		return err
+	}
+	since := int64FromBytes(bz)
+	if since <= 1 || fromHeight >= since {
+		return nil
+	}
+
+	var start []byte
+	for {
+		keys, next, err := txi.legacyHeightEventKeys(start, since)
+		if err != nil {
+			return err
+		}
+
+		batch := txi.store.NewBatch()
+		for key, hash := range keys {
+			if err := batch.Set([]byte(key), hash); err != nil {
+				batch.Close()
+				return err
+			}
+		}
+		err = batch.Write()
+		batch.Close()
+		if err != nil {
+			return err
+		}
+
+		if next == nil {
+			return txi.store.SetSync(heightEventsSinceKey, int64ToBytes(1))
+		}
+		start = next
+	}
+}
+
+// legacyHeightEventKeys returns the keys indexing by height up to
+// migrateBatchSize height and event entries of the heights below since, from
+// start on, along with the hashes they point to and the key to resume the scan
+// from, which is nil if the end of the store was reached.
+func (txi *TxIndex) legacyHeightEventKeys(start []byte, since int64) (map[string][]byte, []byte, error) {
+	it, err := txi.store.Iterator(start, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer it.Close()
+
+	keys := make(map[string][]byte)
+	for ; it.Valid(); it.Next() {
+		height, index, compositeKey, eventValue, ok := parseLegacyKey(it.Key())
+		if !ok || height >= since {
+			continue
+		}
+
+		key, err := heightEventKey(height, index, compositeKey, eventValue)
+		if err != nil {
+			return nil, nil, err
+		}
+		keys[string(key)] = it.Value()
+		if len(keys) == migrateBatchSize {
+			next := append(append([]byte{}, it.Key()...), 0)
+			return keys, next, it.Error()
+		}
+	}
+
+	return keys, nil, it.Error()
+}
+
+// heightBounds returns the lowest and the highest heights in the height
+// index or zeros if no tx is indexed.
+func (txi *TxIndex) heightBounds() (first, last int64) {
+	it, err := dbm.IteratePrefix(txi.store, startKey(types.TxHeightKey))
+	if err != nil {
+		panic(err)
+	}
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		height, err := strconv.ParseInt(extractValueFromKey(it.Key()), 10, 64)
+		if err != nil {
+			continue
+		}
+		if first == 0 || height < first {
+			first = height
+		}
+		if height > last {
+			last = height
+		}
+	}
+
+	return first, last
+}
+
+// matchExpression returns all matching txs by hash that meet the given
+// expression. The conditions of an AND are matched together by
+// matchConditions, and the rest of its sub-expressions only narrow down their
+// matches.
+func (txi *TxIndex) matchExpression(ctx context.Context, expr *query.Expression) (map[string][]byte, error) {
+	switch expr.Op {
+	case query.ExprCondition:
+		return txi.matchConditions(ctx, []query.Condition{expr.Condition})
+
+	case query.ExprOr:
+		filteredHashes := make(map[string][]byte)
+		for _, sub := range expr.Expressions {
+			tmpHashes, err := txi.matchExpression(ctx, sub)
+			if err != nil {
+				return nil, err
+			}
+			for k, v := range tmpHashes {
+				filteredHashes[k] = v
+			}
+		}
+		return filteredHashes, nil
+
+	case query.ExprNot:
+		return txi.exclude(ctx, txi.matchAll(ctx), expr.Expressions[0])
+
+	case query.ExprAnd:
+		var (
+			conditions        []query.Condition
+			others, negations []*query.Expression
+		)
+		for _, sub := range expr.Expressions {
+			switch sub.Op {
+			case query.ExprCondition:
+				conditions = append(conditions, sub.Condition)
+			case query.ExprNot:
+				negations = append(negations, sub.Expressions[0])
+			default:
+				others = append(others, sub)
+			}
+		}
+
+		var (
+			filteredHashes    map[string][]byte
+			hashesInitialized bool
+			err               error
+		)
+		if len(conditions) > 0 {
+			filteredHashes, err = txi.matchConditions(ctx, conditions)
+			if err != nil {
+				return nil, err
+			}
+			hashesInitialized = true
+		}
+
+		for _, sub := range others {
+			// Ignore any remaining sub-expressions if there is no match already.
+			if hashesInitialized && len(filteredHashes) == 0 {
+				return filteredHashes, nil
+			}
+
+			tmpHashes, err := txi.matchExpression(ctx, sub)
+			if err != nil {
+				return nil, err
+			}
+			if !hashesInitialized {
+				filteredHashes = tmpHashes
+				hashesInitialized = true
+				continue
+			}
+			for k := range filteredHashes {
+				if tmpHashes[k] == nil {
+					delete(filteredHashes, k)
+				}
+			}
+		}
+
+		if !hashesInitialized {
+			filteredHashes = txi.matchAll(ctx)
+		}
+		for _, sub := range negations {
+			filteredHashes, err = txi.exclude(ctx, filteredHashes, sub)
+			if err != nil {
+				return nil, err
+			}
 		}
+		return filteredHashes, nil
+
+	default:
+		return nil, fmt.Errorf("unknown expression operator %v", expr.Op)
+	}
+}
+
+// exclude removes the txs matching the expression from filteredHashes.
+func (txi *TxIndex) exclude(
+	ctx context.Context,
+	filteredHashes map[string][]byte,
+	expr *query.Expression,
+) (map[string][]byte, error) {
+	if len(filteredHashes) == 0 {
+		return filteredHashes, nil
 	}
 
+	tmpHashes, err := txi.matchExpression(ctx, expr)
+	if err != nil {
+		return nil, err
+	}
+	for k := range tmpHashes {
+		delete(filteredHashes, k)
+	}
+	return filteredHashes, nil
+}
+
+// matchAll returns all the indexed txs by hash.
+func (txi *TxIndex) matchAll(ctx context.Context) map[string][]byte {
+	return txi.match(ctx, query.Condition{CompositeKey: types.TxHeightKey, Op: query.OpExists}, nil,
+		make(map[string][]byte), true)
+}
+
+// matchConditions returns all matching txs by hash that meet all the given
+// conditions.
+func (txi *TxIndex) matchConditions(ctx context.Context, conditions []query.Condition) (map[string][]byte, error) {
+	var hashesInitialized bool
+	filteredHashes := make(map[string][]byte)
+
 	// conditions to skip because they're handled before "everything else"
 	skipIndexes := make([]int, 0)
 
+	// hash conditions are looked up directly, as the hash isn't indexed as an
+	// event
+	for i, c := range conditions {
+		if c.CompositeKey != types.TxHashKey || (c.Op != query.OpEqual && c.Op != query.OpIn) {
+			continue
+		}
+		skipIndexes = append(skipIndexes, i)
+
+		tmpHashes, err := txi.matchHashes(c)
+		if err != nil {
+			return nil, err
+		}
+		if !hashesInitialized {
+			filteredHashes = tmpHashes
+			hashesInitialized = true
+			continue
+		}
+		for k := range filteredHashes {
+			if tmpHashes[k] == nil {
+				delete(filteredHashes, k)
+			}
+		}
+	}
+	if hashesInitialized && len(filteredHashes) == 0 {
+		return filteredHashes, nil
+	}
+
 	// extract ranges
 	// if both upper and lower bounds exist, it's better to get them in order not
 	// no iterate over kvs that are not within range.
@@ -269,28 +1004,41 @@ func (txi *TxIndex) Search(ctx context.Context, q *query.Query) ([]*abci.TxResul
 		}
 	}
 
-	results := make([]*abci.TxResult, 0, len(filteredHashes))
-	for _, h := range filteredHashes {
-		res, err := txi.Get(h)
+	return filteredHashes, nil
+}
+
+// matchHashes returns the indexed txs among the ones of a "tx.hash" condition.
+func (txi *TxIndex) matchHashes(c query.Condition) (map[string][]byte, error) {
+	operands := []interface{}{c.Operand}
+	if c.Op == query.OpIn {
+		operands = c.Operand.([]interface{})
+	}
+
+	hashes := make(map[string][]byte)
+	for _, operand := range operands {
+		s, ok := operand.(string)
+		if !ok {
+			continue
+		}
+		hash, err := hex.DecodeString(s)
 		if err != nil {
-			return nil, fmt.Errorf("failed to get Tx{%X}: %w", h, err)
+			return nil, fmt.Errorf("error during searching for a hash in the query: %w", err)
 		}
-		results = append(results, res)
 
-		// Potentially exit early.
-		select {
-		case <-ctx.Done():
-			break
-		default:
+		ok, err = txi.store.Has(hash)
+		if err != nil {
+			panic(err)
+		}
+		if ok {
+			hashes[string(hash)] = hash
 		}
 	}
-
-	return results, nil
+	return hashes, nil
 }
 
 func lookForHash(conditions []query.Condition) (hash []byte, ok bool, err error) {
 	for _, c := range conditions {
-		if c.CompositeKey == types.TxHashKey {
+		if c.CompositeKey == types.TxHashKey && c.Op == query.OpEqual {
 			decoded, err := hex.DecodeString(c.Operand.(string))
 			return decoded, true, err
 		}
@@ -308,6 +1056,38 @@ func lookForHeight(conditions []query.Condition) (height int64) {
 	return 0
 }
 
+// lookForHeightRange narrows down the given inclusive height bounds with the
+// "tx.height" conditions.
+func lookForHeightRange(conditions []query.Condition, minHeight, maxHeight int64) (int64, int64) {
+	for _, c := range conditions {
+		height, ok := c.Operand.(int64)
+		if c.CompositeKey != types.TxHeightKey || !ok {
+			continue
+		}
+
+		lower, upper := minHeight, maxHeight
+		switch c.Op {
+		case query.OpEqual:
+			lower, upper = height, height
+		case query.OpGreater:
+			lower = height + 1
+		case query.OpGreaterEqual:
+			lower = height
+		case query.OpLess:
+			upper = height - 1
+		case query.OpLessEqual:
+			upper = height
+		}
+		if lower > minHeight {
+			minHeight = lower
+		}
+		if upper < maxHeight {
+			maxHeight = upper
+		}
+	}
+	return minHeight, maxHeight
+}
+
 // match returns all matching txs by hash that meet a given condition and start
 // key. An already filtered result (filteredHashes) is provided such that any
 // non-intersecting matches are removed.
@@ -373,6 +1153,30 @@ func (txi *TxIndex) match(
 			panic(err)
 		}
 
+	case c.Op == query.OpIn:
+		// XXX: startKeyBz does not apply here, each value has its own prefix.
+		for _, operand := range c.Operand.([]interface{}) {
+			it, err := dbm.IteratePrefix(txi.store, startKey(c.CompositeKey, operand))
+			if err != nil {
+				panic(err)
+			}
+
+			for ; it.Valid(); it.Next() {
+				tmpHashes[string(it.Value())] = it.Value()
+
+				// Potentially exit early.
+				select {
+				case <-ctx.Done():
+					break
+				default:
+				}
+			}
+			if err := it.Error(); err != nil {
+				panic(err)
+			}
+			it.Close()
+		}
+
 	case c.Op == query.OpContains:
 		// XXX: startKey does not apply here.
 		// For example, if startKey = "account.owner/an/" and search query = "account.owner CONTAINS an"
@@ -565,6 +1369,61 @@ func keyForHeight(result *abci.TxResult) []byte {
 	))
 }
 
+// heightEventKey indexes an event of a tx, or its height, by the height and
+// the index of the tx first, so that the events of the txs are found in order
+// without a scan.
+func heightEventKey(height int64, index uint32, compositeKey, eventValue string) ([]byte, error) {
+	return orderedcode.Append(
+		nil,
+		heightEventsKey,
+		height,
+		int64(index),
+		compositeKey,
+		eventValue,
+	)
+}
+
+func parseHeightEventKey(key []byte) (height int64, index uint32, compositeKey, eventValue string, err error) {
+	var (
+		prefix string
+		idx    int64
+	)
+
+	remaining, err := orderedcode.Parse(string(key), &prefix, &height, &idx, &compositeKey, &eventValue)
+	if err != nil {
+		return 0, 0, "", "", fmt.Errorf("failed to parse height event key: %w", err)
+	}
+
+	if len(remaining) != 0 || prefix != heightEventsKey || idx < 0 || idx > math.MaxUint32 {
+		return 0, 0, "", "", fmt.Errorf("unexpected height event key: %X", key)
+	}
+
+	return height, uint32(idx), compositeKey, eventValue, nil
+}
+
+// parseLegacyKey returns the position and the event of a height or an event
+// key, as they are indexed by height. ok is false for any other key.
+func parseLegacyKey(key []byte) (height int64, index uint32, compositeKey, eventValue string, ok bool) {
+	if _, _, _, _, err := parseHeightEventKey(key); err == nil {
+		return 0, 0, "", "", false
+	}
+
+	parts := strings.Split(string(key), tagKeySeparator)
+	if len(parts) < 4 || len(parts[0]) == 0 {
+		return 0, 0, "", "", false
+	}
+	height, err := strconv.ParseInt(parts[len(parts)-2], 10, 64)
+	if err != nil {
+		return 0, 0, "", "", false
+	}
+	idx, err := strconv.ParseUint(parts[len(parts)-1], 10, 32)
+	if err != nil {
+		return 0, 0, "", "", false
+	}
+
+	return height, uint32(idx), parts[0], strings.Join(parts[1:len(parts)-2], tagKeySeparator), true
+}
+
 func startKeyForCondition(c query.Condition, height int64) []byte {
 	if height > 0 {
 		return startKey(c.CompositeKey, c.Operand, height)
@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/gogo/protobuf/proto"
@@ -16,6 +18,7 @@ import (
 	ocabci "github.com/Finschia/ostracon/abci/types"
 	"github.com/Finschia/ostracon/libs/pubsub/query"
 	tmrand "github.com/Finschia/ostracon/libs/rand"
+	"github.com/Finschia/ostracon/state/indexer"
 	"github.com/Finschia/ostracon/state/txindex"
 	"github.com/Finschia/ostracon/types"
 )
@@ -120,6 +123,21 @@ func TestTxSearch(t *testing.T) {
 		{"account.number EXISTS", 1},
 		// search using EXISTS for non existing key
 		{"account.date EXISTS", 0},
+		// search using OR
+		{"account.owner = 'Vlad' OR account.number = 1", 1},
+		{"account.owner = 'Vlad' OR account.number = 2", 0},
+		// search using NOT
+		{"NOT account.owner = 'Vlad'", 1},
+		{"NOT account.owner = 'Ivan'", 0},
+		{"account.number = 1 AND NOT account.owner CONTAINS 'Vl'", 1},
+		// search using IN
+		{"account.owner IN ('Vlad', 'Ivan')", 1},
+		{"account.owner IN ('Vlad', 'Igor')", 0},
+		{fmt.Sprintf("tx.hash IN ('%X', 'FF')", hash), 1},
+		// search using a group
+		{"account.number = 1 AND (account.owner = 'Vlad' OR account.owner = 'Ivan')", 1},
+		{"account.number = 2 AND (account.owner = 'Vlad' OR account.owner = 'Ivan')", 0},
+		{fmt.Sprintf("account.owner = 'Vlad' OR (tx.hash = '%X' AND tx.height = 1)", hash), 1},
 	}
 
 	ctx := context.Background()
@@ -131,6 +149,10 @@ func TestTxSearch(t *testing.T) {
 			assert.NoError(t, err)
 
 			assert.Len(t, results, tc.resultsLength)
+
+			count, err := indexer.Count(ctx, query.MustParse(tc.q))
+			assert.NoError(t, err)
+			assert.Equal(t, tc.resultsLength, count)
 			if tc.resultsLength > 0 {
 				for _, txr := range results {
 					assert.True(t, proto.Equal(txResult, txr))
@@ -410,6 +432,337 @@ func TestTxSearchMultipleTxs(t *testing.T) {
 	require.Len(t, results, 3)
 }
 
+func TestTxSearchExpressions(t *testing.T) {
+	indexer := NewTxIndex(db.NewMemDB())
+
+	owners := []string{"Ivan", "Igor", "Vlad", "Pavel"}
+	for i, owner := range owners {
+		txResult := txResultWithEvents([]abci.Event{
+			{Type: "account", Attributes: []abci.EventAttribute{
+				{Key: []byte("number"), Value: []byte(fmt.Sprintf("%d", i+1)), Index: true},
+				{Key: []byte("owner"), Value: []byte(owner), Index: true},
+			}},
+		})
+		txResult.Tx = types.Tx(owner + "'s account")
+		txResult.Height = int64(i/2 + 1)
+		txResult.Index = uint32(i % 2)
+		require.NoError(t, indexer.Index(txResult))
+	}
+
+	testCases := []struct {
+		q      string
+		owners []string
+	}{
+		{"account.owner = 'Ivan' OR account.owner = 'Vlad'", []string{"Ivan", "Vlad"}},
+		{"account.owner IN ('Ivan', 'Vlad', 'John')", []string{"Ivan", "Vlad"}},
+		{"NOT account.owner IN ('Ivan', 'Vlad')", []string{"Igor", "Pavel"}},
+		{"NOT (account.number < 2 OR account.number > 3)", []string{"Igor", "Vlad"}},
+		{"account.number >= 2 AND NOT tx.height = 2", []string{"Igor"}},
+		{"tx.height = 2 AND (account.owner = 'Ivan' OR account.number < 4)", []string{"Vlad"}},
+		{"tx.height = 1 OR account.owner CONTAINS 'av'", []string{"Ivan", "Igor", "Pavel"}},
+		{"(account.number = 1 OR account.number = 4) AND (account.owner = 'Pavel' OR tx.height = 1)", []string{"Ivan", "Pavel"}},
+		{"account.number > 1 AND account.number < 4 AND NOT account.owner = 'Igor' AND NOT account.owner = 'Vlad'", nil},
+		{"account.number EXISTS AND NOT account.number EXISTS", nil},
+	}
+
+	ctx := context.Background()
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.q, func(t *testing.T) {
+			results, err := indexer.Search(ctx, query.MustParse(tc.q))
+			require.NoError(t, err)
+
+			txs := make([]string, 0, len(results))
+			for _, txr := range results {
+				txs = append(txs, string(txr.Tx))
+			}
+			expected := make([]string, 0, len(tc.owners))
+			for _, owner := range tc.owners {
+				expected = append(expected, owner+"'s account")
+			}
+			assert.ElementsMatch(t, expected, txs)
+		})
+	}
+}
+
+func TestTxSearchStream(t *testing.T) {
+	indexer := NewTxIndex(db.NewMemDB())
+
+	// heights 1 to 12 sort differently as numbers and as strings
+	for height := int64(1); height <= 12; height++ {
+		for index := uint32(0); index < 2; index++ {
+			txResult := txResultWithEvents([]abci.Event{
+				{Type: "account", Attributes: []abci.EventAttribute{
+					{Key: []byte("parity"), Value: []byte(fmt.Sprintf("%d", height%2)), Index: true},
+					{Key: []byte("owner"), Value: []byte("Ivan"), Index: false},
+				}},
+			})
+			txResult.Tx = types.Tx(fmt.Sprintf("%d/%d", height, index))
+			txResult.Height = height
+			txResult.Index = index
+			require.NoError(t, indexer.Index(txResult))
+		}
+	}
+
+	testCases := []struct {
+		q        string
+		opts     txindex.StreamOptions
+		limit    int
+		expected []string
+	}{
+		{"account.parity = 0 AND tx.height < 7", txindex.StreamOptions{}, 0,
+			[]string{"2/0", "2/1", "4/0", "4/1", "6/0", "6/1"}},
+		{"account.parity = 0", txindex.StreamOptions{MinHeight: 9}, 0,
+			[]string{"10/0", "10/1", "12/0", "12/1"}},
+		{"account.parity = 1", txindex.StreamOptions{Desc: true}, 3,
+			[]string{"11/1", "11/0", "9/1"}},
+		{"account.parity = 1", txindex.StreamOptions{Desc: true, After: &txindex.Cursor{Height: 9, Index: 1}}, 3,
+			[]string{"9/0", "7/1", "7/0"}},
+		{"tx.height >= 9 AND tx.height <= 10", txindex.StreamOptions{After: &txindex.Cursor{Height: 9, Index: 0}}, 0,
+			[]string{"9/1", "10/0", "10/1"}},
+		{"tx.height = 3 OR tx.height = 10", txindex.StreamOptions{MaxHeight: 5}, 0,
+			[]string{"3/0", "3/1"}},
+		{"account.owner = 'Ivan'", txindex.StreamOptions{}, 0, nil},
+		{"NOT account.parity = 0 AND tx.height <= 3", txindex.StreamOptions{}, 0,
+			[]string{"1/0", "1/1", "3/0", "3/1"}},
+		{fmt.Sprintf("tx.hash = '%X'", types.Tx("5/1").Hash()), txindex.StreamOptions{}, 0,
+			[]string{"5/1"}},
+		{fmt.Sprintf("tx.hash = '%X'", types.Tx("5/1").Hash()), txindex.StreamOptions{MinHeight: 6}, 0, nil},
+	}
+
+	ctx := context.Background()
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.q, func(t *testing.T) {
+			txs := make([]string, 0)
+			err := indexer.SearchStream(ctx, query.MustParse(tc.q), tc.opts, func(txr *abci.TxResult) bool {
+				txs = append(txs, string(txr.Tx))
+				return len(txs) != tc.limit
+			})
+			require.NoError(t, err)
+			if tc.expected == nil {
+				assert.Empty(t, txs)
+			} else {
+				assert.Equal(t, tc.expected, txs)
+			}
+		})
+	}
+
+	// a tx indexed again is found at its last position only
+	txResult := txResultWithEvents([]abci.Event{
+		{Type: "account", Attributes: []abci.EventAttribute{
+			{Key: []byte("parity"), Value: []byte("1"), Index: true},
+		}},
+	})
+	txResult.Tx = types.Tx("2/0")
+	txResult.Height = 13
+	require.NoError(t, indexer.Index(txResult))
+	for _, q := range []string{"account.parity = 0 AND tx.height <= 2", "account.parity = 1 AND tx.height >= 11"} {
+		txs := make([]string, 0)
+		err := indexer.SearchStream(ctx, query.MustParse(q), txindex.StreamOptions{}, func(txr *abci.TxResult) bool {
+			txs = append(txs, fmt.Sprintf("%s@%d", txr.Tx, txr.Height))
+			return true
+		})
+		require.NoError(t, err)
+		assert.Equal(t, map[string][]string{
+			"account.parity = 0 AND tx.height <= 2":  {"2/1@2"},
+			"account.parity = 1 AND tx.height >= 11": {"11/0@11", "11/1@11", "2/0@13"},
+		}[q], txs)
+	}
+
+	cancelledCtx, cancel := context.WithCancel(ctx)
+	cancel()
+	err := indexer.SearchStream(cancelledCtx, query.MustParse("account.parity = 1"), txindex.StreamOptions{},
+		func(*abci.TxResult) bool { return true })
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func TestTxIndexPrune(t *testing.T) {
+	store := db.NewMemDB()
+	indexer := NewTxIndex(store)
+
+	for height := int64(3); height <= 12; height++ {
+		for index := uint32(0); index < 2; index++ {
+			txResult := txResultWithEvents([]abci.Event{
+				{Type: "account", Attributes: []abci.EventAttribute{
+					{Key: []byte("parity"), Value: []byte(fmt.Sprintf("%d", height%2)), Index: true},
+				}},
+			})
+			txResult.Tx = types.Tx(fmt.Sprintf("%d/%d", height, index))
+			txResult.Height = height
+			txResult.Index = index
+			require.NoError(t, indexer.Index(txResult))
+		}
+	}
+
+	pruned, err := indexer.Prune(7)
+	require.NoError(t, err)
+	assert.EqualValues(t, 8, pruned)
+
+	// pruning again up to the same height is a noop
+	pruned, err = indexer.Prune(7)
+	require.NoError(t, err)
+	assert.EqualValues(t, 0, pruned)
+
+	txr, err := indexer.Get(types.Tx("6/1").Hash())
+	require.NoError(t, err)
+	assert.Nil(t, txr)
+	txr, err = indexer.Get(types.Tx("7/0").Hash())
+	require.NoError(t, err)
+	assert.NotNil(t, txr)
+
+	results, err := indexer.Search(context.Background(), query.MustParse("account.parity = 0"))
+	require.NoError(t, err)
+	assert.Len(t, results, 6)
+
+	// the height and event entries of the pruned txs are gone
+	it, err := store.Iterator(nil, nil)
+	require.NoError(t, err)
+	for ; it.Valid(); it.Next() {
+		if isTagKey(it.Key()) {
+			parts := strings.Split(string(it.Key()), tagKeySeparator)
+			height, err := strconv.ParseInt(parts[2], 10, 64)
+			require.NoError(t, err)
+			assert.GreaterOrEqual(t, height, int64(7), string(it.Key()))
+		}
+		if height, _, _, _, err := parseHeightEventKey(it.Key()); err == nil {
+			assert.GreaterOrEqual(t, height, int64(7), string(it.Key()))
+		}
+	}
+	require.NoError(t, it.Close())
+
+	// the pruning resumes where it stopped
+	pruned, err = indexer.Prune(9)
+	require.NoError(t, err)
+	assert.EqualValues(t, 4, pruned)
+}
+
+func TestTxSearchStreamUnindexedHeights(t *testing.T) {
+	store := db.NewMemDB()
+	indexer := NewTxIndex(store)
+	index := func(height int64) {
+		for index := uint32(0); index < 2; index++ {
+			txResult := txResultWithEvents([]abci.Event{
+				{Type: "account", Attributes: []abci.EventAttribute{
+					{Key: []byte("owner"), Value: []byte("Ivan/Vlad"), Index: true},
+				}},
+			})
+			txResult.Tx = types.Tx(fmt.Sprintf("%d/%d", height, index))
+			txResult.Height = height
+			txResult.Index = index
+			require.NoError(t, indexer.Index(txResult))
+		}
+	}
+
+	// the txs indexed before their events were indexed by height
+	for height := int64(1); height <= 3; height++ {
+		index(height)
+	}
+	it, err := store.Iterator(nil, nil)
+	require.NoError(t, err)
+	var keys [][]byte
+	for ; it.Valid(); it.Next() {
+		if _, _, _, _, err := parseHeightEventKey(it.Key()); err == nil {
+			keys = append(keys, it.Key())
+		}
+	}
+	require.NoError(t, it.Close())
+	for _, key := range append(keys, heightEventsSinceKey) {
+		require.NoError(t, store.Delete(key))
+	}
+
+	index(4)
+
+	search := func(q string, opts txindex.StreamOptions) []string {
+		txs := make([]string, 0)
+		err := indexer.SearchStream(context.Background(), query.MustParse(q), opts, func(txr *abci.TxResult) bool {
+			txs = append(txs, string(txr.Tx))
+			return true
+		})
+		require.NoError(t, err)
+		return txs
+	}
+
+	// the search above them doesn't index them, the one reaching them does
+	assert.Equal(t, []string{"4/1"},
+		search("account.owner = 'Ivan/Vlad'", txindex.StreamOptions{After: &txindex.Cursor{Height: 4, Index: 0}}))
+	since, err := store.Get(heightEventsSinceKey)
+	require.NoError(t, err)
+	assert.EqualValues(t, 4, int64FromBytes(since))
+	assert.Equal(t, []string{"2/0", "2/1", "3/0", "3/1"},
+		search("account.owner = 'Ivan/Vlad' AND tx.height >= 2 AND tx.height < 4", txindex.StreamOptions{}))
+	assert.Equal(t, []string{"4/1", "4/0", "3/1", "3/0", "2/1", "2/0", "1/1", "1/0"},
+		search("account.owner = 'Ivan/Vlad'", txindex.StreamOptions{Desc: true}))
+	since, err = store.Get(heightEventsSinceKey)
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, int64FromBytes(since))
+}
+
+func TestTxIndexKeyFilter(t *testing.T) {
+	txIndexer := NewTxIndex(db.NewMemDB(), WithKeyFilter(indexer.NewKeyFilter([]string{"transfer.*"}, []string{"*.memo"})))
+
+	txResult := txResultWithEvents([]abci.Event{
+		{Type: "transfer", Attributes: []abci.EventAttribute{
+			{Key: []byte("sender"), Value: []byte("alice"), Index: true},
+			{Key: []byte("memo"), Value: []byte("hello"), Index: true},
+		}},
+		{Type: "account", Attributes: []abci.EventAttribute{
+			{Key: []byte("number"), Value: []byte("1"), Index: true},
+		}},
+	})
+	require.NoError(t, txIndexer.Index(txResult))
+
+	for q, found := range map[string]bool{
+		"tx.height = 1":             true,
+		"transfer.sender = 'alice'": true,
+		"transfer.memo = 'hello'":   false,
+		"account.number = 1":        false,
+	} {
+		results, err := txIndexer.Search(context.Background(), query.MustParse(q))
+		require.NoError(t, err)
+		assert.Equal(t, found, len(results) == 1, q)
+
+		// the streamed results are matched against the same events
+		var streamed int
+		err = txIndexer.SearchStream(context.Background(), query.MustParse(q), txindex.StreamOptions{},
+			func(*abci.TxResult) bool {
+				streamed++
+				return true
+			})
+		require.NoError(t, err)
+		assert.Equal(t, found, streamed == 1, q)
+	}
+}
+
+func TestTxIndexDeleteHeights(t *testing.T) {
+	txIndexer := NewTxIndex(db.NewMemDB())
+
+	for height := int64(1); height <= 5; height++ {
+		txResult := txResultWithEvents([]abci.Event{
+			{Type: "account", Attributes: []abci.EventAttribute{
+				{Key: []byte("number"), Value: []byte("1"), Index: true},
+			}},
+		})
+		txResult.Tx = types.Tx(fmt.Sprintf("tx at %d", height))
+		txResult.Height = height
+		require.NoError(t, txIndexer.Index(txResult))
+	}
+
+	require.NoError(t, txIndexer.DeleteHeights(2, 4))
+
+	for height := int64(1); height <= 5; height++ {
+		txr, err := txIndexer.Get(types.Tx(fmt.Sprintf("tx at %d", height)).Hash())
+		require.NoError(t, err)
+		assert.Equal(t, height == 1 || height == 5, txr != nil)
+	}
+
+	results, err := txIndexer.Search(context.Background(), query.MustParse("account.number = 1"))
+	require.NoError(t, err)
+	assert.Len(t, results, 2)
+}
+
 func txResultWithEvents(events []abci.Event) *abci.TxResult {
 	tx := types.Tx("HELLO WORLD")
 	return &abci.TxResult{
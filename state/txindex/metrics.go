@@ -0,0 +1,81 @@
+package txindex
+
+import (
+	"github.com/go-kit/kit/metrics"
+	"github.com/go-kit/kit/metrics/discard"
+	"github.com/go-kit/kit/metrics/prometheus"
+	stdprometheus "github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	// MetricsSubsystem is a subsystem shared by all metrics exposed by this
+	// package.
+	MetricsSubsystem = "indexer"
+)
+
+// Metrics contains metrics exposed by this package.
+type Metrics struct {
+	// Height below which the indexes are to be pruned.
+	RetainHeight metrics.Gauge
+	// Height below which the indexes are pruned.
+	PrunedHeight metrics.Gauge
+	// Number of txs removed from the tx index.
+	PrunedTxs metrics.Counter
+	// Number of blocks removed from the block index.
+	PrunedBlocks metrics.Counter
+	// Time of the last pruning
+	PruningTime metrics.Gauge
+}
+
+// PrometheusMetrics returns Metrics build using Prometheus client library.
+// Optionally, labels can be provided along with their values ("foo",
+// "fooValue").
+func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
+	labels := []string{}
+	for i := 0; i < len(labelsAndValues); i += 2 {
+		labels = append(labels, labelsAndValues[i])
+	}
+	return &Metrics{
+		RetainHeight: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "retain_height",
+			Help:      "Height below which the indexes are to be pruned.",
+		}, labels).With(labelsAndValues...),
+		PrunedHeight: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "pruned_height",
+			Help:      "Height below which the indexes are pruned.",
+		}, labels).With(labelsAndValues...),
+		PrunedTxs: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "pruned_txs",
+			Help:      "Number of txs removed from the tx index.",
+		}, labels).With(labelsAndValues...),
+		PrunedBlocks: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "pruned_blocks",
+			Help:      "Number of blocks removed from the block index.",
+		}, labels).With(labelsAndValues...),
+		PruningTime: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "pruning_time",
+			Help:      "Time of the last pruning in ms.",
+		}, labels).With(labelsAndValues...),
+	}
+}
+
+// NopMetrics returns no-op Metrics.
+func NopMetrics() *Metrics {
+	return &Metrics{
+		RetainHeight: discard.NewGauge(),
+		PrunedHeight: discard.NewGauge(),
+		PrunedTxs:    discard.NewCounter(),
+		PrunedBlocks: discard.NewCounter(),
+		PruningTime:  discard.NewGauge(),
+	}
+}
@@ -32,6 +32,30 @@ func (_m *TxIndexer) AddBatch(b *txindex.Batch) error {
 	return r0
 }
 
+// Count provides a mock function with given fields: ctx, q
+func (_m *TxIndexer) Count(ctx context.Context, q *query.Query) (int, error) {
+	ret := _m.Called(ctx, q)
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *query.Query) (int, error)); ok {
+		return rf(ctx, q)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *query.Query) int); ok {
+		r0 = rf(ctx, q)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *query.Query) error); ok {
+		r1 = rf(ctx, q)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Get provides a mock function with given fields: hash
 func (_m *TxIndexer) Get(hash []byte) (*types.TxResult, error) {
 	ret := _m.Called(hash)
@@ -72,6 +96,30 @@ func (_m *TxIndexer) Index(result *types.TxResult) error {
 	return r0
 }
 
+// Prune provides a mock function with given fields: retainHeight
+func (_m *TxIndexer) Prune(retainHeight int64) (int64, error) {
+	ret := _m.Called(retainHeight)
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int64) (int64, error)); ok {
+		return rf(retainHeight)
+	}
+	if rf, ok := ret.Get(0).(func(int64) int64); ok {
+		r0 = rf(retainHeight)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(int64) error); ok {
+		r1 = rf(retainHeight)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Search provides a mock function with given fields: ctx, q
 func (_m *TxIndexer) Search(ctx context.Context, q *query.Query) ([]*types.TxResult, error) {
 	ret := _m.Called(ctx, q)
@@ -98,6 +146,20 @@ func (_m *TxIndexer) Search(ctx context.Context, q *query.Query) ([]*types.TxRes
 	return r0, r1
 }
 
+// SearchStream provides a mock function with given fields: ctx, q, opts, fn
+func (_m *TxIndexer) SearchStream(ctx context.Context, q *query.Query, opts txindex.StreamOptions, fn func(*types.TxResult) bool) error {
+	ret := _m.Called(ctx, q, opts, fn)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *query.Query, txindex.StreamOptions, func(*types.TxResult) bool) error); ok {
+		r0 = rf(ctx, q, opts, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // NewTxIndexer creates a new instance of TxIndexer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewTxIndexer(t interface {
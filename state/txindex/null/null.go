@@ -33,3 +33,22 @@ func (txi *TxIndex) Index(result *abci.TxResult) error {
 func (txi *TxIndex) Search(ctx context.Context, q *query.Query) ([]*abci.TxResult, error) {
 	return []*abci.TxResult{}, nil
 }
+
+// Count is a noop and always returns 0.
+func (txi *TxIndex) Count(ctx context.Context, q *query.Query) (int, error) {
+	return 0, nil
+}
+
+func (txi *TxIndex) SearchStream(
+	ctx context.Context,
+	q *query.Query,
+	opts txindex.StreamOptions,
+	fn func(*abci.TxResult) bool,
+) error {
+	return nil
+}
+
+// Prune is a noop and always returns 0.
+func (txi *TxIndex) Prune(retainHeight int64) (int64, error) {
+	return 0, nil
+}
@@ -0,0 +1,538 @@
+package statesync
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/Finschia/ostracon/behaviour"
+	"github.com/Finschia/ostracon/config"
+	tmjson "github.com/Finschia/ostracon/libs/json"
+	"github.com/Finschia/ostracon/libs/log"
+	"github.com/Finschia/ostracon/light"
+	"github.com/Finschia/ostracon/proxy"
+	sm "github.com/Finschia/ostracon/state"
+	"github.com/Finschia/ostracon/types"
+)
+
+const (
+	// archiveManifestName is the name of the manifest in a snapshot archive. It comes first.
+	archiveManifestName = "manifest.json"
+	// archiveChunkPrefix prefixes the names of the chunks in a snapshot archive, which are
+	// followed by their index.
+	archiveChunkPrefix = "chunks/"
+
+	// archiveMaxClockDrift is how much the time of the light blocks of an archive may drift into
+	// the future, as for the light client.
+	archiveMaxClockDrift = 10 * time.Second
+)
+
+// archiveManifest describes the snapshot of an archive, along with the data needed to build and
+// verify the states at its height and at the previous one, as a StateProvider would.
+type archiveManifest struct {
+	ChainID  string         `json:"chain_id"`
+	Snapshot *abci.Snapshot `json:"snapshot"`
+	// Heights are the heights from the snapshot height - 1 (unless it is the first one) to the
+	// snapshot height + 2, in ascending order.
+	Heights []archiveHeight `json:"heights"`
+}
+
+// archiveHeight is the data of an archive at a height.
+type archiveHeight struct {
+	LightBlock *types.LightBlock `json:"light_block"`
+	// Block is given at the heights of the states, for the proof hash of their proposer.
+	Block *types.Block `json:"block,omitempty"`
+	// ConsensusParams are given at the heights following the ones of the states.
+	ConsensusParams *tmproto.ConsensusParams `json:"consensus_params,omitempty"`
+}
+
+// ExportArchive writes to w an archive of the snapshot of the app at the given height, or of the
+// latest one if 0, which RestoreArchive restores offline. Besides the chunks of the snapshot,
+// loaded from the app, the archive holds the light blocks, blocks and consensus params needed to
+// bootstrap the node, loaded from the stores. Only the snapshots whose height + 2 is in the block
+// store may be exported.
+func ExportArchive(
+	w io.Writer,
+	conn proxy.AppConnSnapshot,
+	stateStore sm.Store,
+	blockStore sm.BlockStore,
+	height uint64,
+) (*abci.Snapshot, error) {
+	state, err := stateStore.Load()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load state: %w", err)
+	}
+
+	res, err := conn.ListSnapshotsSync(abci.RequestListSnapshots{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list snapshots: %w", err)
+	}
+	snapshot, err := selectArchiveSnapshot(res.Snapshots, height, blockStore)
+	if err != nil {
+		return nil, err
+	}
+
+	manifest := &archiveManifest{ChainID: state.ChainID, Snapshot: snapshot}
+	first := int64(snapshot.Height) - 1
+	if first < 1 {
+		first = 1
+	}
+	last := int64(snapshot.Height) + 2
+	for h := first; h <= last; h++ {
+		ah, err := loadArchiveHeight(stateStore, blockStore, h, h < last-1, h > first && h < last)
+		if err != nil {
+			return nil, err
+		}
+		manifest.Heights = append(manifest.Heights, ah)
+	}
+
+	bz, err := tmjson.Marshal(manifest)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode manifest: %w", err)
+	}
+
+	tw := tar.NewWriter(w)
+	if err := writeArchiveFile(tw, archiveManifestName, bz); err != nil {
+		return nil, err
+	}
+	for index := uint32(0); index < snapshot.Chunks; index++ {
+		chunk, err := conn.LoadSnapshotChunkSync(abci.RequestLoadSnapshotChunk{
+			Height: snapshot.Height,
+			Format: snapshot.Format,
+			Chunk:  index,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to load chunk %v: %w", index, err)
+		}
+		if chunk.Chunk == nil {
+			return nil, fmt.Errorf("app returned no chunk %v", index)
+		}
+		name := archiveChunkPrefix + strconv.FormatUint(uint64(index), 10)
+		if err := writeArchiveFile(tw, name, chunk.Chunk); err != nil {
+			return nil, err
+		}
+	}
+	if err := tw.Close(); err != nil {
+		return nil, fmt.Errorf("failed to write archive: %w", err)
+	}
+
+	return snapshot, nil
+}
+
+// selectArchiveSnapshot returns the snapshot at the given height, or the latest one if 0, with
+// the highest format. The block store must have the blocks from the snapshot height - 1 to the
+// snapshot height + 2.
+func selectArchiveSnapshot(snapshots []*abci.Snapshot, height uint64,
+	blockStore sm.BlockStore) (*abci.Snapshot, error) {
+	var best *abci.Snapshot
+	for _, s := range snapshots {
+		if height != 0 && s.Height != height {
+			continue
+		}
+		if s.Height+2 > uint64(blockStore.Height()) || (s.Height > 1 && s.Height-1 < uint64(blockStore.Base())) {
+			continue
+		}
+		if best == nil || s.Height > best.Height || (s.Height == best.Height && s.Format > best.Format) {
+			best = s
+		}
+	}
+	if best == nil {
+		if height != 0 {
+			return nil, fmt.Errorf("no snapshot at height %v with the blocks from height %v to %v",
+				height, height-1, height+2)
+		}
+		return nil, errors.New("no snapshot with the blocks from its height - 1 to its height + 2")
+	}
+	return best, nil
+}
+
+// loadArchiveHeight loads the light block at the given height, along with the block if withBlock
+// and the consensus params if withParams.
+func loadArchiveHeight(stateStore sm.Store, blockStore sm.BlockStore, height int64,
+	withBlock, withParams bool) (archiveHeight, error) {
+	lightBlock, err := loadLightBlock(stateStore, blockStore, height)
+	if err != nil {
+		return archiveHeight{}, err
+	}
+
+	ah := archiveHeight{LightBlock: lightBlock}
+	if withBlock {
+		if ah.Block = blockStore.LoadBlock(height); ah.Block == nil {
+			return archiveHeight{}, fmt.Errorf("no block at height %v", height)
+		}
+	}
+	if withParams {
+		params, err := stateStore.LoadConsensusParams(height)
+		if err != nil {
+			return archiveHeight{}, fmt.Errorf("failed to load consensus params at height %v: %w", height, err)
+		}
+		ah.ConsensusParams = &params
+	}
+	return ah, nil
+}
+
+func writeArchiveFile(tw *tar.Writer, name string, bz []byte) error {
+	err := tw.WriteHeader(&tar.Header{
+		Typeflag: tar.TypeReg,
+		Name:     name,
+		Mode:     0o600,
+		Size:     int64(len(bz)),
+		ModTime:  time.Now(),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to write %v: %w", name, err)
+	}
+	if _, err := tw.Write(bz); err != nil {
+		return fmt.Errorf("failed to write %v: %w", name, err)
+	}
+	return nil
+}
+
+// RestoreArchive restores the snapshot of an archive, as written by ExportArchive, into the app
+// through OfferSnapshot and ApplySnapshotChunk. The light blocks of the archive are verified from
+// the trusted one given by trustOptions, which must be among them, and so are the blocks and
+// consensus params against them. It returns the latest state, previous state and block commit
+// which the caller must use to bootstrap the node, as Reactor.Sync does.
+//
+// The state is the genesis state of the node, which must not have synced yet.
+func RestoreArchive(
+	r io.Reader,
+	cfg config.StateSyncConfig,
+	logger log.Logger,
+	conn proxy.AppConnSnapshot,
+	connQuery proxy.AppConnQuery,
+	state sm.State,
+	trustOptions light.TrustOptions,
+) (sm.State, sm.State, *types.Commit, error) {
+	if err := trustOptions.ValidateBasic(); err != nil {
+		return sm.State{}, sm.State{}, nil, fmt.Errorf("invalid trust options: %w", err)
+	}
+
+	dir, err := os.MkdirTemp(cfg.TempDir, "oc-statesync-archive")
+	if err != nil {
+		return sm.State{}, sm.State{}, nil, fmt.Errorf("unable to create temp dir for the archive: %w", err)
+	}
+	defer os.RemoveAll(dir)
+
+	manifest, err := readArchive(r, dir)
+	if err != nil {
+		return sm.State{}, sm.State{}, nil, err
+	}
+
+	stateProvider, err := newArchiveStateProvider(manifest, state, trustOptions, time.Now())
+	if err != nil {
+		return sm.State{}, sm.State{}, nil, fmt.Errorf("failed to verify archive: %w", err)
+	}
+
+	snapshot := &snapshot{
+		Height:   manifest.Snapshot.Height,
+		Format:   manifest.Snapshot.Format,
+		Chunks:   manifest.Snapshot.Chunks,
+		Hash:     manifest.Snapshot.Hash,
+		Metadata: manifest.Snapshot.Metadata,
+	}
+	chunks, err := newChunkQueue(snapshot, cfg.TempDir)
+	if err != nil {
+		return sm.State{}, sm.State{}, nil, fmt.Errorf("failed to create chunk queue: %w", err)
+	}
+	defer chunks.Close()
+
+	// The chunks are fed from the archive instead of being fetched from peers, again if the app
+	// asks to refetch them.
+	cfg.ChunkFetchers = 0
+	s := newSyncer(cfg, logger, behaviour.NewMockReporter(), conn, connQuery, stateProvider, cfg.TempDir,
+		NopMetrics())
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go feedArchiveChunks(ctx, logger, dir, snapshot, chunks)
+
+	for {
+		newState, previousState, commit, err := s.Sync(snapshot, chunks)
+		if errors.Is(err, errRetrySnapshot) {
+			chunks.RetryAll()
+			logger.Info("Retrying snapshot", "height", snapshot.Height, "format", snapshot.Format,
+				"hash", snapshot.Hash)
+			continue
+		}
+		if err != nil {
+			return sm.State{}, sm.State{}, nil, fmt.Errorf("snapshot restoration failed: %w", err)
+		}
+		return newState, previousState, commit, nil
+	}
+}
+
+// readArchive reads the manifest of an archive, and extracts its chunks into dir, named by their
+// index.
+func readArchive(r io.Reader, dir string) (*archiveManifest, error) {
+	tr := tar.NewReader(r)
+
+	hdr, err := tr.Next()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read archive: %w", err)
+	}
+	if hdr.Name != archiveManifestName {
+		return nil, fmt.Errorf("expected %v first in archive, got %v", archiveManifestName, hdr.Name)
+	}
+	bz, err := io.ReadAll(tr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %v: %w", archiveManifestName, err)
+	}
+	manifest := &archiveManifest{}
+	if err := tmjson.Unmarshal(bz, manifest); err != nil {
+		return nil, fmt.Errorf("failed to decode %v: %w", archiveManifestName, err)
+	}
+	if manifest.Snapshot == nil || manifest.Snapshot.Chunks == 0 {
+		return nil, errors.New("archive has no snapshot chunks")
+	}
+
+	found := make(map[uint32]bool, manifest.Snapshot.Chunks)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, fmt.Errorf("failed to read archive: %w", err)
+		}
+
+		if !strings.HasPrefix(hdr.Name, archiveChunkPrefix) {
+			return nil, fmt.Errorf("unexpected file %v in archive", hdr.Name)
+		}
+		index, err := strconv.ParseUint(strings.TrimPrefix(hdr.Name, archiveChunkPrefix), 10, 32)
+		if err != nil || uint32(index) >= manifest.Snapshot.Chunks {
+			return nil, fmt.Errorf("unexpected chunk %v in archive", hdr.Name)
+		}
+		if err := extractArchiveFile(tr, archiveChunkPath(dir, uint32(index))); err != nil {
+			return nil, err
+		}
+		found[uint32(index)] = true
+	}
+	if uint32(len(found)) != manifest.Snapshot.Chunks {
+		return nil, fmt.Errorf("archive has %v chunks, expected %v", len(found), manifest.Snapshot.Chunks)
+	}
+
+	return manifest, nil
+}
+
+func extractArchiveFile(r io.Reader, path string) error {
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o600)
+	if err != nil {
+		return fmt.Errorf("failed to create %v: %w", path, err)
+	}
+	if _, err := io.Copy(f, r); err != nil {
+		f.Close()
+		return fmt.Errorf("failed to extract %v: %w", path, err)
+	}
+	return f.Close()
+}
+
+func archiveChunkPath(dir string, index uint32) string {
+	return filepath.Join(dir, strconv.FormatUint(uint64(index), 10))
+}
+
+// feedArchiveChunks adds the chunks extracted from an archive to the chunk queue as they are
+// allocated, as fetchChunks does with the ones of peers, until ctx is cancelled.
+func feedArchiveChunks(ctx context.Context, logger log.Logger, dir string, snapshot *snapshot,
+	chunks *chunkQueue) {
+	for {
+		index, err := chunks.Allocate()
+		if errors.Is(err, errDone) {
+			// Keep checking until the context is canceled (restore is done), in case any
+			// chunks need to be refetched.
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(100 * time.Millisecond):
+			}
+			continue
+		}
+		if err != nil {
+			logger.Error("Failed to allocate chunk from queue", "err", err)
+			return
+		}
+
+		bz, err := os.ReadFile(archiveChunkPath(dir, index))
+		if err != nil {
+			logger.Error("Failed to read chunk from archive", "chunk", index, "err", err)
+			return
+		}
+		if _, err := chunks.Add(&chunk{
+			Height: snapshot.Height,
+			Format: snapshot.Format,
+			Index:  index,
+			Chunk:  bz,
+		}); err != nil {
+			logger.Error("Failed to add chunk to queue", "chunk", index, "err", err)
+			return
+		}
+	}
+}
+
+// archiveStateProvider is a state provider using the data of an archive, once verified.
+type archiveStateProvider struct {
+	state   sm.State
+	heights map[int64]archiveHeight
+}
+
+var _ StateProvider = (*archiveStateProvider)(nil)
+
+// newArchiveStateProvider verifies the data of the manifest, from the trusted light block given
+// by trustOptions, and returns a state provider for it. The state is the genesis state.
+func newArchiveStateProvider(manifest *archiveManifest, state sm.State, trustOptions light.TrustOptions,
+	now time.Time) (*archiveStateProvider, error) {
+	if manifest.ChainID != state.ChainID {
+		return nil, fmt.Errorf("archive is for chain %q, expected %q", manifest.ChainID, state.ChainID)
+	}
+	height := int64(manifest.Snapshot.Height)
+	first := height - 1
+	if first < 1 {
+		first = 1
+	}
+	if int64(len(manifest.Heights)) != height+3-first {
+		return nil, fmt.Errorf("archive has %v heights, expected %v", len(manifest.Heights), height+3-first)
+	}
+
+	heights := make(map[int64]archiveHeight, len(manifest.Heights))
+	for i, ah := range manifest.Heights {
+		h := first + int64(i)
+		if ah.LightBlock == nil || ah.LightBlock.SignedHeader == nil || ah.LightBlock.Height != h {
+			return nil, fmt.Errorf("expected light block at height %v", h)
+		}
+		if err := ah.LightBlock.ValidateBasic(state.ChainID); err != nil {
+			return nil, fmt.Errorf("invalid light block at height %v: %w", h, err)
+		}
+		heights[h] = ah
+	}
+
+	if err := verifyArchiveLightBlocks(heights, first, height+2, trustOptions, now); err != nil {
+		return nil, err
+	}
+
+	// The blocks and consensus params must match the verified light blocks.
+	for h := first; h <= height; h++ {
+		block := heights[h].Block
+		if block == nil {
+			return nil, fmt.Errorf("expected block at height %v", h)
+		}
+		if err := block.ValidateBasic(); err != nil {
+			return nil, fmt.Errorf("invalid block at height %v: %w", h, err)
+		}
+		blockID := types.BlockID{Hash: block.Hash(), PartSetHeader: block.MakePartSet(types.BlockPartSizeBytes).Header()}
+		if next := heights[h+1].LightBlock; !next.LastBlockID.Equals(blockID) {
+			return nil, fmt.Errorf("block %v at height %v does not match trusted block %v",
+				blockID, h, next.LastBlockID)
+		}
+
+		params := heights[h+1].ConsensusParams
+		if params == nil {
+			return nil, fmt.Errorf("expected consensus params at height %v", h+1)
+		}
+		if cH, tH := types.HashConsensusParams(*params), heights[h+1].LightBlock.ConsensusHash; !bytes.Equal(cH, tH) {
+			return nil, fmt.Errorf("consensus params hash %X at height %v does not match trusted hash %X",
+				cH, h+1, tH)
+		}
+	}
+
+	return &archiveStateProvider{state: state, heights: heights}, nil
+}
+
+// verifyArchiveLightBlocks verifies the light blocks from first to last, whose basic validation
+// passed, from the trusted one: the later ones as the light client would, and the earlier ones
+// backwards, by their hash.
+func verifyArchiveLightBlocks(heights map[int64]archiveHeight, first, last int64,
+	trustOptions light.TrustOptions, now time.Time) error {
+	trusted, ok := heights[trustOptions.Height]
+	if !ok {
+		return fmt.Errorf("trusted height %v is not in the archive (%v-%v)", trustOptions.Height, first, last)
+	}
+	if !bytes.Equal(trusted.LightBlock.Header.Hash(), trustOptions.Hash) {
+		return fmt.Errorf("expected header's hash %X, but got %X", trustOptions.Hash, trusted.LightBlock.Header.Hash())
+	}
+	if light.HeaderExpired(trusted.LightBlock.SignedHeader, trustOptions.Period, now) {
+		return light.ErrOldHeaderExpired{
+			At:  trusted.LightBlock.Time.Add(trustOptions.Period),
+			Now: now,
+		}
+	}
+
+	for h := trustOptions.Height + 1; h <= last; h++ {
+		prev, lb := heights[h-1].LightBlock, heights[h].LightBlock
+		if err := light.VerifyAdjacent(prev.SignedHeader, lb.SignedHeader, lb.ValidatorSet,
+			trustOptions.Period, now, archiveMaxClockDrift); err != nil {
+			return fmt.Errorf("failed to verify light block at height %v: %w", h, err)
+		}
+	}
+	for h := trustOptions.Height; h >= first; h-- {
+		lb := heights[h].LightBlock
+		if h < trustOptions.Height {
+			if err := light.VerifyBackwards(lb.Header, heights[h+1].LightBlock.Header); err != nil {
+				return fmt.Errorf("failed to verify light block at height %v: %w", h, err)
+			}
+		}
+		// The commits of the trusted and earlier light blocks are not verified otherwise.
+		if err := lb.ValidatorSet.VerifyCommitLight(lb.ChainID, lb.Commit.BlockID, h, lb.Commit); err != nil {
+			return fmt.Errorf("failed to verify commit at height %v: %w", h, err)
+		}
+	}
+	return nil
+}
+
+// AppHash implements StateProvider.
+func (s *archiveStateProvider) AppHash(ctx context.Context, height uint64) ([]byte, error) {
+	lb, err := s.lightBlock(int64(height + 1))
+	if err != nil {
+		return nil, err
+	}
+	return lb.AppHash, nil
+}
+
+// Commit implements StateProvider.
+func (s *archiveStateProvider) Commit(ctx context.Context, height uint64) (*types.Commit, error) {
+	lb, err := s.lightBlock(int64(height))
+	if err != nil {
+		return nil, err
+	}
+	return lb.Commit, nil
+}
+
+// State implements StateProvider.
+func (s *archiveStateProvider) State(ctx context.Context, height uint64) (sm.State, error) {
+	h := int64(height)
+	last, ok := s.heights[h]
+	if !ok || last.Block == nil {
+		return sm.State{}, fmt.Errorf("no state at height %v in the archive", height)
+	}
+
+	state := sm.State{
+		ChainID:       s.state.ChainID,
+		Version:       s.state.Version,
+		InitialHeight: s.state.InitialHeight,
+	}
+	if state.InitialHeight == 0 {
+		state.InitialHeight = 1
+	}
+	lastProofHash, err := proposerProofHash(last.LightBlock, last.Block.Proof.Bytes())
+	if err != nil {
+		return sm.State{}, err
+	}
+	return buildState(state, last.LightBlock, s.heights[h+1].LightBlock, s.heights[h+2].LightBlock,
+		*s.heights[h+1].ConsensusParams, lastProofHash), nil
+}
+
+func (s *archiveStateProvider) lightBlock(height int64) (*types.LightBlock, error) {
+	ah, ok := s.heights[height]
+	if !ok {
+		return nil, fmt.Errorf("no light block at height %v in the archive", height)
+	}
+	return ah.LightBlock, nil
+}
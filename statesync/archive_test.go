@@ -0,0 +1,243 @@
+package statesync
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/Finschia/ostracon/config"
+	tmjson "github.com/Finschia/ostracon/libs/json"
+	"github.com/Finschia/ostracon/libs/log"
+	"github.com/Finschia/ostracon/light"
+	"github.com/Finschia/ostracon/proxy"
+	proxymocks "github.com/Finschia/ostracon/proxy/mocks"
+	sm "github.com/Finschia/ostracon/state"
+	"github.com/Finschia/ostracon/store"
+	"github.com/Finschia/ostracon/types"
+	tmtime "github.com/Finschia/ostracon/types/time"
+)
+
+// makeArchiveChain stores a chain of numBlocks blocks signed by a single validator, as the block
+// executor would, with the app hash h after the block at height h. It returns the genesis state.
+func makeArchiveChain(t *testing.T, numBlocks int64) (sm.State, sm.Store, *store.BlockStore) {
+	pv := types.NewMockPV()
+	pubKey, err := pv.GetPubKey()
+	require.NoError(t, err)
+	genDoc := &types.GenesisDoc{
+		ChainID:     "archive-chain",
+		GenesisTime: tmtime.Now().Add(-time.Hour),
+		Validators:  []types.GenesisValidator{{Address: pubKey.Address(), PubKey: pubKey, Power: 10}},
+	}
+	require.NoError(t, genDoc.ValidateAndComplete())
+	genesis, err := sm.MakeGenesisState(genDoc)
+	require.NoError(t, err)
+
+	stateStore := sm.NewStore(dbm.NewMemDB(), sm.StoreOptions{})
+	blockStore := store.NewBlockStore(dbm.NewMemDB())
+	require.NoError(t, stateStore.Save(genesis))
+
+	state := genesis.Copy()
+	lastCommit := types.NewCommit(0, 0, types.BlockID{}, nil)
+	for height := int64(1); height <= numBlocks; height++ {
+		message := state.MakeHashMessage(0)
+		proof, err := pv.GenerateVRFProof(message)
+		require.NoError(t, err)
+		block, parts := state.MakeBlock(height, nil, lastCommit, nil, pubKey.Address(), 0, proof)
+		blockID := types.BlockID{Hash: block.Hash(), PartSetHeader: parts.Header()}
+
+		voteSet := types.NewVoteSet(state.ChainID, height, 0, tmproto.PrecommitType, state.Validators)
+		commit, err := types.MakeCommit(blockID, height, 0, voteSet, []types.PrivValidator{pv},
+			block.Time.Add(time.Second))
+		require.NoError(t, err)
+		blockStore.SaveBlock(block, parts, commit)
+
+		proofHash, err := types.ProofToHash(pubKey, proof)
+		require.NoError(t, err)
+		state.LastBlockHeight = height
+		state.LastBlockID = blockID
+		state.LastBlockTime = block.Time
+		state.LastProofHash = proofHash
+		state.AppHash = []byte{byte(height)}
+		state.LastValidators = state.Validators.Copy()
+		require.NoError(t, stateStore.Save(state))
+		lastCommit = commit
+	}
+	return genesis, stateStore, blockStore
+}
+
+func TestArchive_ExportRestore(t *testing.T) {
+	genesis, stateStore, blockStore := makeArchiveChain(t, 6)
+	chunks := [][]byte{[]byte("chunk 0"), []byte("chunk 1")}
+
+	// the snapshot at height 5 can't be exported without the block at height 7
+	exportConn := &proxymocks.AppConnSnapshot{}
+	exportConn.On("ListSnapshotsSync", abci.RequestListSnapshots{}).Return(&abci.ResponseListSnapshots{
+		Snapshots: []*abci.Snapshot{
+			{Height: 3, Format: 1, Chunks: 2, Hash: []byte{3}},
+			{Height: 5, Format: 1, Chunks: 2, Hash: []byte{5}},
+		},
+	}, nil)
+	for i, chunk := range chunks {
+		exportConn.On("LoadSnapshotChunkSync", abci.RequestLoadSnapshotChunk{
+			Height: 3, Format: 1, Chunk: uint32(i),
+		}).Return(&abci.ResponseLoadSnapshotChunk{Chunk: chunk}, nil)
+	}
+
+	archive := &bytes.Buffer{}
+	snapshot, err := ExportArchive(archive, exportConn, stateStore, blockStore, 0)
+	require.NoError(t, err)
+	assert.EqualValues(t, 3, snapshot.Height)
+	exportConn.AssertExpectations(t)
+
+	_, err = ExportArchive(&bytes.Buffer{}, exportConn, stateStore, blockStore, 5)
+	require.Error(t, err)
+
+	restoreConn := &proxymocks.AppConnSnapshot{}
+	restoreConn.On("OfferSnapshotSync", abci.RequestOfferSnapshot{
+		Snapshot: &abci.Snapshot{Height: 3, Format: 1, Chunks: 2, Hash: []byte{3}},
+		AppHash:  []byte{3},
+	}).Return(&abci.ResponseOfferSnapshot{Result: abci.ResponseOfferSnapshot_ACCEPT}, nil)
+	// the app asks to refetch the first chunk once
+	restoreConn.On("ApplySnapshotChunkSync", abci.RequestApplySnapshotChunk{
+		Index: 0, Chunk: chunks[0],
+	}).Once().Return(&abci.ResponseApplySnapshotChunk{
+		Result:        abci.ResponseApplySnapshotChunk_RETRY,
+		RefetchChunks: []uint32{0},
+	}, nil)
+	for i, chunk := range chunks {
+		restoreConn.On("ApplySnapshotChunkSync", abci.RequestApplySnapshotChunk{
+			Index: uint32(i), Chunk: chunk,
+		}).Once().Return(&abci.ResponseApplySnapshotChunk{Result: abci.ResponseApplySnapshotChunk_ACCEPT}, nil)
+	}
+	connQuery := &proxymocks.AppConnQuery{}
+	connQuery.On("InfoSync", proxy.RequestInfo).Return(&abci.ResponseInfo{
+		AppVersion:       genesis.Version.Consensus.App,
+		LastBlockHeight:  3,
+		LastBlockAppHash: []byte{3},
+	}, nil)
+
+	cfg := config.DefaultStateSyncConfig()
+	cfg.TempDir = t.TempDir()
+	state, previousState, commit, err := RestoreArchive(archive, *cfg, log.TestingLogger(), restoreConn,
+		connQuery, genesis, light.TrustOptions{
+			Period: time.Hour * 24,
+			Height: 3,
+			Hash:   blockStore.LoadBlockMeta(3).Header.Hash(),
+		})
+	require.NoError(t, err)
+	restoreConn.AssertExpectations(t)
+
+	expected, err := stateStore.Load()
+	require.NoError(t, err)
+	expectedProofHash, err := types.ProofToHash(expected.Validators.Validators[0].PubKey,
+		blockStore.LoadBlock(3).Proof.Bytes())
+	require.NoError(t, err)
+	assert.EqualValues(t, 3, state.LastBlockHeight)
+	assert.Equal(t, blockStore.LoadBlockMeta(3).BlockID, state.LastBlockID)
+	assert.Equal(t, []byte{3}, []byte(state.AppHash))
+	assert.Equal(t, expected.Validators.Hash(), state.Validators.Hash())
+	assert.Equal(t, expectedProofHash, state.LastProofHash)
+	assert.EqualValues(t, 2, previousState.LastBlockHeight)
+	assert.Equal(t, blockStore.LoadBlockCommit(3).Hash(), commit.Hash())
+}
+
+func TestArchive_Verify(t *testing.T) {
+	genesis, stateStore, blockStore := makeArchiveChain(t, 6)
+
+	manifest := &archiveManifest{
+		ChainID:  genesis.ChainID,
+		Snapshot: &abci.Snapshot{Height: 3, Format: 1, Chunks: 1},
+	}
+	for h := int64(2); h <= 5; h++ {
+		ah, err := loadArchiveHeight(stateStore, blockStore, h, h <= 3, h > 2 && h <= 4)
+		require.NoError(t, err)
+		manifest.Heights = append(manifest.Heights, ah)
+	}
+	trustOptions := func(height int64) light.TrustOptions {
+		return light.TrustOptions{
+			Period: time.Hour * 24,
+			Height: height,
+			Hash:   blockStore.LoadBlockMeta(height).Header.Hash(),
+		}
+	}
+
+	// the light blocks are verified forwards and backwards from the trusted one
+	for h := int64(2); h <= 5; h++ {
+		provider, err := newArchiveStateProvider(manifest, genesis, trustOptions(h), time.Now())
+		require.NoError(t, err)
+		state, err := provider.State(context.Background(), 3)
+		require.NoError(t, err)
+		assert.EqualValues(t, 3, state.LastBlockHeight)
+	}
+
+	_, err := newArchiveStateProvider(manifest, genesis, trustOptions(1), time.Now())
+	assert.Error(t, err)
+
+	wrongHash := trustOptions(3)
+	wrongHash.Hash = blockStore.LoadBlockMeta(4).Header.Hash()
+	_, err = newArchiveStateProvider(manifest, genesis, wrongHash, time.Now())
+	assert.Error(t, err)
+
+	_, err = newArchiveStateProvider(manifest, genesis, trustOptions(3), time.Now().Add(48*time.Hour))
+	assert.Error(t, err)
+
+	// a block with another proof doesn't match the light blocks
+	manifest.Heights[1].Block = blockStore.LoadBlock(3)
+	manifest.Heights[1].Block.Proof = manifest.Heights[0].Block.Proof
+	_, err = newArchiveStateProvider(manifest, genesis, trustOptions(3), time.Now())
+	assert.Error(t, err)
+}
+
+func TestReadArchive(t *testing.T) {
+	bz, err := tmjson.Marshal(&archiveManifest{Snapshot: &abci.Snapshot{Height: 3, Format: 1, Chunks: 2}})
+	require.NoError(t, err)
+
+	testcases := map[string]struct {
+		files map[string][]byte
+		err   bool
+	}{
+		"complete":        {map[string][]byte{"chunks/0": {0}, "chunks/1": {1}}, false},
+		"missing chunk":   {map[string][]byte{"chunks/1": {1}}, true},
+		"unknown chunk":   {map[string][]byte{"chunks/0": {0}, "chunks/1": {1}, "chunks/2": {2}}, true},
+		"unexpected file": {map[string][]byte{"chunks/0": {0}, "chunks/1": {1}, "../1": {1}}, true},
+	}
+	for name, tc := range testcases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			archive := &bytes.Buffer{}
+			tw := tar.NewWriter(archive)
+			require.NoError(t, writeArchiveFile(tw, archiveManifestName, bz))
+			for name, chunk := range tc.files {
+				require.NoError(t, writeArchiveFile(tw, name, chunk))
+			}
+			require.NoError(t, tw.Close())
+
+			dir := t.TempDir()
+			manifest, err := readArchive(archive, dir)
+			if tc.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.EqualValues(t, 2, manifest.Snapshot.Chunks)
+			for i := uint32(0); i < 2; i++ {
+				chunk, err := os.ReadFile(archiveChunkPath(dir, i))
+				require.NoError(t, err)
+				assert.Equal(t, []byte{byte(i)}, chunk)
+			}
+		})
+	}
+
+	_, err = readArchive(&bytes.Buffer{}, t.TempDir())
+	assert.Error(t, err)
+}
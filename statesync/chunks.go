@@ -35,6 +35,7 @@ type chunkQueue struct {
 	chunkSenders   map[uint32]p2p.ID          // the peer who sent the given chunk
 	chunkAllocated map[uint32]bool            // chunks that have been allocated via Allocate()
 	chunkReturned  map[uint32]bool            // chunks returned via Next()
+	chunkSkipped   map[uint32]bool            // chunks returned without fetching via Skip()
 	waiters        map[uint32][]chan<- uint32 // signals WaitFor() waiters about chunk arrival
 }
 
@@ -55,6 +56,7 @@ func newChunkQueue(snapshot *snapshot, tempDir string) (*chunkQueue, error) {
 		chunkSenders:   make(map[uint32]p2p.ID, snapshot.Chunks),
 		chunkAllocated: make(map[uint32]bool, snapshot.Chunks),
 		chunkReturned:  make(map[uint32]bool, snapshot.Chunks),
+		chunkSkipped:   make(map[uint32]bool),
 		waiters:        make(map[uint32][]chan<- uint32),
 	}, nil
 }
@@ -78,7 +80,7 @@ func (q *chunkQueue) Add(chunk *chunk) (bool, error) {
 	if chunk.Index >= q.snapshot.Chunks {
 		return false, fmt.Errorf("received unexpected chunk %v", chunk.Index)
 	}
-	if q.chunkFiles[chunk.Index] != "" {
+	if q.chunkFiles[chunk.Index] != "" || q.chunkSkipped[chunk.Index] {
 		return false, nil
 	}
 
@@ -157,6 +159,11 @@ func (q *chunkQueue) discard(index uint32) error {
 	}
 	path := q.chunkFiles[index]
 	if path == "" {
+		if q.chunkSkipped[index] {
+			delete(q.chunkSkipped, index)
+			delete(q.chunkReturned, index)
+			delete(q.chunkAllocated, index)
+		}
 		return nil
 	}
 	err := os.Remove(path)
@@ -278,11 +285,36 @@ func (q *chunkQueue) Retry(index uint32) {
 	delete(q.chunkReturned, index)
 }
 
-// RetryAll schedules all chunks to be retried, without refetching them.
+// RetryAll schedules all chunks to be retried, without refetching them. The skipped chunks are
+// fetched, as they were never fetched.
 func (q *chunkQueue) RetryAll() {
 	q.Lock()
 	defer q.Unlock()
 	q.chunkReturned = make(map[uint32]bool)
+	for index := range q.chunkSkipped {
+		delete(q.chunkAllocated, index)
+	}
+	q.chunkSkipped = make(map[uint32]bool)
+}
+
+// Skip marks a chunk as returned without fetching it, as it was applied before the node
+// restarted. Discard() or RetryAll() schedule it for fetching.
+func (q *chunkQueue) Skip(index uint32) error {
+	q.Lock()
+	defer q.Unlock()
+	if q.snapshot == nil {
+		return nil
+	}
+	if index >= q.snapshot.Chunks {
+		return fmt.Errorf("received unexpected chunk %v", index)
+	}
+	if q.chunkFiles[index] != "" {
+		return fmt.Errorf("chunk %v was already fetched", index)
+	}
+	q.chunkAllocated[index] = true
+	q.chunkReturned[index] = true
+	q.chunkSkipped[index] = true
+	return nil
 }
 
 // Size returns the total number of chunks for the snapshot and queue, or 0 when closed.
@@ -295,6 +327,21 @@ func (q *chunkQueue) Size() uint32 {
 	return q.snapshot.Chunks
 }
 
+// Fetched returns the number of chunks in the queue, which were fetched and not discarded, or
+// skipped.
+func (q *chunkQueue) Fetched() uint32 {
+	q.Lock()
+	defer q.Unlock()
+	return uint32(len(q.chunkFiles) + len(q.chunkSkipped))
+}
+
+// Returned returns the number of chunks returned via Next() and not retried since.
+func (q *chunkQueue) Returned() uint32 {
+	q.Lock()
+	defer q.Unlock()
+	return uint32(len(q.chunkReturned))
+}
+
 // WaitFor returns a channel that receives a chunk index when it arrives in the queue, or
 // immediately if it has already arrived. The channel is closed without a value if the queue is
 // closed or if the chunk index is not valid.
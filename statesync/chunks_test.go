@@ -478,6 +478,52 @@ func TestChunkQueue_RetryAll(t *testing.T) {
 	assert.Equal(t, errDone, err)
 }
 
+func TestChunkQueue_Skip(t *testing.T) {
+	queue, teardown := setupChunkQueue(t)
+	defer teardown()
+
+	// Skipped chunks are neither allocated nor returned, and are counted as fetched
+	require.NoError(t, queue.Skip(0))
+	require.NoError(t, queue.Skip(2))
+	assert.Error(t, queue.Skip(5))
+	assert.EqualValues(t, 2, queue.Fetched())
+
+	index, err := queue.Allocate()
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, index)
+	added, err := queue.Add(&chunk{Height: 3, Format: 1, Index: 1, Chunk: []byte{1}})
+	require.NoError(t, err)
+	assert.True(t, added)
+	assert.Error(t, queue.Skip(1))
+
+	// Adding a skipped chunk is ignored
+	added, err = queue.Add(&chunk{Height: 3, Format: 1, Index: 2, Chunk: []byte{2}})
+	require.NoError(t, err)
+	assert.False(t, added)
+
+	chunk, err := queue.Next()
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, chunk.Index)
+
+	// Discarding a skipped chunk makes it allocatable and fetched again
+	require.NoError(t, queue.Discard(0))
+	assert.EqualValues(t, 2, queue.Fetched())
+	index, err = queue.Allocate()
+	require.NoError(t, err)
+	assert.EqualValues(t, 0, index)
+
+	// Retrying all chunks fetches the skipped ones
+	queue.RetryAll()
+	assert.EqualValues(t, 1, queue.Fetched())
+	for _, expected := range []uint32{2, 3, 4} {
+		index, err = queue.Allocate()
+		require.NoError(t, err)
+		assert.Equal(t, expected, index)
+	}
+	_, err = queue.Allocate()
+	assert.Equal(t, errDone, err)
+}
+
 func TestChunkQueue_Size(t *testing.T) {
 	queue, teardown := setupChunkQueue(t)
 	defer teardown()
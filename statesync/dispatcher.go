@@ -0,0 +1,247 @@
+package statesync
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/Finschia/ostracon/libs/log"
+	tmsync "github.com/Finschia/ostracon/libs/sync"
+	lightprovider "github.com/Finschia/ostracon/light/provider"
+	"github.com/Finschia/ostracon/p2p"
+	ocssproto "github.com/Finschia/ostracon/proto/ostracon/statesync"
+	"github.com/Finschia/ostracon/types"
+)
+
+// lightBlockResponseTimeout is how long to wait for a peer to respond to a light block or
+// consensus params request.
+const lightBlockResponseTimeout = 10 * time.Second
+
+// errPeerRemoved is returned by calls to a peer that was removed before it responded.
+var errPeerRemoved = errors.New("peer removed")
+
+// callKey identifies a pending call. There is at most one per peer, kind of request and height.
+type callKey struct {
+	peer   p2p.ID
+	params bool
+	height uint64
+}
+
+// dispatcher sends the light block and consensus params requests to peers, and routes their
+// responses back to the callers.
+type dispatcher struct {
+	mtx   tmsync.Mutex
+	calls map[callKey]chan proto.Message
+}
+
+func newDispatcher() *dispatcher {
+	return &dispatcher{
+		calls: make(map[callKey]chan proto.Message),
+	}
+}
+
+// call sends the request, a LightBlockRequest or a ParamsRequest, to the peer and waits for its
+// response until the context is done.
+func (d *dispatcher) call(ctx context.Context, peer p2p.Peer, request proto.Message) (proto.Message, error) {
+	key, err := newCallKey(peer.ID(), request)
+	if err != nil {
+		return nil, err
+	}
+
+	d.mtx.Lock()
+	if _, ok := d.calls[key]; ok {
+		d.mtx.Unlock()
+		return nil, fmt.Errorf("a request for height %v is already pending for peer %v", key.height, key.peer)
+	}
+	ch := make(chan proto.Message, 1)
+	d.calls[key] = ch
+	d.mtx.Unlock()
+
+	defer func() {
+		d.mtx.Lock()
+		if d.calls[key] == ch {
+			delete(d.calls, key)
+		}
+		d.mtx.Unlock()
+	}()
+
+	// the failure to send is returned rather than logged
+	if !p2p.SendEnvelopeShim(peer, p2p.Envelope{ //nolint: staticcheck
+		ChannelID: LightBlockChannel,
+		Message:   request,
+	}, log.NewNopLogger()) {
+		return nil, fmt.Errorf("failed to send request to peer %v", peer.ID())
+	}
+
+	select {
+	case response, ok := <-ch:
+		if !ok {
+			return nil, errPeerRemoved
+		}
+		return response, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// respond routes the response, a LightBlockResponse or a ParamsResponse, of the peer to the
+// pending call. It returns an error if there is none.
+func (d *dispatcher) respond(peerID p2p.ID, response proto.Message) error {
+	key, err := newCallKey(peerID, response)
+	if err != nil {
+		return err
+	}
+
+	d.mtx.Lock()
+	defer d.mtx.Unlock()
+	ch, ok := d.calls[key]
+	if !ok {
+		return fmt.Errorf("unsolicited response for height %v", key.height)
+	}
+	delete(d.calls, key)
+	ch <- response
+	return nil
+}
+
+// removePeer fails the pending calls to the peer.
+func (d *dispatcher) removePeer(peerID p2p.ID) {
+	d.mtx.Lock()
+	defer d.mtx.Unlock()
+	for key, ch := range d.calls {
+		if key.peer == peerID {
+			delete(d.calls, key)
+			close(ch)
+		}
+	}
+}
+
+func newCallKey(peerID p2p.ID, msg proto.Message) (callKey, error) {
+	switch msg := msg.(type) {
+	case *ocssproto.LightBlockRequest:
+		return callKey{peer: peerID, height: msg.Height}, nil
+	case *ocssproto.LightBlockResponse:
+		return callKey{peer: peerID, height: msg.Height}, nil
+	case *ocssproto.ParamsRequest:
+		return callKey{peer: peerID, params: true, height: msg.Height}, nil
+	case *ocssproto.ParamsResponse:
+		return callKey{peer: peerID, params: true, height: msg.Height}, nil
+	default:
+		return callKey{}, fmt.Errorf("unexpected message type %T", msg)
+	}
+}
+
+// blockProvider is a light client provider fetching the light blocks, their entropy and the
+// consensus params from a peer.
+type blockProvider struct {
+	chainID    string
+	peer       p2p.Peer
+	dispatcher *dispatcher
+	timeout    time.Duration
+}
+
+var _ lightprovider.EntropyProvider = (*blockProvider)(nil)
+
+func newBlockProvider(chainID string, peer p2p.Peer, dispatcher *dispatcher) *blockProvider {
+	return &blockProvider{
+		chainID:    chainID,
+		peer:       peer,
+		dispatcher: dispatcher,
+		timeout:    lightBlockResponseTimeout,
+	}
+}
+
+// ChainID implements provider.Provider.
+func (p *blockProvider) ChainID() string {
+	return p.chainID
+}
+
+// LightBlock implements provider.Provider.
+func (p *blockProvider) LightBlock(ctx context.Context, height int64) (*types.LightBlock, error) {
+	lightBlock, _, err := p.lightBlock(ctx, height)
+	return lightBlock, err
+}
+
+// Entropy implements provider.EntropyProvider. The peer sends the entropy of a block along with
+// its light block.
+func (p *blockProvider) Entropy(ctx context.Context, height int64) (*types.Entropy, error) {
+	_, entropy, err := p.lightBlock(ctx, height)
+	return entropy, err
+}
+
+// ReportEvidence implements provider.Provider. Peers receive evidence through the evidence
+// reactor instead.
+func (p *blockProvider) ReportEvidence(ctx context.Context, ev types.Evidence) error {
+	return errors.New("reporting evidence to a peer is not supported")
+}
+
+// ConsensusParams fetches the consensus params at the given height, which are not verified.
+func (p *blockProvider) ConsensusParams(ctx context.Context, height int64) (*tmproto.ConsensusParams, error) {
+	res, err := p.call(ctx, &ocssproto.ParamsRequest{Height: uint64(height)})
+	if err != nil {
+		return nil, err
+	}
+	params := res.(*ocssproto.ParamsResponse).ConsensusParams
+	if params == nil {
+		return nil, fmt.Errorf("peer %v has no consensus params at height %v", p.peer.ID(), height)
+	}
+	return params, nil
+}
+
+func (p *blockProvider) String() string {
+	return fmt.Sprintf("peer %v", p.peer.ID())
+}
+
+// lightBlock fetches the light block at the given height, or the latest one if the height is 0,
+// with the entropy of its block.
+func (p *blockProvider) lightBlock(ctx context.Context, height int64) (*types.LightBlock, *types.Entropy, error) {
+	if height < 0 {
+		return nil, nil, lightprovider.ErrBadLightBlock{Reason: fmt.Errorf("expected height >= 0, got height %d", height)}
+	}
+
+	res, err := p.call(ctx, &ocssproto.LightBlockRequest{Height: uint64(height)})
+	if err != nil {
+		return nil, nil, err
+	}
+	resp := res.(*ocssproto.LightBlockResponse)
+	if resp.LightBlock == nil {
+		return nil, nil, lightprovider.ErrLightBlockNotFound
+	}
+
+	lightBlock, err := types.LightBlockFromProto(resp.LightBlock)
+	if err != nil {
+		return nil, nil, lightprovider.ErrBadLightBlock{Reason: err}
+	}
+	if err := lightBlock.ValidateBasic(p.chainID); err != nil {
+		return nil, nil, lightprovider.ErrBadLightBlock{Reason: err}
+	}
+	if height != 0 && lightBlock.Height != height {
+		return nil, nil, lightprovider.ErrBadLightBlock{
+			Reason: fmt.Errorf("height %d responded doesn't match height %d requested", lightBlock.Height, height),
+		}
+	}
+	entropy, err := types.EntropyFromProto(resp.Entropy)
+	if err != nil {
+		return nil, nil, lightprovider.ErrBadLightBlock{Reason: fmt.Errorf("invalid entropy: %w", err)}
+	}
+	return lightBlock, &entropy, nil
+}
+
+// call calls the peer, returning provider.ErrNoResponse if it doesn't respond in time.
+func (p *blockProvider) call(ctx context.Context, request proto.Message) (proto.Message, error) {
+	callCtx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+	res, err := p.dispatcher.call(callCtx, p.peer, request)
+	switch {
+	case err == nil:
+		return res, nil
+	case ctx.Err() == nil && errors.Is(err, context.DeadlineExceeded), errors.Is(err, errPeerRemoved):
+		return nil, lightprovider.ErrNoResponse
+	default:
+		return nil, err
+	}
+}
@@ -0,0 +1,104 @@
+package statesync
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	lightprovider "github.com/Finschia/ostracon/light/provider"
+	"github.com/Finschia/ostracon/p2p"
+	p2pmocks "github.com/Finschia/ostracon/p2p/mocks"
+	ocssproto "github.com/Finschia/ostracon/proto/ostracon/statesync"
+)
+
+// makeDispatcherPeer returns a peer sending the requests to the given channel.
+func makeDispatcherPeer(id p2p.ID, requests chan<- proto.Message) *Peer {
+	peer := &Peer{Peer: &p2pmocks.Peer{}, EnvelopeSender: &p2pmocks.EnvelopeSender{}}
+	peer.Peer.On("ID").Return(id)
+	peer.EnvelopeSender.On("SendEnvelope", mock.MatchedBy(func(e p2p.Envelope) bool {
+		return e.ChannelID == LightBlockChannel
+	})).Run(func(args mock.Arguments) {
+		requests <- args[0].(p2p.Envelope).Message
+	}).Return(true)
+	return peer
+}
+
+func TestDispatcher(t *testing.T) {
+	d := newDispatcher()
+	requests := make(chan proto.Message, 1)
+	peer := makeDispatcherPeer("a", requests)
+
+	// responses are routed by peer, kind of request and height
+	params := &tmproto.ConsensusParams{Block: tmproto.BlockParams{MaxBytes: 1}}
+	go func() {
+		<-requests
+		assert.Error(t, d.respond("b", &ocssproto.ParamsResponse{Height: 1}))
+		assert.Error(t, d.respond("a", &ocssproto.LightBlockResponse{Height: 1}))
+		assert.Error(t, d.respond("a", &ocssproto.ParamsResponse{Height: 2}))
+		assert.NoError(t, d.respond("a", &ocssproto.ParamsResponse{Height: 1, ConsensusParams: params}))
+	}()
+	res, err := d.call(context.Background(), peer, &ocssproto.ParamsRequest{Height: 1})
+	require.NoError(t, err)
+	assert.Equal(t, params, res.(*ocssproto.ParamsResponse).ConsensusParams)
+
+	// a response can't be routed twice
+	assert.Error(t, d.respond("a", &ocssproto.ParamsResponse{Height: 1}))
+
+	// the pending calls to a removed peer fail
+	go func() {
+		<-requests
+		d.removePeer("a")
+	}()
+	_, err = d.call(context.Background(), peer, &ocssproto.LightBlockRequest{Height: 1})
+	assert.ErrorIs(t, err, errPeerRemoved)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err = d.call(ctx, peer, &ocssproto.LightBlockRequest{Height: 1})
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Empty(t, d.calls)
+}
+
+func TestBlockProvider(t *testing.T) {
+	d := newDispatcher()
+	requests := make(chan proto.Message, 1)
+	provider := newBlockProvider("test-chain", makeDispatcherPeer("a", requests), d)
+	provider.timeout = 10 * time.Millisecond
+
+	go func() {
+		<-requests
+		assert.NoError(t, d.respond("a", &ocssproto.LightBlockResponse{Height: 1}))
+	}()
+	_, err := provider.LightBlock(context.Background(), 1)
+	assert.ErrorIs(t, err, lightprovider.ErrLightBlockNotFound)
+
+	go func() {
+		<-requests
+		assert.NoError(t, d.respond("a", &ocssproto.LightBlockResponse{
+			Height:     1,
+			LightBlock: &tmproto.LightBlock{},
+		}))
+	}()
+	_, err = provider.LightBlock(context.Background(), 1)
+	assert.IsType(t, lightprovider.ErrBadLightBlock{}, err)
+
+	go func() {
+		<-requests
+	}()
+	_, err = provider.Entropy(context.Background(), 1)
+	assert.ErrorIs(t, err, lightprovider.ErrNoResponse)
+
+	go func() {
+		<-requests
+		assert.NoError(t, d.respond("a", &ocssproto.ParamsResponse{Height: 1}))
+	}()
+	_, err = provider.ConsensusParams(context.Background(), 1)
+	assert.Error(t, err)
+}
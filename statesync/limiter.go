@@ -0,0 +1,67 @@
+package statesync
+
+import (
+	"math"
+	"time"
+
+	tmsync "github.com/Finschia/ostracon/libs/sync"
+	"github.com/Finschia/ostracon/p2p"
+)
+
+// peerLimiter is a set of token buckets, one per peer, each refilled at rate tokens per second
+// up to burst tokens. A request takes a token from the bucket of its peer, and is rejected if it
+// is empty.
+type peerLimiter struct {
+	mtx     tmsync.Mutex
+	rate    float64
+	burst   float64
+	buckets map[p2p.ID]*tokenBucket
+
+	now func() time.Time
+}
+
+type tokenBucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// newPeerLimiter returns a peerLimiter allowing rate requests per second per peer, with bursts
+// of up to burst requests.
+func newPeerLimiter(rate float64, burst int) *peerLimiter {
+	return &peerLimiter{
+		rate:    rate,
+		burst:   float64(burst),
+		buckets: make(map[p2p.ID]*tokenBucket),
+		now:     time.Now,
+	}
+}
+
+// allow takes a token from the bucket of the peer, and returns false if there was none left.
+func (l *peerLimiter) allow(peerID p2p.ID) bool {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+
+	now := l.now()
+	b, ok := l.buckets[peerID]
+	if !ok {
+		b = &tokenBucket{tokens: l.burst, last: now}
+		l.buckets[peerID] = b
+	} else {
+		b.tokens = math.Min(l.burst, b.tokens+now.Sub(b.last).Seconds()*l.rate)
+		b.last = now
+	}
+
+	if b.tokens < 1 {
+		return false
+	}
+	b.tokens--
+	return true
+}
+
+// removePeer drops the bucket of the peer.
+func (l *peerLimiter) removePeer(peerID p2p.ID) {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+
+	delete(l.buckets, peerID)
+}
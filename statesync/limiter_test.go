@@ -0,0 +1,34 @@
+package statesync
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Finschia/ostracon/p2p"
+)
+
+func TestPeerLimiter(t *testing.T) {
+	now := time.Now()
+	l := newPeerLimiter(1, 2)
+	l.now = func() time.Time { return now }
+
+	// Each peer has its own burst
+	for _, peerID := range []p2p.ID{"a", "b"} {
+		assert.True(t, l.allow(peerID))
+		assert.True(t, l.allow(peerID))
+		assert.False(t, l.allow(peerID))
+	}
+
+	// The buckets are refilled at the rate
+	now = now.Add(time.Second)
+	assert.True(t, l.allow("a"))
+	assert.False(t, l.allow("a"))
+
+	// and a removed peer starts over
+	l.removePeer("b")
+	assert.True(t, l.allow("b"))
+	assert.True(t, l.allow("b"))
+	assert.False(t, l.allow("b"))
+}
@@ -7,6 +7,8 @@ import (
 	"github.com/gogo/protobuf/proto"
 
 	ssproto "github.com/tendermint/tendermint/proto/tendermint/statesync"
+
+	ocssproto "github.com/Finschia/ostracon/proto/ostracon/statesync"
 )
 
 const (
@@ -14,6 +16,8 @@ const (
 	snapshotMsgSize = int(4e6)
 	// chunkMsgSize is the maximum size of a chunkResponseMessage
 	chunkMsgSize = int(16e6)
+	// lightBlockMsgSize is the maximum size of a lightBlockResponseMessage
+	lightBlockMsgSize = int(1e7)
 )
 
 // validateMsg validates a message.
@@ -47,6 +51,22 @@ func validateMsg(pb proto.Message) error {
 		if msg.Chunks == 0 {
 			return errors.New("snapshot has no chunks")
 		}
+	case *ocssproto.LightBlockRequest:
+	case *ocssproto.LightBlockResponse:
+		if msg.LightBlock != nil && msg.Entropy == nil {
+			return errors.New("light block has no entropy")
+		}
+		if msg.LightBlock == nil && msg.Entropy != nil {
+			return errors.New("missing light block cannot have entropy")
+		}
+	case *ocssproto.ParamsRequest:
+		if msg.Height == 0 {
+			return errors.New("height cannot be 0")
+		}
+	case *ocssproto.ParamsResponse:
+		if msg.Height == 0 {
+			return errors.New("height cannot be 0")
+		}
 	default:
 		return fmt.Errorf("unknown message type %T", msg)
 	}
@@ -9,7 +9,9 @@ import (
 
 	"github.com/tendermint/tendermint/p2p"
 	ssproto "github.com/tendermint/tendermint/proto/tendermint/statesync"
+	tmtypes "github.com/tendermint/tendermint/proto/tendermint/types"
 
+	ocssproto "github.com/Finschia/ostracon/proto/ostracon/statesync"
 	tmproto "github.com/Finschia/ostracon/proto/ostracon/types"
 )
 
@@ -71,6 +73,29 @@ func TestValidateMsg(t *testing.T) {
 		"SnapshotsResponse no hash": {
 			&ssproto.SnapshotsResponse{Height: 1, Format: 1, Chunks: 2, Hash: []byte{}},
 			false},
+
+		"LightBlockRequest valid":    {&ocssproto.LightBlockRequest{Height: 1}, true},
+		"LightBlockRequest 0 height": {&ocssproto.LightBlockRequest{Height: 0}, true},
+
+		"LightBlockResponse valid": {
+			&ocssproto.LightBlockResponse{Height: 1, LightBlock: &tmtypes.LightBlock{}, Entropy: &tmproto.Entropy{}},
+			true},
+		"LightBlockResponse missing": {&ocssproto.LightBlockResponse{Height: 1}, true},
+		"LightBlockResponse no entropy": {
+			&ocssproto.LightBlockResponse{Height: 1, LightBlock: &tmtypes.LightBlock{}},
+			false},
+		"LightBlockResponse missing with entropy": {
+			&ocssproto.LightBlockResponse{Height: 1, Entropy: &tmproto.Entropy{}},
+			false},
+
+		"ParamsRequest valid":    {&ocssproto.ParamsRequest{Height: 1}, true},
+		"ParamsRequest 0 height": {&ocssproto.ParamsRequest{Height: 0}, false},
+
+		"ParamsResponse valid": {
+			&ocssproto.ParamsResponse{Height: 1, ConsensusParams: &tmtypes.ConsensusParams{}},
+			true},
+		"ParamsResponse missing":  {&ocssproto.ParamsResponse{Height: 1}, true},
+		"ParamsResponse 0 height": {&ocssproto.ParamsResponse{Height: 0}, false},
 	}
 	for name, tc := range testcases {
 		tc := tc
@@ -97,6 +122,10 @@ func TestStateSyncVectors(t *testing.T) {
 		{"SnapshotsResponse", &ssproto.SnapshotsResponse{Height: 1, Format: 2, Chunks: 3, Hash: []byte("chuck hash"), Metadata: []byte("snapshot metadata")}, "1225080110021803220a636875636b20686173682a11736e617073686f74206d65746164617461"},
 		{"ChunkRequest", &ssproto.ChunkRequest{Height: 1, Format: 2, Index: 3}, "1a06080110021803"},
 		{"ChunkResponse", &ssproto.ChunkResponse{Height: 1, Format: 2, Index: 3, Chunk: []byte("it's a chunk")}, "2214080110021803220c697427732061206368756e6b"},
+		{"LightBlockRequest", &ocssproto.LightBlockRequest{Height: 1}, "0a020801"},
+		{"LightBlockResponse", &ocssproto.LightBlockResponse{Height: 1}, "12020801"},
+		{"ParamsRequest", &ocssproto.ParamsRequest{Height: 1}, "1a020801"},
+		{"ParamsResponse", &ocssproto.ParamsResponse{Height: 1}, "22020801"},
 	}
 
 	for _, tc := range testCases {
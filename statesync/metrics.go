@@ -0,0 +1,136 @@
+package statesync
+
+import (
+	"github.com/go-kit/kit/metrics"
+	"github.com/go-kit/kit/metrics/discard"
+	"github.com/go-kit/kit/metrics/prometheus"
+	stdprometheus "github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	// MetricsSubsystem is a subsystem shared by all metrics exposed by this
+	// package.
+	MetricsSubsystem = "statesync"
+)
+
+// Metrics contains metrics exposed by this package.
+type Metrics struct {
+	// Height of the snapshot being restored.
+	SnapshotHeight metrics.Gauge
+	// Number of chunks of the snapshot being restored.
+	SnapshotChunks metrics.Gauge
+	// Number of chunks of the snapshot fetched.
+	ChunksFetched metrics.Gauge
+	// Number of chunks of the snapshot applied to the app.
+	ChunksApplied metrics.Gauge
+	// Number of chunk bytes fetched.
+	ChunkBytes metrics.Counter
+	// Number of chunk requests sent, including the hedged ones.
+	ChunkRequests metrics.Counter
+	// Number of chunk requests hedged to another peer, as the first peer was slow.
+	HedgedChunkRequests metrics.Counter
+	// Number of chunk requests not responded in time.
+	ChunkTimeouts metrics.Counter
+	// Time to receive a chunk after requesting it, in seconds.
+	ChunkLatency metrics.Histogram
+	// Moving average of the bytes per second received from a peer, by peer.
+	PeerThroughput metrics.Gauge
+	// Estimated time to fetch the remaining chunks of the snapshot, in seconds.
+	ETASeconds metrics.Gauge
+}
+
+// PrometheusMetrics returns Metrics build using Prometheus client library.
+// Optionally, labels can be provided along with their values ("foo",
+// "fooValue").
+func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
+	labels := []string{}
+	for i := 0; i < len(labelsAndValues); i += 2 {
+		labels = append(labels, labelsAndValues[i])
+	}
+	return &Metrics{
+		SnapshotHeight: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "snapshot_height",
+			Help:      "Height of the snapshot being restored.",
+		}, labels).With(labelsAndValues...),
+		SnapshotChunks: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "snapshot_chunks",
+			Help:      "Number of chunks of the snapshot being restored.",
+		}, labels).With(labelsAndValues...),
+		ChunksFetched: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "chunks_fetched",
+			Help:      "Number of chunks of the snapshot fetched.",
+		}, labels).With(labelsAndValues...),
+		ChunksApplied: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "chunks_applied",
+			Help:      "Number of chunks of the snapshot applied to the app.",
+		}, labels).With(labelsAndValues...),
+		ChunkBytes: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "chunk_bytes",
+			Help:      "Number of chunk bytes fetched.",
+		}, labels).With(labelsAndValues...),
+		ChunkRequests: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "chunk_requests",
+			Help:      "Number of chunk requests sent, including the hedged ones.",
+		}, labels).With(labelsAndValues...),
+		HedgedChunkRequests: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "hedged_chunk_requests",
+			Help:      "Number of chunk requests hedged to another peer, as the first peer was slow.",
+		}, labels).With(labelsAndValues...),
+		ChunkTimeouts: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "chunk_timeouts",
+			Help:      "Number of chunk requests not responded in time.",
+		}, labels).With(labelsAndValues...),
+		ChunkLatency: prometheus.NewHistogramFrom(stdprometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "chunk_latency",
+			Help:      "Time to receive a chunk after requesting it, in seconds.",
+			Buckets:   stdprometheus.ExponentialBuckets(0.05, 2, 10),
+		}, labels).With(labelsAndValues...),
+		PeerThroughput: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "peer_throughput",
+			Help:      "Moving average of the bytes per second received from a peer, by peer.",
+		}, append(labels, "peer_id")).With(labelsAndValues...),
+		ETASeconds: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "eta_seconds",
+			Help:      "Estimated time to fetch the remaining chunks of the snapshot, in seconds.",
+		}, labels).With(labelsAndValues...),
+	}
+}
+
+// NopMetrics returns no-op Metrics.
+func NopMetrics() *Metrics {
+	return &Metrics{
+		SnapshotHeight:      discard.NewGauge(),
+		SnapshotChunks:      discard.NewGauge(),
+		ChunksFetched:       discard.NewGauge(),
+		ChunksApplied:       discard.NewGauge(),
+		ChunkBytes:          discard.NewCounter(),
+		ChunkRequests:       discard.NewCounter(),
+		HedgedChunkRequests: discard.NewCounter(),
+		ChunkTimeouts:       discard.NewCounter(),
+		ChunkLatency:        discard.NewHistogram(),
+		PeerThroughput:      discard.NewGauge(),
+		ETASeconds:          discard.NewGauge(),
+	}
+}
@@ -1,7 +1,9 @@
 package statesync
 
 import (
+	"context"
 	"errors"
+	"fmt"
 	"sort"
 	"time"
 
@@ -9,10 +11,14 @@ import (
 
 	abci "github.com/tendermint/tendermint/abci/types"
 	ssproto "github.com/tendermint/tendermint/proto/tendermint/statesync"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
+	"github.com/Finschia/ostracon/behaviour"
 	"github.com/Finschia/ostracon/config"
 	tmsync "github.com/Finschia/ostracon/libs/sync"
 	"github.com/Finschia/ostracon/p2p"
+	ocssproto "github.com/Finschia/ostracon/proto/ostracon/statesync"
+	ocproto "github.com/Finschia/ostracon/proto/ostracon/types"
 	"github.com/Finschia/ostracon/proxy"
 	sm "github.com/Finschia/ostracon/state"
 	"github.com/Finschia/ostracon/types"
@@ -23,8 +29,19 @@ const (
 	SnapshotChannel = byte(0x60)
 	// ChunkChannel exchanges chunk contents
 	ChunkChannel = byte(0x61)
+	// LightBlockChannel exchanges light blocks and consensus params
+	LightBlockChannel = byte(0x62)
 	// recentSnapshots is the number of recent snapshots to send and receive per peer.
 	recentSnapshots = 10
+	// maxLightBlockPeers is the maximum number of peers the light client of the P2P state provider
+	// fetches light blocks from, as it cross-checks them with every witness.
+	maxLightBlockPeers = 5
+	// lightBlockPeersInterval is how often to check whether enough peers serve light blocks.
+	lightBlockPeersInterval = 100 * time.Millisecond
+	// lightBlockRequestRate is the number of light block and consensus params requests per second
+	// served per peer, with bursts of up to lightBlockRequestBurst requests. The others are dropped.
+	lightBlockRequestRate  = 10
+	lightBlockRequestBurst = 20
 )
 
 // Reactor handles state sync, both restoring snapshots for the local node and serving snapshots
@@ -36,6 +53,19 @@ type Reactor struct {
 	conn      proxy.AppConnSnapshot
 	connQuery proxy.AppConnQuery
 	tempDir   string
+	reporter  behaviour.Reporter
+	metrics   *Metrics
+
+	// The progress of a restoration is persisted to the resume file, if set, to resume it after
+	// a restart.
+	resumeFile string
+
+	// The stores serve the light blocks and consensus params requested by peers, and the
+	// dispatcher routes the responses to the requests of the P2P state provider.
+	stateStore sm.Store
+	blockStore sm.BlockStore
+	dispatcher *dispatcher
+	limiter    *peerLimiter
 
 	// This will only be set when a state sync is in progress. It is used to feed received
 	// snapshots and chunks into the sync.
@@ -43,22 +73,49 @@ type Reactor struct {
 	syncer *syncer
 }
 
+// ReactorOption sets an optional parameter on the Reactor.
+type ReactorOption func(*Reactor)
+
+// ReactorMetrics sets the metrics of the state syncs run by the reactor.
+func ReactorMetrics(metrics *Metrics) ReactorOption {
+	return func(r *Reactor) { r.metrics = metrics }
+}
+
+// ReactorResumeFile sets the file the progress of a restoration is persisted to, so that it's
+// resumed if the node restarts before it completes and the app supports it. See
+// ResumableRestoreInfo and config.StateSyncConfig.ResumeRestoration.
+func ReactorResumeFile(path string) ReactorOption {
+	return func(r *Reactor) { r.resumeFile = path }
+}
+
 // NewReactor creates a new state sync reactor.
 func NewReactor(
 	cfg config.StateSyncConfig,
 	conn proxy.AppConnSnapshot,
 	connQuery proxy.AppConnQuery,
+	stateStore sm.Store,
+	blockStore sm.BlockStore,
 	async bool,
 	recvBufSize int,
+	options ...ReactorOption,
 ) *Reactor {
 
 	r := &Reactor{
-		cfg:       cfg,
-		conn:      conn,
-		connQuery: connQuery,
+		cfg:        cfg,
+		conn:       conn,
+		connQuery:  connQuery,
+		stateStore: stateStore,
+		blockStore: blockStore,
+		dispatcher: newDispatcher(),
+		limiter:    newPeerLimiter(lightBlockRequestRate, lightBlockRequestBurst),
+		metrics:    NopMetrics(),
 	}
 	r.BaseReactor = *p2p.NewBaseReactor("StateSync", r, async, recvBufSize)
 
+	for _, option := range options {
+		option(r)
+	}
+
 	return r
 }
 
@@ -79,11 +136,28 @@ func (r *Reactor) GetChannels() []*p2p.ChannelDescriptor {
 			RecvMessageCapacity: chunkMsgSize,
 			MessageType:         &ssproto.Message{},
 		},
+		{
+			ID:                  LightBlockChannel,
+			Priority:            5,
+			SendQueueCapacity:   10,
+			RecvMessageCapacity: lightBlockMsgSize,
+			MessageType:         &ocssproto.Message{},
+		},
 	}
 }
 
+// SetReporter sets the reporter the peer behaviours are reported to.
+// It must be called before the reactor is started.
+func (r *Reactor) SetReporter(reporter behaviour.Reporter) {
+	r.reporter = reporter
+}
+
 // OnStart implements p2p.Reactor.
 func (r *Reactor) OnStart() error {
+	if r.reporter == nil {
+		r.reporter = behaviour.NewSwitchReporter(r.Switch)
+	}
+
 	// call BaseReactor's OnStart()
 	err := r.BaseReactor.OnStart()
 	if err != nil {
@@ -103,6 +177,9 @@ func (r *Reactor) AddPeer(peer p2p.Peer) {
 
 // RemovePeer implements p2p.Reactor.
 func (r *Reactor) RemovePeer(peer p2p.Peer, reason interface{}) {
+	r.dispatcher.removePeer(peer.ID())
+	r.limiter.removePeer(peer.ID())
+
 	r.mtx.RLock()
 	defer r.mtx.RUnlock()
 	if r.syncer != nil {
@@ -119,7 +196,9 @@ func (r *Reactor) ReceiveEnvelope(e p2p.Envelope) {
 	err := validateMsg(e.Message)
 	if err != nil {
 		r.Logger.Error("Invalid message", "peer", e.Src, "msg", e.Message, "err", err)
-		r.Switch.StopPeerForError(e.Src, err)
+		if err := r.reporter.Report(behaviour.BadMessage(e.Src.ID(), err.Error())); err != nil {
+			r.Logger.Debug("Failed to report peer behaviour", "err", err)
+		}
 		return
 	}
 
@@ -227,13 +306,65 @@ func (r *Reactor) ReceiveEnvelope(e p2p.Envelope) {
 			r.Logger.Error("Received unknown message %T", msg)
 		}
 
+	case LightBlockChannel:
+		switch msg := e.Message.(type) {
+		case *ocssproto.LightBlockRequest:
+			if r.overRateLimit(e) {
+				return
+			}
+			r.Logger.Debug("Received light block request", "height", msg.Height, "peer", e.Src.ID())
+			resp := &ocssproto.LightBlockResponse{Height: msg.Height}
+			height := int64(msg.Height)
+			if height == 0 {
+				height = r.blockStore.Height()
+			}
+			lightBlock, entropy, err := r.loadLightBlock(height)
+			if err != nil {
+				r.Logger.Debug("Failed to load light block", "height", height, "err", err)
+			} else {
+				resp.LightBlock, resp.Entropy = lightBlock, entropy
+			}
+			p2p.SendEnvelopeShim(e.Src, p2p.Envelope{ //nolint: staticcheck
+				ChannelID: LightBlockChannel,
+				Message:   resp,
+			}, r.Logger)
+
+		case *ocssproto.ParamsRequest:
+			if r.overRateLimit(e) {
+				return
+			}
+			r.Logger.Debug("Received consensus params request", "height", msg.Height, "peer", e.Src.ID())
+			resp := &ocssproto.ParamsResponse{Height: msg.Height}
+			params, err := r.stateStore.LoadConsensusParams(int64(msg.Height))
+			if err != nil {
+				r.Logger.Debug("Failed to load consensus params", "height", msg.Height, "err", err)
+			} else {
+				resp.ConsensusParams = &params
+			}
+			p2p.SendEnvelopeShim(e.Src, p2p.Envelope{ //nolint: staticcheck
+				ChannelID: LightBlockChannel,
+				Message:   resp,
+			}, r.Logger)
+
+		case *ocssproto.LightBlockResponse, *ocssproto.ParamsResponse:
+			if err := r.dispatcher.respond(e.Src.ID(), msg); err != nil {
+				r.Logger.Debug("Failed to dispatch response", "peer", e.Src.ID(), "err", err)
+			}
+
+		default:
+			r.Logger.Error("Received unknown message %T", msg)
+		}
+
 	default:
 		r.Logger.Error("Received message on invalid channel %x", e.ChannelID)
 	}
 }
 
 func (r *Reactor) Receive(chID byte, peer p2p.Peer, msgBytes []byte) {
-	msg := &ssproto.Message{}
+	var msg p2p.Unwrapper = &ssproto.Message{}
+	if chID == LightBlockChannel {
+		msg = &ocssproto.Message{}
+	}
 	err := proto.Unmarshal(msgBytes, msg)
 	if err != nil {
 		panic(err)
@@ -284,6 +415,94 @@ func (r *Reactor) recentSnapshots(n uint32) ([]*snapshot, error) {
 	return snapshots, nil
 }
 
+// waitForLightBlockPeers waits until at least n peers serve light blocks, and returns up to
+// maxLightBlockPeers of them.
+func (r *Reactor) waitForLightBlockPeers(ctx context.Context, n int) ([]p2p.Peer, error) {
+	ticker := time.NewTicker(lightBlockPeersInterval)
+	defer ticker.Stop()
+	for {
+		var peers []p2p.Peer
+		for _, peer := range r.Switch.Peers().List() {
+			if ni, ok := peer.NodeInfo().(p2p.DefaultNodeInfo); ok && ni.HasChannel(LightBlockChannel) {
+				peers = append(peers, peer)
+			}
+			if len(peers) == maxLightBlockPeers {
+				break
+			}
+		}
+		if len(peers) >= n {
+			return peers, nil
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return nil, fmt.Errorf("waiting for %v peers serving light blocks, got %v: %w", n, len(peers), ctx.Err())
+		}
+	}
+}
+
+// overRateLimit returns whether the request is over the rate limit of its peer, and then drops it.
+func (r *Reactor) overRateLimit(e p2p.Envelope) bool {
+	if r.limiter.allow(e.Src.ID()) {
+		return false
+	}
+	r.Logger.Debug("Dropping request over the rate limit", "msg", e.Message, "peer", e.Src.ID())
+	return true
+}
+
+// loadLightBlock loads the light block at the given height, with the entropy of its block.
+func (r *Reactor) loadLightBlock(height int64) (*tmproto.LightBlock, *ocproto.Entropy, error) {
+	lightBlock, err := loadLightBlock(r.stateStore, r.blockStore, height)
+	if err != nil {
+		return nil, nil, err
+	}
+	entropy := r.blockStore.LoadBlockEntropy(height)
+	if entropy == nil {
+		return nil, nil, fmt.Errorf("no block at height %v", height)
+	}
+	pb, err := lightBlock.ToProto()
+	if err != nil {
+		return nil, nil, err
+	}
+	return pb, entropy.ToProto(), nil
+}
+
+// loadLightBlock loads the light block at the given height from the stores.
+func loadLightBlock(stateStore sm.Store, blockStore sm.BlockStore, height int64) (*types.LightBlock, error) {
+	meta := blockStore.LoadBlockMeta(height)
+	if meta == nil {
+		return nil, fmt.Errorf("no block at height %v", height)
+	}
+	commit := blockStore.LoadBlockCommit(height)
+	if commit == nil {
+		commit = blockStore.LoadSeenCommit(height)
+	}
+	if commit == nil {
+		return nil, fmt.Errorf("no commit at height %v", height)
+	}
+	vals, err := stateStore.LoadValidators(height)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load validators at height %v: %w", height, err)
+	}
+
+	return &types.LightBlock{
+		SignedHeader: &types.SignedHeader{Header: &meta.Header, Commit: commit},
+		ValidatorSet: vals,
+	}, nil
+}
+
+// Progress returns the progress of the state sync in progress, or nil if there is none or it's
+// still discovering snapshots.
+func (r *Reactor) Progress() *Progress {
+	r.mtx.RLock()
+	defer r.mtx.RUnlock()
+	if r.syncer == nil {
+		return nil
+	}
+	return r.syncer.Progress()
+}
+
 // Sync runs a state sync, returning the new state, previous state and last commit at the snapshot height.
 // The caller must store the state and commit in the state database and block store.
 func (r *Reactor) Sync(
@@ -293,7 +512,9 @@ func (r *Reactor) Sync(
 		r.mtx.Unlock()
 		return sm.State{}, sm.State{}, nil, errors.New("a state sync is already in progress")
 	}
-	r.syncer = newSyncer(r.cfg, r.Logger, r.conn, r.connQuery, stateProvider, r.tempDir)
+	r.syncer = newSyncer(r.cfg, r.Logger, r.reporter, r.conn, r.connQuery, stateProvider, r.tempDir,
+		r.metrics)
+	r.syncer.resumeFile = r.resumeFile
 	r.mtx.Unlock()
 
 	hook := func() {
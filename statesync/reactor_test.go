@@ -85,7 +85,7 @@ func TestReactor_Receive_ChunkRequest(t *testing.T) {
 
 			// Start a reactor and send a ssproto.ChunkRequest, then wait for and check response
 			cfg := config.DefaultStateSyncConfig()
-			r := NewReactor(*cfg, conn, nil, true, 1000)
+			r := NewReactor(*cfg, conn, nil, nil, nil, true, 1000)
 			err := r.Start()
 			require.NoError(t, err)
 			t.Cleanup(func() {
@@ -176,7 +176,7 @@ func TestReactor_Receive_SnapshotsRequest(t *testing.T) {
 
 			// Start a reactor and send a SnapshotsRequestMessage, then wait for and check responses
 			cfg := config.DefaultStateSyncConfig()
-			r := NewReactor(*cfg, conn, nil, true, 1000)
+			r := NewReactor(*cfg, conn, nil, nil, nil, true, 1000)
 			err := r.Start()
 			require.NoError(t, err)
 			t.Cleanup(func() {
@@ -203,7 +203,7 @@ func TestReactor_Receive_SnapshotsRequest(t *testing.T) {
 func TestLegacyReactorReceiveBasic(t *testing.T) {
 	cfg := config.DefaultStateSyncConfig()
 	conn := &proxymocks.AppConnSnapshot{}
-	reactor := NewReactor(*cfg, conn, nil, true, 1000)
+	reactor := NewReactor(*cfg, conn, nil, nil, nil, true, 1000)
 	peer := p2p.CreateRandomPeer(false)
 
 	reactor.InitPeer(peer)
@@ -233,7 +233,7 @@ func makeTestStateSyncReactor(
 	initSwitch := func(i int, s *p2p.Switch, p2pConfig *config.P2PConfig) *p2p.Switch {
 		logger := log.TestingLogger()
 		cfg := config.DefaultStateSyncConfig()
-		reactors[i] = NewReactor(*cfg, connSnapshot, connQuery, true, 1000)
+		reactors[i] = NewReactor(*cfg, connSnapshot, connQuery, nil, nil, true, 1000)
 		reactors[i].SetLogger(logger)
 		reactors[i].SetSwitch(s)
 
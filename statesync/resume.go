@@ -0,0 +1,232 @@
+package statesync
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/Finschia/ostracon/libs/tempfile"
+	ocssproto "github.com/Finschia/ostracon/proto/ostracon/statesync"
+	"github.com/Finschia/ostracon/proxy"
+	sm "github.com/Finschia/ostracon/state"
+	"github.com/Finschia/ostracon/types"
+)
+
+// ResumeFileName is the name of the file the progress of a restoration is persisted to, in the
+// data directory of the node.
+const ResumeFileName = "statesync_progress.pb"
+
+// ResumableRestoreInfo is the token an app reports among the whitespace-separated fields of the
+// data of its Info response while it's restoring a snapshot, if it keeps the chunks it applied
+// across restarts, e.g. "myapp statesync:resumable". If the node restarts before the restoration
+// completes, it then offers the same snapshot to the app again and applies the chunks following
+// the ones applied, rather than starting over.
+const ResumableRestoreInfo = "statesync:resumable"
+
+// restoreProgress is the progress of a snapshot restoration, persisted so that a node restarted
+// before it completes can resume it: the snapshot, with the app hash, states and commit verified
+// by the state provider, and the chunks applied.
+type restoreProgress struct {
+	snapshot      *snapshot
+	state         sm.State
+	previousState sm.State
+	commit        *types.Commit
+	applied       map[uint32]bool
+}
+
+func newRestoreProgress(snapshot *snapshot, state, previousState sm.State, commit *types.Commit) *restoreProgress {
+	return &restoreProgress{
+		snapshot:      snapshot,
+		state:         state,
+		previousState: previousState,
+		commit:        commit,
+		applied:       make(map[uint32]bool),
+	}
+}
+
+// loadRestoreProgress loads the restore progress from the file, or returns nil if there is none.
+func loadRestoreProgress(path string) (*restoreProgress, error) {
+	bz, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	pb := &ocssproto.RestoreProgress{}
+	if err := pb.Unmarshal(bz); err != nil {
+		return nil, err
+	}
+	if pb.Snapshot == nil {
+		return nil, errors.New("no snapshot")
+	}
+	progress := &restoreProgress{
+		snapshot: &snapshot{
+			Height:         pb.Snapshot.Height,
+			Format:         pb.Snapshot.Format,
+			Chunks:         pb.Snapshot.Chunks,
+			Hash:           pb.Snapshot.Hash,
+			Metadata:       pb.Snapshot.Metadata,
+			trustedAppHash: pb.TrustedAppHash,
+		},
+		applied: make(map[uint32]bool, len(pb.AppliedChunks)),
+	}
+	state, err := sm.FromProto(&pb.State)
+	if err != nil {
+		return nil, fmt.Errorf("invalid state: %w", err)
+	}
+	progress.state = *state
+	// the previous state is empty for a snapshot at height 1
+	if pb.PreviousState.LastBlockHeight > 0 {
+		previousState, err := sm.FromProto(&pb.PreviousState)
+		if err != nil {
+			return nil, fmt.Errorf("invalid previous state: %w", err)
+		}
+		progress.previousState = *previousState
+	}
+	if progress.commit, err = types.CommitFromProto(pb.Commit); err != nil {
+		return nil, fmt.Errorf("invalid commit: %w", err)
+	}
+	for _, index := range pb.AppliedChunks {
+		if index >= progress.snapshot.Chunks {
+			return nil, fmt.Errorf("invalid applied chunk %v", index)
+		}
+		progress.applied[index] = true
+	}
+	return progress, nil
+}
+
+// save writes the restore progress to the file, replacing it atomically.
+func (p *restoreProgress) save(path string) error {
+	pb := &ocssproto.RestoreProgress{
+		Snapshot: &abci.Snapshot{
+			Height:   p.snapshot.Height,
+			Format:   p.snapshot.Format,
+			Chunks:   p.snapshot.Chunks,
+			Hash:     p.snapshot.Hash,
+			Metadata: p.snapshot.Metadata,
+		},
+		TrustedAppHash: p.snapshot.trustedAppHash,
+		Commit:         p.commit.ToProto(),
+		AppliedChunks:  make([]uint32, 0, len(p.applied)),
+	}
+	state, err := p.state.ToProto()
+	if err != nil {
+		return err
+	}
+	pb.State = *state
+	if !p.previousState.IsEmpty() {
+		previousState, err := p.previousState.ToProto()
+		if err != nil {
+			return err
+		}
+		pb.PreviousState = *previousState
+	}
+	for index := range p.applied {
+		pb.AppliedChunks = append(pb.AppliedChunks, index)
+	}
+	sort.Slice(pb.AppliedChunks, func(i, j int) bool {
+		return pb.AppliedChunks[i] < pb.AppliedChunks[j]
+	})
+
+	bz, err := pb.Marshal()
+	if err != nil {
+		return err
+	}
+	return tempfile.WriteFileAtomic(path, bz, 0o600)
+}
+
+// loadResumable loads the progress of the restoration interrupted by a restart, if the app can
+// resume it. Otherwise the progress is discarded, and the restoration starts over.
+func (s *syncer) loadResumable() *restoreProgress {
+	if s.resumeFile == "" {
+		return nil
+	}
+	progress, err := loadRestoreProgress(s.resumeFile)
+	if err != nil {
+		s.logger.Error("Failed to load restore progress, starting over", "err", err)
+		s.removeRestoreProgress()
+		return nil
+	}
+	if progress == nil {
+		return nil
+	}
+
+	resp, err := s.connQuery.InfoSync(proxy.RequestInfo)
+	if err != nil {
+		s.logger.Error("Failed to query app for partial restore support, starting over", "err", err)
+		s.removeRestoreProgress()
+		return nil
+	}
+	if !resumableRestoreInfo(resp.Data) {
+		s.logger.Info("App doesn't support resuming the restoration of a snapshot, starting over",
+			"height", progress.snapshot.Height, "format", progress.snapshot.Format)
+		s.removeRestoreProgress()
+		return nil
+	}
+	s.logger.Info("Resuming restoration of snapshot", "height", progress.snapshot.Height,
+		"format", progress.snapshot.Format, "hash", progress.snapshot.Hash,
+		"applied", len(progress.applied), "total", progress.snapshot.Chunks)
+	return progress
+}
+
+// resumableRestoreInfo returns whether the data of the Info response of the app has the
+// ResumableRestoreInfo token.
+func resumableRestoreInfo(data string) bool {
+	for _, field := range strings.Fields(data) {
+		if field == ResumableRestoreInfo {
+			return true
+		}
+	}
+	return false
+}
+
+// discovered returns the snapshot of the pool matching the given one, or nil if there is none.
+func (s *syncer) discovered(snapshot *snapshot) *snapshot {
+	key := snapshot.Key()
+	for _, candidate := range s.snapshots.Ranked() {
+		if candidate.Key() == key {
+			return candidate
+		}
+	}
+	return nil
+}
+
+// setApplied records whether the chunk was applied to the app in the restore progress, and
+// persists it.
+func (s *syncer) setApplied(index uint32, applied bool) {
+	if s.restore == nil || s.restore.applied[index] == applied {
+		return
+	}
+	if applied {
+		s.restore.applied[index] = true
+	} else {
+		delete(s.restore.applied, index)
+	}
+	s.saveRestoreProgress()
+}
+
+// saveRestoreProgress persists the restore progress, if enabled. A failure is logged, as the
+// restoration goes on without being resumable.
+func (s *syncer) saveRestoreProgress() {
+	if s.resumeFile == "" || s.restore == nil {
+		return
+	}
+	if err := s.restore.save(s.resumeFile); err != nil {
+		s.logger.Error("Failed to save restore progress", "err", err)
+	}
+}
+
+// removeRestoreProgress removes the persisted restore progress, if any.
+func (s *syncer) removeRestoreProgress() {
+	if s.resumeFile == "" {
+		return
+	}
+	if err := os.Remove(s.resumeFile); err != nil && !errors.Is(err, os.ErrNotExist) {
+		s.logger.Error("Failed to remove restore progress", "err", err)
+	}
+}
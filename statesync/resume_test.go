@@ -0,0 +1,165 @@
+package statesync
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	ssproto "github.com/tendermint/tendermint/proto/tendermint/statesync"
+
+	"github.com/Finschia/ostracon/behaviour"
+	"github.com/Finschia/ostracon/config"
+	"github.com/Finschia/ostracon/libs/log"
+	tmsync "github.com/Finschia/ostracon/libs/sync"
+	"github.com/Finschia/ostracon/p2p"
+	p2pmocks "github.com/Finschia/ostracon/p2p/mocks"
+	"github.com/Finschia/ostracon/proxy"
+	proxymocks "github.com/Finschia/ostracon/proxy/mocks"
+	sm "github.com/Finschia/ostracon/state"
+	"github.com/Finschia/ostracon/statesync/mocks"
+)
+
+// makeRestoreProgress returns the progress of the restoration of a snapshot at height 3, with
+// the states and commit of a chain.
+func makeRestoreProgress(t *testing.T, applied ...uint32) *restoreProgress {
+	_, stateStore, blockStore := makeArchiveChain(t, 3)
+	state, err := stateStore.Load()
+	require.NoError(t, err)
+	previousState := state.Copy()
+	previousState.LastBlockHeight--
+
+	progress := newRestoreProgress(
+		&snapshot{Height: 3, Format: 1, Chunks: 3, Hash: []byte{3}, trustedAppHash: state.AppHash},
+		state, previousState, blockStore.LoadSeenCommit(3))
+	for _, index := range applied {
+		progress.applied[index] = true
+	}
+	return progress
+}
+
+func TestRestoreProgress_SaveLoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), ResumeFileName)
+	progress, err := loadRestoreProgress(path)
+	require.NoError(t, err)
+	assert.Nil(t, progress)
+
+	expected := makeRestoreProgress(t, 0, 2)
+	require.NoError(t, expected.save(path))
+	progress, err = loadRestoreProgress(path)
+	require.NoError(t, err)
+	assert.Equal(t, expected.snapshot, progress.snapshot)
+	assert.True(t, expected.state.Equals(progress.state))
+	assert.True(t, expected.previousState.Equals(progress.previousState))
+	assert.Equal(t, expected.commit.Hash(), progress.commit.Hash())
+	assert.Equal(t, expected.applied, progress.applied)
+
+	// the previous state of a snapshot at height 1 is empty
+	expected.previousState = sm.State{}
+	require.NoError(t, expected.save(path))
+	progress, err = loadRestoreProgress(path)
+	require.NoError(t, err)
+	assert.True(t, progress.previousState.IsEmpty())
+
+	expected.applied[3] = true
+	require.NoError(t, expected.save(path))
+	_, err = loadRestoreProgress(path)
+	assert.Error(t, err)
+
+	require.NoError(t, os.WriteFile(path, []byte("invalid"), 0o600))
+	_, err = loadRestoreProgress(path)
+	assert.Error(t, err)
+}
+
+func TestSyncer_SyncAny_resume(t *testing.T) {
+	progress := makeRestoreProgress(t, 0)
+	chunks := [][]byte{{3, 0}, {3, 1}, {3, 2}}
+
+	testcases := map[string]struct {
+		infoData    string
+		offerResult abci.ResponseOfferSnapshot_Result
+		resumed     bool
+	}{
+		"resumed":     {"myapp " + ResumableRestoreInfo, abci.ResponseOfferSnapshot_ACCEPT, true},
+		"unsupported": {"myapp", abci.ResponseOfferSnapshot_UNKNOWN, false},
+		"conflicting": {ResumableRestoreInfo + "2", abci.ResponseOfferSnapshot_UNKNOWN, false},
+		"rejected":    {ResumableRestoreInfo, abci.ResponseOfferSnapshot_REJECT, false},
+	}
+	for name, tc := range testcases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			// the state provider isn't called when resuming, but when starting over
+			stateProvider := &mocks.StateProvider{}
+			if !tc.resumed {
+				stateProvider.On("AppHash", mock.Anything, uint64(3)).Return(nil, errRejectSnapshot)
+			}
+			connSnapshot := &proxymocks.AppConnSnapshot{}
+			connQuery := &proxymocks.AppConnQuery{}
+			connQuery.On("InfoSync", proxy.RequestInfo).Return(&abci.ResponseInfo{
+				Data:             tc.infoData,
+				AppVersion:       progress.state.Version.Consensus.App,
+				LastBlockHeight:  3,
+				LastBlockAppHash: progress.state.AppHash,
+			}, nil)
+
+			cfg := config.DefaultStateSyncConfig()
+			syncer := newSyncer(*cfg, log.NewNopLogger(), behaviour.NewMockReporter(), connSnapshot, connQuery,
+				stateProvider, "", NopMetrics())
+			syncer.resumeFile = filepath.Join(t.TempDir(), ResumeFileName)
+			require.NoError(t, progress.save(syncer.resumeFile))
+
+			chunkRequests := make(map[uint32]int)
+			chunkRequestsMtx := tmsync.Mutex{}
+			peer := &Peer{Peer: &p2pmocks.Peer{}, EnvelopeSender: &p2pmocks.EnvelopeSender{}}
+			peer.Peer.On("ID").Return(p2p.ID("a"))
+			peer.EnvelopeSender.On("SendEnvelope", mock.Anything).Maybe().Run(func(args mock.Arguments) {
+				index := args[0].(p2p.Envelope).Message.(*ssproto.ChunkRequest).Index
+				chunkRequestsMtx.Lock()
+				chunkRequests[index]++
+				chunkRequestsMtx.Unlock()
+				_, err := syncer.AddChunk(&chunk{Height: 3, Format: 1, Index: index, Chunk: chunks[index], Sender: "a"})
+				require.NoError(t, err)
+			}).Return(true)
+			_, err := syncer.AddSnapshot(peer, &snapshot{Height: 3, Format: 1, Chunks: 3, Hash: []byte{3}})
+			require.NoError(t, err)
+
+			// the app supporting it is offered the snapshot again, and only the chunks not applied are
+			// fetched if it accepts it
+			if tc.offerResult != abci.ResponseOfferSnapshot_UNKNOWN {
+				connSnapshot.On("OfferSnapshotSync", abci.RequestOfferSnapshot{
+					Snapshot: &abci.Snapshot{Height: 3, Format: 1, Chunks: 3, Hash: []byte{3}},
+					AppHash:  progress.state.AppHash,
+				}).Once().Return(&abci.ResponseOfferSnapshot{Result: tc.offerResult}, nil)
+			}
+			if tc.resumed {
+				for _, index := range []uint32{1, 2} {
+					connSnapshot.On("ApplySnapshotChunkSync", abci.RequestApplySnapshotChunk{
+						Index: index, Chunk: chunks[index], Sender: "a",
+					}).Once().Return(&abci.ResponseApplySnapshotChunk{Result: abci.ResponseApplySnapshotChunk_ACCEPT}, nil)
+				}
+			}
+
+			state, previousState, commit, err := syncer.SyncAny(0, func() {})
+			assert.NoFileExists(t, syncer.resumeFile)
+			stateProvider.AssertExpectations(t)
+			connSnapshot.AssertExpectations(t)
+			if !tc.resumed {
+				require.Equal(t, errNoSnapshots, err)
+				return
+			}
+			require.NoError(t, err)
+			chunkRequestsMtx.Lock()
+			assert.Zero(t, chunkRequests[0])
+			assert.Positive(t, chunkRequests[1])
+			assert.Positive(t, chunkRequests[2])
+			chunkRequestsMtx.Unlock()
+			assert.True(t, progress.state.Equals(state))
+			assert.True(t, progress.previousState.Equals(previousState))
+			assert.Equal(t, progress.commit.Hash(), commit.Hash())
+		})
+	}
+}
@@ -0,0 +1,246 @@
+package statesync
+
+import (
+	"sort"
+	"time"
+
+	tmsync "github.com/Finschia/ostracon/libs/sync"
+	"github.com/Finschia/ostracon/p2p"
+)
+
+const (
+	// chunkStatsWeight is the weight of a new sample in the moving averages of the latency and
+	// throughput of a peer.
+	chunkStatsWeight = 0.2
+	// hedgeFactor is how many times its expected latency a chunk request waits for a peer before
+	// it's hedged to another peer.
+	hedgeFactor = 3
+	// minHedgeDelay is the minimum time a chunk request waits before it's hedged to another peer.
+	minHedgeDelay = time.Second
+)
+
+// PeerProgress is what the chunk scheduler measured of a peer.
+type PeerProgress struct {
+	ID         p2p.ID
+	Latency    time.Duration // moving average of the time to receive a chunk
+	Throughput float64       // moving average of the bytes received per second
+	InFlight   int           // chunk requests waiting for a response
+	Received   int           // chunks received in response to a request
+	Timeouts   int           // chunk requests not responded in time
+}
+
+// chunkRequest is a pending chunk request to a peer.
+type chunkRequest struct {
+	peer  p2p.ID
+	index uint32
+}
+
+// chunkScheduler tracks the latency and throughput of the peers serving chunks, and assigns the
+// chunk requests to the peers expected to respond first.
+type chunkScheduler struct {
+	tmsync.Mutex
+	timeout   time.Duration
+	peers     map[p2p.ID]*PeerProgress
+	requests  map[chunkRequest]time.Time
+	chunkSize float64 // moving average of the size of the chunks received
+}
+
+func newChunkScheduler(timeout time.Duration) *chunkScheduler {
+	return &chunkScheduler{
+		timeout:  timeout,
+		peers:    make(map[p2p.ID]*PeerProgress),
+		requests: make(map[chunkRequest]time.Time),
+	}
+}
+
+// Pick returns the peer expected to respond first to a chunk request, given its latency, its
+// throughput and the requests in flight, or nil if there are no peers but the excluded one. See
+// expectedWait.
+func (s *chunkScheduler) Pick(peers []p2p.Peer, exclude p2p.ID) p2p.Peer {
+	s.Lock()
+	defer s.Unlock()
+
+	var (
+		meanLatency time.Duration
+		measured    int
+	)
+	for _, peer := range peers {
+		if stats := s.peers[peer.ID()]; stats != nil && stats.Received > 0 {
+			meanLatency += stats.Latency
+			measured++
+		}
+	}
+	if measured > 0 {
+		meanLatency /= time.Duration(measured)
+	}
+
+	var (
+		best         p2p.Peer
+		bestWait     time.Duration
+		bestInFlight int
+	)
+	for _, peer := range peers {
+		if peer.ID() == exclude {
+			continue
+		}
+		wait, inFlight := meanLatency, 0
+		if stats := s.peers[peer.ID()]; stats != nil {
+			wait, inFlight = s.expectedWait(stats, meanLatency), stats.InFlight
+		}
+		if best == nil || wait < bestWait || (wait == bestWait && inFlight < bestInFlight) {
+			best, bestWait, bestInFlight = peer, wait, inFlight
+		}
+	}
+	return best
+}
+
+// expectedWait returns how long a new chunk request to the peer is expected to wait for the
+// chunk: the latency of the peer, after the chunks requested before are transferred at its
+// throughput. The latency of a peer which didn't respond yet is assumed to be the mean latency of
+// the others, so that it's tried, unless its requests timed out: it's then backed off by the
+// timeout for each of them.
+func (s *chunkScheduler) expectedWait(stats *PeerProgress, meanLatency time.Duration) time.Duration {
+	latency := meanLatency
+	switch {
+	case stats.Received > 0:
+		latency = stats.Latency
+	case stats.Timeouts > 0:
+		latency = time.Duration(stats.Timeouts) * s.timeout
+	}
+	transfer := latency
+	if stats.Throughput > 0 && s.chunkSize > 0 {
+		transfer = time.Duration(s.chunkSize / stats.Throughput * float64(time.Second))
+	}
+	return latency + time.Duration(stats.InFlight)*transfer
+}
+
+// Requested records a chunk request sent to the peer.
+func (s *chunkScheduler) Requested(peerID p2p.ID, index uint32) {
+	s.Lock()
+	defer s.Unlock()
+	key := chunkRequest{peer: peerID, index: index}
+	if _, ok := s.requests[key]; ok {
+		return
+	}
+	s.requests[key] = time.Now()
+	s.stats(peerID).InFlight++
+}
+
+// Received records a chunk of the given size received from the peer. It returns the time since
+// the chunk was requested and the new throughput of the peer, or false if the chunk wasn't
+// requested from the peer.
+func (s *chunkScheduler) Received(peerID p2p.ID, index uint32, size int) (time.Duration, float64, bool) {
+	s.Lock()
+	defer s.Unlock()
+	key := chunkRequest{peer: peerID, index: index}
+	requested, ok := s.requests[key]
+	if !ok {
+		return 0, 0, false
+	}
+	delete(s.requests, key)
+
+	latency := time.Since(requested)
+	stats := s.stats(peerID)
+	stats.InFlight--
+	if stats.Received == 0 {
+		stats.Latency = latency
+	} else {
+		stats.Latency += time.Duration(chunkStatsWeight * float64(latency-stats.Latency))
+	}
+	if latency > 0 {
+		throughput := float64(size) / latency.Seconds()
+		if stats.Received == 0 {
+			stats.Throughput = throughput
+		} else {
+			stats.Throughput += chunkStatsWeight * (throughput - stats.Throughput)
+		}
+	}
+	stats.Received++
+	if s.chunkSize == 0 {
+		s.chunkSize = float64(size)
+	} else {
+		s.chunkSize += chunkStatsWeight * (float64(size) - s.chunkSize)
+	}
+	return latency, stats.Throughput, true
+}
+
+// Expire expires the requests pending for longer than the timeout, counting them as timeouts of
+// their peers, and returns how many there were.
+func (s *chunkScheduler) Expire() int {
+	s.Lock()
+	defer s.Unlock()
+	now := time.Now()
+	expired := 0
+	for key, requested := range s.requests {
+		if now.Sub(requested) < s.timeout {
+			continue
+		}
+		delete(s.requests, key)
+		stats := s.stats(key.peer)
+		stats.InFlight--
+		stats.Timeouts++
+		// a peer that doesn't respond is as slow as the timeout, see expectedWait for the peers
+		// which never responded
+		if stats.Received > 0 && stats.Latency < s.timeout {
+			stats.Latency += time.Duration(chunkStatsWeight * float64(s.timeout-stats.Latency))
+		}
+		expired++
+	}
+	return expired
+}
+
+// HedgeDelay returns how long to wait for the peer to respond to a chunk request before
+// requesting the chunk from another peer: a multiple of its latency, or half the timeout if it
+// didn't respond yet.
+func (s *chunkScheduler) HedgeDelay(peerID p2p.ID) time.Duration {
+	s.Lock()
+	defer s.Unlock()
+	maxDelay := s.timeout / 2
+	stats := s.peers[peerID]
+	if stats == nil || stats.Received == 0 {
+		return maxDelay
+	}
+	delay := hedgeFactor * stats.Latency
+	if delay < minHedgeDelay {
+		delay = minHedgeDelay
+	}
+	if delay > maxDelay {
+		delay = maxDelay
+	}
+	return delay
+}
+
+// RemovePeer forgets the peer and its pending requests.
+func (s *chunkScheduler) RemovePeer(peerID p2p.ID) {
+	s.Lock()
+	defer s.Unlock()
+	delete(s.peers, peerID)
+	for key := range s.requests {
+		if key.peer == peerID {
+			delete(s.requests, key)
+		}
+	}
+}
+
+// Peers returns what was measured of the peers, sorted by ID.
+func (s *chunkScheduler) Peers() []PeerProgress {
+	s.Lock()
+	defer s.Unlock()
+	peers := make([]PeerProgress, 0, len(s.peers))
+	for _, stats := range s.peers {
+		peers = append(peers, *stats)
+	}
+	sort.Slice(peers, func(i, j int) bool {
+		return peers[i].ID < peers[j].ID
+	})
+	return peers
+}
+
+func (s *chunkScheduler) stats(peerID p2p.ID) *PeerProgress {
+	stats := s.peers[peerID]
+	if stats == nil {
+		stats = &PeerProgress{ID: peerID}
+		s.peers[peerID] = stats
+	}
+	return stats
+}
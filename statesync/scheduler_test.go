@@ -0,0 +1,144 @@
+package statesync
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Finschia/ostracon/p2p"
+	p2pmocks "github.com/Finschia/ostracon/p2p/mocks"
+)
+
+func makeSchedulerPeers(ids ...p2p.ID) []p2p.Peer {
+	peers := make([]p2p.Peer, 0, len(ids))
+	for _, id := range ids {
+		peer := &p2pmocks.Peer{}
+		peer.On("ID").Return(id)
+		peers = append(peers, peer)
+	}
+	return peers
+}
+
+func TestChunkScheduler_Pick(t *testing.T) {
+	s := newChunkScheduler(time.Minute)
+	peers := makeSchedulerPeers("a", "b", "c")
+
+	// the requests are spread over the peers not measured yet
+	for i, id := range []p2p.ID{"a", "b", "c", "a"} {
+		peer := s.Pick(peers, "")
+		require.NotNil(t, peer)
+		assert.Equal(t, id, peer.ID())
+		s.Requested(peer.ID(), uint32(i))
+	}
+
+	// the fastest peer is preferred, until its requests in flight make it slower than the others
+	s.requests[chunkRequest{peer: "a", index: 0}] = time.Now().Add(-10 * time.Millisecond)
+	s.requests[chunkRequest{peer: "a", index: 3}] = time.Now().Add(-10 * time.Millisecond)
+	s.requests[chunkRequest{peer: "b", index: 1}] = time.Now().Add(-time.Second)
+	for _, req := range []chunkRequest{{"a", 0}, {"b", 1}, {"a", 3}} {
+		latency, throughput, ok := s.Received(req.peer, req.index, 1000)
+		require.True(t, ok)
+		assert.Positive(t, latency)
+		assert.Positive(t, throughput)
+	}
+	_, _, ok := s.Received("a", 1, 1000)
+	assert.False(t, ok)
+
+	assert.Equal(t, p2p.ID("a"), s.Pick(peers, "").ID())
+	assert.Equal(t, p2p.ID("b"), s.Pick(peers, "a").ID())
+	for i := uint32(10); i < 210; i++ {
+		s.Requested("a", i)
+	}
+	assert.NotEqual(t, p2p.ID("a"), s.Pick(peers, "").ID())
+	assert.Nil(t, s.Pick(peers[:1], "a"))
+
+	// the peers are measured separately
+	stats := s.Peers()
+	require.Len(t, stats, 3)
+	assert.Equal(t, p2p.ID("a"), stats[0].ID)
+	assert.Equal(t, 2, stats[0].Received)
+	assert.Equal(t, 200, stats[0].InFlight)
+	assert.Less(t, stats[0].Latency, stats[1].Latency)
+	assert.Greater(t, stats[0].Throughput, stats[1].Throughput)
+	assert.Equal(t, 1, stats[2].InFlight)
+
+	s.RemovePeer("a")
+	assert.Len(t, s.Peers(), 2)
+	assert.Len(t, s.requests, 1)
+}
+
+func TestChunkScheduler_PickThroughput(t *testing.T) {
+	s := newChunkScheduler(time.Minute)
+	peers := makeSchedulerPeers("a", "b")
+	s.peers["a"] = &PeerProgress{ID: "a", Latency: 100 * time.Millisecond, Throughput: 1e6, Received: 1}
+	s.peers["b"] = &PeerProgress{ID: "b", Latency: 50 * time.Millisecond, Throughput: 1e4, Received: 1}
+	s.chunkSize = 1e4
+
+	// b responds first to a single request
+	assert.Equal(t, p2p.ID("b"), s.Pick(peers, "").ID())
+
+	// but transfers the chunks requested before in 1s each, when a does in 10ms
+	s.peers["a"].InFlight, s.peers["b"].InFlight = 3, 1
+	assert.Equal(t, p2p.ID("a"), s.Pick(peers, "").ID())
+}
+
+func TestChunkScheduler_PickTimedOut(t *testing.T) {
+	s := newChunkScheduler(time.Minute)
+	peers := makeSchedulerPeers("a", "b", "c")
+	s.peers["b"] = &PeerProgress{ID: "b", Latency: 10 * time.Second, Received: 1}
+
+	// the peers which never responded are backed off once they time out, even behind a slow peer
+	s.Requested("a", 0)
+	s.requests[chunkRequest{peer: "a", index: 0}] = time.Now().Add(-time.Minute)
+	require.Equal(t, 1, s.Expire())
+	assert.Equal(t, p2p.ID("b"), s.Pick(peers[:2], "").ID())
+
+	// the more timeouts, the longer
+	s.peers["c"] = &PeerProgress{ID: "c", Timeouts: 2}
+	assert.Equal(t, p2p.ID("a"), s.Pick(peers, "b").ID())
+}
+
+func TestChunkScheduler_HedgeDelay(t *testing.T) {
+	s := newChunkScheduler(time.Minute)
+
+	// the requests to peers not measured yet are hedged after half the timeout
+	assert.Equal(t, 30*time.Second, s.HedgeDelay("a"))
+
+	s.Requested("a", 0)
+	s.requests[chunkRequest{peer: "a", index: 0}] = time.Now().Add(-time.Millisecond)
+	_, _, ok := s.Received("a", 0, 1)
+	require.True(t, ok)
+	assert.Equal(t, minHedgeDelay, s.HedgeDelay("a"))
+
+	s.Requested("b", 0)
+	s.requests[chunkRequest{peer: "b", index: 0}] = time.Now().Add(-5 * time.Second)
+	_, _, ok = s.Received("b", 0, 1)
+	require.True(t, ok)
+	assert.InDelta(t, float64(15*time.Second), float64(s.HedgeDelay("b")), float64(time.Second))
+
+	s.Requested("c", 0)
+	s.requests[chunkRequest{peer: "c", index: 0}] = time.Now().Add(-50 * time.Second)
+	_, _, ok = s.Received("c", 0, 1)
+	require.True(t, ok)
+	assert.Equal(t, 30*time.Second, s.HedgeDelay("c"))
+}
+
+func TestChunkScheduler_Expire(t *testing.T) {
+	s := newChunkScheduler(time.Minute)
+	s.Requested("a", 0)
+	s.Requested("a", 1)
+	assert.Zero(t, s.Expire())
+
+	s.requests[chunkRequest{peer: "a", index: 0}] = time.Now().Add(-time.Minute)
+	assert.Equal(t, 1, s.Expire())
+	stats := s.Peers()
+	require.Len(t, stats, 1)
+	assert.Equal(t, 1, stats[0].Timeouts)
+	assert.Equal(t, 1, stats[0].InFlight)
+
+	// a response after the timeout isn't a sample
+	_, _, ok := s.Received("a", 0, 1)
+	assert.False(t, ok)
+}
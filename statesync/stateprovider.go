@@ -1,15 +1,16 @@
 package statesync
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"strings"
 	"time"
 
 	tmstate "github.com/tendermint/tendermint/proto/tendermint/state"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 	dbm "github.com/tendermint/tm-db"
 
-	"github.com/Finschia/ostracon/crypto/ed25519"
 	"github.com/Finschia/ostracon/libs/log"
 	tmsync "github.com/Finschia/ostracon/libs/sync"
 	"github.com/Finschia/ostracon/light"
@@ -144,6 +145,45 @@ func (s *lightClientStateProvider) State(ctx context.Context, height uint64) (sm
 	s.Lock()
 	defer s.Unlock()
 
+	lastLightBlock, currentLightBlock, nextLightBlock, err := s.verifyStateLightBlocks(ctx, height)
+	if err != nil {
+		return sm.State{}, err
+	}
+
+	// We'll also need to fetch consensus params and last proof hash via RPC, using light client verification.
+	primaryURL, ok := s.providers[s.lc.Primary()]
+	if !ok || primaryURL == "" {
+		return sm.State{}, fmt.Errorf("could not find address for primary light client provider")
+	}
+	primaryRPC, err := rpcClient(primaryURL)
+	if err != nil {
+		return sm.State{}, fmt.Errorf("unable to create RPC client: %w", err)
+	}
+	rpcclient := lightrpc.NewClient(primaryRPC, s.lc)
+
+	resultConsensusParams, err := rpcclient.ConsensusParams(ctx, &currentLightBlock.Height)
+	if err != nil {
+		return sm.State{}, fmt.Errorf("unable to fetch consensus parameters for height %v: %w",
+			nextLightBlock.Height, err)
+	}
+
+	resultBlock, err := rpcclient.Block(ctx, &lastLightBlock.Height)
+	if err != nil {
+		return sm.State{}, fmt.Errorf("unable to fetch block for height %v: %w",
+			lastLightBlock.Height, err)
+	}
+
+	lastProofHash, err := proposerProofHash(lastLightBlock, resultBlock.Block.Proof.Bytes())
+	if err != nil {
+		return sm.State{}, err
+	}
+
+	return buildState(s.newState(), lastLightBlock, currentLightBlock, nextLightBlock,
+		resultConsensusParams.ConsensusParams, lastProofHash), nil
+}
+
+// newState returns the state of the chain before filling it with buildState.
+func (s *lightClientStateProvider) newState() sm.State {
 	state := sm.State{
 		ChainID:       s.lc.ChainID(),
 		Version:       s.version,
@@ -152,7 +192,15 @@ func (s *lightClientStateProvider) State(ctx context.Context, height uint64) (sm
 	if state.InitialHeight == 0 {
 		state.InitialHeight = 1
 	}
+	return state
+}
 
+// verifyStateLightBlocks verifies and returns the light blocks at the snapshot height and the two
+// next ones.
+func (s *lightClientStateProvider) verifyStateLightBlocks(
+	ctx context.Context,
+	height uint64,
+) (*types.LightBlock, *types.LightBlock, *types.LightBlock, error) {
 	// The snapshot height maps onto the state heights as follows:
 	//
 	// height: last block, i.e. the snapshotted height
@@ -163,17 +211,146 @@ func (s *lightClientStateProvider) State(ctx context.Context, height uint64) (sm
 	// the validator set at the snapshot height then this only takes effect at height+2.
 	lastLightBlock, err := s.lc.VerifyLightBlockAtHeight(ctx, int64(height), time.Now())
 	if err != nil {
-		return sm.State{}, err
+		return nil, nil, nil, err
 	}
 	currentLightBlock, err := s.lc.VerifyLightBlockAtHeight(ctx, int64(height+1), time.Now())
 	if err != nil {
-		return sm.State{}, err
+		return nil, nil, nil, err
 	}
 	nextLightBlock, err := s.lc.VerifyLightBlockAtHeight(ctx, int64(height+2), time.Now())
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return lastLightBlock, currentLightBlock, nextLightBlock, nil
+}
+
+// p2pStateProvider is a light client state provider fetching the light blocks, their entropy and
+// the consensus params from peers instead of RPC servers.
+type p2pStateProvider struct {
+	*lightClientStateProvider
+}
+
+// NewP2PStateProvider creates a new StateProvider using a light client and the peers of the state
+// sync reactor, for nodes without RPC servers to verify the synced state with. It waits until at
+// least two peers serve light blocks: the primary and a witness of the light client.
+func NewP2PStateProvider(
+	ctx context.Context,
+	chainID string,
+	version tmstate.Version,
+	initialHeight int64,
+	r *Reactor,
+	trustOptions light.TrustOptions,
+	logger log.Logger,
+) (StateProvider, error) {
+	peers, err := r.waitForLightBlockPeers(ctx, 2)
+	if err != nil {
+		return nil, err
+	}
+
+	providers := make([]lightprovider.Provider, 0, len(peers))
+	for _, peer := range peers {
+		providers = append(providers, newBlockProvider(chainID, peer, r.dispatcher))
+	}
+
+	lc, err := light.NewClient(ctx, chainID, trustOptions, providers[0], providers[1:],
+		lightdb.New(dbm.NewMemDB(), ""), light.Logger(logger), light.MaxRetryAttempts(5))
+	if err != nil {
+		return nil, err
+	}
+	return &p2pStateProvider{&lightClientStateProvider{
+		lc:            lc,
+		version:       version,
+		initialHeight: initialHeight,
+	}}, nil
+}
+
+// State implements StateProvider.
+func (s *p2pStateProvider) State(ctx context.Context, height uint64) (sm.State, error) {
+	s.Lock()
+	defer s.Unlock()
+
+	lastLightBlock, currentLightBlock, nextLightBlock, err := s.verifyStateLightBlocks(ctx, height)
+	if err != nil {
+		return sm.State{}, err
+	}
+
+	consensusParams, err := s.consensusParams(ctx, currentLightBlock)
+	if err != nil {
+		return sm.State{}, err
+	}
+
+	lastProofHash, err := s.lastProofHash(ctx, lastLightBlock, currentLightBlock)
 	if err != nil {
 		return sm.State{}, err
 	}
 
+	return buildState(s.newState(), lastLightBlock, currentLightBlock, nextLightBlock,
+		*consensusParams, lastProofHash), nil
+}
+
+// consensusParams fetches the consensus params at the height of the verified light block from
+// the primary, or from the witnesses if it fails, and verifies them against its consensus hash.
+func (s *p2pStateProvider) consensusParams(
+	ctx context.Context,
+	lightBlock *types.LightBlock,
+) (*tmproto.ConsensusParams, error) {
+	var errs []string
+	for _, provider := range append([]lightprovider.Provider{s.lc.Primary()}, s.lc.Witnesses()...) {
+		params, err := provider.(*blockProvider).ConsensusParams(ctx, lightBlock.Height)
+		if err == nil {
+			if hash := types.HashConsensusParams(*params); !bytes.Equal(hash, lightBlock.ConsensusHash) {
+				err = fmt.Errorf("consensus params hash %X does not match trusted hash %X",
+					hash, lightBlock.ConsensusHash)
+			}
+		}
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("%v: %v", provider, err))
+			continue
+		}
+		return params, nil
+	}
+	return nil, fmt.Errorf("unable to fetch consensus parameters for height %v: %v",
+		lightBlock.Height, strings.Join(errs, "; "))
+}
+
+// lastProofHash fetches the entropy of the verified last and current light blocks from the
+// primary and returns the proof hash of the last one. The entropy is not part of the header, but
+// the proof of the current block is verified against the last proof hash, which its VRF message
+// derives from (see light.VerifyProposer).
+func (s *p2pStateProvider) lastProofHash(
+	ctx context.Context,
+	lastLightBlock, currentLightBlock *types.LightBlock,
+) ([]byte, error) {
+	primary := s.lc.Primary().(lightprovider.EntropyProvider)
+	lastEntropy, err := primary.Entropy(ctx, lastLightBlock.Height)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch entropy for height %v: %w", lastLightBlock.Height, err)
+	}
+	currentEntropy, err := primary.Entropy(ctx, currentLightBlock.Height)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch entropy for height %v: %w", currentLightBlock.Height, err)
+	}
+
+	lastProofHash, err := proposerProofHash(lastLightBlock, lastEntropy.Proof)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := light.VerifyProposer(currentLightBlock.SignedHeader, currentLightBlock.ValidatorSet,
+		currentEntropy, lastProofHash); err != nil {
+		return nil, fmt.Errorf("failed to verify proof hash for height %v: %w", lastLightBlock.Height, err)
+	}
+	return lastProofHash, nil
+}
+
+// buildState fills state with the verified light blocks at the snapshot height (last) and the
+// two next ones, the consensus params at the next height and the proof hash of the block at the
+// snapshot height.
+func buildState(
+	state sm.State,
+	lastLightBlock, currentLightBlock, nextLightBlock *types.LightBlock,
+	consensusParams tmproto.ConsensusParams,
+	lastProofHash []byte,
+) sm.State {
 	state.Version = tmstate.Version{
 		Consensus: currentLightBlock.Version,
 		Software:  version.OCCoreSemVer,
@@ -188,37 +365,22 @@ func (s *lightClientStateProvider) State(ctx context.Context, height uint64) (sm
 	state.NextValidators = nextLightBlock.ValidatorSet
 	state.LastHeightValidatorsChanged = nextLightBlock.Height
 
-	// We'll also need to fetch consensus params and last proof hash via RPC, using light client verification.
-	primaryURL, ok := s.providers[s.lc.Primary()]
-	if !ok || primaryURL == "" {
-		return sm.State{}, fmt.Errorf("could not find address for primary light client provider")
-	}
-	primaryRPC, err := rpcClient(primaryURL)
-	if err != nil {
-		return sm.State{}, fmt.Errorf("unable to create RPC client: %w", err)
-	}
-	rpcclient := lightrpc.NewClient(primaryRPC, s.lc)
-
-	resultConsensusParams, err := rpcclient.ConsensusParams(ctx, &currentLightBlock.Height)
-	if err != nil {
-		return sm.State{}, fmt.Errorf("unable to fetch consensus parameters for height %v: %w",
-			nextLightBlock.Height, err)
-	}
-	state.ConsensusParams = resultConsensusParams.ConsensusParams
+	state.ConsensusParams = consensusParams
 	state.Version.Consensus.App = state.ConsensusParams.Version.AppVersion
 	state.LastHeightConsensusParamsChanged = currentLightBlock.Height
 
-	resultBlock, err := rpcclient.Block(ctx, &lastLightBlock.Height)
-	if err != nil {
-		return sm.State{}, fmt.Errorf("unable to fetch block for height %v: %w",
-			lastLightBlock.Height, err)
-	}
-	proofHash, err := ed25519.ProofToHash(resultBlock.Block.Proof.Bytes())
-	if err != nil {
-		return sm.State{}, err
+	state.LastProofHash = lastProofHash
+	return state
+}
+
+// proposerProofHash returns the hash of the VRF proof of the proposer of the light block.
+func proposerProofHash(lightBlock *types.LightBlock, proof []byte) ([]byte, error) {
+	_, proposer := lightBlock.ValidatorSet.GetByAddress(lightBlock.ProposerAddress)
+	if proposer == nil {
+		return nil, fmt.Errorf("proposer %X of block at height %v is not in the validator set",
+			lightBlock.ProposerAddress, lightBlock.Height)
 	}
-	state.LastProofHash = proofHash
-	return state, nil
+	return types.ProofToHash(proposer.PubKey, proof)
 }
 
 // rpcClient sets up a new RPC client
@@ -20,9 +20,11 @@ import (
 	"github.com/Finschia/ostracon/libs/log"
 	tmrand "github.com/Finschia/ostracon/libs/rand"
 	"github.com/Finschia/ostracon/light"
+	"github.com/Finschia/ostracon/p2p"
 	ctypes "github.com/Finschia/ostracon/rpc/core/types"
 	rpcserver "github.com/Finschia/ostracon/rpc/jsonrpc/server"
 	rpctypes "github.com/Finschia/ostracon/rpc/jsonrpc/types"
+	sm "github.com/Finschia/ostracon/state"
 	"github.com/Finschia/ostracon/types"
 	tmtime "github.com/Finschia/ostracon/types/time"
 	"github.com/Finschia/ostracon/version"
@@ -249,3 +251,99 @@ func validatorsFunc(ctx *rpctypes.Context, heightPtr *int64, pagePtr, perPagePtr
 		Total:       size,
 	}, nil
 }
+
+// tamperedBlockStore serves the entropy of the block at the given height with the proof of the
+// previous one.
+type tamperedBlockStore struct {
+	sm.BlockStore
+	height int64
+}
+
+func (s tamperedBlockStore) LoadBlockEntropy(height int64) *types.Entropy {
+	entropy := s.BlockStore.LoadBlockEntropy(height)
+	if entropy != nil && height == s.height {
+		entropy.Proof = s.BlockStore.LoadBlockEntropy(height - 1).Proof
+	}
+	return entropy
+}
+
+func TestP2PStateProvider(t *testing.T) {
+	genesis, stateStore, blockStore := makeArchiveChain(t, 6)
+	expected, err := stateStore.Load()
+	require.NoError(t, err)
+	expectedParams, err := stateStore.LoadConsensusParams(4)
+	require.NoError(t, err)
+	expectedProofHash, err := types.ProofToHash(expected.Validators.Validators[0].PubKey,
+		blockStore.LoadBlock(3).Proof.Bytes())
+	require.NoError(t, err)
+
+	testcases := map[string]struct {
+		blockStore sm.BlockStore
+		err        bool
+	}{
+		"verified":         {blockStore, false},
+		"tampered entropy": {tamperedBlockStore{BlockStore: blockStore, height: 3}, true},
+	}
+	for name, tc := range testcases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			// the first node fetches the light blocks from the two others
+			reactors := make([]*Reactor, 3)
+			switches := p2p.MakeConnectedSwitches(config.DefaultP2PConfig(), len(reactors),
+				func(i int, s *p2p.Switch, p2pConfig *config.P2PConfig) *p2p.Switch {
+					if i == 0 {
+						reactors[i] = NewReactor(*config.DefaultStateSyncConfig(), nil, nil, nil, nil, true, 1000)
+					} else {
+						reactors[i] = NewReactor(*config.DefaultStateSyncConfig(), nil, nil,
+							stateStore, tc.blockStore, true, 1000)
+					}
+					reactors[i].SetLogger(log.TestingLogger())
+					s.AddReactor("STATESYNC", reactors[i])
+					s.SetLogger(log.TestingLogger())
+					return s
+				}, p2p.Connect2Switches)
+			t.Cleanup(func() {
+				for _, s := range switches {
+					if err := s.Stop(); err != nil {
+						t.Error(err)
+					}
+				}
+			})
+
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+			stateProvider, err := NewP2PStateProvider(ctx, genesis.ChainID, genesis.Version,
+				genesis.InitialHeight, reactors[0], light.TrustOptions{
+					Period: time.Hour * 24,
+					Height: 2,
+					Hash:   blockStore.LoadBlockMeta(2).Header.Hash(),
+				}, log.TestingLogger())
+			require.NoError(t, err)
+
+			appHash, err := stateProvider.AppHash(ctx, 3)
+			require.NoError(t, err)
+			assert.Equal(t, []byte{3}, appHash)
+
+			commit, err := stateProvider.Commit(ctx, 3)
+			require.NoError(t, err)
+			assert.Equal(t, blockStore.LoadBlockCommit(3).Hash(), commit.Hash())
+
+			state, err := stateProvider.State(ctx, 3)
+			if tc.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.EqualValues(t, 3, state.LastBlockHeight)
+			assert.Equal(t, blockStore.LoadBlockMeta(3).BlockID, state.LastBlockID)
+			assert.Equal(t, []byte{3}, []byte(state.AppHash))
+			assert.Equal(t, expected.Validators.Hash(), state.Validators.Hash())
+			assert.Equal(t, expectedParams, state.ConsensusParams)
+			assert.Equal(t, expectedProofHash, state.LastProofHash)
+
+			// the light block at height 7 is missing
+			_, err = stateProvider.AppHash(ctx, 5)
+			assert.Error(t, err)
+		})
+	}
+}
@@ -10,6 +10,7 @@ import (
 	abci "github.com/tendermint/tendermint/abci/types"
 	ssproto "github.com/tendermint/tendermint/proto/tendermint/statesync"
 
+	"github.com/Finschia/ostracon/behaviour"
 	"github.com/Finschia/ostracon/config"
 	"github.com/Finschia/ostracon/libs/log"
 	tmsync "github.com/Finschia/ostracon/libs/sync"
@@ -44,15 +45,32 @@ var (
 	errVerifyFailed = errors.New("verification failed")
 	// errTimeout is returned by Sync() when we've waited too long to receive a chunk.
 	errTimeout = errors.New("timed out waiting for chunk")
+	// errResumeRejected is returned by Sync() when the app doesn't accept the snapshot offered to
+	// resume its restoration.
+	errResumeRejected = errors.New("resuming the restoration was rejected")
 	// errNoSnapshots is returned by SyncAny() if no snapshots are found and discovery is disabled.
 	errNoSnapshots = errors.New("no suitable snapshots found")
 )
 
+// Progress is the progress of the restoration of a snapshot.
+type Progress struct {
+	SnapshotHeight uint64
+	SnapshotFormat uint32
+	ChunksTotal    uint32
+	ChunksFetched  uint32
+	ChunksApplied  uint32
+	BytesFetched   int64
+	Elapsed        time.Duration
+	ETA            time.Duration // estimated time to fetch the remaining chunks, 0 if unknown
+	Peers          []PeerProgress
+}
+
 // syncer runs a state sync against an ABCI app. Use either SyncAny() to automatically attempt to
 // sync all snapshots in the pool (pausing to discover new ones), or Sync() to sync a specific
 // snapshot. Snapshots and chunks are fed via AddSnapshot() and AddChunk() as appropriate.
 type syncer struct {
 	logger        log.Logger
+	reporter      behaviour.Reporter
 	stateProvider StateProvider
 	conn          proxy.AppConnSnapshot
 	connQuery     proxy.AppConnQuery
@@ -60,23 +78,41 @@ type syncer struct {
 	tempDir       string
 	chunkFetchers int32
 	retryTimeout  time.Duration
-
-	mtx    tmsync.RWMutex
-	chunks *chunkQueue
+	scheduler     *chunkScheduler
+	metrics       *Metrics
+
+	// The progress of the restoration is persisted to resumeFile, if set, so that it's resumed
+	// after a restart. The restore progress is only accessed by the Sync() goroutine.
+	resumeFile string
+	restore    *restoreProgress
+
+	mtx      tmsync.RWMutex
+	chunks   *chunkQueue
+	snapshot *snapshot
+
+	// The progress of the restoration of the snapshot: when it started, the chunks already in
+	// the queue then, and the bytes fetched since.
+	progressMtx    tmsync.Mutex
+	started        time.Time
+	fetchedAtStart uint32
+	fetchedBytes   int64
 }
 
 // newSyncer creates a new syncer.
 func newSyncer(
 	cfg config.StateSyncConfig,
 	logger log.Logger,
+	reporter behaviour.Reporter,
 	conn proxy.AppConnSnapshot,
 	connQuery proxy.AppConnQuery,
 	stateProvider StateProvider,
 	tempDir string,
+	metrics *Metrics,
 ) *syncer {
 
 	return &syncer{
 		logger:        logger,
+		reporter:      reporter,
 		stateProvider: stateProvider,
 		conn:          conn,
 		connQuery:     connQuery,
@@ -84,6 +120,8 @@ func newSyncer(
 		tempDir:       tempDir,
 		chunkFetchers: cfg.ChunkFetchers,
 		retryTimeout:  cfg.ChunkRequestTimeout,
+		scheduler:     newChunkScheduler(cfg.ChunkRequestTimeout),
+		metrics:       metrics,
 	}
 }
 
@@ -95,6 +133,11 @@ func (s *syncer) AddChunk(chunk *chunk) (bool, error) {
 	if s.chunks == nil {
 		return false, errors.New("no state sync in progress")
 	}
+	// the latency is recorded even if another peer sent the chunk first
+	if latency, throughput, ok := s.scheduler.Received(chunk.Sender, chunk.Index, len(chunk.Chunk)); ok {
+		s.metrics.ChunkLatency.Observe(latency.Seconds())
+		s.metrics.PeerThroughput.With("peer_id", string(chunk.Sender)).Set(throughput)
+	}
 	added, err := s.chunks.Add(chunk)
 	if err != nil {
 		return false, err
@@ -102,6 +145,12 @@ func (s *syncer) AddChunk(chunk *chunk) (bool, error) {
 	if added {
 		s.logger.Debug("Added chunk to queue", "height", chunk.Height, "format", chunk.Format,
 			"chunk", chunk.Index)
+		s.progressMtx.Lock()
+		s.fetchedBytes += int64(len(chunk.Chunk))
+		s.progressMtx.Unlock()
+		s.metrics.ChunkBytes.Add(float64(len(chunk.Chunk)))
+		s.metrics.ChunksFetched.Set(float64(s.chunks.Fetched()))
+		s.metrics.ETASeconds.Set(s.progress().ETA.Seconds())
 	} else {
 		s.logger.Debug("Ignoring duplicate chunk in queue", "height", chunk.Height, "format", chunk.Format,
 			"chunk", chunk.Index)
@@ -138,6 +187,40 @@ func (s *syncer) AddPeer(peer p2p.Peer) {
 func (s *syncer) RemovePeer(peer p2p.Peer) {
 	s.logger.Debug("Removing peer from sync", "peer", peer.ID())
 	s.snapshots.RemovePeer(peer.ID())
+	s.scheduler.RemovePeer(peer.ID())
+}
+
+// Progress returns the progress of the restoration of a snapshot, or nil if none is in progress.
+func (s *syncer) Progress() *Progress {
+	s.mtx.RLock()
+	defer s.mtx.RUnlock()
+	if s.chunks == nil {
+		return nil
+	}
+	return s.progress()
+}
+
+// progress returns the progress of the restoration of the snapshot in the chunk queue. The
+// caller must hold s.mtx.
+func (s *syncer) progress() *Progress {
+	s.progressMtx.Lock()
+	defer s.progressMtx.Unlock()
+	p := &Progress{
+		SnapshotHeight: s.snapshot.Height,
+		SnapshotFormat: s.snapshot.Format,
+		ChunksTotal:    s.snapshot.Chunks,
+		ChunksFetched:  s.chunks.Fetched(),
+		ChunksApplied:  s.chunks.Returned(),
+		BytesFetched:   s.fetchedBytes,
+		Elapsed:        time.Since(s.started),
+		Peers:          s.scheduler.Peers(),
+	}
+	// the remaining chunks are assumed to be fetched at the rate of the ones fetched so far
+	if p.ChunksFetched > s.fetchedAtStart {
+		perChunk := p.Elapsed / time.Duration(p.ChunksFetched-s.fetchedAtStart)
+		p.ETA = perChunk * time.Duration(p.ChunksTotal-p.ChunksFetched)
+	}
+	return p
 }
 
 // SyncAny tries to sync any of the snapshots in the snapshot pool, waiting to discover further
@@ -148,6 +231,11 @@ func (s *syncer) SyncAny(discoveryTime time.Duration, retryHook func()) (sm.Stat
 		discoveryTime = 5 * minimumDiscoveryTime
 	}
 
+	// The restoration interrupted by a restart is resumed if the app can, and its progress is
+	// discarded once it completes or fails.
+	resume := s.loadResumable()
+	defer s.removeRestoreProgress()
+
 	if discoveryTime > 0 {
 		s.logger.Info("sync any", "msg", log.NewLazySprintf("Discovering snapshots for %v", discoveryTime))
 		time.Sleep(discoveryTime)
@@ -163,7 +251,17 @@ func (s *syncer) SyncAny(discoveryTime time.Duration, retryHook func()) (sm.Stat
 	for {
 		// If not nil, we're going to retry restoration of the same snapshot.
 		if snapshot == nil {
-			snapshot = s.snapshots.Best()
+			if resume != nil {
+				snapshot = s.discovered(resume.snapshot)
+				if snapshot == nil {
+					s.logger.Info("Snapshot to resume not discovered, starting over", "height", resume.snapshot.Height,
+						"format", resume.snapshot.Format, "hash", resume.snapshot.Hash)
+					resume = nil
+				}
+			}
+			if snapshot == nil {
+				snapshot = s.snapshots.Best()
+			}
 			chunks = nil
 		}
 		if snapshot == nil {
@@ -181,9 +279,17 @@ func (s *syncer) SyncAny(discoveryTime time.Duration, retryHook func()) (sm.Stat
 				return sm.State{}, sm.State{}, nil, fmt.Errorf("failed to create chunk queue: %w", err)
 			}
 			defer chunks.Close() // in case we forget to close it elsewhere
+			if resume != nil {
+				for index := range resume.applied {
+					if err := chunks.Skip(index); err != nil {
+						return sm.State{}, sm.State{}, nil, fmt.Errorf("failed to skip applied chunk: %w", err)
+					}
+				}
+			}
 		}
 
-		newState, previousState, commit, err := s.Sync(snapshot, chunks)
+		newState, previousState, commit, err := s.sync(snapshot, chunks, resume)
+		resume = nil
 		switch {
 		case err == nil:
 			return newState, previousState, commit, nil
@@ -191,6 +297,16 @@ func (s *syncer) SyncAny(discoveryTime time.Duration, retryHook func()) (sm.Stat
 		case errors.Is(err, errAbort):
 			return sm.State{}, sm.State{}, nil, err
 
+		case errors.Is(err, errResumeRejected):
+			s.logger.Info("App didn't accept resuming the restoration, starting over", "height", snapshot.Height,
+				"format", snapshot.Format, "hash", snapshot.Hash)
+			s.removeRestoreProgress()
+			if err := chunks.Close(); err != nil {
+				s.logger.Error("Failed to clean up chunk queue", "err", err)
+			}
+			chunks = nil
+			continue
+
 		case errors.Is(err, errRetrySnapshot):
 			chunks.RetryAll()
 			s.logger.Info("Retrying snapshot", "height", snapshot.Height, "format", snapshot.Format,
@@ -228,6 +344,7 @@ func (s *syncer) SyncAny(discoveryTime time.Duration, retryHook func()) (sm.Stat
 		}
 
 		// Discard snapshot and chunks for next iteration
+		s.removeRestoreProgress()
 		err = chunks.Close()
 		if err != nil {
 			s.logger.Error("Failed to clean up chunk queue", "err", err)
@@ -240,35 +357,62 @@ func (s *syncer) SyncAny(discoveryTime time.Duration, retryHook func()) (sm.Stat
 // Sync executes a sync for a specific snapshot, returning the latest state, previous state and block commit which
 // the caller must use to bootstrap the node.
 func (s *syncer) Sync(snapshot *snapshot, chunks *chunkQueue) (sm.State, sm.State, *types.Commit, error) {
+	return s.sync(snapshot, chunks, nil)
+}
+
+// sync executes a sync for a specific snapshot like Sync(), resuming the restoration of the
+// given progress, if any, instead of verifying the snapshot again.
+func (s *syncer) sync(
+	snapshot *snapshot, chunks *chunkQueue, resume *restoreProgress) (sm.State, sm.State, *types.Commit, error) {
 	s.mtx.Lock()
 	if s.chunks != nil {
 		s.mtx.Unlock()
 		return sm.State{}, sm.State{}, nil, errors.New("a state sync is already in progress")
 	}
 	s.chunks = chunks
+	s.snapshot = snapshot
+	s.progressMtx.Lock()
+	s.started = time.Now()
+	s.fetchedAtStart = chunks.Fetched()
+	s.fetchedBytes = 0
+	s.progressMtx.Unlock()
 	s.mtx.Unlock()
 	defer func() {
 		s.mtx.Lock()
 		s.chunks = nil
+		s.snapshot = nil
 		s.mtx.Unlock()
 	}()
 
-	hctx, cancel := context.WithTimeout(context.TODO(), 30*time.Second)
-	defer cancel()
+	s.metrics.SnapshotHeight.Set(float64(snapshot.Height))
+	s.metrics.SnapshotChunks.Set(float64(snapshot.Chunks))
+	s.metrics.ChunksFetched.Set(float64(chunks.Fetched()))
+	s.metrics.ChunksApplied.Set(float64(chunks.Returned()))
 
-	appHash, err := s.stateProvider.AppHash(hctx, snapshot.Height)
-	if err != nil {
-		s.logger.Info("failed to fetch and verify app hash", "err", err)
-		if err == light.ErrNoWitnesses {
-			return sm.State{}, sm.State{}, nil, err
+	if resume != nil {
+		snapshot.trustedAppHash = resume.snapshot.trustedAppHash
+	} else {
+		hctx, cancel := context.WithTimeout(context.TODO(), 30*time.Second)
+		defer cancel()
+
+		appHash, err := s.stateProvider.AppHash(hctx, snapshot.Height)
+		if err != nil {
+			s.logger.Info("failed to fetch and verify app hash", "err", err)
+			if err == light.ErrNoWitnesses {
+				return sm.State{}, sm.State{}, nil, err
+			}
+			return sm.State{}, sm.State{}, nil, errRejectSnapshot
 		}
-		return sm.State{}, sm.State{}, nil, errRejectSnapshot
+		snapshot.trustedAppHash = appHash
 	}
-	snapshot.trustedAppHash = appHash
 
-	// Offer snapshot to ABCI app.
-	err = s.offerSnapshot(snapshot)
+	// Offer snapshot to ABCI app. The restoration starts over if the app rejects resuming it.
+	err := s.offerSnapshot(snapshot)
 	if err != nil {
+		if resume != nil && (errors.Is(err, errRejectSnapshot) || errors.Is(err, errRejectFormat) ||
+			errors.Is(err, errRejectSender)) {
+			return sm.State{}, sm.State{}, nil, fmt.Errorf("%w: %v", errResumeRejected, err)
+		}
 		return sm.State{}, sm.State{}, nil, err
 	}
 
@@ -279,10 +423,43 @@ func (s *syncer) Sync(snapshot *snapshot, chunks *chunkQueue) (sm.State, sm.Stat
 		go s.fetchChunks(fetchCtx, snapshot, chunks)
 	}
 
+	// Optimistically build new state, so we don't discover any light client failures at the end.
+	// It's persisted with the progress of the restoration, which is resumed without verifying
+	// the snapshot again.
+	if resume == nil {
+		state, previousState, commit, err := s.fetchState(snapshot)
+		if err != nil {
+			return sm.State{}, sm.State{}, nil, err
+		}
+		resume = newRestoreProgress(snapshot, state, previousState, commit)
+	}
+	s.restore = resume
+	defer func() { s.restore = nil }()
+	s.saveRestoreProgress()
+
+	// Restore snapshot
+	err = s.applyChunks(chunks)
+	if err != nil {
+		return sm.State{}, sm.State{}, nil, err
+	}
+
+	// Verify app and app version
+	if err := s.verifyApp(snapshot, resume.state.Version.Consensus.App); err != nil {
+		return sm.State{}, sm.State{}, nil, err
+	}
+
+	// Done! 🎉
+	s.logger.Info("Snapshot restored", "height", snapshot.Height, "format", snapshot.Format,
+		"hash", snapshot.Hash)
+
+	return resume.state, resume.previousState, resume.commit, nil
+}
+
+// fetchState fetches and verifies the state, previous state and commit at the snapshot height.
+func (s *syncer) fetchState(snapshot *snapshot) (sm.State, sm.State, *types.Commit, error) {
 	pctx, pcancel := context.WithTimeout(context.TODO(), 30*time.Second)
 	defer pcancel()
 
-	// Optimistically build new state, so we don't discover any light client failures at the end.
 	state, err := s.stateProvider.State(pctx, snapshot.Height)
 	if err != nil {
 		s.logger.Info("failed to fetch and verify ostracon state", "err", err)
@@ -312,22 +489,6 @@ func (s *syncer) Sync(snapshot *snapshot, chunks *chunkQueue) (sm.State, sm.Stat
 		}
 		return sm.State{}, sm.State{}, nil, errRejectSnapshot
 	}
-
-	// Restore snapshot
-	err = s.applyChunks(chunks)
-	if err != nil {
-		return sm.State{}, sm.State{}, nil, err
-	}
-
-	// Verify app and app version
-	if err := s.verifyApp(snapshot, state.Version.Consensus.App); err != nil {
-		return sm.State{}, sm.State{}, nil, err
-	}
-
-	// Done! 🎉
-	s.logger.Info("Snapshot restored", "height", snapshot.Height, "format", snapshot.Format,
-		"hash", snapshot.Hash)
-
 	return state, previousState, commit, nil
 }
 
@@ -395,12 +556,16 @@ func (s *syncer) applyChunks(chunks *chunkQueue) error {
 			if err != nil {
 				return fmt.Errorf("failed to discard chunk %v: %w", index, err)
 			}
+			s.setApplied(index, false)
 		}
 
 		// Reject any senders as requested by the app
 		for _, sender := range resp.RejectSenders {
 			if sender != "" {
 				s.snapshots.RejectPeer(p2p.ID(sender))
+				if err := s.reporter.Report(behaviour.BadChunk(p2p.ID(sender), "chunk rejected by app")); err != nil {
+					s.logger.Debug("Failed to report peer behaviour", "err", err)
+				}
 				err := chunks.DiscardSender(p2p.ID(sender))
 				if err != nil {
 					return fmt.Errorf("failed to reject sender: %w", err)
@@ -410,11 +575,17 @@ func (s *syncer) applyChunks(chunks *chunkQueue) error {
 
 		switch resp.Result {
 		case abci.ResponseApplySnapshotChunk_ACCEPT:
+			s.setApplied(chunk.Index, true)
+			s.metrics.ChunksApplied.Set(float64(chunks.Returned()))
 		case abci.ResponseApplySnapshotChunk_ABORT:
 			return errAbort
 		case abci.ResponseApplySnapshotChunk_RETRY:
 			chunks.Retry(chunk.Index)
 		case abci.ResponseApplySnapshotChunk_RETRY_SNAPSHOT:
+			if s.restore != nil {
+				s.restore.applied = make(map[uint32]bool)
+				s.saveRestoreProgress()
+			}
 			return errRetrySnapshot
 		case abci.ResponseApplySnapshotChunk_REJECT_SNAPSHOT:
 			return errRejectSnapshot
@@ -425,7 +596,9 @@ func (s *syncer) applyChunks(chunks *chunkQueue) error {
 }
 
 // fetchChunks requests chunks from peers, receiving allocations from the chunk queue. Chunks
-// will be received from the reactor via syncer.AddChunks() to chunkQueue.Add().
+// will be received from the reactor via syncer.AddChunks() to chunkQueue.Add(). Each chunk is
+// requested from the peer expected to respond first and, if it's slower than usual, from the
+// next best peer too, so that a slow peer doesn't stall the restoration.
 func (s *syncer) fetchChunks(ctx context.Context, snapshot *snapshot, chunks *chunkQueue) {
 	var (
 		next  = true
@@ -455,36 +628,60 @@ func (s *syncer) fetchChunks(ctx context.Context, snapshot *snapshot, chunks *ch
 		s.logger.Info("Fetching snapshot chunk", "height", snapshot.Height,
 			"format", snapshot.Format, "chunk", index, "total", chunks.Size())
 
-		ticker := time.NewTicker(s.retryTimeout)
-		defer ticker.Stop()
-
-		s.requestChunk(snapshot, index)
-
-		select {
-		case <-chunks.WaitFor(index):
-			next = true
+		peer := s.requestChunk(snapshot, index, "")
+		hedgeDelay := s.retryTimeout
+		if peer != nil {
+			hedgeDelay = s.scheduler.HedgeDelay(peer.ID())
+		}
+		retry := time.NewTimer(s.retryTimeout)
+		hedge := time.NewTimer(hedgeDelay)
+		arrived := chunks.WaitFor(index)
+
+	wait:
+		for {
+			select {
+			case <-arrived:
+				next = true
+				break wait
+
+			case <-hedge.C:
+				if peer != nil && s.requestChunk(snapshot, index, peer.ID()) != nil {
+					s.metrics.HedgedChunkRequests.Add(1)
+				}
 
-		case <-ticker.C:
-			next = false
+			case <-retry.C:
+				s.metrics.ChunkTimeouts.Add(float64(s.scheduler.Expire()))
+				next = false
+				break wait
 
-		case <-ctx.Done():
-			return
+			case <-ctx.Done():
+				retry.Stop()
+				hedge.Stop()
+				return
+			}
 		}
 
-		ticker.Stop()
+		retry.Stop()
+		hedge.Stop()
 	}
 }
 
-// requestChunk requests a chunk from a peer.
-func (s *syncer) requestChunk(snapshot *snapshot, chunk uint32) {
-	peer := s.snapshots.GetPeer(snapshot)
+// requestChunk requests a chunk from the peer expected to respond first, other than the excluded
+// one, and returns it. It returns nil if there is no such peer.
+func (s *syncer) requestChunk(snapshot *snapshot, chunk uint32, exclude p2p.ID) p2p.Peer {
+	peer := s.scheduler.Pick(s.snapshots.GetPeers(snapshot), exclude)
 	if peer == nil {
-		s.logger.Error("No valid peers found for snapshot", "height", snapshot.Height,
-			"format", snapshot.Format, "hash", snapshot.Hash)
-		return
+		if exclude == "" {
+			s.logger.Error("No valid peers found for snapshot", "height", snapshot.Height,
+				"format", snapshot.Format, "hash", snapshot.Hash)
+		}
+		return nil
 	}
 	s.logger.Debug("Requesting snapshot chunk", "height", snapshot.Height,
 		"format", snapshot.Format, "chunk", chunk, "peer", peer.ID())
+	// the request is recorded first, as the peer may respond before the send returns
+	s.scheduler.Requested(peer.ID(), chunk)
+	s.metrics.ChunkRequests.Add(1)
 	p2p.SendEnvelopeShim(peer, p2p.Envelope{ //nolint: staticcheck
 		ChannelID: ChunkChannel,
 		Message: &ssproto.ChunkRequest{
@@ -493,6 +690,7 @@ func (s *syncer) requestChunk(snapshot *snapshot, chunk uint32) {
 			Index:  chunk,
 		},
 	}, s.logger)
+	return peer
 }
 
 // verifyApp verifies the sync, checking the app hash, last block height and app version
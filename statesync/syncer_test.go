@@ -15,6 +15,7 @@ import (
 	ssproto "github.com/tendermint/tendermint/proto/tendermint/statesync"
 	tmversion "github.com/tendermint/tendermint/proto/tendermint/version"
 
+	"github.com/Finschia/ostracon/behaviour"
 	"github.com/Finschia/ostracon/config"
 	"github.com/Finschia/ostracon/libs/log"
 	tmsync "github.com/Finschia/ostracon/libs/sync"
@@ -38,7 +39,8 @@ func setupOfferSyncer(t *testing.T) (*syncer, *proxymocks.AppConnSnapshot) {
 	stateProvider := &mocks.StateProvider{}
 	stateProvider.On("AppHash", mock.Anything, mock.Anything).Return([]byte("app_hash"), nil)
 	cfg := config.DefaultStateSyncConfig()
-	syncer := newSyncer(*cfg, log.NewNopLogger(), connSnapshot, connQuery, stateProvider, "")
+	syncer := newSyncer(*cfg, log.NewNopLogger(), behaviour.NewMockReporter(), connSnapshot, connQuery, stateProvider, "",
+		NopMetrics())
 
 	return syncer, connSnapshot
 }
@@ -93,7 +95,8 @@ func TestSyncer_SyncAny(t *testing.T) {
 	connQuery := &proxymocks.AppConnQuery{}
 
 	cfg := config.DefaultStateSyncConfig()
-	syncer := newSyncer(*cfg, log.NewNopLogger(), connSnapshot, connQuery, stateProvider, "")
+	syncer := newSyncer(*cfg, log.NewNopLogger(), behaviour.NewMockReporter(), connSnapshot, connQuery, stateProvider, "",
+		NopMetrics())
 
 	// Adding a chunk should error when no sync is in progress
 	_, err := syncer.AddChunk(&chunk{Height: 1, Format: 1, Index: 0, Chunk: []byte{1}})
@@ -442,7 +445,8 @@ func TestSyncer_applyChunks_Results(t *testing.T) {
 			stateProvider.On("AppHash", mock.Anything, mock.Anything).Return([]byte("app_hash"), nil)
 
 			cfg := config.DefaultStateSyncConfig()
-			syncer := newSyncer(*cfg, log.NewNopLogger(), connSnapshot, connQuery, stateProvider, "")
+			syncer := newSyncer(*cfg, log.NewNopLogger(), behaviour.NewMockReporter(), connSnapshot, connQuery, stateProvider, "",
+				NopMetrics())
 
 			body := []byte{1, 2, 3}
 			chunks, err := newChunkQueue(&snapshot{Height: 1, Format: 1, Chunks: 1}, "")
@@ -495,7 +499,8 @@ func TestSyncer_applyChunks_RefetchChunks(t *testing.T) {
 			stateProvider.On("AppHash", mock.Anything, mock.Anything).Return([]byte("app_hash"), nil)
 
 			cfg := config.DefaultStateSyncConfig()
-			syncer := newSyncer(*cfg, log.NewNopLogger(), connSnapshot, connQuery, stateProvider, "")
+			syncer := newSyncer(*cfg, log.NewNopLogger(), behaviour.NewMockReporter(), connSnapshot, connQuery, stateProvider, "",
+				NopMetrics())
 
 			chunks, err := newChunkQueue(&snapshot{Height: 1, Format: 1, Chunks: 3}, "")
 			require.NoError(t, err)
@@ -560,7 +565,9 @@ func TestSyncer_applyChunks_RejectSenders(t *testing.T) {
 			stateProvider.On("AppHash", mock.Anything, mock.Anything).Return([]byte("app_hash"), nil)
 
 			cfg := config.DefaultStateSyncConfig()
-			syncer := newSyncer(*cfg, log.NewNopLogger(), connSnapshot, connQuery, stateProvider, "")
+			reporter := behaviour.NewMockReporter()
+			syncer := newSyncer(*cfg, log.NewNopLogger(), reporter, connSnapshot, connQuery, stateProvider, "",
+				NopMetrics())
 
 			// Set up three peers across two snapshots, and ask for one of them to be banned.
 			// It should be banned from all snapshots.
@@ -635,6 +642,9 @@ func TestSyncer_applyChunks_RejectSenders(t *testing.T) {
 			assert.EqualValues(t, "a", s1peers[0].ID())
 			assert.EqualValues(t, "c", s1peers[1].ID())
 
+			assert.Equal(t, []behaviour.PeerBehaviour{behaviour.BadChunk(peerB.ID(), "chunk rejected by app")},
+				reporter.GetBehaviours(peerB.ID()))
+
 			err = chunks.Close()
 			require.NoError(t, err)
 		})
@@ -683,7 +693,8 @@ func TestSyncer_verifyApp(t *testing.T) {
 			stateProvider := &mocks.StateProvider{}
 
 			cfg := config.DefaultStateSyncConfig()
-			syncer := newSyncer(*cfg, log.NewNopLogger(), connSnapshot, connQuery, stateProvider, "")
+			syncer := newSyncer(*cfg, log.NewNopLogger(), behaviour.NewMockReporter(), connSnapshot, connQuery, stateProvider, "",
+				NopMetrics())
 
 			connQuery.On("InfoSync", proxy.RequestInfo).Return(tc.response, tc.err)
 			err := syncer.verifyApp(s, appVersion)
@@ -702,7 +713,8 @@ func TestSyncer_Sync(t *testing.T) {
 	stateProvider := &mocks.StateProvider{}
 
 	cfg := config.DefaultStateSyncConfig()
-	syncer := newSyncer(*cfg, log.NewNopLogger(), connSnapshot, connQuery, stateProvider, "")
+	syncer := newSyncer(*cfg, log.NewNopLogger(), behaviour.NewMockReporter(), connSnapshot, connQuery, stateProvider, "",
+		NopMetrics())
 	snapshot := &snapshot{}
 	chunkQueue := &chunkQueue{}
 
@@ -803,6 +815,42 @@ func TestSyncer_Sync(t *testing.T) {
 	}
 }
 
+func TestSyncer_Progress(t *testing.T) {
+	cfg := config.DefaultStateSyncConfig()
+	syncer := newSyncer(*cfg, log.NewNopLogger(), behaviour.NewMockReporter(), &proxymocks.AppConnSnapshot{},
+		&proxymocks.AppConnQuery{}, &mocks.StateProvider{}, "", NopMetrics())
+	assert.Nil(t, syncer.Progress())
+
+	s := &snapshot{Height: 3, Format: 1, Chunks: 4}
+	chunks, err := newChunkQueue(s, "")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = chunks.Close() })
+	_, err = chunks.Add(&chunk{Height: 3, Format: 1, Index: 0, Chunk: []byte{0}})
+	require.NoError(t, err)
+
+	// the chunks already in the queue don't count towards the fetch rate
+	syncer.chunks, syncer.snapshot = chunks, s
+	syncer.started, syncer.fetchedAtStart = time.Now().Add(-2*time.Second), 1
+	progress := syncer.Progress()
+	require.NotNil(t, progress)
+	assert.EqualValues(t, 1, progress.ChunksFetched)
+	assert.Zero(t, progress.ETA)
+
+	syncer.scheduler.Requested("a", 1)
+	added, err := syncer.AddChunk(&chunk{Height: 3, Format: 1, Index: 1, Chunk: []byte{1, 1}, Sender: "a"})
+	require.NoError(t, err)
+	require.True(t, added)
+
+	progress = syncer.Progress()
+	assert.EqualValues(t, 3, progress.SnapshotHeight)
+	assert.EqualValues(t, 4, progress.ChunksTotal)
+	assert.EqualValues(t, 2, progress.ChunksFetched)
+	assert.EqualValues(t, 2, progress.BytesFetched)
+	assert.InDelta(t, float64(4*time.Second), float64(progress.ETA), float64(time.Second))
+	require.Len(t, progress.Peers, 1)
+	assert.Equal(t, 1, progress.Peers[0].Received)
+}
+
 func toABCI(s *snapshot) *abci.Snapshot {
 	return &abci.Snapshot{
 		Height:   s.Height,
@@ -198,6 +198,34 @@ func (bs *BlockStore) LoadBlockMeta(height int64) *types.BlockMeta {
 	return blockMeta
 }
 
+// LoadBlockEntropy returns the Entropy of the block with the given height,
+// without loading the whole block.
+// If no block is found for that height, it returns nil.
+func (bs *BlockStore) LoadBlockEntropy(height int64) *types.Entropy {
+	bz, err := bs.db.Get(calcBlockEntropyKey(height))
+	if err != nil {
+		panic(err)
+	}
+	if len(bz) == 0 {
+		// The blocks saved by former versions have no separate entropy
+		block := bs.LoadBlock(height)
+		if block == nil {
+			return nil
+		}
+		return &block.Entropy
+	}
+	pbe := new(ocproto.Entropy)
+	err = proto.Unmarshal(bz, pbe)
+	if err != nil {
+		panic(fmt.Errorf("unmarshal to ocproto.Entropy: %w", err))
+	}
+	entropy, err := types.EntropyFromProto(pbe)
+	if err != nil {
+		panic(fmt.Errorf("error from proto entropy: %w", err))
+	}
+	return &entropy
+}
+
 // LoadBlockCommit returns the Commit for the given height.
 // This commit consists of the +2/3 and other Precommit-votes for block at `height`,
 // and it comes from the block.LastCommit for `height+1`.
@@ -299,6 +327,9 @@ func (bs *BlockStore) PruneBlocks(height int64) (uint64, error) {
 		if err := batch.Delete(calcSeenCommitKey(h)); err != nil {
 			return 0, err
 		}
+		if err := batch.Delete(calcBlockEntropyKey(h)); err != nil {
+			return 0, err
+		}
 		for p := 0; p < int(meta.BlockID.PartSetHeader.Total); p++ {
 			if err := batch.Delete(calcBlockPartKey(h, p)); err != nil {
 				return 0, err
@@ -355,6 +386,12 @@ func (bs *BlockStore) SaveBlock(block *types.Block, blockParts *types.PartSet, s
 		bs.saveBlockPart(height, i, part)
 	}
 
+	// Save block entropy (duplicate and separate from the Block)
+	entropyBytes := mustEncode(block.Entropy.ToProto())
+	if err := bs.db.Set(calcBlockEntropyKey(height), entropyBytes); err != nil {
+		panic(err)
+	}
+
 	// Save block meta
 	blockMeta := types.NewBlockMeta(block, blockParts)
 	pbm := blockMeta.ToProto()
@@ -449,6 +486,10 @@ func calcSeenCommitKey(height int64) []byte {
 	return []byte(fmt.Sprintf("SC:%v", height))
 }
 
+func calcBlockEntropyKey(height int64) []byte {
+	return []byte(fmt.Sprintf("E:%v", height))
+}
+
 func calcBlockHashKey(hash []byte) []byte {
 	return []byte(fmt.Sprintf("BH:%x", hash))
 }
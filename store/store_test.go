@@ -434,6 +434,33 @@ func TestLoadBlockPart(t *testing.T) {
 		"expecting successful retrieval of previously saved block")
 }
 
+func TestLoadBlockEntropy(t *testing.T) {
+	config := cfg.ResetTestRoot("blockchain_reactor_test")
+	defer os.RemoveAll(config.RootDir)
+	stateStore := sm.NewStore(dbm.NewMemDB(), sm.StoreOptions{
+		DiscardABCIResponses: false,
+	})
+	state, err := stateStore.LoadFromDBOrGenesisFile(config.GenesisFile())
+	require.NoError(t, err)
+	db := dbm.NewMemDB()
+	bs := NewBlockStore(db)
+
+	require.Nil(t, bs.LoadBlockEntropy(1))
+
+	for h := int64(1); h <= 2; h++ {
+		block := makeBlock(h, state, new(types.Commit))
+		partSet := block.MakePartSet(2)
+		seenCommit := makeTestCommit(h, tmtime.Now())
+		bs.SaveBlock(block, partSet, seenCommit)
+	}
+	assert.Equal(t, &bs.LoadBlock(1).Entropy, bs.LoadBlockEntropy(1))
+
+	// The entropy of the blocks saved by former versions is loaded from the block
+	err = db.Delete(calcBlockEntropyKey(2))
+	require.NoError(t, err)
+	assert.Equal(t, &bs.LoadBlock(2).Entropy, bs.LoadBlockEntropy(2))
+}
+
 func TestPruneBlocks(t *testing.T) {
 	config := cfg.ResetTestRoot("blockchain_reactor_test")
 	defer os.RemoveAll(config.RootDir)
@@ -487,6 +514,7 @@ func TestPruneBlocks(t *testing.T) {
 	require.Nil(t, bs.LoadBlockCommit(1199))
 	require.Nil(t, bs.LoadBlockMeta(1199))
 	require.Nil(t, bs.LoadBlockPart(1199, 1))
+	require.Nil(t, bs.LoadBlockEntropy(1199))
 
 	for i := int64(1); i < 1200; i++ {
 		require.Nil(t, bs.LoadBlock(i))
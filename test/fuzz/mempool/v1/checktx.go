@@ -1,15 +1,12 @@
-//go:build deprecated
-
 package v1
 
 import (
-	"github.com/tendermint/tendermint/abci/example/kvstore"
-	"github.com/tendermint/tendermint/config"
-	"github.com/tendermint/tendermint/libs/log"
-	mempl "github.com/tendermint/tendermint/mempool"
-	"github.com/tendermint/tendermint/proxy"
-
-	mempoolv1 "github.com/tendermint/tendermint/mempool/v1"
+	"github.com/Finschia/ostracon/abci/example/kvstore"
+	"github.com/Finschia/ostracon/config"
+	"github.com/Finschia/ostracon/libs/log"
+	mempl "github.com/Finschia/ostracon/mempool"
+	mempoolv1 "github.com/Finschia/ostracon/mempool/v1"
+	"github.com/Finschia/ostracon/proxy"
 )
 
 var mempool mempl.Mempool
@@ -22,6 +19,7 @@ func init() {
 	if err != nil {
 		panic(err)
 	}
+
 	cfg := config.DefaultMempoolConfig()
 	cfg.Broadcast = false
 	log := log.NewNopLogger()
@@ -29,8 +27,7 @@ func init() {
 }
 
 func Fuzz(data []byte) int {
-
-	err := mempool.CheckTx(data, nil, mempl.TxInfo{})
+	err := mempool.CheckTxSync(data, nil, mempl.TxInfo{})
 	if err != nil {
 		return 0
 	}
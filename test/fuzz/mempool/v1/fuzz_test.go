@@ -1,5 +1,3 @@
-//go:build deprecated
-
 package v1_test
 
 import (
@@ -10,7 +8,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 
-	mempoolv1 "github.com/tendermint/tendermint/test/fuzz/mempool/v1"
+	mempoolv1 "github.com/Finschia/ostracon/test/fuzz/mempool/v1"
 )
 
 const testdataCasesDir = "testdata/cases"
@@ -35,6 +35,7 @@ const (
 
 	// 🏺 Note that this value is the encoded size of the ProtocolBuffer. See TestMaxEntropyBytes() for how Tendermint
 	//  calculates this value. Add/remove Ostracon-specific field sizes to/from this heuristically determined constant.
+	//  It's the size with an ed25519 proof; see MaxEntropyBytesForKeyTypes() for the other key types.
 	MaxEntropyBytes int64 = (1 + 5) + // +Round
 		(2 + int64(vrf.ProofSize)) // +Proof
 
@@ -316,16 +317,34 @@ func BlockFromProto(bp *ocproto.Block) (*Block, error) {
 
 //-----------------------------------------------------------------------------
 
-// MaxDataBytes returns the maximum size of block's data.
+// MaxEntropyBytesForKeyTypes returns the maximum size of the entropy of a
+// block whose proposer has a key of one of the given types, i.e. with the
+// largest VRF proof among them.
+func MaxEntropyBytesForKeyTypes(pubKeyTypes []string) int64 {
+	maxEntropyBytes := MaxEntropyBytes
+	for _, keyType := range pubKeyTypes {
+		size, ok := vrfProofSizes[keyType]
+		if !ok {
+			continue
+		}
+		if entropyBytes := MaxEntropyBytes - int64(vrf.ProofSize) + int64(size); entropyBytes > maxEntropyBytes {
+			maxEntropyBytes = entropyBytes
+		}
+	}
+	return maxEntropyBytes
+}
+
+// MaxDataBytes returns the maximum size of block's data, given the key types
+// of the validators.
 //
 // XXX: Panics on negative result.
-func MaxDataBytes(maxBytes, evidenceBytes int64, valsCount int) int64 {
+func MaxDataBytes(maxBytes, evidenceBytes int64, valsCount int, pubKeyTypes []string) int64 {
 	maxDataBytes := maxBytes -
 		MaxOverheadForBlock -
 		MaxHeaderBytes -
 		MaxCommitBytes(valsCount) -
 		evidenceBytes -
-		MaxEntropyBytes
+		MaxEntropyBytesForKeyTypes(pubKeyTypes)
 
 	if maxDataBytes < 0 {
 		panic(fmt.Sprintf(
@@ -339,15 +358,15 @@ func MaxDataBytes(maxBytes, evidenceBytes int64, valsCount int) int64 {
 }
 
 // MaxDataBytesNoEvidence returns the maximum size of block's data when
-// evidence count is unknown. MaxEvidencePerBlock will be used for the size
-// of evidence.
+// evidence count is unknown, given the key types of the validators.
+// MaxEvidencePerBlock will be used for the size of evidence.
 //
 // XXX: Panics on negative result.
-func MaxDataBytesNoEvidence(maxBytes int64, valsCount int) int64 {
+func MaxDataBytesNoEvidence(maxBytes int64, valsCount int, pubKeyTypes []string) int64 {
 	maxDataBytes := maxBytes -
 		MaxOverheadForBlock -
 		MaxHeaderBytes -
-		MaxEntropyBytes -
+		MaxEntropyBytesForKeyTypes(pubKeyTypes) -
 		MaxCommitBytes(valsCount)
 
 	if maxDataBytes < 0 {
@@ -23,6 +23,7 @@ import (
 
 	"github.com/Finschia/ostracon/crypto"
 	"github.com/Finschia/ostracon/crypto/merkle"
+	"github.com/Finschia/ostracon/crypto/secp256k1"
 	"github.com/Finschia/ostracon/crypto/tmhash"
 	"github.com/Finschia/ostracon/libs/bits"
 	"github.com/Finschia/ostracon/libs/bytes"
@@ -617,6 +618,8 @@ func hexBytesFromString(s string) bytes.HexBytes {
 	return bytes.HexBytes(b)
 }
 
+var ed25519KeyTypes = []string{ABCIPubKeyTypeEd25519}
+
 func TestBlockMaxDataBytes(t *testing.T) {
 	testCases := []struct {
 		maxBytes      int64
@@ -642,12 +645,12 @@ func TestBlockMaxDataBytes(t *testing.T) {
 		tc := tc
 		if tc.panics {
 			assert.Panics(t, func() {
-				MaxDataBytes(tc.maxBytes, tc.evidenceBytes, tc.valsCount)
+				MaxDataBytes(tc.maxBytes, tc.evidenceBytes, tc.valsCount, ed25519KeyTypes)
 			}, "#%v", i)
 		} else {
 			assert.Equal(t,
 				tc.result,
-				MaxDataBytes(tc.maxBytes, tc.evidenceBytes, tc.valsCount),
+				MaxDataBytes(tc.maxBytes, tc.evidenceBytes, tc.valsCount, ed25519KeyTypes),
 				"#%v", i)
 		}
 	}
@@ -674,20 +677,40 @@ func TestBlockMaxDataBytesNoEvidence(t *testing.T) {
 		tc := tc
 		if tc.panics {
 			assert.Panics(t, func() {
-				MaxDataBytesNoEvidence(tc.maxBytes, tc.valsCount)
+				MaxDataBytesNoEvidence(tc.maxBytes, tc.valsCount, ed25519KeyTypes)
 			}, "#%v", i)
 		} else {
 			assert.NotPanics(t, func() {
-				MaxDataBytesNoEvidence(tc.maxBytes, tc.valsCount)
+				MaxDataBytesNoEvidence(tc.maxBytes, tc.valsCount, ed25519KeyTypes)
 			}, "#%v", i)
 			assert.Equal(t,
 				tc.result,
-				MaxDataBytesNoEvidence(tc.maxBytes, tc.valsCount),
+				MaxDataBytesNoEvidence(tc.maxBytes, tc.valsCount, ed25519KeyTypes),
 				"#%v", i)
 		}
 	}
 }
 
+func TestBlockMaxDataBytesByKeyTypes(t *testing.T) {
+	// the default ed25519 keys leave the same room for the data as before secp256k1 supported VRF,
+	// which is 1 byte less when secp256k1 keys are allowed
+	testCases := []struct {
+		pubKeyTypes   []string
+		result        int64
+		noEvidenceRes int64
+	}{
+		{nil, 19991, 20091},
+		{[]string{ABCIPubKeyTypeEd25519}, 19991, 20091},
+		{[]string{ABCIPubKeyTypeEd25519, ABCIPubKeyTypeSr25519}, 19991, 20091},
+		{[]string{ABCIPubKeyTypeSecp256k1}, 19990, 20090},
+		{[]string{ABCIPubKeyTypeEd25519, ABCIPubKeyTypeSecp256k1}, 19990, 20090},
+	}
+	for i, tc := range testCases {
+		assert.EqualValues(t, tc.result, MaxDataBytes(22020, 100, 10, tc.pubKeyTypes), "#%v", i)
+		assert.EqualValues(t, tc.noEvidenceRes, MaxDataBytesNoEvidence(22020, 10, tc.pubKeyTypes), "#%v", i)
+	}
+}
+
 func TestCommitToVoteSet(t *testing.T) {
 	lastID := makeBlockIDRandom()
 	h := int64(3)
@@ -1152,20 +1175,31 @@ func TestEntropyValidateBasic(t *testing.T) {
 }
 
 func TestMaxEntropyBytes(t *testing.T) {
-	proof := make([]byte, vrf.ProofSize)
-	for i := 0; i < len(proof); i++ {
-		proof[i] = 0xFF
+	testCases := []struct {
+		proofSize   int
+		pubKeyTypes []string
+	}{
+		{vrf.ProofSize, nil},
+		{vrf.ProofSize, []string{ABCIPubKeyTypeEd25519}},
+		{secp256k1.ProofSize, []string{ABCIPubKeyTypeSecp256k1}},
 	}
+	for i, tc := range testCases {
+		proof := make([]byte, tc.proofSize)
+		for i := 0; i < len(proof); i++ {
+			proof[i] = 0xFF
+		}
 
-	h := Entropy{
-		Round: math.MaxInt32,
-		Proof: proof,
-	}
+		h := Entropy{
+			Round: math.MaxInt32,
+			Proof: proof,
+		}
 
-	bz, err := h.ToProto().Marshal()
-	require.NoError(t, err)
+		bz, err := h.ToProto().Marshal()
+		require.NoError(t, err)
 
-	assert.EqualValues(t, MaxEntropyBytes, int64(len(bz)))
+		assert.EqualValues(t, MaxEntropyBytesForKeyTypes(tc.pubKeyTypes), int64(len(bz)), "#%v", i)
+	}
+	assert.EqualValues(t, MaxEntropyBytes, MaxEntropyBytesForKeyTypes(nil))
 }
 
 func makeEntropyHeader() Entropy {
@@ -110,7 +110,7 @@ func (b *EventBus) Publish(eventType string, eventData OCEventData) error {
 // map of stringified events where each key is composed of the event
 // type and each of the event's attributes keys in the form of
 // "{event.Type}.{attribute.Key}" and the value is each attribute's value.
-func (b *EventBus) validateAndStringifyEvents(events []types.Event, logger log.Logger) map[string][]string {
+func validateAndStringifyEvents(events []types.Event, logger log.Logger) map[string][]string {
 	result := make(map[string][]string)
 	for _, event := range events {
 		if len(event.Type) == 0 {
@@ -136,27 +136,40 @@ func (b *EventBus) PublishEventNewBlock(data EventDataNewBlock) error {
 	// no explicit deadline for publishing events
 	ctx := context.Background()
 
+	events := NewBlockEvents(data, b.Logger.With("block", data.Block.StringShort()))
+	return b.pubsub.PublishWithEvents(ctx, data, events)
+}
+
+// NewBlockEvents returns the events a NewBlock event is published with.
+func NewBlockEvents(data EventDataNewBlock, logger log.Logger) map[string][]string {
 	resultEvents := append(data.ResultBeginBlock.Events, data.ResultEndBlock.Events...)
-	events := b.validateAndStringifyEvents(resultEvents, b.Logger.With("block", data.Block.StringShort()))
+	events := validateAndStringifyEvents(resultEvents, logger)
 
 	// add predefined new block event
 	events[EventTypeKey] = append(events[EventTypeKey], EventNewBlock)
 
-	return b.pubsub.PublishWithEvents(ctx, data, events)
+	return events
 }
 
 func (b *EventBus) PublishEventNewBlockHeader(data EventDataNewBlockHeader) error {
 	// no explicit deadline for publishing events
 	ctx := context.Background()
 
-	resultTags := append(data.ResultBeginBlock.Events, data.ResultEndBlock.Events...)
 	// TODO: Create StringShort method for Header and use it in logger.
-	events := b.validateAndStringifyEvents(resultTags, b.Logger.With("header", data.Header))
+	events := NewBlockHeaderEvents(data, b.Logger.With("header", data.Header))
+	return b.pubsub.PublishWithEvents(ctx, data, events)
+}
+
+// NewBlockHeaderEvents returns the events a NewBlockHeader event is published
+// with.
+func NewBlockHeaderEvents(data EventDataNewBlockHeader, logger log.Logger) map[string][]string {
+	resultTags := append(data.ResultBeginBlock.Events, data.ResultEndBlock.Events...)
+	events := validateAndStringifyEvents(resultTags, logger)
 
 	// add predefined new block header event
 	events[EventTypeKey] = append(events[EventTypeKey], EventNewBlockHeader)
 
-	return b.pubsub.PublishWithEvents(ctx, data, events)
+	return events
 }
 
 func (b *EventBus) PublishEventNewEvidence(evidence EventDataNewEvidence) error {
@@ -178,14 +191,21 @@ func (b *EventBus) PublishEventTx(data EventDataTx) error {
 	// no explicit deadline for publishing events
 	ctx := context.Background()
 
-	events := b.validateAndStringifyEvents(data.Result.Events, b.Logger.With("tx", data.Tx))
+	events := TxEvents(data, b.Logger.With("tx", data.Tx))
+	return b.pubsub.PublishWithEvents(ctx, data, events)
+}
+
+// TxEvents returns the events a Tx event is published with, including the
+// predefined keys (EventTypeKey, TxHashKey, TxHeightKey).
+func TxEvents(data EventDataTx, logger log.Logger) map[string][]string {
+	events := validateAndStringifyEvents(data.Result.Events, logger)
 
 	// add predefined compositeKeys
 	events[EventTypeKey] = append(events[EventTypeKey], EventTx)
 	events[TxHashKey] = append(events[TxHashKey], fmt.Sprintf("%X", Tx(data.Tx).Hash()))
 	events[TxHeightKey] = append(events[TxHeightKey], fmt.Sprintf("%d", data.Height))
 
-	return b.pubsub.PublishWithEvents(ctx, data, events)
+	return events
 }
 
 func (b *EventBus) PublishEventNewRoundStep(data EventDataRoundState) error {
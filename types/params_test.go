@@ -41,6 +41,8 @@ func TestConsensusParamsValidation(t *testing.T) {
 		13: {makeParams(1, 0, 10, 2, 0, []string{}), false},
 		// test invalid pubkey type provided
 		14: {makeParams(1, 0, 10, 2, 0, []string{"potatoes make good pubkeys"}), false},
+		// test pubkey type not supported by the proto encoding
+		15: {makeParams(1, 0, 10, 2, 0, []string{ABCIPubKeyTypeSr25519}), false},
 	}
 	for i, tc := range testCases {
 		if tc.valid {
@@ -1,6 +1,7 @@
 package types
 
 import (
+	vrf "github.com/oasisprotocol/curve25519-voi/primitives/ed25519/extra/ecvrf"
 	abci "github.com/tendermint/tendermint/abci/types"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
@@ -8,6 +9,7 @@ import (
 	"github.com/Finschia/ostracon/crypto/ed25519"
 	cryptoenc "github.com/Finschia/ostracon/crypto/encoding"
 	"github.com/Finschia/ostracon/crypto/secp256k1"
+	"github.com/Finschia/ostracon/crypto/sr25519"
 )
 
 //-------------------------------------------------------
@@ -16,6 +18,10 @@ import (
 const (
 	ABCIPubKeyTypeEd25519   = ed25519.KeyType
 	ABCIPubKeyTypeSecp256k1 = secp256k1.KeyType
+	// ABCIPubKeyTypeSr25519 can't be used by validators until
+	// tendermint.crypto.PublicKey has an sr25519 variant, see
+	// crypto/encoding.PubKeyToProto.
+	ABCIPubKeyTypeSr25519 = sr25519.KeyType
 )
 
 // TODO: Make non-global by allowing for registration of more pubkey types
@@ -25,6 +31,21 @@ var ABCIPubKeyTypesToNames = map[string]string{
 	ABCIPubKeyTypeSecp256k1: secp256k1.PubKeyName,
 }
 
+// vrfProofSizes are the sizes of the VRF proofs generated by the key types
+// which are able to generate one, i.e. whose validators are able to propose a
+// block.
+var vrfProofSizes = map[string]int{
+	ABCIPubKeyTypeEd25519:   vrf.ProofSize,
+	ABCIPubKeyTypeSecp256k1: secp256k1.ProofSize,
+}
+
+// IsVRFSupportedKeyType returns true if keys of the given type are able to
+// generate and verify a VRF proof.
+func IsVRFSupportedKeyType(keyType string) bool {
+	_, ok := vrfProofSizes[keyType]
+	return ok
+}
+
 //-------------------------------------------------------
 
 // OC2PB is used for converting Ostracon ABCI to protobuf ABCI.
@@ -27,6 +27,12 @@ func TestABCIPubKey(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestIsVRFSupportedKeyType(t *testing.T) {
+	assert.True(t, IsVRFSupportedKeyType(ABCIPubKeyTypeEd25519))
+	assert.True(t, IsVRFSupportedKeyType(ABCIPubKeyTypeSecp256k1))
+	assert.False(t, IsVRFSupportedKeyType("sr25519"))
+}
+
 func testABCIPubKey(t *testing.T, pk crypto.PubKey, typeStr string) error {
 	abciPubKey, err := cryptoenc.PubKeyToProto(pk)
 	require.NoError(t, err)
@@ -4,7 +4,9 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/Finschia/ostracon/crypto"
 	"github.com/Finschia/ostracon/crypto/ed25519"
+	"github.com/Finschia/ostracon/crypto/secp256k1"
 	"github.com/Finschia/ostracon/crypto/tmhash"
 	tmtime "github.com/Finschia/ostracon/types/time"
 )
@@ -40,6 +42,26 @@ func ValidateHash(h []byte) error {
 	return nil
 }
 
+// ValidateProof returns an error if the size of the proof is neither the one
+// of ed25519 nor secp256k1.
 func ValidateProof(h []byte) error {
-	return ed25519.ValidateProof(h)
+	if err := ed25519.ValidateProof(h); err != nil {
+		if secp256k1.ValidateProof(h) == nil {
+			return nil
+		}
+		return err
+	}
+	return nil
+}
+
+// ProofToHash returns the VRF output of the proof generated by the given
+// public key. Since the proofs of different key types may have the same
+// size, the key type is necessary to decode the proof.
+func ProofToHash(pubKey crypto.PubKey, proof []byte) ([]byte, error) {
+	switch pubKey.Type() {
+	case secp256k1.KeyType:
+		return secp256k1.ProofToHash(proof)
+	default:
+		return ed25519.ProofToHash(proof)
+	}
 }
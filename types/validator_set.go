@@ -807,10 +807,9 @@ func (vals *ValidatorSet) SelectProposer(proofHash []byte, height int64, round i
 	if vals.IsNilOrEmpty() {
 		panic("empty validator set")
 	}
-	seed := hashToSeed(MakeRoundHash(proofHash, height, round))
-	random := nextRandom(&seed)
+	seed := ProposerSeed(MakeRoundHash(proofHash, height, round))
 	totalVotingPower := vals.TotalVotingPower()
-	thresholdVotingPower := dividePoint(random, totalVotingPower)
+	thresholdVotingPower := ProposerThreshold(seed, totalVotingPower)
 	threshold := thresholdVotingPower
 	for _, val := range vals.Validators {
 		if threshold < uint64(val.VotingPower) {
@@ -823,8 +822,20 @@ func (vals *ValidatorSet) SelectProposer(proofHash []byte, height int64, round i
 	//   1) The totalVotingPower is not equal to the actual total VotingPower.
 	//   2) The length of vals.Validators is zero (but checked above).
 	// Both are due to unexpected state irregularities and can be identified by the output error message.
-	panic(fmt.Sprintf("Cannot select samples; r=%d, thresholdVotingPower=%d, totalVotingPower=%d: %+v",
-		random, thresholdVotingPower, totalVotingPower, vals))
+	panic(fmt.Sprintf("Cannot select samples; seed=%d, thresholdVotingPower=%d, totalVotingPower=%d: %+v",
+		seed, thresholdVotingPower, totalVotingPower, vals))
+}
+
+// ProposerSeed returns the seed that SelectProposer derives from the round hash.
+func ProposerSeed(roundHash []byte) uint64 {
+	return hashToSeed(roundHash)
+}
+
+// ProposerThreshold returns the voting power threshold drawn from the seed. SelectProposer elects the first
+// validator, in the canonical order, whose cumulative voting power exceeds the threshold.
+func ProposerThreshold(seed uint64, totalVotingPower int64) uint64 {
+	random := nextRandom(&seed)
+	return dividePoint(random, totalVotingPower)
 }
 
 var divider *big.Int
@@ -226,6 +226,31 @@ func TestProposerSelection1(t *testing.T) {
 	}
 }
 
+func TestProposerThreshold(t *testing.T) {
+	vset := NewValidatorSet([]*Validator{
+		newValidator([]byte("foo"), 1000),
+		newValidator([]byte("bar"), 300),
+		newValidator([]byte("baz"), 330),
+	})
+	for i := 0; i < 99; i++ {
+		seed := ProposerSeed(MakeRoundHash([]byte{}, int64(i), 0))
+		threshold := ProposerThreshold(seed, vset.TotalVotingPower())
+		require.Less(t, threshold, uint64(vset.TotalVotingPower()))
+
+		// the proposer is the first validator whose cumulative voting power exceeds the threshold
+		var elected *Validator
+		cumulative := uint64(0)
+		for _, val := range vset.Validators {
+			cumulative += uint64(val.VotingPower)
+			if threshold < cumulative {
+				elected = val
+				break
+			}
+		}
+		assert.Equal(t, vset.SelectProposer([]byte{}, int64(i), 0).Address, elected.Address)
+	}
+}
+
 func TestProposerSelection2(t *testing.T) {
 	addr0 := []byte{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}
 	addr1 := []byte{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1}